@@ -0,0 +1,161 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/0utl1er-tech/prism-backend/internal/service"
+	"github.com/0utl1er-tech/prism-backend/internal/store"
+	"github.com/0utl1er-tech/prism-backend/internal/util"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+func newBookCommand(cfg *util.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "book",
+		Short: "Import and export customer lists",
+	}
+	cmd.AddCommand(newBookImportCommand(cfg), newBookExportCommand(cfg))
+	return cmd
+}
+
+// newBookImportCommand CSVの顧客を CreateCustomer と同じ検証で顧客リストに登録する
+func newBookImportCommand(cfg *util.Config) *cobra.Command {
+	var (
+		userID string
+		bookID string
+		name   string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "import FILE",
+		Short: "Import customers from a CSV file with a header row",
+		Long:  "Import customers from a CSV file. Columns: " + strings.Join(service.CustomerImportColumns, ", "),
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			actorID, err := uuid.Parse(userID)
+			if err != nil {
+				return fmt.Errorf("invalid --user: %w", err)
+			}
+			if (bookID == "") == (name == "") {
+				return errors.New("specify either --book or --name")
+			}
+
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			return withStore(cmd.Context(), cfg, func(dbStore, systemStore store.Store) error {
+				ctx, err := actAs(cmd.Context(), systemStore, actorID)
+				if err != nil {
+					return err
+				}
+				if name != "" {
+					bookID, err = createBook(ctx, dbStore, actorID, name)
+					if err != nil {
+						return err
+					}
+				}
+
+				customers := service.NewCustomerService(dbStore, nil, cfg.BatchMaxSize)
+				count, err := importCustomers(ctx, customers, bookID, file)
+				fmt.Printf("book: %s\nimported: %d\n", bookID, count)
+				return err
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&userID, "user", "", "ID of the user to import as")
+	cmd.Flags().StringVar(&bookID, "book", "", "ID of an existing customer list")
+	cmd.Flags().StringVar(&name, "name", "", "name of a new customer list to create")
+	cmd.MarkFlagRequired("user")
+	cmd.MarkFlagsMutuallyExclusive("book", "name")
+	return cmd
+}
+
+func newBookExportCommand(cfg *util.Config) *cobra.Command {
+	var (
+		userID     string
+		output     string
+		forCalling bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "export BOOK_ID",
+		Short: "Export the customers of a customer list as CSV",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			actorID, err := uuid.Parse(userID)
+			if err != nil {
+				return fmt.Errorf("invalid --user: %w", err)
+			}
+			bookID, err := uuid.Parse(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid book ID: %w", err)
+			}
+
+			out := os.Stdout
+			if output != "" {
+				out, err = os.Create(output)
+				if err != nil {
+					return err
+				}
+				defer out.Close()
+			}
+
+			return withStore(cmd.Context(), cfg, func(dbStore, systemStore store.Store) error {
+				ctx, err := actAs(cmd.Context(), systemStore, actorID)
+				if err != nil {
+					return err
+				}
+				// 他の組織の顧客リストは行レベルセキュリティで見えない
+				_, err = dbStore.GetBook(ctx, bookID)
+				if err != nil {
+					return fmt.Errorf("book %s: %w", bookID, err)
+				}
+				return service.NewCustomerService(dbStore, nil, cfg.BatchMaxSize).ExportCSV(ctx, bookID, forCalling, out)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&userID, "user", "", "ID of the user to export as")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "file to write instead of stdout")
+	cmd.Flags().BoolVar(&forCalling, "for-calling", false, "exclude customers whose phone number is on the do-not-call list")
+	cmd.MarkFlagRequired("user")
+	return cmd
+}
+
+func createBook(ctx context.Context, dbStore store.Store, actorID uuid.UUID, name string) (string, error) {
+	auditCtx := store.WithAudit(ctx, store.Audit{ActorID: actorID, RPC: "cli.book.import"})
+	var book db.Book
+	err := dbStore.ExecTx(auditCtx, func(q *db.Queries) error {
+		var err error
+		book, err = q.CreateBook(auditCtx, db.CreateBookParams{
+			ID:   uuid.New(),
+			Name: name,
+		})
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return book.ID.String(), nil
+}
+
+// importCustomers 1行ずつ登録する。エラーの行で中断し、それまでの行は登録済みのまま残る
+func importCustomers(ctx context.Context, customers *service.CustomerService, bookID string, r io.Reader) (int, error) {
+	return customers.ImportCSV(ctx, bookID, r, func(row service.CustomerCSVRow) error {
+		if row.Err != nil {
+			return fmt.Errorf("line %d: %w", row.Line, row.Err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"slices"
+	"strings"
+	"testing"
+
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/0utl1er-tech/prism-backend/internal/dbtest"
+	"github.com/0utl1er-tech/prism-backend/internal/service"
+	"github.com/google/uuid"
+)
+
+func TestImportCustomers(t *testing.T) {
+	dbStore, systemStore := dbtest.Open(t)
+	ctx, user := dbtest.CreateOrganization(t, systemStore)
+
+	bookID, err := createBook(ctx, dbStore, user.ID, "import test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	csv := "\ufeffname,corporation,phone,mail,leader,pic\n" +
+		"山田商店,株式会社山田,03-1234-5678,info@yamada.example.jp,山田 太郎,佐藤 花子\n" +
+		"鈴木工業,,06-1234-5678,,,\n"
+	customers := service.NewCustomerService(dbStore, nil, 1000)
+	count, err := importCustomers(ctx, customers, bookID, strings.NewReader(csv))
+	if err != nil {
+		t.Fatalf("importCustomers() error = %v", err)
+	}
+	if count != 2 {
+		t.Fatalf("importCustomers() = %d, want 2", count)
+	}
+
+	rows, err := dbStore.GetCustomerByBookId(ctx, db.GetCustomerByBookIdParams{
+		BookID:   uuid.MustParse(bookID),
+		RowLimit: 10,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("GetCustomerByBookId() returned %d customers, want 2", len(rows))
+	}
+
+	phones := map[string]string{
+		"山田商店": "03-1234-5678",
+		"鈴木工業": "06-1234-5678",
+	}
+	for _, row := range rows {
+		customer, err := dbStore.GetCustomer(ctx, row.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if customer.ContactPhone.String != phones[row.Name] {
+			t.Errorf("GetCustomer(%s) phone = %q, want %q", row.Name, customer.ContactPhone.String, phones[row.Name])
+		}
+		if !customer.CustomerLeader.Valid || !customer.CustomerPic.Valid {
+			t.Errorf("GetCustomer(%s) has no leader or pic", row.Name)
+		}
+	}
+}
+
+func TestExportCustomersForCalling(t *testing.T) {
+	dbStore, systemStore := dbtest.Open(t)
+	ctx, user := dbtest.CreateOrganization(t, systemStore)
+
+	bookID, err := createBook(ctx, dbStore, user.ID, "export test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	csvData := "name,phone\n" +
+		"架電可,03-1234-5678\n" +
+		"架電禁止,06-1234-5678\n"
+	_, err = importCustomers(ctx, service.NewCustomerService(dbStore, nil, 1000), bookID, strings.NewReader(csvData))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 登録時と表記が異なっても正規化した番号で一致する
+	_, err = dbStore.CreateDoNotCall(ctx, db.CreateDoNotCallParams{
+		Phone:  "0612345678",
+		Source: db.DncSourceManual,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		forCalling bool
+		want       []string
+	}{
+		{false, []string{"架電可", "架電禁止"}},
+		{true, []string{"架電可"}},
+	}
+	for _, tt := range tests {
+		var out bytes.Buffer
+		err = service.NewCustomerService(dbStore, nil, 1000).ExportCSV(ctx, uuid.MustParse(bookID), tt.forCalling, &out)
+		if err != nil {
+			t.Fatalf("ExportCSV(forCalling=%t) error = %v", tt.forCalling, err)
+		}
+		records, err := csv.NewReader(&out).ReadAll()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, record := range records[1:] {
+			names = append(names, record[1])
+		}
+		slices.Sort(names)
+		if !slices.Equal(names, tt.want) {
+			t.Errorf("ExportCSV(forCalling=%t) = %v, want %v", tt.forCalling, names, tt.want)
+		}
+	}
+}
+
+func TestImportCustomersUnknownColumn(t *testing.T) {
+	_, err := importCustomers(context.Background(), nil, uuid.NewString(), strings.NewReader("name,unknown\nfoo,bar\n"))
+	if err == nil || !strings.Contains(err.Error(), `unknown column "unknown"`) {
+		t.Fatalf("importCustomers() error = %v, want unknown column", err)
+	}
+}
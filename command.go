@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+
+	"github.com/0utl1er-tech/prism-backend/internal/middleware"
+	"github.com/0utl1er-tech/prism-backend/internal/store"
+	"github.com/0utl1er-tech/prism-backend/internal/util"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+// newRootCommand サブコマンドを省略した場合はサーバーを起動する
+func newRootCommand() *cobra.Command {
+	var cfg util.Config
+
+	root := &cobra.Command{
+		Use:           "prism",
+		Short:         "prism backend server and administrative commands",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			var err error
+			cfg, err = util.LoadConfig(".")
+			return err
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runServer(cfg)
+		},
+	}
+
+	root.AddCommand(
+		&cobra.Command{
+			Use:   "serve",
+			Short: "Start the gRPC server and HTTP gateway",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return runServer(cfg)
+			},
+		},
+		newMigrateCommand(&cfg),
+		newUserCommand(&cfg),
+		newBookCommand(&cfg),
+		newTrashCommand(&cfg),
+		newSeedCommand(&cfg),
+	)
+	return root
+}
+
+// withStore コマンドの実行中だけ、組織に限定したコネクションプールと組織をまたぐコネクションプールを開く
+func withStore(ctx context.Context, cfg *util.Config, fn func(dbStore, systemStore store.Store) error) error {
+	connPool, err := newConnPool(ctx, *cfg)
+	if err != nil {
+		return err
+	}
+	defer connPool.Close()
+
+	systemPool, err := newSystemConnPool(ctx, *cfg)
+	if err != nil {
+		return err
+	}
+	defer systemPool.Close()
+	return fn(store.New(connPool), store.NewSystem(systemPool))
+}
+
+// actAs userID のユーザーとしてサービスを呼び出す。所属する組織に限定され、変更履歴にユーザーが残る。
+// 組織が決まる前なのでユーザーは systemStore で検索する
+func actAs(ctx context.Context, systemStore store.Store, userID uuid.UUID) (context.Context, error) {
+	user, err := systemStore.AuthenticateUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(middleware.UserIDMetadataKey, user.ID.String()))
+	return store.WithOrganization(ctx, user.OrganizationID), nil
+}
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS "Call_customer_id_created_at_idx";
+
+DROP TABLE IF EXISTS "DialLease";
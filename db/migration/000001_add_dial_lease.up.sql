@@ -0,0 +1,18 @@
+CREATE TABLE "DialLease" (
+  "customer_id" uuid PRIMARY KEY,
+  "user_id" uuid NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+COMMENT ON TABLE "DialLease" IS '架電中の顧客の貸し出し状況';
+
+COMMENT ON COLUMN "DialLease"."expires_at" IS 'この時刻を過ぎたら他のユーザーに再度払い出す';
+
+CREATE INDEX ON "DialLease" ("user_id");
+
+ALTER TABLE "DialLease" ADD FOREIGN KEY ("customer_id") REFERENCES "Customer" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
+
+ALTER TABLE "DialLease" ADD FOREIGN KEY ("user_id") REFERENCES "User" ("id");
+
+CREATE INDEX ON "Call" ("customer_id", "created_at");
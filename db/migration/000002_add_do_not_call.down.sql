@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS "Contact_normalized_phone_idx";
+
+ALTER TABLE "Status" DROP COLUMN IF EXISTS "dnc";
+
+DROP TABLE IF EXISTS "DoNotCall";
+
+DROP FUNCTION IF EXISTS normalize_phone(text);
+
+DROP TYPE IF EXISTS "dnc_source";
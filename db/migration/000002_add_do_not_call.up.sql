@@ -0,0 +1,41 @@
+CREATE TYPE "dnc_source" AS ENUM (
+  'manual',
+  'import',
+  'call'
+);
+
+-- 全角数字や記号を取り除き、+81 を国内表記の 0 に揃える
+CREATE FUNCTION normalize_phone(phone text) RETURNS text
+LANGUAGE sql IMMUTABLE STRICT
+AS $$
+  SELECT regexp_replace(
+    regexp_replace(
+      regexp_replace(translate(phone, '０１２３４５６７８９＋', '0123456789+'), '[^0-9+]', '', 'g'),
+      '^\+81', '0'
+    ),
+    '[^0-9]', '', 'g'
+  )
+$$;
+
+CREATE TABLE "DoNotCall" (
+  "phone" varchar PRIMARY KEY,
+  "reason" text,
+  "source" dnc_source NOT NULL,
+  "user_id" uuid,
+  "call_id" uuid,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+COMMENT ON TABLE "DoNotCall" IS '架電禁止リスト';
+
+COMMENT ON COLUMN "DoNotCall"."phone" IS 'normalize_phone で正規化済みの電話番号';
+
+ALTER TABLE "DoNotCall" ADD FOREIGN KEY ("user_id") REFERENCES "User" ("id") ON DELETE SET NULL;
+
+ALTER TABLE "DoNotCall" ADD FOREIGN KEY ("call_id") REFERENCES "Call" ("id") ON DELETE SET NULL;
+
+ALTER TABLE "Status" ADD COLUMN "dnc" bool NOT NULL DEFAULT false;
+
+COMMENT ON COLUMN "Status"."dnc" IS 'このステータスで架電記録したら架電禁止リストに登録する';
+
+CREATE INDEX "Contact_normalized_phone_idx" ON "Contact" (normalize_phone("phone"));
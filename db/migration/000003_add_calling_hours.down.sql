@@ -0,0 +1,21 @@
+DROP INDEX IF EXISTS "Redial_user_id_scheduled_at_idx";
+
+ALTER TABLE "Redial" ADD COLUMN "date" date;
+
+ALTER TABLE "Redial" ADD COLUMN "time" time;
+
+UPDATE "Redial" SET
+  "date" = ("scheduled_at" AT TIME ZONE 'Asia/Tokyo')::date,
+  "time" = ("scheduled_at" AT TIME ZONE 'Asia/Tokyo')::time;
+
+ALTER TABLE "Redial" ALTER COLUMN "date" SET NOT NULL;
+
+ALTER TABLE "Redial" ALTER COLUMN "time" SET NOT NULL;
+
+ALTER TABLE "Redial" DROP COLUMN "scheduled_at";
+
+DROP TABLE IF EXISTS "CallingWindow";
+
+ALTER TABLE "Book" DROP COLUMN IF EXISTS "skip_holidays";
+
+ALTER TABLE "Book" DROP COLUMN IF EXISTS "timezone";
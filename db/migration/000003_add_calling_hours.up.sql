@@ -0,0 +1,36 @@
+ALTER TABLE "Book" ADD COLUMN "timezone" varchar NOT NULL DEFAULT 'Asia/Tokyo';
+
+ALTER TABLE "Book" ADD COLUMN "skip_holidays" bool NOT NULL DEFAULT true;
+
+COMMENT ON COLUMN "Book"."skip_holidays" IS '祝日と年末年始は架電しない';
+
+CREATE TABLE "CallingWindow" (
+  "id" uuid PRIMARY KEY,
+  "book_id" uuid NOT NULL,
+  "weekday" smallint NOT NULL,
+  "start_time" time NOT NULL,
+  "end_time" time NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  CHECK ("weekday" BETWEEN 0 AND 6),
+  CHECK ("start_time" < "end_time")
+);
+
+COMMENT ON TABLE "CallingWindow" IS '顧客リストごとの架電可能時間帯';
+
+COMMENT ON COLUMN "CallingWindow"."weekday" IS '0が日曜日';
+
+CREATE INDEX ON "CallingWindow" ("book_id", "weekday");
+
+ALTER TABLE "CallingWindow" ADD FOREIGN KEY ("book_id") REFERENCES "Book" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
+
+ALTER TABLE "Redial" ADD COLUMN "scheduled_at" timestamptz;
+
+UPDATE "Redial" SET "scheduled_at" = ("date" + "time") AT TIME ZONE 'Asia/Tokyo';
+
+ALTER TABLE "Redial" ALTER COLUMN "scheduled_at" SET NOT NULL;
+
+ALTER TABLE "Redial" DROP COLUMN "date";
+
+ALTER TABLE "Redial" DROP COLUMN "time";
+
+CREATE INDEX ON "Redial" ("user_id", "scheduled_at");
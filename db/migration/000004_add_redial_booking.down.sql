@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS "Call_created_at_idx";
+
+DROP INDEX IF EXISTS "Call_user_id_created_at_idx";
+
+DROP INDEX IF EXISTS "Redial_booked_by_booked_at_idx";
+
+ALTER TABLE "Redial" DROP COLUMN IF EXISTS "booked_at";
+
+ALTER TABLE "Redial" DROP COLUMN IF EXISTS "booked_by";
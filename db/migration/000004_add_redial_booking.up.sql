@@ -0,0 +1,15 @@
+ALTER TABLE "Redial" ADD COLUMN "booked_by" uuid;
+
+ALTER TABLE "Redial" ADD COLUMN "booked_at" timestamptz NOT NULL DEFAULT (now());
+
+COMMENT ON COLUMN "Redial"."booked_by" IS '再架電を予約したユーザー';
+
+UPDATE "Redial" SET "booked_by" = "user_id", "booked_at" = "created_at";
+
+ALTER TABLE "Redial" ADD FOREIGN KEY ("booked_by") REFERENCES "User" ("id") ON DELETE SET NULL;
+
+CREATE INDEX ON "Redial" ("booked_by", "booked_at");
+
+CREATE INDEX ON "Call" ("user_id", "created_at");
+
+CREATE INDEX ON "Call" ("created_at");
@@ -0,0 +1,7 @@
+DROP TRIGGER IF EXISTS "Customer_notify_activity" ON "Customer";
+
+DROP TRIGGER IF EXISTS "Call_notify_activity" ON "Call";
+
+DROP FUNCTION IF EXISTS notify_customer_activity();
+
+DROP FUNCTION IF EXISTS notify_call_activity();
@@ -0,0 +1,39 @@
+-- 架電記録や顧客の変更を LISTEN/NOTIFY でサーバーに通知する
+CREATE FUNCTION notify_call_activity() RETURNS trigger
+LANGUAGE plpgsql
+AS $$
+BEGIN
+  PERFORM pg_notify('prism_activity', json_build_object(
+    'type', 'call_logged',
+    'book_id', (SELECT c.book_id FROM "Customer" c WHERE c.id = NEW.customer_id),
+    'customer_id', NEW.customer_id,
+    'user_id', NEW.user_id,
+    'call_id', NEW.id,
+    'status_id', NEW.status_id,
+    'occurred_at', NEW.created_at
+  )::text);
+  RETURN NEW;
+END;
+$$;
+
+CREATE FUNCTION notify_customer_activity() RETURNS trigger
+LANGUAGE plpgsql
+AS $$
+BEGIN
+  PERFORM pg_notify('prism_activity', json_build_object(
+    'type', CASE TG_OP WHEN 'INSERT' THEN 'customer_created' ELSE 'customer_updated' END,
+    'book_id', NEW.book_id,
+    'customer_id', NEW.id,
+    'occurred_at', now()
+  )::text);
+  RETURN NEW;
+END;
+$$;
+
+CREATE TRIGGER "Call_notify_activity"
+AFTER INSERT ON "Call"
+FOR EACH ROW EXECUTE FUNCTION notify_call_activity();
+
+CREATE TRIGGER "Customer_notify_activity"
+AFTER INSERT OR UPDATE ON "Customer"
+FOR EACH ROW EXECUTE FUNCTION notify_customer_activity();
@@ -0,0 +1,19 @@
+DROP TRIGGER IF EXISTS "DoNotCall_audit" ON "DoNotCall";
+DROP TRIGGER IF EXISTS "User_audit" ON "User";
+DROP TRIGGER IF EXISTS "Status_audit" ON "Status";
+DROP TRIGGER IF EXISTS "Redial_audit" ON "Redial";
+DROP TRIGGER IF EXISTS "Call_audit" ON "Call";
+DROP TRIGGER IF EXISTS "Staff_audit" ON "Staff";
+DROP TRIGGER IF EXISTS "Contact_audit" ON "Contact";
+DROP TRIGGER IF EXISTS "Customer_audit" ON "Customer";
+DROP TRIGGER IF EXISTS "Category_audit" ON "Category";
+DROP TRIGGER IF EXISTS "CallingWindow_audit" ON "CallingWindow";
+DROP TRIGGER IF EXISTS "Book_audit" ON "Book";
+
+DROP FUNCTION IF EXISTS audit_row_change();
+
+DROP TABLE IF EXISTS "AuditEvent";
+
+DROP FUNCTION IF EXISTS audit_append_only();
+
+DROP TYPE IF EXISTS "audit_action";
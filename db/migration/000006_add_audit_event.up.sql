@@ -0,0 +1,115 @@
+CREATE TYPE "audit_action" AS ENUM (
+  'insert',
+  'update',
+  'delete'
+);
+
+CREATE TABLE "AuditEvent" (
+  "id" uuid PRIMARY KEY DEFAULT (gen_random_uuid()),
+  "actor_id" uuid,
+  "rpc" varchar,
+  "entity_type" varchar NOT NULL,
+  "entity_id" varchar NOT NULL,
+  "action" audit_action NOT NULL,
+  "before" jsonb,
+  "after" jsonb,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+COMMENT ON TABLE "AuditEvent" IS '変更履歴。追記のみで更新・削除はできない';
+
+COMMENT ON COLUMN "AuditEvent"."actor_id" IS '変更したユーザー。ユーザー削除後も残すため外部キーにしない';
+
+COMMENT ON COLUMN "AuditEvent"."before" IS '更新の場合は変更された項目のみ';
+
+COMMENT ON COLUMN "AuditEvent"."after" IS '更新の場合は変更された項目のみ';
+
+CREATE INDEX ON "AuditEvent" ("entity_type", "entity_id", "created_at");
+
+CREATE INDEX ON "AuditEvent" ("actor_id", "created_at");
+
+CREATE INDEX ON "AuditEvent" ("created_at");
+
+CREATE FUNCTION audit_append_only() RETURNS trigger
+LANGUAGE plpgsql
+AS $$
+BEGIN
+  RAISE EXCEPTION 'AuditEvent is append-only';
+END;
+$$;
+
+CREATE TRIGGER "AuditEvent_append_only"
+BEFORE UPDATE OR DELETE ON "AuditEvent"
+FOR EACH ROW EXECUTE FUNCTION audit_append_only();
+
+-- 行の変更を AuditEvent に記録する。引数は主キーの列名。
+-- 操作したユーザーとRPCはトランザクション内で set_config した prism.actor_id と prism.rpc から取る
+CREATE FUNCTION audit_row_change() RETURNS trigger
+LANGUAGE plpgsql
+AS $$
+DECLARE
+  old_row jsonb := CASE WHEN TG_OP <> 'INSERT' THEN to_jsonb(OLD) END;
+  new_row jsonb := CASE WHEN TG_OP <> 'DELETE' THEN to_jsonb(NEW) END;
+  before_row jsonb := old_row;
+  after_row jsonb := new_row;
+BEGIN
+  IF TG_OP = 'UPDATE' THEN
+    SELECT jsonb_object_agg(o.key, o.value) INTO before_row
+    FROM jsonb_each(old_row) o
+    WHERE o.value IS DISTINCT FROM new_row -> o.key;
+
+    IF before_row IS NULL THEN
+      RETURN NULL;
+    END IF;
+
+    SELECT jsonb_object_agg(n.key, n.value) INTO after_row
+    FROM jsonb_each(new_row) n
+    WHERE n.value IS DISTINCT FROM old_row -> n.key;
+  END IF;
+
+  INSERT INTO "AuditEvent" (actor_id, rpc, entity_type, entity_id, action, before, after)
+  VALUES (
+    NULLIF(current_setting('prism.actor_id', true), '')::uuid,
+    NULLIF(current_setting('prism.rpc', true), ''),
+    TG_TABLE_NAME,
+    COALESCE(new_row, old_row) ->> TG_ARGV[0],
+    lower(TG_OP)::audit_action,
+    before_row,
+    after_row
+  );
+  RETURN NULL;
+END;
+$$;
+
+CREATE TRIGGER "Book_audit" AFTER INSERT OR UPDATE OR DELETE ON "Book"
+FOR EACH ROW EXECUTE FUNCTION audit_row_change('id');
+
+CREATE TRIGGER "CallingWindow_audit" AFTER INSERT OR UPDATE OR DELETE ON "CallingWindow"
+FOR EACH ROW EXECUTE FUNCTION audit_row_change('id');
+
+CREATE TRIGGER "Category_audit" AFTER INSERT OR UPDATE OR DELETE ON "Category"
+FOR EACH ROW EXECUTE FUNCTION audit_row_change('id');
+
+CREATE TRIGGER "Customer_audit" AFTER INSERT OR UPDATE OR DELETE ON "Customer"
+FOR EACH ROW EXECUTE FUNCTION audit_row_change('id');
+
+CREATE TRIGGER "Contact_audit" AFTER INSERT OR UPDATE OR DELETE ON "Contact"
+FOR EACH ROW EXECUTE FUNCTION audit_row_change('id');
+
+CREATE TRIGGER "Staff_audit" AFTER INSERT OR UPDATE OR DELETE ON "Staff"
+FOR EACH ROW EXECUTE FUNCTION audit_row_change('id');
+
+CREATE TRIGGER "Call_audit" AFTER INSERT OR UPDATE OR DELETE ON "Call"
+FOR EACH ROW EXECUTE FUNCTION audit_row_change('id');
+
+CREATE TRIGGER "Redial_audit" AFTER INSERT OR UPDATE OR DELETE ON "Redial"
+FOR EACH ROW EXECUTE FUNCTION audit_row_change('id');
+
+CREATE TRIGGER "Status_audit" AFTER INSERT OR UPDATE OR DELETE ON "Status"
+FOR EACH ROW EXECUTE FUNCTION audit_row_change('id');
+
+CREATE TRIGGER "User_audit" AFTER INSERT OR UPDATE OR DELETE ON "User"
+FOR EACH ROW EXECUTE FUNCTION audit_row_change('id');
+
+CREATE TRIGGER "DoNotCall_audit" AFTER INSERT OR UPDATE OR DELETE ON "DoNotCall"
+FOR EACH ROW EXECUTE FUNCTION audit_row_change('phone');
@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS "Contact_deleted_at_idx";
+
+DROP INDEX IF EXISTS "Customer_deleted_at_idx";
+
+DROP INDEX IF EXISTS "Book_deleted_at_idx";
+
+ALTER TABLE "Contact" DROP COLUMN IF EXISTS "deleted_at";
+
+ALTER TABLE "Customer" DROP COLUMN IF EXISTS "deleted_at";
+
+ALTER TABLE "Book" DROP COLUMN IF EXISTS "deleted_at";
@@ -0,0 +1,17 @@
+ALTER TABLE "Book" ADD COLUMN "deleted_at" timestamptz;
+
+ALTER TABLE "Customer" ADD COLUMN "deleted_at" timestamptz;
+
+ALTER TABLE "Contact" ADD COLUMN "deleted_at" timestamptz;
+
+COMMENT ON COLUMN "Book"."deleted_at" IS 'ゴミ箱に移動した日時。保持期間を過ぎると完全に削除する';
+
+COMMENT ON COLUMN "Customer"."deleted_at" IS 'ゴミ箱に移動した日時。リストと一緒に削除した場合はリストと同じ日時';
+
+COMMENT ON COLUMN "Contact"."deleted_at" IS 'ゴミ箱に移動した日時。顧客と一緒に削除した場合は顧客と同じ日時';
+
+CREATE INDEX ON "Book" ("deleted_at") WHERE "deleted_at" IS NOT NULL;
+
+CREATE INDEX ON "Customer" ("deleted_at") WHERE "deleted_at" IS NOT NULL;
+
+CREATE INDEX ON "Contact" ("deleted_at") WHERE "deleted_at" IS NOT NULL;
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS "Note_audit" ON "Note";
+
+DROP TABLE IF EXISTS "Note";
@@ -0,0 +1,21 @@
+CREATE TABLE "Note" (
+  "id" uuid PRIMARY KEY,
+  "customer_id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  "body" text NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+COMMENT ON TABLE "Note" IS '顧客へのメモ。Customer.memo と違い追記していく';
+
+COMMENT ON COLUMN "Note"."user_id" IS '書いたユーザー';
+
+ALTER TABLE "Note" ADD FOREIGN KEY ("customer_id") REFERENCES "Customer" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "Note" ADD FOREIGN KEY ("user_id") REFERENCES "User" ("id");
+
+CREATE INDEX ON "Note" ("customer_id", "created_at");
+
+CREATE TRIGGER "Note_audit" AFTER INSERT OR UPDATE OR DELETE ON "Note"
+FOR EACH ROW EXECUTE FUNCTION audit_row_change('id');
@@ -0,0 +1,9 @@
+DROP TRIGGER IF EXISTS "CustomField_audit" ON "CustomField";
+
+DROP INDEX IF EXISTS "Customer_custom_fields_idx";
+
+ALTER TABLE "Customer" DROP COLUMN IF EXISTS "custom_fields";
+
+DROP TABLE IF EXISTS "CustomField";
+
+DROP TYPE IF EXISTS "custom_field_type";
@@ -0,0 +1,38 @@
+CREATE TYPE "custom_field_type" AS ENUM (
+  'text',
+  'number',
+  'date',
+  'select',
+  'boolean'
+);
+
+CREATE TABLE "CustomField" (
+  "id" uuid PRIMARY KEY,
+  "book_id" uuid NOT NULL,
+  "key" varchar NOT NULL,
+  "label" varchar NOT NULL,
+  "type" custom_field_type NOT NULL,
+  "options" varchar[] NOT NULL DEFAULT '{}',
+  "required" bool NOT NULL DEFAULT false,
+  "position" int NOT NULL DEFAULT 0,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+COMMENT ON TABLE "CustomField" IS '顧客リストごとの独自項目の定義';
+
+COMMENT ON COLUMN "CustomField"."key" IS 'Customer.custom_fields のキー。作成後は変更できない';
+
+COMMENT ON COLUMN "CustomField"."options" IS 'select の選択肢';
+
+ALTER TABLE "CustomField" ADD FOREIGN KEY ("book_id") REFERENCES "Book" ("id") ON DELETE CASCADE;
+
+CREATE UNIQUE INDEX ON "CustomField" ("book_id", "key");
+
+ALTER TABLE "Customer" ADD COLUMN "custom_fields" jsonb NOT NULL DEFAULT '{}';
+
+COMMENT ON COLUMN "Customer"."custom_fields" IS '独自項目の値。キーは CustomField.key';
+
+CREATE INDEX ON "Customer" USING gin ("custom_fields" jsonb_path_ops);
+
+CREATE TRIGGER "CustomField_audit" AFTER INSERT OR UPDATE OR DELETE ON "CustomField"
+FOR EACH ROW EXECUTE FUNCTION audit_row_change('id');
@@ -0,0 +1,7 @@
+DROP TRIGGER IF EXISTS "CustomerTag_audit" ON "CustomerTag";
+
+DROP TRIGGER IF EXISTS "Tag_audit" ON "Tag";
+
+DROP TABLE IF EXISTS "CustomerTag";
+
+DROP TABLE IF EXISTS "Tag";
@@ -0,0 +1,37 @@
+CREATE TABLE "Tag" (
+  "id" uuid PRIMARY KEY,
+  "book_id" uuid NOT NULL,
+  "name" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+COMMENT ON TABLE "Tag" IS '顧客に複数付けられるラベル。顧客リストごとに管理する';
+
+CREATE TABLE "CustomerTag" (
+  "customer_id" uuid NOT NULL,
+  "tag_id" uuid NOT NULL,
+  "user_id" uuid,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("customer_id", "tag_id")
+);
+
+COMMENT ON COLUMN "CustomerTag"."user_id" IS 'タグを付けたユーザー';
+
+ALTER TABLE "Tag" ADD FOREIGN KEY ("book_id") REFERENCES "Book" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "CustomerTag" ADD FOREIGN KEY ("customer_id") REFERENCES "Customer" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "CustomerTag" ADD FOREIGN KEY ("tag_id") REFERENCES "Tag" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "CustomerTag" ADD FOREIGN KEY ("user_id") REFERENCES "User" ("id") ON DELETE SET NULL;
+
+CREATE UNIQUE INDEX ON "Tag" ("book_id", "name");
+
+CREATE INDEX ON "CustomerTag" ("tag_id");
+
+CREATE TRIGGER "Tag_audit" AFTER INSERT OR UPDATE OR DELETE ON "Tag"
+FOR EACH ROW EXECUTE FUNCTION audit_row_change('id');
+
+-- 顧客の対応履歴に表示するため顧客IDで記録する
+CREATE TRIGGER "CustomerTag_audit" AFTER INSERT OR UPDATE OR DELETE ON "CustomerTag"
+FOR EACH ROW EXECUTE FUNCTION audit_row_change('customer_id');
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "Job";
+
+DROP TYPE IF EXISTS "job_status";
@@ -0,0 +1,46 @@
+CREATE TYPE "job_status" AS ENUM (
+  'queued',
+  'running',
+  'succeeded',
+  'failed',
+  'canceled'
+);
+
+CREATE TABLE "Job" (
+  "id" uuid PRIMARY KEY,
+  "type" varchar NOT NULL,
+  "status" job_status NOT NULL DEFAULT 'queued',
+  "params" jsonb NOT NULL DEFAULT '{}',
+  "progress" int NOT NULL DEFAULT 0,
+  "result" jsonb,
+  "result_location" varchar,
+  "error" text,
+  "attempts" int NOT NULL DEFAULT 0,
+  "max_attempts" int NOT NULL DEFAULT 3,
+  "cancel_requested" bool NOT NULL DEFAULT false,
+  "user_id" uuid,
+  "run_after" timestamptz NOT NULL DEFAULT (now()),
+  "locked_by" varchar,
+  "locked_until" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "started_at" timestamptz,
+  "finished_at" timestamptz
+);
+
+COMMENT ON TABLE "Job" IS '時間のかかる処理をサーバー内のワーカーで非同期に実行する';
+
+COMMENT ON COLUMN "Job"."progress" IS '進捗率 (0〜100)';
+
+COMMENT ON COLUMN "Job"."result_location" IS 'エクスポートしたファイルなど結果の取得先';
+
+COMMENT ON COLUMN "Job"."run_after" IS 'この時刻以降に実行する。再試行の待ち時間に使う';
+
+COMMENT ON COLUMN "Job"."locked_by" IS '実行中のワーカー';
+
+COMMENT ON COLUMN "Job"."locked_until" IS 'ワーカーが定期的に延長する。過ぎた場合はワーカーが停止したとみなして再実行する';
+
+ALTER TABLE "Job" ADD FOREIGN KEY ("user_id") REFERENCES "User" ("id") ON DELETE SET NULL;
+
+CREATE INDEX ON "Job" ("status", "run_after");
+
+CREATE INDEX ON "Job" ("user_id", "created_at");
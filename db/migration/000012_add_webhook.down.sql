@@ -0,0 +1,15 @@
+DROP TRIGGER IF EXISTS "Customer_outbox" ON "Customer";
+
+DROP TRIGGER IF EXISTS "Call_outbox" ON "Call";
+
+DROP FUNCTION IF EXISTS outbox_customer_created();
+
+DROP FUNCTION IF EXISTS outbox_call_effective();
+
+DROP TABLE IF EXISTS "WebhookDelivery";
+
+DROP TABLE IF EXISTS "Webhook";
+
+DROP TABLE IF EXISTS "OutboxEvent";
+
+DROP TYPE IF EXISTS "webhook_delivery_status";
@@ -0,0 +1,124 @@
+CREATE TYPE "webhook_delivery_status" AS ENUM (
+  'pending',
+  'succeeded',
+  'dead'
+);
+
+CREATE TABLE "OutboxEvent" (
+  "id" uuid PRIMARY KEY DEFAULT (gen_random_uuid()),
+  "event_type" varchar NOT NULL,
+  "book_id" uuid,
+  "payload" jsonb NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "dispatched_at" timestamptz
+);
+
+CREATE TABLE "Webhook" (
+  "id" uuid PRIMARY KEY,
+  "url" varchar NOT NULL,
+  "secret" varchar NOT NULL,
+  "event_types" varchar[] NOT NULL DEFAULT '{}',
+  "description" varchar,
+  "active" bool NOT NULL DEFAULT true,
+  "user_id" uuid,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "WebhookDelivery" (
+  "id" uuid PRIMARY KEY DEFAULT (gen_random_uuid()),
+  "webhook_id" uuid NOT NULL,
+  "event_id" uuid NOT NULL,
+  "status" webhook_delivery_status NOT NULL DEFAULT 'pending',
+  "attempts" int NOT NULL DEFAULT 0,
+  "next_attempt_at" timestamptz NOT NULL DEFAULT (now()),
+  "locked_until" timestamptz,
+  "last_status_code" int,
+  "last_error" text,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "delivered_at" timestamptz
+);
+
+COMMENT ON TABLE "OutboxEvent" IS '外部に通知するイベント。変更と同じトランザクションでトリガーが書き込む';
+
+COMMENT ON COLUMN "OutboxEvent"."dispatched_at" IS 'WebhookDelivery に振り分けた日時';
+
+COMMENT ON TABLE "Webhook" IS 'イベントを通知する外部のエンドポイント';
+
+COMMENT ON COLUMN "Webhook"."secret" IS 'ペイロードの HMAC-SHA256 署名に使う';
+
+COMMENT ON COLUMN "Webhook"."event_types" IS '通知するイベントの種類。空の場合はすべて';
+
+COMMENT ON TABLE "WebhookDelivery" IS 'イベントごとの送信状況。再試行の上限を超えたものは dead になる';
+
+COMMENT ON COLUMN "WebhookDelivery"."locked_until" IS '送信中のディスパッチャーが設定する。過ぎた場合は再送する';
+
+ALTER TABLE "Webhook" ADD FOREIGN KEY ("user_id") REFERENCES "User" ("id") ON DELETE SET NULL;
+
+ALTER TABLE "WebhookDelivery" ADD FOREIGN KEY ("webhook_id") REFERENCES "Webhook" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "WebhookDelivery" ADD FOREIGN KEY ("event_id") REFERENCES "OutboxEvent" ("id") ON DELETE CASCADE;
+
+CREATE INDEX ON "OutboxEvent" ("created_at") WHERE "dispatched_at" IS NULL;
+
+CREATE UNIQUE INDEX ON "WebhookDelivery" ("webhook_id", "event_id");
+
+CREATE INDEX ON "WebhookDelivery" ("next_attempt_at") WHERE "status" = 'pending';
+
+CREATE INDEX ON "WebhookDelivery" ("status", "created_at");
+
+-- 有効数となる架電記録と顧客の作成を OutboxEvent に書き込む
+CREATE FUNCTION outbox_call_effective() RETURNS trigger
+LANGUAGE plpgsql
+AS $$
+DECLARE
+  customer "Customer"%ROWTYPE;
+  call_status "Status"%ROWTYPE;
+BEGIN
+  SELECT * INTO call_status FROM "Status" s WHERE s.id = NEW.status_id;
+  IF NOT COALESCE(call_status.effective, false) THEN
+    RETURN NULL;
+  END IF;
+  SELECT * INTO customer FROM "Customer" c WHERE c.id = NEW.customer_id;
+
+  INSERT INTO "OutboxEvent" (event_type, book_id, payload)
+  VALUES ('call.effective', customer.book_id, jsonb_build_object(
+    'call_id', NEW.id,
+    'book_id', customer.book_id,
+    'customer_id', NEW.customer_id,
+    'customer_name', customer.name,
+    'corporation', customer.corporation,
+    'user_id', NEW.user_id,
+    'status_id', NEW.status_id,
+    'status_name', call_status.name,
+    'called_at', NEW.created_at
+  ));
+  RETURN NULL;
+END;
+$$;
+
+CREATE FUNCTION outbox_customer_created() RETURNS trigger
+LANGUAGE plpgsql
+AS $$
+BEGIN
+  INSERT INTO "OutboxEvent" (event_type, book_id, payload)
+  VALUES ('customer.created', NEW.book_id, jsonb_build_object(
+    'customer_id', NEW.id,
+    'book_id', NEW.book_id,
+    'category_id', NEW.category_id,
+    'name', NEW.name,
+    'corporation', NEW.corporation,
+    'address', NEW.address,
+    'created_at', NEW.created_at
+  ));
+  RETURN NULL;
+END;
+$$;
+
+CREATE TRIGGER "Call_outbox"
+AFTER INSERT ON "Call"
+FOR EACH ROW EXECUTE FUNCTION outbox_call_effective();
+
+CREATE TRIGGER "Customer_outbox"
+AFTER INSERT ON "Customer"
+FOR EACH ROW EXECUTE FUNCTION outbox_customer_created();
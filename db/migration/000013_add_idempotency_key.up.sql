@@ -0,0 +1,20 @@
+CREATE TABLE "IdempotencyKey" (
+  "user_id" uuid NOT NULL,
+  "key" varchar NOT NULL,
+  "rpc" varchar NOT NULL,
+  "request_hash" bytea NOT NULL,
+  "response" bytea,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "expires_at" timestamptz NOT NULL,
+  PRIMARY KEY ("user_id", "key")
+);
+
+COMMENT ON TABLE "IdempotencyKey" IS '作成系RPCの Idempotency-Key と最初のレスポンス';
+
+COMMENT ON COLUMN "IdempotencyKey"."user_id" IS 'キーはユーザーごと。ユーザーがない場合はゼロのUUID';
+
+COMMENT ON COLUMN "IdempotencyKey"."request_hash" IS 'RPCとリクエストの SHA-256。同じキーで異なるリクエストを拒否する';
+
+COMMENT ON COLUMN "IdempotencyKey"."response" IS 'protobuf でシリアライズしたレスポンス。NULL の場合は処理中';
+
+CREATE INDEX ON "IdempotencyKey" ("expires_at");
@@ -0,0 +1,17 @@
+DROP TRIGGER IF EXISTS "Staff_bump_version" ON "Staff";
+
+DROP TRIGGER IF EXISTS "Contact_bump_version" ON "Contact";
+
+DROP TRIGGER IF EXISTS "Customer_bump_version" ON "Customer";
+
+DROP TRIGGER IF EXISTS "Book_bump_version" ON "Book";
+
+DROP FUNCTION IF EXISTS bump_version();
+
+ALTER TABLE "Staff" DROP COLUMN IF EXISTS "version";
+
+ALTER TABLE "Contact" DROP COLUMN IF EXISTS "version";
+
+ALTER TABLE "Customer" DROP COLUMN IF EXISTS "version";
+
+ALTER TABLE "Book" DROP COLUMN IF EXISTS "version";
@@ -0,0 +1,43 @@
+ALTER TABLE "Book" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
+
+ALTER TABLE "Customer" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
+
+ALTER TABLE "Contact" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
+
+ALTER TABLE "Staff" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
+
+COMMENT ON COLUMN "Book"."version" IS '更新のたびに増える。ETag として返し、古い値での更新を拒否する';
+
+COMMENT ON COLUMN "Customer"."version" IS '更新のたびに増える。ETag として返し、古い値での更新を拒否する';
+
+COMMENT ON COLUMN "Contact"."version" IS '更新のたびに増える。ETag として返し、古い値での更新を拒否する';
+
+COMMENT ON COLUMN "Staff"."version" IS '更新のたびに増える。ETag として返し、古い値での更新を拒否する';
+
+-- 値が変わった更新でのみ version を増やす。一括更新や論理削除でも増える
+CREATE FUNCTION bump_version() RETURNS trigger
+LANGUAGE plpgsql
+AS $$
+BEGIN
+  IF NEW IS DISTINCT FROM OLD THEN
+    NEW.version := OLD.version + 1;
+  END IF;
+  RETURN NEW;
+END;
+$$;
+
+CREATE TRIGGER "Book_bump_version"
+BEFORE UPDATE ON "Book"
+FOR EACH ROW EXECUTE FUNCTION bump_version();
+
+CREATE TRIGGER "Customer_bump_version"
+BEFORE UPDATE ON "Customer"
+FOR EACH ROW EXECUTE FUNCTION bump_version();
+
+CREATE TRIGGER "Contact_bump_version"
+BEFORE UPDATE ON "Contact"
+FOR EACH ROW EXECUTE FUNCTION bump_version();
+
+CREATE TRIGGER "Staff_bump_version"
+BEFORE UPDATE ON "Staff"
+FOR EACH ROW EXECUTE FUNCTION bump_version();
@@ -0,0 +1,209 @@
+DROP POLICY IF EXISTS "WebhookDelivery_isolation" ON "WebhookDelivery";
+
+ALTER TABLE "WebhookDelivery" NO FORCE ROW LEVEL SECURITY;
+
+ALTER TABLE "WebhookDelivery" DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS "DialLease_isolation" ON "DialLease";
+
+ALTER TABLE "DialLease" NO FORCE ROW LEVEL SECURITY;
+
+ALTER TABLE "DialLease" DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS "CustomerTag_isolation" ON "CustomerTag";
+
+ALTER TABLE "CustomerTag" NO FORCE ROW LEVEL SECURITY;
+
+ALTER TABLE "CustomerTag" DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS "Call_isolation" ON "Call";
+
+ALTER TABLE "Call" NO FORCE ROW LEVEL SECURITY;
+
+ALTER TABLE "Call" DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS "Note_isolation" ON "Note";
+
+ALTER TABLE "Note" NO FORCE ROW LEVEL SECURITY;
+
+ALTER TABLE "Note" DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS "Contact_isolation" ON "Contact";
+
+ALTER TABLE "Contact" NO FORCE ROW LEVEL SECURITY;
+
+ALTER TABLE "Contact" DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS "Redial_isolation" ON "Redial";
+
+ALTER TABLE "Redial" NO FORCE ROW LEVEL SECURITY;
+
+ALTER TABLE "Redial" DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS "CustomField_isolation" ON "CustomField";
+
+ALTER TABLE "CustomField" NO FORCE ROW LEVEL SECURITY;
+
+ALTER TABLE "CustomField" DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS "Tag_isolation" ON "Tag";
+
+ALTER TABLE "Tag" NO FORCE ROW LEVEL SECURITY;
+
+ALTER TABLE "Tag" DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS "Customer_isolation" ON "Customer";
+
+ALTER TABLE "Customer" NO FORCE ROW LEVEL SECURITY;
+
+ALTER TABLE "Customer" DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS "CallingWindow_isolation" ON "CallingWindow";
+
+ALTER TABLE "CallingWindow" NO FORCE ROW LEVEL SECURITY;
+
+ALTER TABLE "CallingWindow" DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS "AuditEvent_isolation" ON "AuditEvent";
+
+ALTER TABLE "AuditEvent" NO FORCE ROW LEVEL SECURITY;
+
+ALTER TABLE "AuditEvent" DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS "OutboxEvent_isolation" ON "OutboxEvent";
+
+ALTER TABLE "OutboxEvent" NO FORCE ROW LEVEL SECURITY;
+
+ALTER TABLE "OutboxEvent" DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS "Webhook_isolation" ON "Webhook";
+
+ALTER TABLE "Webhook" NO FORCE ROW LEVEL SECURITY;
+
+ALTER TABLE "Webhook" DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS "Job_isolation" ON "Job";
+
+ALTER TABLE "Job" NO FORCE ROW LEVEL SECURITY;
+
+ALTER TABLE "Job" DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS "DoNotCall_isolation" ON "DoNotCall";
+
+ALTER TABLE "DoNotCall" NO FORCE ROW LEVEL SECURITY;
+
+ALTER TABLE "DoNotCall" DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS "Staff_isolation" ON "Staff";
+
+ALTER TABLE "Staff" NO FORCE ROW LEVEL SECURITY;
+
+ALTER TABLE "Staff" DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS "Category_isolation" ON "Category";
+
+ALTER TABLE "Category" NO FORCE ROW LEVEL SECURITY;
+
+ALTER TABLE "Category" DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS "Status_isolation" ON "Status";
+
+ALTER TABLE "Status" NO FORCE ROW LEVEL SECURITY;
+
+ALTER TABLE "Status" DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS "Book_isolation" ON "Book";
+
+ALTER TABLE "Book" NO FORCE ROW LEVEL SECURITY;
+
+ALTER TABLE "Book" DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS "User_isolation" ON "User";
+
+ALTER TABLE "User" NO FORCE ROW LEVEL SECURITY;
+
+ALTER TABLE "User" DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS "Organization_isolation" ON "Organization";
+
+ALTER TABLE "Organization" NO FORCE ROW LEVEL SECURITY;
+
+ALTER TABLE "Organization" DISABLE ROW LEVEL SECURITY;
+
+CREATE OR REPLACE FUNCTION outbox_customer_created() RETURNS trigger
+LANGUAGE plpgsql
+AS $$
+BEGIN
+  INSERT INTO "OutboxEvent" (event_type, book_id, payload)
+  VALUES ('customer.created', NEW.book_id, jsonb_build_object(
+    'customer_id', NEW.id,
+    'book_id', NEW.book_id,
+    'category_id', NEW.category_id,
+    'name', NEW.name,
+    'corporation', NEW.corporation,
+    'address', NEW.address,
+    'created_at', NEW.created_at
+  ));
+  RETURN NULL;
+END;
+$$;
+
+CREATE OR REPLACE FUNCTION outbox_call_effective() RETURNS trigger
+LANGUAGE plpgsql
+AS $$
+DECLARE
+  customer "Customer"%ROWTYPE;
+  call_status "Status"%ROWTYPE;
+BEGIN
+  SELECT * INTO call_status FROM "Status" s WHERE s.id = NEW.status_id;
+  IF NOT COALESCE(call_status.effective, false) THEN
+    RETURN NULL;
+  END IF;
+  SELECT * INTO customer FROM "Customer" c WHERE c.id = NEW.customer_id;
+
+  INSERT INTO "OutboxEvent" (event_type, book_id, payload)
+  VALUES ('call.effective', customer.book_id, jsonb_build_object(
+    'call_id', NEW.id,
+    'book_id', customer.book_id,
+    'customer_id', NEW.customer_id,
+    'customer_name', customer.name,
+    'corporation', customer.corporation,
+    'user_id', NEW.user_id,
+    'status_id', NEW.status_id,
+    'status_name', call_status.name,
+    'called_at', NEW.created_at
+  ));
+  RETURN NULL;
+END;
+$$;
+
+-- 組織をまたいで同じ電話番号がある場合は失敗する
+ALTER TABLE "DoNotCall" DROP CONSTRAINT "DoNotCall_pkey";
+
+ALTER TABLE "DoNotCall" ADD PRIMARY KEY ("phone");
+
+ALTER TABLE "AuditEvent" DROP COLUMN IF EXISTS "organization_id";
+
+ALTER TABLE "OutboxEvent" DROP COLUMN IF EXISTS "organization_id";
+
+ALTER TABLE "Webhook" DROP COLUMN IF EXISTS "organization_id";
+
+ALTER TABLE "Job" DROP COLUMN IF EXISTS "organization_id";
+
+ALTER TABLE "DoNotCall" DROP COLUMN IF EXISTS "organization_id";
+
+ALTER TABLE "Staff" DROP COLUMN IF EXISTS "organization_id";
+
+ALTER TABLE "Category" DROP COLUMN IF EXISTS "organization_id";
+
+ALTER TABLE "Status" DROP COLUMN IF EXISTS "organization_id";
+
+ALTER TABLE "Book" DROP COLUMN IF EXISTS "organization_id";
+
+ALTER TABLE "User" DROP COLUMN IF EXISTS "organization_id";
+
+DROP FUNCTION IF EXISTS rls_bypassed();
+
+DROP FUNCTION IF EXISTS current_organization_id();
+
+DROP TABLE IF EXISTS "Organization";
@@ -0,0 +1,315 @@
+CREATE TABLE "Organization" (
+  "id" uuid PRIMARY KEY,
+  "name" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+COMMENT ON TABLE "Organization" IS 'prism を利用する代理店。データは組織ごとに分離する';
+
+-- 既存のデータはすべてこの組織に入れる
+INSERT INTO "Organization" (id, name)
+VALUES ('00000000-0000-0000-0000-000000000001', 'Default');
+
+-- リクエストの組織。接続を取得するときにサーバーが prism.organization_id に設定する
+CREATE FUNCTION current_organization_id() RETURNS uuid
+LANGUAGE sql STABLE
+AS $$
+  SELECT NULLIF(current_setting('prism.organization_id', true), '')::uuid
+$$;
+
+-- バックグラウンド処理など組織をまたぐ処理は prism.bypass_rls を on にする
+CREATE FUNCTION rls_bypassed() RETURNS bool
+LANGUAGE sql STABLE
+AS $$
+  SELECT COALESCE(current_setting('prism.bypass_rls', true), '') = 'on'
+$$;
+
+ALTER TABLE "User" ADD COLUMN "organization_id" uuid NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001';
+
+ALTER TABLE "User" ALTER COLUMN "organization_id" SET DEFAULT current_organization_id();
+
+ALTER TABLE "User" ADD FOREIGN KEY ("organization_id") REFERENCES "Organization" ("id");
+
+ALTER TABLE "Book" ADD COLUMN "organization_id" uuid NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001';
+
+ALTER TABLE "Book" ALTER COLUMN "organization_id" SET DEFAULT current_organization_id();
+
+ALTER TABLE "Book" ADD FOREIGN KEY ("organization_id") REFERENCES "Organization" ("id");
+
+ALTER TABLE "Status" ADD COLUMN "organization_id" uuid NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001';
+
+ALTER TABLE "Status" ALTER COLUMN "organization_id" SET DEFAULT current_organization_id();
+
+ALTER TABLE "Status" ADD FOREIGN KEY ("organization_id") REFERENCES "Organization" ("id");
+
+ALTER TABLE "Category" ADD COLUMN "organization_id" uuid NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001';
+
+ALTER TABLE "Category" ALTER COLUMN "organization_id" SET DEFAULT current_organization_id();
+
+ALTER TABLE "Category" ADD FOREIGN KEY ("organization_id") REFERENCES "Organization" ("id");
+
+ALTER TABLE "Staff" ADD COLUMN "organization_id" uuid NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001';
+
+ALTER TABLE "Staff" ALTER COLUMN "organization_id" SET DEFAULT current_organization_id();
+
+ALTER TABLE "Staff" ADD FOREIGN KEY ("organization_id") REFERENCES "Organization" ("id");
+
+ALTER TABLE "DoNotCall" ADD COLUMN "organization_id" uuid NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001';
+
+ALTER TABLE "DoNotCall" ALTER COLUMN "organization_id" SET DEFAULT current_organization_id();
+
+ALTER TABLE "DoNotCall" ADD FOREIGN KEY ("organization_id") REFERENCES "Organization" ("id");
+
+ALTER TABLE "Job" ADD COLUMN "organization_id" uuid NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001';
+
+ALTER TABLE "Job" ALTER COLUMN "organization_id" SET DEFAULT current_organization_id();
+
+ALTER TABLE "Job" ADD FOREIGN KEY ("organization_id") REFERENCES "Organization" ("id");
+
+ALTER TABLE "Webhook" ADD COLUMN "organization_id" uuid NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001';
+
+ALTER TABLE "Webhook" ALTER COLUMN "organization_id" SET DEFAULT current_organization_id();
+
+ALTER TABLE "Webhook" ADD FOREIGN KEY ("organization_id") REFERENCES "Organization" ("id");
+
+ALTER TABLE "OutboxEvent" ADD COLUMN "organization_id" uuid NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001';
+
+ALTER TABLE "OutboxEvent" ALTER COLUMN "organization_id" SET DEFAULT current_organization_id();
+
+ALTER TABLE "OutboxEvent" ADD FOREIGN KEY ("organization_id") REFERENCES "Organization" ("id");
+
+ALTER TABLE "AuditEvent" ADD COLUMN "organization_id" uuid DEFAULT '00000000-0000-0000-0000-000000000001';
+
+ALTER TABLE "AuditEvent" ALTER COLUMN "organization_id" SET DEFAULT current_organization_id();
+
+ALTER TABLE "AuditEvent" ADD FOREIGN KEY ("organization_id") REFERENCES "Organization" ("id");
+
+COMMENT ON COLUMN "AuditEvent"."organization_id" IS 'バックグラウンド処理による変更は NULL';
+
+-- 架電禁止リストは組織ごと
+ALTER TABLE "DoNotCall" DROP CONSTRAINT "DoNotCall_pkey";
+
+ALTER TABLE "DoNotCall" ADD PRIMARY KEY ("organization_id", "phone");
+
+CREATE INDEX ON "User" ("organization_id");
+
+CREATE INDEX ON "Book" ("organization_id");
+
+CREATE INDEX ON "Status" ("organization_id");
+
+CREATE INDEX ON "Category" ("organization_id");
+
+CREATE INDEX ON "Staff" ("organization_id");
+
+CREATE INDEX ON "Job" ("organization_id", "created_at");
+
+CREATE INDEX ON "Webhook" ("organization_id");
+
+CREATE INDEX ON "AuditEvent" ("organization_id", "created_at");
+
+-- 顧客の作成イベントにリストの組織を入れる
+CREATE OR REPLACE FUNCTION outbox_customer_created() RETURNS trigger
+LANGUAGE plpgsql
+AS $$
+BEGIN
+  INSERT INTO "OutboxEvent" (event_type, organization_id, book_id, payload)
+  VALUES ('customer.created', (SELECT b.organization_id FROM "Book" b WHERE b.id = NEW.book_id), NEW.book_id, jsonb_build_object(
+    'customer_id', NEW.id,
+    'book_id', NEW.book_id,
+    'category_id', NEW.category_id,
+    'name', NEW.name,
+    'corporation', NEW.corporation,
+    'address', NEW.address,
+    'created_at', NEW.created_at
+  ));
+  RETURN NULL;
+END;
+$$;
+
+CREATE OR REPLACE FUNCTION outbox_call_effective() RETURNS trigger
+LANGUAGE plpgsql
+AS $$
+DECLARE
+  customer "Customer"%ROWTYPE;
+  call_status "Status"%ROWTYPE;
+BEGIN
+  SELECT * INTO call_status FROM "Status" s WHERE s.id = NEW.status_id;
+  IF NOT COALESCE(call_status.effective, false) THEN
+    RETURN NULL;
+  END IF;
+  SELECT * INTO customer FROM "Customer" c WHERE c.id = NEW.customer_id;
+
+  INSERT INTO "OutboxEvent" (event_type, organization_id, book_id, payload)
+  VALUES ('call.effective', (SELECT b.organization_id FROM "Book" b WHERE b.id = customer.book_id), customer.book_id, jsonb_build_object(
+    'call_id', NEW.id,
+    'book_id', customer.book_id,
+    'customer_id', NEW.customer_id,
+    'customer_name', customer.name,
+    'corporation', customer.corporation,
+    'user_id', NEW.user_id,
+    'status_id', NEW.status_id,
+    'status_name', call_status.name,
+    'called_at', NEW.created_at
+  ));
+  RETURN NULL;
+END;
+$$;
+
+-- 行レベルセキュリティ。アプリケーションのクエリの絞り込みに加えて、組織をまたぐ読み書きを防ぐ。
+-- テーブルの所有者にも適用する。スーパーユーザーには適用されないため、サーバーは一般ユーザーで接続する
+
+ALTER TABLE "Organization" ENABLE ROW LEVEL SECURITY;
+
+ALTER TABLE "Organization" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY "Organization_isolation" ON "Organization"
+USING (rls_bypassed() OR id = current_organization_id());
+
+ALTER TABLE "User" ENABLE ROW LEVEL SECURITY;
+
+ALTER TABLE "User" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY "User_isolation" ON "User"
+USING (rls_bypassed() OR organization_id = current_organization_id());
+
+ALTER TABLE "Book" ENABLE ROW LEVEL SECURITY;
+
+ALTER TABLE "Book" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY "Book_isolation" ON "Book"
+USING (rls_bypassed() OR organization_id = current_organization_id());
+
+ALTER TABLE "Status" ENABLE ROW LEVEL SECURITY;
+
+ALTER TABLE "Status" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY "Status_isolation" ON "Status"
+USING (rls_bypassed() OR organization_id = current_organization_id());
+
+ALTER TABLE "Category" ENABLE ROW LEVEL SECURITY;
+
+ALTER TABLE "Category" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY "Category_isolation" ON "Category"
+USING (rls_bypassed() OR organization_id = current_organization_id());
+
+ALTER TABLE "Staff" ENABLE ROW LEVEL SECURITY;
+
+ALTER TABLE "Staff" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY "Staff_isolation" ON "Staff"
+USING (rls_bypassed() OR organization_id = current_organization_id());
+
+ALTER TABLE "DoNotCall" ENABLE ROW LEVEL SECURITY;
+
+ALTER TABLE "DoNotCall" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY "DoNotCall_isolation" ON "DoNotCall"
+USING (rls_bypassed() OR organization_id = current_organization_id());
+
+ALTER TABLE "Job" ENABLE ROW LEVEL SECURITY;
+
+ALTER TABLE "Job" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY "Job_isolation" ON "Job"
+USING (rls_bypassed() OR organization_id = current_organization_id());
+
+ALTER TABLE "Webhook" ENABLE ROW LEVEL SECURITY;
+
+ALTER TABLE "Webhook" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY "Webhook_isolation" ON "Webhook"
+USING (rls_bypassed() OR organization_id = current_organization_id());
+
+ALTER TABLE "OutboxEvent" ENABLE ROW LEVEL SECURITY;
+
+ALTER TABLE "OutboxEvent" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY "OutboxEvent_isolation" ON "OutboxEvent"
+USING (rls_bypassed() OR organization_id = current_organization_id());
+
+ALTER TABLE "AuditEvent" ENABLE ROW LEVEL SECURITY;
+
+ALTER TABLE "AuditEvent" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY "AuditEvent_isolation" ON "AuditEvent"
+USING (rls_bypassed() OR organization_id = current_organization_id());
+
+-- 親のテーブルのポリシーで絞り込まれた行のみ参照できる
+
+ALTER TABLE "CallingWindow" ENABLE ROW LEVEL SECURITY;
+
+ALTER TABLE "CallingWindow" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY "CallingWindow_isolation" ON "CallingWindow"
+USING (rls_bypassed() OR EXISTS (SELECT 1 FROM "Book" b WHERE b.id = "CallingWindow".book_id));
+
+ALTER TABLE "Customer" ENABLE ROW LEVEL SECURITY;
+
+ALTER TABLE "Customer" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY "Customer_isolation" ON "Customer"
+USING (rls_bypassed() OR EXISTS (SELECT 1 FROM "Book" b WHERE b.id = "Customer".book_id));
+
+ALTER TABLE "Tag" ENABLE ROW LEVEL SECURITY;
+
+ALTER TABLE "Tag" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY "Tag_isolation" ON "Tag"
+USING (rls_bypassed() OR EXISTS (SELECT 1 FROM "Book" b WHERE b.id = "Tag".book_id));
+
+ALTER TABLE "CustomField" ENABLE ROW LEVEL SECURITY;
+
+ALTER TABLE "CustomField" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY "CustomField_isolation" ON "CustomField"
+USING (rls_bypassed() OR EXISTS (SELECT 1 FROM "Book" b WHERE b.id = "CustomField".book_id));
+
+ALTER TABLE "Redial" ENABLE ROW LEVEL SECURITY;
+
+ALTER TABLE "Redial" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY "Redial_isolation" ON "Redial"
+USING (rls_bypassed() OR EXISTS (SELECT 1 FROM "Customer" c WHERE c.id = "Redial".id));
+
+ALTER TABLE "Contact" ENABLE ROW LEVEL SECURITY;
+
+ALTER TABLE "Contact" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY "Contact_isolation" ON "Contact"
+USING (rls_bypassed() OR EXISTS (SELECT 1 FROM "Customer" c WHERE c.id = "Contact".customer_id));
+
+ALTER TABLE "Note" ENABLE ROW LEVEL SECURITY;
+
+ALTER TABLE "Note" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY "Note_isolation" ON "Note"
+USING (rls_bypassed() OR EXISTS (SELECT 1 FROM "Customer" c WHERE c.id = "Note".customer_id));
+
+ALTER TABLE "Call" ENABLE ROW LEVEL SECURITY;
+
+ALTER TABLE "Call" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY "Call_isolation" ON "Call"
+USING (rls_bypassed() OR EXISTS (SELECT 1 FROM "Customer" c WHERE c.id = "Call".customer_id));
+
+ALTER TABLE "CustomerTag" ENABLE ROW LEVEL SECURITY;
+
+ALTER TABLE "CustomerTag" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY "CustomerTag_isolation" ON "CustomerTag"
+USING (rls_bypassed() OR EXISTS (SELECT 1 FROM "Customer" c WHERE c.id = "CustomerTag".customer_id));
+
+ALTER TABLE "DialLease" ENABLE ROW LEVEL SECURITY;
+
+ALTER TABLE "DialLease" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY "DialLease_isolation" ON "DialLease"
+USING (rls_bypassed() OR EXISTS (SELECT 1 FROM "Customer" c WHERE c.id = "DialLease".customer_id));
+
+ALTER TABLE "WebhookDelivery" ENABLE ROW LEVEL SECURITY;
+
+ALTER TABLE "WebhookDelivery" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY "WebhookDelivery_isolation" ON "WebhookDelivery"
+USING (rls_bypassed() OR EXISTS (SELECT 1 FROM "Webhook" w WHERE w.id = "WebhookDelivery".webhook_id));
@@ -0,0 +1,3 @@
+ALTER TABLE "Contact" DROP CONSTRAINT "Contact_staff_id_fkey";
+
+ALTER TABLE "Staff" ADD CONSTRAINT "Staff_id_fkey" FOREIGN KEY ("id") REFERENCES "Contact" ("staff_id");
@@ -0,0 +1,5 @@
+-- 初期スキーマでは Staff.id が Contact.staff_id を参照しており、連絡先より先に担当者を作成できなかった。
+-- 担当者の直通番号として Contact が Staff を参照する向きに直す
+ALTER TABLE "Staff" DROP CONSTRAINT "Staff_id_fkey";
+
+ALTER TABLE "Contact" ADD CONSTRAINT "Contact_staff_id_fkey" FOREIGN KEY ("staff_id") REFERENCES "Staff" ("id") ON DELETE SET NULL;
@@ -0,0 +1,7 @@
+ALTER TABLE "Redial" DROP CONSTRAINT "Redial_id_fkey";
+
+ALTER TABLE "Redial" ADD CONSTRAINT "Redial_id_fkey" FOREIGN KEY ("id") REFERENCES "Customer" ("id");
+
+ALTER TABLE "Contact" DROP CONSTRAINT "Contact_customer_id_fkey";
+
+ALTER TABLE "Contact" ADD CONSTRAINT "Contact_customer_id_fkey" FOREIGN KEY ("customer_id") REFERENCES "Customer" ("id");
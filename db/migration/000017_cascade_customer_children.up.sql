@@ -0,0 +1,8 @@
+-- ゴミ箱の完全削除で顧客を消したときに連絡先と再架電も削除されるようにする
+ALTER TABLE "Contact" DROP CONSTRAINT "Contact_customer_id_fkey";
+
+ALTER TABLE "Contact" ADD CONSTRAINT "Contact_customer_id_fkey" FOREIGN KEY ("customer_id") REFERENCES "Customer" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
+
+ALTER TABLE "Redial" DROP CONSTRAINT "Redial_id_fkey";
+
+ALTER TABLE "Redial" ADD CONSTRAINT "Redial_id_fkey" FOREIGN KEY ("id") REFERENCES "Customer" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
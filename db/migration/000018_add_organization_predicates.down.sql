@@ -0,0 +1,3 @@
+DROP FUNCTION IF EXISTS customer_in_organization(uuid);
+
+DROP FUNCTION IF EXISTS book_in_organization(uuid);
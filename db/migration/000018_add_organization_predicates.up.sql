@@ -0,0 +1,21 @@
+-- クエリで組織の絞り込みを明示するための関数。行レベルセキュリティとは別に、
+-- リストや顧客を親に持つテーブルのクエリが現在の組織の行だけを対象にするよう WHERE で使う
+
+CREATE FUNCTION book_in_organization(uuid) RETURNS bool
+LANGUAGE sql STABLE
+AS $$
+  SELECT EXISTS (
+    SELECT 1 FROM "Book" b
+    WHERE b.id = $1 AND b.organization_id = current_organization_id()
+  )
+$$;
+
+CREATE FUNCTION customer_in_organization(uuid) RETURNS bool
+LANGUAGE sql STABLE
+AS $$
+  SELECT EXISTS (
+    SELECT 1 FROM "Customer" c
+    JOIN "Book" b ON b.id = c.book_id
+    WHERE c.id = $1 AND b.organization_id = current_organization_id()
+  )
+$$;
@@ -0,0 +1,18 @@
+-- アプリケーションが接続するロール。スーパーユーザーやテーブルの所有者は行レベルセキュリティを
+-- 迂回できるため、所有者ではなく権限だけを付与したロールで接続する。
+-- ロールはクラスタ全体で共有されるため、既に作成されている場合はそのまま使う
+DO $$
+BEGIN
+  IF NOT EXISTS (SELECT 1 FROM pg_roles WHERE rolname = 'prism_app') THEN
+    CREATE ROLE prism_app NOLOGIN NOSUPERUSER NOBYPASSRLS NOCREATEDB NOCREATEROLE;
+  END IF;
+END
+$$;
+
+GRANT USAGE ON SCHEMA public TO prism_app;
+GRANT SELECT, INSERT, UPDATE, DELETE ON ALL TABLES IN SCHEMA public TO prism_app;
+GRANT USAGE, SELECT ON ALL SEQUENCES IN SCHEMA public TO prism_app;
+
+-- 以降のマイグレーションで追加するテーブルにも同じ権限を付与する
+ALTER DEFAULT PRIVILEGES IN SCHEMA public GRANT SELECT, INSERT, UPDATE, DELETE ON TABLES TO prism_app;
+ALTER DEFAULT PRIVILEGES IN SCHEMA public GRANT USAGE, SELECT ON SEQUENCES TO prism_app;
@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS "Redial_booking" ON "Redial";
+
+DROP FUNCTION IF EXISTS record_redial_booking();
+
+DROP TABLE IF EXISTS "RedialBooking";
@@ -0,0 +1,45 @@
+-- 再架電の予約履歴。Redial は顧客ごとに1行で、予約し直すと booked_by と booked_at が上書きされるため、
+-- 予約件数の集計はこのテーブルを使う
+CREATE TABLE "RedialBooking" (
+  "id" uuid PRIMARY KEY DEFAULT (gen_random_uuid()),
+  "customer_id" uuid NOT NULL,
+  "booked_by" uuid,
+  "booked_at" timestamptz NOT NULL,
+  "scheduled_at" timestamptz NOT NULL
+);
+
+COMMENT ON TABLE "RedialBooking" IS '再架電の予約履歴。Redial の予約時にトリガーで追加する';
+
+CREATE INDEX ON "RedialBooking" ("booked_by", "booked_at");
+
+CREATE INDEX ON "RedialBooking" ("customer_id");
+
+ALTER TABLE "RedialBooking" ADD FOREIGN KEY ("customer_id") REFERENCES "Customer" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
+
+ALTER TABLE "RedialBooking" ADD FOREIGN KEY ("booked_by") REFERENCES "User" ("id") ON DELETE SET NULL;
+
+ALTER TABLE "RedialBooking" ENABLE ROW LEVEL SECURITY;
+
+ALTER TABLE "RedialBooking" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY "RedialBooking_isolation" ON "RedialBooking"
+USING (rls_bypassed() OR EXISTS (SELECT 1 FROM "Customer" c WHERE c.id = "RedialBooking".customer_id));
+
+-- 同じトランザクションで予約し直すと booked_at が変わらないため、値の比較ではなく
+-- booked_at を SET したかどうかで予約を判定する
+CREATE FUNCTION record_redial_booking() RETURNS trigger
+LANGUAGE plpgsql
+AS $$
+BEGIN
+  INSERT INTO "RedialBooking" (customer_id, booked_by, booked_at, scheduled_at)
+  VALUES (NEW.id, NEW.booked_by, NEW.booked_at, NEW.scheduled_at);
+  RETURN NULL;
+END;
+$$;
+
+CREATE TRIGGER "Redial_booking" AFTER INSERT OR UPDATE OF booked_at ON "Redial"
+FOR EACH ROW EXECUTE FUNCTION record_redial_booking();
+
+-- 既存の予約は上書きされた後の最新のものだけが残っている
+INSERT INTO "RedialBooking" (customer_id, booked_by, booked_at, scheduled_at)
+SELECT id, booked_by, booked_at, scheduled_at FROM "Redial";
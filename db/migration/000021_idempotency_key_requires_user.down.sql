@@ -0,0 +1 @@
+COMMENT ON COLUMN "IdempotencyKey"."user_id" IS 'キーはユーザーごと。ユーザーがない場合はゼロのUUID';
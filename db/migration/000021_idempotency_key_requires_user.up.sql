@@ -0,0 +1 @@
+COMMENT ON COLUMN "IdempotencyKey"."user_id" IS 'キーはユーザーごと。ユーザーがないリクエストの Idempotency-Key は受け付けない';
@@ -0,0 +1,83 @@
+-- バックグラウンド処理など組織をまたぐ処理は prism.bypass_rls を on にする
+CREATE FUNCTION rls_bypassed() RETURNS bool
+LANGUAGE sql STABLE
+AS $$
+  SELECT COALESCE(current_setting('prism.bypass_rls', true), '') = 'on'
+$$;
+
+ALTER POLICY "Organization_isolation" ON "Organization"
+USING (rls_bypassed() OR id = current_organization_id());
+
+ALTER POLICY "User_isolation" ON "User"
+USING (rls_bypassed() OR organization_id = current_organization_id());
+
+ALTER POLICY "Book_isolation" ON "Book"
+USING (rls_bypassed() OR organization_id = current_organization_id());
+
+ALTER POLICY "Status_isolation" ON "Status"
+USING (rls_bypassed() OR organization_id = current_organization_id());
+
+ALTER POLICY "Category_isolation" ON "Category"
+USING (rls_bypassed() OR organization_id = current_organization_id());
+
+ALTER POLICY "Staff_isolation" ON "Staff"
+USING (rls_bypassed() OR organization_id = current_organization_id());
+
+ALTER POLICY "DoNotCall_isolation" ON "DoNotCall"
+USING (rls_bypassed() OR organization_id = current_organization_id());
+
+ALTER POLICY "Job_isolation" ON "Job"
+USING (rls_bypassed() OR organization_id = current_organization_id());
+
+ALTER POLICY "Webhook_isolation" ON "Webhook"
+USING (rls_bypassed() OR organization_id = current_organization_id());
+
+ALTER POLICY "OutboxEvent_isolation" ON "OutboxEvent"
+USING (rls_bypassed() OR organization_id = current_organization_id());
+
+ALTER POLICY "AuditEvent_isolation" ON "AuditEvent"
+USING (rls_bypassed() OR organization_id = current_organization_id());
+
+ALTER POLICY "CallingWindow_isolation" ON "CallingWindow"
+USING (rls_bypassed() OR EXISTS (SELECT 1 FROM "Book" b WHERE b.id = "CallingWindow".book_id));
+
+ALTER POLICY "Customer_isolation" ON "Customer"
+USING (rls_bypassed() OR EXISTS (SELECT 1 FROM "Book" b WHERE b.id = "Customer".book_id));
+
+ALTER POLICY "Tag_isolation" ON "Tag"
+USING (rls_bypassed() OR EXISTS (SELECT 1 FROM "Book" b WHERE b.id = "Tag".book_id));
+
+ALTER POLICY "CustomField_isolation" ON "CustomField"
+USING (rls_bypassed() OR EXISTS (SELECT 1 FROM "Book" b WHERE b.id = "CustomField".book_id));
+
+ALTER POLICY "Redial_isolation" ON "Redial"
+USING (rls_bypassed() OR EXISTS (SELECT 1 FROM "Customer" c WHERE c.id = "Redial".id));
+
+ALTER POLICY "Contact_isolation" ON "Contact"
+USING (rls_bypassed() OR EXISTS (SELECT 1 FROM "Customer" c WHERE c.id = "Contact".customer_id));
+
+ALTER POLICY "Note_isolation" ON "Note"
+USING (rls_bypassed() OR EXISTS (SELECT 1 FROM "Customer" c WHERE c.id = "Note".customer_id));
+
+ALTER POLICY "Call_isolation" ON "Call"
+USING (rls_bypassed() OR EXISTS (SELECT 1 FROM "Customer" c WHERE c.id = "Call".customer_id));
+
+ALTER POLICY "CustomerTag_isolation" ON "CustomerTag"
+USING (rls_bypassed() OR EXISTS (SELECT 1 FROM "Customer" c WHERE c.id = "CustomerTag".customer_id));
+
+ALTER POLICY "DialLease_isolation" ON "DialLease"
+USING (rls_bypassed() OR EXISTS (SELECT 1 FROM "Customer" c WHERE c.id = "DialLease".customer_id));
+
+ALTER POLICY "WebhookDelivery_isolation" ON "WebhookDelivery"
+USING (rls_bypassed() OR EXISTS (SELECT 1 FROM "Webhook" w WHERE w.id = "WebhookDelivery".webhook_id));
+
+ALTER POLICY "RedialBooking_isolation" ON "RedialBooking"
+USING (rls_bypassed() OR EXISTS (SELECT 1 FROM "Customer" c WHERE c.id = "RedialBooking".customer_id));
+
+-- ロールは他のデータベースでも使われている可能性があるため削除しない
+ALTER DEFAULT PRIVILEGES IN SCHEMA public REVOKE USAGE, SELECT ON SEQUENCES FROM prism_system;
+ALTER DEFAULT PRIVILEGES IN SCHEMA public REVOKE SELECT, INSERT, UPDATE, DELETE ON TABLES FROM prism_system;
+
+REVOKE USAGE, SELECT ON ALL SEQUENCES IN SCHEMA public FROM prism_system;
+REVOKE SELECT, INSERT, UPDATE, DELETE ON ALL TABLES IN SCHEMA public FROM prism_system;
+REVOKE USAGE ON SCHEMA public FROM prism_system;
@@ -0,0 +1,87 @@
+-- 組織をまたぐバックグラウンド処理が接続するロール。prism.bypass_rls はどのセッションからも
+-- 設定できてしまうため、ポリシーでは迂回させず BYPASSRLS のロールで接続を分ける
+DO $$
+BEGIN
+  IF NOT EXISTS (SELECT 1 FROM pg_roles WHERE rolname = 'prism_system') THEN
+    CREATE ROLE prism_system NOLOGIN NOSUPERUSER BYPASSRLS NOCREATEDB NOCREATEROLE;
+  END IF;
+END
+$$;
+
+GRANT USAGE ON SCHEMA public TO prism_system;
+GRANT SELECT, INSERT, UPDATE, DELETE ON ALL TABLES IN SCHEMA public TO prism_system;
+GRANT USAGE, SELECT ON ALL SEQUENCES IN SCHEMA public TO prism_system;
+
+ALTER DEFAULT PRIVILEGES IN SCHEMA public GRANT SELECT, INSERT, UPDATE, DELETE ON TABLES TO prism_system;
+ALTER DEFAULT PRIVILEGES IN SCHEMA public GRANT USAGE, SELECT ON SEQUENCES TO prism_system;
+
+ALTER POLICY "Organization_isolation" ON "Organization"
+USING (id = current_organization_id());
+
+ALTER POLICY "User_isolation" ON "User"
+USING (organization_id = current_organization_id());
+
+ALTER POLICY "Book_isolation" ON "Book"
+USING (organization_id = current_organization_id());
+
+ALTER POLICY "Status_isolation" ON "Status"
+USING (organization_id = current_organization_id());
+
+ALTER POLICY "Category_isolation" ON "Category"
+USING (organization_id = current_organization_id());
+
+ALTER POLICY "Staff_isolation" ON "Staff"
+USING (organization_id = current_organization_id());
+
+ALTER POLICY "DoNotCall_isolation" ON "DoNotCall"
+USING (organization_id = current_organization_id());
+
+ALTER POLICY "Job_isolation" ON "Job"
+USING (organization_id = current_organization_id());
+
+ALTER POLICY "Webhook_isolation" ON "Webhook"
+USING (organization_id = current_organization_id());
+
+ALTER POLICY "OutboxEvent_isolation" ON "OutboxEvent"
+USING (organization_id = current_organization_id());
+
+ALTER POLICY "AuditEvent_isolation" ON "AuditEvent"
+USING (organization_id = current_organization_id());
+
+ALTER POLICY "CallingWindow_isolation" ON "CallingWindow"
+USING (EXISTS (SELECT 1 FROM "Book" b WHERE b.id = "CallingWindow".book_id));
+
+ALTER POLICY "Customer_isolation" ON "Customer"
+USING (EXISTS (SELECT 1 FROM "Book" b WHERE b.id = "Customer".book_id));
+
+ALTER POLICY "Tag_isolation" ON "Tag"
+USING (EXISTS (SELECT 1 FROM "Book" b WHERE b.id = "Tag".book_id));
+
+ALTER POLICY "CustomField_isolation" ON "CustomField"
+USING (EXISTS (SELECT 1 FROM "Book" b WHERE b.id = "CustomField".book_id));
+
+ALTER POLICY "Redial_isolation" ON "Redial"
+USING (EXISTS (SELECT 1 FROM "Customer" c WHERE c.id = "Redial".id));
+
+ALTER POLICY "Contact_isolation" ON "Contact"
+USING (EXISTS (SELECT 1 FROM "Customer" c WHERE c.id = "Contact".customer_id));
+
+ALTER POLICY "Note_isolation" ON "Note"
+USING (EXISTS (SELECT 1 FROM "Customer" c WHERE c.id = "Note".customer_id));
+
+ALTER POLICY "Call_isolation" ON "Call"
+USING (EXISTS (SELECT 1 FROM "Customer" c WHERE c.id = "Call".customer_id));
+
+ALTER POLICY "CustomerTag_isolation" ON "CustomerTag"
+USING (EXISTS (SELECT 1 FROM "Customer" c WHERE c.id = "CustomerTag".customer_id));
+
+ALTER POLICY "DialLease_isolation" ON "DialLease"
+USING (EXISTS (SELECT 1 FROM "Customer" c WHERE c.id = "DialLease".customer_id));
+
+ALTER POLICY "WebhookDelivery_isolation" ON "WebhookDelivery"
+USING (EXISTS (SELECT 1 FROM "Webhook" w WHERE w.id = "WebhookDelivery".webhook_id));
+
+ALTER POLICY "RedialBooking_isolation" ON "RedialBooking"
+USING (EXISTS (SELECT 1 FROM "Customer" c WHERE c.id = "RedialBooking".customer_id));
+
+DROP FUNCTION rls_bypassed();
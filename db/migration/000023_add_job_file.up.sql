@@ -0,0 +1,17 @@
+CREATE TABLE "JobFile" (
+  "job_id" uuid PRIMARY KEY,
+  "content_type" varchar NOT NULL,
+  "content" bytea NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+COMMENT ON TABLE "JobFile" IS 'エクスポートなどジョブが作成したファイル。Job.result_location から取得する';
+
+ALTER TABLE "JobFile" ADD FOREIGN KEY ("job_id") REFERENCES "Job" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "JobFile" ENABLE ROW LEVEL SECURITY;
+
+ALTER TABLE "JobFile" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY "JobFile_isolation" ON "JobFile"
+USING (EXISTS (SELECT 1 FROM "Job" j WHERE j.id = "JobFile".job_id));
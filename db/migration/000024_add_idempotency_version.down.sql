@@ -0,0 +1 @@
+ALTER TABLE "IdempotencyKey" DROP COLUMN "version";
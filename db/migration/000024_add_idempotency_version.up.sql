@@ -0,0 +1,3 @@
+ALTER TABLE "IdempotencyKey" ADD COLUMN "version" bigint;
+
+COMMENT ON COLUMN "IdempotencyKey"."version" IS 'レスポンスの ETag に使った version。再送時に同じ ETag を返す';
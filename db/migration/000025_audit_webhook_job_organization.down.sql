@@ -0,0 +1,42 @@
+DROP TRIGGER IF EXISTS "Job_audit" ON "Job";
+
+DROP TRIGGER IF EXISTS "Webhook_audit" ON "Webhook";
+
+DROP TRIGGER IF EXISTS "Organization_audit" ON "Organization";
+
+CREATE OR REPLACE FUNCTION audit_row_change() RETURNS trigger
+LANGUAGE plpgsql
+AS $$
+DECLARE
+  old_row jsonb := CASE WHEN TG_OP <> 'INSERT' THEN to_jsonb(OLD) END;
+  new_row jsonb := CASE WHEN TG_OP <> 'DELETE' THEN to_jsonb(NEW) END;
+  before_row jsonb := old_row;
+  after_row jsonb := new_row;
+BEGIN
+  IF TG_OP = 'UPDATE' THEN
+    SELECT jsonb_object_agg(o.key, o.value) INTO before_row
+    FROM jsonb_each(old_row) o
+    WHERE o.value IS DISTINCT FROM new_row -> o.key;
+
+    IF before_row IS NULL THEN
+      RETURN NULL;
+    END IF;
+
+    SELECT jsonb_object_agg(n.key, n.value) INTO after_row
+    FROM jsonb_each(new_row) n
+    WHERE n.value IS DISTINCT FROM old_row -> n.key;
+  END IF;
+
+  INSERT INTO "AuditEvent" (actor_id, rpc, entity_type, entity_id, action, before, after)
+  VALUES (
+    NULLIF(current_setting('prism.actor_id', true), '')::uuid,
+    NULLIF(current_setting('prism.rpc', true), ''),
+    TG_TABLE_NAME,
+    COALESCE(new_row, old_row) ->> TG_ARGV[0],
+    lower(TG_OP)::audit_action,
+    before_row,
+    after_row
+  );
+  RETURN NULL;
+END;
+$$;
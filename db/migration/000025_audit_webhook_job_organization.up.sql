@@ -0,0 +1,60 @@
+-- 行の変更を AuditEvent に記録する。1つ目の引数は主キーの列名。
+-- 2つ目以降の引数の列は変更の有無のみ記録し、値は残さない
+CREATE OR REPLACE FUNCTION audit_row_change() RETURNS trigger
+LANGUAGE plpgsql
+AS $$
+DECLARE
+  old_row jsonb := CASE WHEN TG_OP <> 'INSERT' THEN to_jsonb(OLD) END;
+  new_row jsonb := CASE WHEN TG_OP <> 'DELETE' THEN to_jsonb(NEW) END;
+  before_row jsonb := old_row;
+  after_row jsonb := new_row;
+  redacted text;
+BEGIN
+  IF TG_OP = 'UPDATE' THEN
+    SELECT jsonb_object_agg(o.key, o.value) INTO before_row
+    FROM jsonb_each(old_row) o
+    WHERE o.value IS DISTINCT FROM new_row -> o.key;
+
+    IF before_row IS NULL THEN
+      RETURN NULL;
+    END IF;
+
+    SELECT jsonb_object_agg(n.key, n.value) INTO after_row
+    FROM jsonb_each(new_row) n
+    WHERE n.value IS DISTINCT FROM old_row -> n.key;
+  END IF;
+
+  FOREACH redacted IN ARRAY COALESCE(TG_ARGV[1:], '{}') LOOP
+    IF before_row ? redacted THEN
+      before_row := jsonb_set(before_row, ARRAY[redacted], '"[redacted]"');
+    END IF;
+    IF after_row ? redacted THEN
+      after_row := jsonb_set(after_row, ARRAY[redacted], '"[redacted]"');
+    END IF;
+  END LOOP;
+
+  INSERT INTO "AuditEvent" (actor_id, rpc, entity_type, entity_id, action, before, after)
+  VALUES (
+    NULLIF(current_setting('prism.actor_id', true), '')::uuid,
+    NULLIF(current_setting('prism.rpc', true), ''),
+    TG_TABLE_NAME,
+    COALESCE(new_row, old_row) ->> TG_ARGV[0],
+    lower(TG_OP)::audit_action,
+    before_row,
+    after_row
+  );
+  RETURN NULL;
+END;
+$$;
+
+CREATE TRIGGER "Organization_audit" AFTER INSERT OR UPDATE OR DELETE ON "Organization"
+FOR EACH ROW EXECUTE FUNCTION audit_row_change('id');
+
+-- 署名の秘密鍵は記録しない
+CREATE TRIGGER "Webhook_audit" AFTER INSERT OR UPDATE OR DELETE ON "Webhook"
+FOR EACH ROW EXECUTE FUNCTION audit_row_change('id', 'secret');
+
+-- ワーカーによる進捗や状態の更新は記録せず、作成とキャンセルのみ記録する。
+-- パラメータと結果はインポートするCSVなどを含むため記録しない
+CREATE TRIGGER "Job_audit" AFTER INSERT OR DELETE OR UPDATE OF cancel_requested ON "Job"
+FOR EACH ROW EXECUTE FUNCTION audit_row_change('id', 'params', 'result');
@@ -0,0 +1,195 @@
+// Package migration db/migration のSQLをバイナリに埋め込み、サーバーから適用する
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"github.com/golang-migrate/migrate/v4"
+	pgxmigrate "github.com/golang-migrate/migrate/v4/database/pgx/v5"
+	"github.com/golang-migrate/migrate/v4/source"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/rs/zerolog/log"
+)
+
+//go:embed *.sql
+var files embed.FS
+
+// lockID 複数のレプリカが同時にマイグレーションしないように取る advisory lock のキー
+const lockID = 7_152_004_901
+
+// Status 適用済みのバージョンと埋め込まれた最新のバージョン
+type Status struct {
+	Version uint
+	Dirty   bool
+	Latest  uint
+}
+
+// Pending 未適用のマイグレーションがあるか
+func (status Status) Pending() bool {
+	return status.Version < status.Latest
+}
+
+type Migrator struct {
+	db      *sql.DB
+	source  source.Driver
+	migrate *migrate.Migrate
+}
+
+// New dbSource のデータベースに埋め込んだマイグレーションを適用する Migrator を作成する
+func New(dbSource string) (*Migrator, error) {
+	connConfig, err := pgx.ParseConfig(dbSource)
+	if err != nil {
+		return nil, err
+	}
+	// 000022 より前のポリシーは prism.bypass_rls で迂回するため、
+	// それまでのデータを書き換えるマイグレーションが全組織の行を扱えるようにする
+	connConfig.RuntimeParams["prism.bypass_rls"] = "on"
+	db := stdlib.OpenDB(*connConfig)
+
+	src, err := iofs.New(files, ".")
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	driver, err := pgxmigrate.WithInstance(db, &pgxmigrate.Config{})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	m, err := migrate.NewWithInstance("iofs", src, "pgx5", driver)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	m.Log = logger{}
+
+	return &Migrator{
+		db:      db,
+		source:  src,
+		migrate: m,
+	}, nil
+}
+
+func (migrator *Migrator) Close() error {
+	srcErr, dbErr := migrator.migrate.Close()
+	return errors.Join(srcErr, dbErr, migrator.db.Close())
+}
+
+// Up steps が0の場合はすべて適用する
+func (migrator *Migrator) Up(ctx context.Context, steps int) error {
+	return migrator.withLock(ctx, func() error {
+		if steps > 0 {
+			return migrator.migrate.Steps(steps)
+		}
+		return migrator.migrate.Up()
+	})
+}
+
+// Down steps 件のマイグレーションを戻す
+func (migrator *Migrator) Down(ctx context.Context, steps int) error {
+	if steps <= 0 {
+		return fmt.Errorf("steps must be positive: %d", steps)
+	}
+	return migrator.withLock(ctx, func() error {
+		return migrator.migrate.Steps(-steps)
+	})
+}
+
+// Force 失敗して dirty になったバージョンを、手動で修正した後に version として記録し直す
+func (migrator *Migrator) Force(ctx context.Context, version int) error {
+	return migrator.withLock(ctx, func() error {
+		return migrator.migrate.Force(version)
+	})
+}
+
+func (migrator *Migrator) Status() (Status, error) {
+	var status Status
+	version, dirty, err := migrator.migrate.Version()
+	if err != nil && !errors.Is(err, migrate.ErrNilVersion) {
+		return status, err
+	}
+	status.Version = version
+	status.Dirty = dirty
+
+	status.Latest, err = latestVersion(migrator.source)
+	return status, err
+}
+
+// Latest 埋め込まれた最新のバージョン
+func Latest() (uint, error) {
+	src, err := iofs.New(files, ".")
+	if err != nil {
+		return 0, err
+	}
+	defer src.Close()
+	return latestVersion(src)
+}
+
+// Current 適用済みのバージョン。サーバーのコネクションプールから確認する
+func Current(ctx context.Context, conn interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}) (uint, bool, error) {
+	var version int64
+	var dirty bool
+	err := conn.QueryRow(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&version, &dirty)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, false, nil
+	}
+	return uint(version), dirty, err
+}
+
+func latestVersion(src source.Driver) (uint, error) {
+	var latest uint
+	version, err := src.First()
+	for err == nil {
+		latest = version
+		version, err = src.Next(version)
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return 0, err
+	}
+	return latest, nil
+}
+
+// withLock レプリカが同時に起動してもマイグレーションが並行しないように、接続を固定して advisory lock を取る
+func (migrator *Migrator) withLock(ctx context.Context, fn func() error) error {
+	conn, err := migrator.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", lockID)
+	if err != nil {
+		return fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+	defer func() {
+		_, err := conn.ExecContext(context.WithoutCancel(ctx), "SELECT pg_advisory_unlock($1)", lockID)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to release migration lock")
+		}
+	}()
+
+	err = fn()
+	if errors.Is(err, migrate.ErrNoChange) {
+		return nil
+	}
+	return err
+}
+
+type logger struct{}
+
+func (logger) Printf(format string, v ...any) {
+	log.Info().Msgf(format, v...)
+}
+
+func (logger) Verbose() bool {
+	return true
+}
@@ -0,0 +1,16 @@
+-- name: SetAuditContext :exec
+-- トランザクション内の変更を記録するトリガーに操作したユーザーとRPCを渡す
+SELECT
+  set_config('prism.actor_id', sqlc.arg(actor_id)::text, true),
+  set_config('prism.rpc', sqlc.arg(rpc)::text, true);
+
+-- name: ListAuditEvents :many
+SELECT * FROM "AuditEvent"
+WHERE organization_id = current_organization_id()
+AND (sqlc.narg(entity_type)::varchar IS NULL OR entity_type = sqlc.narg(entity_type))
+AND (sqlc.narg(entity_id)::varchar IS NULL OR entity_id = sqlc.narg(entity_id))
+AND (sqlc.narg(actor_id)::uuid IS NULL OR actor_id = sqlc.narg(actor_id))
+AND (sqlc.narg(from_time)::timestamptz IS NULL OR created_at >= sqlc.narg(from_time))
+AND (sqlc.narg(to_time)::timestamptz IS NULL OR created_at < sqlc.narg(to_time))
+ORDER BY created_at DESC, id
+LIMIT sqlc.arg(row_limit) OFFSET sqlc.arg(row_offset);
@@ -5,15 +5,47 @@ RETURNING *;
 
 -- name: GetBook :one
 SELECT * FROM "Book"
-WHERE id = $1 LIMIT 1;
+WHERE id = $1 AND organization_id = current_organization_id()
+AND deleted_at IS NULL LIMIT 1;
+
+-- name: ListBookIDs :many
+SELECT id FROM "Book"
+WHERE organization_id = current_organization_id()
+AND deleted_at IS NULL
+ORDER BY created_at;
 
 -- name: UpdateBook :one
 UPDATE "Book"
 SET 
-  name = COALESCE(sqlc.narg(name), name)
-WHERE id = sqlc.arg(id)
+  name = COALESCE(sqlc.narg(name), name),
+  timezone = COALESCE(sqlc.narg(timezone), timezone),
+  skip_holidays = COALESCE(sqlc.narg(skip_holidays), skip_holidays)
+WHERE id = sqlc.arg(id) AND organization_id = current_organization_id()
+AND deleted_at IS NULL
+AND (sqlc.narg(version)::bigint IS NULL OR version = sqlc.narg(version))
+RETURNING *;
+
+-- name: SoftDeleteBook :one
+UPDATE "Book"
+SET deleted_at = now()
+WHERE id = sqlc.arg(id) AND organization_id = current_organization_id()
+AND deleted_at IS NULL
+RETURNING *;
+
+-- name: GetDeletedBook :one
+SELECT * FROM "Book"
+WHERE id = $1 AND organization_id = current_organization_id()
+AND deleted_at IS NOT NULL LIMIT 1
+FOR UPDATE;
+
+-- name: RestoreBook :one
+UPDATE "Book"
+SET deleted_at = NULL
+WHERE id = sqlc.arg(id) AND organization_id = current_organization_id()
+AND deleted_at IS NOT NULL
 RETURNING *;
 
--- name: DeleteBook :exec
+-- name: PurgeBooks :execrows
+-- 保持期間を過ぎた全組織のリストを完全に削除する。顧客と架電履歴、連絡先、再架電も ON DELETE CASCADE で削除される
 DELETE FROM "Book"
-WHERE id = sqlc.arg(id);
\ No newline at end of file
+WHERE deleted_at < sqlc.arg(deleted_before)::timestamptz;
\ No newline at end of file
@@ -0,0 +1,19 @@
+-- name: CreateCall :one
+INSERT INTO "Call" (id, customer_id, user_id, status_id)
+SELECT sqlc.arg(id)::uuid, sqlc.arg(customer_id)::uuid, sqlc.arg(user_id)::uuid, sqlc.narg(status_id)::uuid
+WHERE customer_in_organization(sqlc.arg(customer_id))
+RETURNING *;
+
+-- name: GetCall :one
+SELECT * FROM "Call"
+WHERE id = $1 AND customer_in_organization(customer_id) LIMIT 1;
+
+-- name: ListCallsByCustomer :many
+SELECT * FROM "Call"
+WHERE customer_id = $1 AND customer_in_organization(customer_id)
+ORDER BY created_at DESC
+LIMIT $2 OFFSET $3;
+
+-- name: DeleteCall :exec
+DELETE FROM "Call"
+WHERE id = sqlc.arg(id) AND customer_in_organization(customer_id);
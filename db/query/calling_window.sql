@@ -0,0 +1,23 @@
+-- name: GetBookByCustomer :one
+SELECT b.* FROM "Book" b
+JOIN "Customer" c ON c.book_id = b.id
+WHERE c.id = $1
+AND b.organization_id = current_organization_id()
+AND c.deleted_at IS NULL
+AND b.deleted_at IS NULL
+LIMIT 1;
+
+-- name: CreateCallingWindow :one
+INSERT INTO "CallingWindow" (id, book_id, weekday, start_time, end_time)
+SELECT sqlc.arg(id)::uuid, sqlc.arg(book_id)::uuid, sqlc.arg(weekday)::smallint, sqlc.arg(start_time)::time, sqlc.arg(end_time)::time
+WHERE book_in_organization(sqlc.arg(book_id))
+RETURNING *;
+
+-- name: ListCallingWindows :many
+SELECT * FROM "CallingWindow"
+WHERE book_id = $1 AND book_in_organization(book_id)
+ORDER BY weekday, start_time;
+
+-- name: DeleteCallingWindows :exec
+DELETE FROM "CallingWindow"
+WHERE book_id = sqlc.arg(book_id) AND book_in_organization(book_id);
@@ -5,15 +5,15 @@ RETURNING *;
 
 -- name: GetCategory :one
 SELECT * FROM "Category"
-WHERE id = $1 LIMIT 1;
+WHERE id = $1 AND organization_id = current_organization_id() LIMIT 1;
 
 -- name: UpdateCategory :one
 UPDATE "Category"
 SET 
   name = COALESCE(sqlc.narg(name), name)
-WHERE id = sqlc.arg(id)
+WHERE id = sqlc.arg(id) AND organization_id = current_organization_id()
 RETURNING *;
 
 -- name: DeleteCategory :exec
 DELETE FROM "Category"
-WHERE id = sqlc.arg(id);
\ No newline at end of file
+WHERE id = sqlc.arg(id) AND organization_id = current_organization_id();
\ No newline at end of file
@@ -1,11 +1,19 @@
 -- name: CreateContact :one
 INSERT INTO "Contact" (id, customer_id, staff_id, phone, mail, fax)
-VALUES ($1, $2, $3, $4, $5, $6)
+SELECT
+  sqlc.arg(id)::uuid,
+  sqlc.arg(customer_id)::uuid,
+  sqlc.narg(staff_id)::uuid,
+  sqlc.arg(phone)::varchar,
+  sqlc.narg(mail)::varchar,
+  sqlc.narg(fax)::varchar
+WHERE customer_in_organization(sqlc.arg(customer_id))
 RETURNING *;
 
 -- name: GetContact :one
 SELECT * FROM "Contact"
-WHERE id = $1 LIMIT 1;
+WHERE id = $1 AND customer_in_organization(customer_id)
+AND deleted_at IS NULL LIMIT 1;
 
 -- name: UpdateContact :one
 UPDATE "Contact"
@@ -14,9 +22,49 @@ SET
   mail = COALESCE(sqlc.narg(mail), mail),
   fax = COALESCE(sqlc.narg(fax), fax)
 WHERE 
-  id = sqlc.arg(id)
+  id = sqlc.arg(id) AND customer_in_organization(customer_id)
+  AND deleted_at IS NULL
+  AND (sqlc.narg(version)::bigint IS NULL OR version = sqlc.narg(version))
 RETURNING *;
 
 -- name: DeleteContact :exec
+UPDATE "Contact"
+SET deleted_at = now()
+WHERE id = sqlc.arg(id) AND customer_in_organization(customer_id)
+AND deleted_at IS NULL;
+
+-- name: SoftDeleteContactsByCustomer :execrows
+UPDATE "Contact"
+SET deleted_at = sqlc.arg(deleted_at)
+WHERE customer_id = sqlc.arg(customer_id) AND customer_in_organization(customer_id)
+AND deleted_at IS NULL;
+
+-- name: SoftDeleteContactsByBook :execrows
+UPDATE "Contact" ct
+SET deleted_at = sqlc.arg(deleted_at)
+FROM "Customer" c
+WHERE c.id = ct.customer_id
+AND c.book_id = sqlc.arg(book_id)
+AND book_in_organization(c.book_id)
+AND ct.deleted_at IS NULL;
+
+-- name: RestoreContactsByCustomer :execrows
+-- 顧客と一緒にゴミ箱に移動した連絡先のみ戻す
+UPDATE "Contact"
+SET deleted_at = NULL
+WHERE customer_id = sqlc.arg(customer_id) AND customer_in_organization(customer_id)
+AND deleted_at = sqlc.arg(deleted_at);
+
+-- name: RestoreContactsByBook :execrows
+UPDATE "Contact" ct
+SET deleted_at = NULL
+FROM "Customer" c
+WHERE c.id = ct.customer_id
+AND c.book_id = sqlc.arg(book_id)
+AND book_in_organization(c.book_id)
+AND ct.deleted_at = sqlc.arg(deleted_at);
+
+-- name: PurgeContacts :execrows
+-- 全組織が対象
 DELETE FROM "Contact"
-WHERE id = sqlc.arg(id);
\ No newline at end of file
+WHERE deleted_at < sqlc.arg(deleted_before)::timestamptz;
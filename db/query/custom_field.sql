@@ -0,0 +1,57 @@
+-- name: CreateCustomField :one
+INSERT INTO "CustomField" (id, book_id, key, label, type, options, required, position)
+SELECT
+  sqlc.arg(id)::uuid,
+  sqlc.arg(book_id)::uuid,
+  sqlc.arg(key)::varchar,
+  sqlc.arg(label)::varchar,
+  sqlc.arg(type)::custom_field_type,
+  sqlc.arg(options)::varchar[],
+  sqlc.arg(required)::bool,
+  sqlc.arg(position)::int
+WHERE book_in_organization(sqlc.arg(book_id))
+RETURNING *;
+
+-- name: GetCustomField :one
+SELECT * FROM "CustomField"
+WHERE id = $1 AND book_in_organization(book_id) LIMIT 1;
+
+-- name: ListCustomFields :many
+SELECT * FROM "CustomField"
+WHERE book_id = $1 AND book_in_organization(book_id)
+ORDER BY position, created_at;
+
+-- name: UpdateCustomField :one
+UPDATE "CustomField"
+SET
+  label = COALESCE(sqlc.narg(label), label),
+  options = COALESCE(sqlc.narg(options), options),
+  required = COALESCE(sqlc.narg(required), required),
+  position = COALESCE(sqlc.narg(position), position)
+WHERE id = sqlc.arg(id) AND book_in_organization(book_id)
+RETURNING *;
+
+-- name: DeleteCustomField :exec
+DELETE FROM "CustomField"
+WHERE id = sqlc.arg(id) AND book_in_organization(book_id);
+
+-- name: DeleteCustomFieldValues :execrows
+-- 削除した項目の値を顧客から取り除く
+UPDATE "Customer"
+SET custom_fields = custom_fields - sqlc.arg(key)::text
+WHERE book_id = sqlc.arg(book_id) AND book_in_organization(book_id)
+AND custom_fields ? sqlc.arg(key)::text;
+
+-- name: CountCustomFieldValuesNotIn :one
+-- select の選択肢から外す値を使っている顧客の数
+SELECT count(*) FROM "Customer"
+WHERE book_id = sqlc.arg(book_id) AND book_in_organization(book_id)
+AND custom_fields ? sqlc.arg(key)::text
+AND NOT (custom_fields ->> sqlc.arg(key)::text = ANY (sqlc.arg(options)::varchar[]));
+
+-- name: CountCustomersMissingCustomField :one
+-- 必須にする項目が未入力の顧客の数
+SELECT count(*) FROM "Customer"
+WHERE book_id = sqlc.arg(book_id) AND book_in_organization(book_id)
+AND deleted_at IS NULL
+AND NOT custom_fields ? sqlc.arg(key)::text;
@@ -1,6 +1,21 @@
 -- name: CreateCustomer :one
-INSERT INTO "Customer" (id, book_id, category_id, name, corporation, address, leader, pic, memo)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+INSERT INTO "Customer" (id, book_id, category_id, name, corporation, address, leader, pic, memo, custom_fields)
+SELECT
+  sqlc.arg(id)::uuid,
+  sqlc.arg(book_id)::uuid,
+  sqlc.narg(category_id)::uuid,
+  sqlc.arg(name)::varchar,
+  sqlc.narg(corporation)::varchar,
+  sqlc.narg(address)::varchar,
+  sqlc.narg(leader)::uuid,
+  sqlc.narg(pic)::uuid,
+  sqlc.narg(memo)::text,
+  sqlc.arg(custom_fields)::jsonb
+WHERE book_in_organization(sqlc.arg(book_id))
+AND (sqlc.narg(category_id) IS NULL OR EXISTS (
+  SELECT 1 FROM "Category" cg
+  WHERE cg.id = sqlc.narg(category_id) AND cg.organization_id = current_organization_id()
+))
 RETURNING *;
 
 -- name: GetCustomer :one
@@ -15,31 +30,105 @@ SELECT
     c.leader as customer_leader,
     c.pic as customer_pic,
     c.memo as customer_memo,
+    c.custom_fields as customer_custom_fields,
     c.created_at as customer_created_at,
+    c.version as customer_version,
     ct.id as contact_id,
     ct.customer_id as contact_customer_id,
     ct.staff_id as contact_staff_id,
     ct.phone as contact_phone,
     ct.mail as contact_mail,
     ct.fax as contact_fax,
-    ct.created_at as contact_created_at
+    ct.created_at as contact_created_at,
+    ct.version as contact_version
 FROM "Customer" c
-LEFT JOIN "Contact" ct ON c.id = ct.id
-WHERE c.id = $1;
+LEFT JOIN "Contact" ct ON c.id = ct.id AND ct.deleted_at IS NULL
+WHERE c.id = $1 AND book_in_organization(c.book_id)
+AND c.deleted_at IS NULL;
 
--- name: GetCustomerByBookId :many
+-- name: GetCustomerByID :one
 SELECT * FROM "Customer"
-WHERE book_id = $1
-ORDER BY created_at DESC
-LIMIT $2 OFFSET $3;
+WHERE id = $1 AND book_in_organization(book_id)
+AND deleted_at IS NULL LIMIT 1;
+
+-- name: GetCustomerByBookId :many
+-- tags_any はいずれか、tags_all はすべてのタグが付いた顧客、tags_none はいずれのタグも付いていない顧客に絞り込む
+SELECT c.* FROM "Customer" c
+WHERE c.book_id = sqlc.arg(book_id) AND book_in_organization(c.book_id)
+AND c.deleted_at IS NULL
+AND (COALESCE(cardinality(sqlc.arg(tags_any)::varchar[]), 0) = 0 OR EXISTS (
+  SELECT 1 FROM "CustomerTag" ct JOIN "Tag" t ON t.id = ct.tag_id
+  WHERE ct.customer_id = c.id AND t.name = ANY (sqlc.arg(tags_any)::varchar[])
+))
+AND (COALESCE(cardinality(sqlc.arg(tags_all)::varchar[]), 0) = 0 OR (
+  SELECT count(DISTINCT t.name) FROM "CustomerTag" ct JOIN "Tag" t ON t.id = ct.tag_id
+  WHERE ct.customer_id = c.id AND t.name = ANY (sqlc.arg(tags_all)::varchar[])
+) = cardinality(sqlc.arg(tags_all)::varchar[]))
+AND NOT EXISTS (
+  SELECT 1 FROM "CustomerTag" ct JOIN "Tag" t ON t.id = ct.tag_id
+  WHERE ct.customer_id = c.id AND t.name = ANY (sqlc.arg(tags_none)::varchar[])
+)
+ORDER BY c.created_at DESC
+LIMIT sqlc.arg(row_limit) OFFSET sqlc.arg(row_offset);
 
 -- name: SearchCustomer :many
-SELECT * FROM "Customer"
-WHERE book_id = COALESCE(sqlc.narg(book_id), book_id)
-AND name ILIKE '%' || COALESCE(sqlc.narg(name), name) || '%'
-AND corporation ILIKE '%' || COALESCE(sqlc.narg(corporation), corporation) || '%'
-AND address ILIKE '%' || COALESCE(sqlc.narg(address), address) || '%'
-AND memo ILIKE '%' || COALESCE(sqlc.narg(memo), memo) || '%';
+-- custom_field_filters は [{"key", "type", "op", "value"}] の配列。
+-- 値は CustomField の型で検証済みのため、number の場合のみ数値として比較する
+SELECT c.* FROM "Customer" c
+WHERE c.book_id = COALESCE(sqlc.narg(book_id), c.book_id)
+AND book_in_organization(c.book_id)
+AND c.name ILIKE '%' || COALESCE(sqlc.narg(name), c.name) || '%'
+AND c.corporation ILIKE '%' || COALESCE(sqlc.narg(corporation), c.corporation) || '%'
+AND c.address ILIKE '%' || COALESCE(sqlc.narg(address), c.address) || '%'
+AND c.memo ILIKE '%' || COALESCE(sqlc.narg(memo), c.memo) || '%'
+AND c.deleted_at IS NULL
+AND (COALESCE(cardinality(sqlc.arg(tags_any)::varchar[]), 0) = 0 OR EXISTS (
+  SELECT 1 FROM "CustomerTag" ct JOIN "Tag" t ON t.id = ct.tag_id
+  WHERE ct.customer_id = c.id AND t.name = ANY (sqlc.arg(tags_any)::varchar[])
+))
+AND (COALESCE(cardinality(sqlc.arg(tags_all)::varchar[]), 0) = 0 OR (
+  SELECT count(DISTINCT t.name) FROM "CustomerTag" ct JOIN "Tag" t ON t.id = ct.tag_id
+  WHERE ct.customer_id = c.id AND t.name = ANY (sqlc.arg(tags_all)::varchar[])
+) = cardinality(sqlc.arg(tags_all)::varchar[]))
+AND NOT EXISTS (
+  SELECT 1 FROM "CustomerTag" ct JOIN "Tag" t ON t.id = ct.tag_id
+  WHERE ct.customer_id = c.id AND t.name = ANY (sqlc.arg(tags_none)::varchar[])
+)
+AND NOT EXISTS (
+  SELECT 1 FROM jsonb_to_recordset(COALESCE(sqlc.narg(custom_field_filters)::jsonb, '[]'))
+    AS f(key text, type text, op text, value text)
+  WHERE NOT COALESCE(CASE
+    WHEN f.op = 'set' THEN c.custom_fields ? f.key
+    WHEN f.op = 'contains' THEN c.custom_fields ->> f.key ILIKE '%' || f.value || '%'
+    WHEN f.type = 'number' THEN CASE f.op
+      WHEN 'eq' THEN (c.custom_fields ->> f.key)::numeric = f.value::numeric
+      WHEN 'ne' THEN (c.custom_fields ->> f.key)::numeric <> f.value::numeric
+      WHEN 'lt' THEN (c.custom_fields ->> f.key)::numeric < f.value::numeric
+      WHEN 'lte' THEN (c.custom_fields ->> f.key)::numeric <= f.value::numeric
+      WHEN 'gt' THEN (c.custom_fields ->> f.key)::numeric > f.value::numeric
+      WHEN 'gte' THEN (c.custom_fields ->> f.key)::numeric >= f.value::numeric
+    END
+    ELSE CASE f.op
+      WHEN 'eq' THEN c.custom_fields ->> f.key = f.value
+      WHEN 'ne' THEN c.custom_fields ->> f.key <> f.value
+      WHEN 'lt' THEN c.custom_fields ->> f.key < f.value
+      WHEN 'lte' THEN c.custom_fields ->> f.key <= f.value
+      WHEN 'gt' THEN c.custom_fields ->> f.key > f.value
+      WHEN 'gte' THEN c.custom_fields ->> f.key >= f.value
+    END
+  END, false)
+)
+ORDER BY
+  CASE WHEN sqlc.narg(sort_type)::text = 'number' AND NOT sqlc.arg(sort_desc)::bool
+    THEN (c.custom_fields ->> sqlc.narg(sort_key)::text)::numeric END ASC,
+  CASE WHEN sqlc.narg(sort_type)::text = 'number' AND sqlc.arg(sort_desc)::bool
+    THEN (c.custom_fields ->> sqlc.narg(sort_key)::text)::numeric END DESC,
+  CASE WHEN sqlc.narg(sort_type)::text <> 'number' AND NOT sqlc.arg(sort_desc)::bool
+    THEN c.custom_fields ->> sqlc.narg(sort_key)::text END ASC,
+  CASE WHEN sqlc.narg(sort_type)::text <> 'number' AND sqlc.arg(sort_desc)::bool
+    THEN c.custom_fields ->> sqlc.narg(sort_key)::text END DESC,
+  c.created_at DESC,
+  c.id;
 
 -- name: UpdateCustomer :one
 UPDATE "Customer"
@@ -48,11 +137,75 @@ SET
   book_id = COALESCE(sqlc.narg(book_id), book_id),
   corporation = COALESCE(sqlc.narg(corporation), corporation),
   address = COALESCE(sqlc.narg(address), address),
-  memo = COALESCE(sqlc.narg(memo), memo)
+  memo = COALESCE(sqlc.narg(memo), memo),
+  custom_fields = COALESCE(sqlc.narg(custom_fields), custom_fields),
+  category_id = COALESCE(sqlc.narg(category_id), category_id)
 WHERE
-  id = sqlc.arg(id)
+  "Customer".id = sqlc.arg(id) AND book_in_organization("Customer".book_id)
+  AND "Customer".deleted_at IS NULL
+  AND (sqlc.narg(version)::bigint IS NULL OR "Customer".version = sqlc.narg(version))
+  -- 移動先のリストと業種も同じ組織のものに限る
+  AND book_in_organization(COALESCE(sqlc.narg(book_id), "Customer".book_id))
+  AND (sqlc.narg(category_id)::uuid IS NULL OR EXISTS (
+    SELECT 1 FROM "Category" cg
+    WHERE cg.id = sqlc.narg(category_id) AND cg.organization_id = current_organization_id()
+  ))
+RETURNING *;
+
+-- name: SoftDeleteCustomer :one
+UPDATE "Customer"
+SET deleted_at = now()
+WHERE id = sqlc.arg(id) AND book_in_organization(book_id)
+AND deleted_at IS NULL
+RETURNING *;
+
+-- name: SoftDeleteCustomersByBook :execrows
+UPDATE "Customer"
+SET deleted_at = sqlc.arg(deleted_at)
+WHERE book_id = sqlc.arg(book_id) AND book_in_organization(book_id)
+AND deleted_at IS NULL;
+
+-- name: GetDeletedCustomer :one
+SELECT * FROM "Customer"
+WHERE id = $1 AND book_in_organization(book_id)
+AND deleted_at IS NOT NULL LIMIT 1
+FOR UPDATE;
+
+-- name: RestoreCustomer :one
+UPDATE "Customer"
+SET deleted_at = NULL
+WHERE id = sqlc.arg(id) AND book_in_organization(book_id)
+AND deleted_at IS NOT NULL
 RETURNING *;
 
--- name: DeleteCustomer :exec
+-- name: RestoreCustomersByBook :execrows
+-- リストと一緒にゴミ箱に移動した顧客のみ戻す
+UPDATE "Customer"
+SET deleted_at = NULL
+WHERE book_id = sqlc.arg(book_id) AND book_in_organization(book_id)
+AND deleted_at = sqlc.arg(deleted_at);
+
+-- name: PurgeCustomers :execrows
+-- 全組織が対象
 DELETE FROM "Customer"
-WHERE id = sqlc.arg(id);
\ No newline at end of file
+WHERE deleted_at < sqlc.arg(deleted_before)::timestamptz;
+
+-- name: ExportCustomers :many
+-- 顧客リストのCSV出力。連絡先は最初に登録したものを出力し、id 順にページングする。
+-- for_calling の場合は架電キューと同じく、連絡先の番号が架電禁止リストにある顧客を除く
+SELECT DISTINCT ON (c.id)
+  c.id, c.name, c.corporation, c.address, c.memo, c.custom_fields,
+  ct.phone, ct.mail, ct.fax
+FROM "Customer" c
+LEFT JOIN "Contact" ct ON ct.customer_id = c.id AND ct.deleted_at IS NULL
+WHERE c.book_id = sqlc.arg(book_id) AND book_in_organization(c.book_id)
+AND c.deleted_at IS NULL
+AND c.id > sqlc.arg(after_id)
+AND (NOT sqlc.arg(for_calling)::bool OR NOT EXISTS (
+  SELECT 1 FROM "Contact" dct
+  JOIN "DoNotCall" d ON d.organization_id = current_organization_id() AND d.phone = normalize_phone(dct.phone)
+  WHERE dct.customer_id = c.id
+  AND dct.deleted_at IS NULL
+))
+ORDER BY c.id, ct.created_at
+LIMIT sqlc.arg(row_limit);
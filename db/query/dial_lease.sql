@@ -0,0 +1,65 @@
+-- name: GetActiveDialLease :one
+SELECT sqlc.embed(l), sqlc.embed(c) FROM "DialLease" l
+JOIN "Customer" c ON c.id = l.customer_id
+WHERE c.book_id = sqlc.arg(book_id) AND book_in_organization(c.book_id)
+AND c.deleted_at IS NULL
+AND l.user_id = sqlc.arg(user_id)
+AND l.expires_at > now()
+ORDER BY l.created_at
+LIMIT 1;
+
+-- name: LockNextDialCandidate :one
+-- NG や架電禁止になっておらず、未架電または再架電予定時刻を過ぎた顧客を1件ロックする。
+-- 他のトランザクションがロック中の行は SKIP LOCKED で読み飛ばす。
+SELECT c.* FROM "Customer" c
+LEFT JOIN "DialLease" l ON l.customer_id = c.id
+LEFT JOIN "Redial" r ON r.id = c.id
+WHERE c.book_id = sqlc.arg(book_id) AND book_in_organization(c.book_id)
+AND c.deleted_at IS NULL
+AND (l.customer_id IS NULL OR l.expires_at <= now())
+AND NOT COALESCE((
+  SELECT s.ng FROM "Call" ca
+  JOIN "Status" s ON s.id = ca.status_id
+  WHERE ca.customer_id = c.id
+  ORDER BY ca.created_at DESC
+  LIMIT 1
+), false)
+AND NOT EXISTS (
+  SELECT 1 FROM "Contact" ct
+  JOIN "DoNotCall" d ON d.organization_id = current_organization_id() AND d.phone = normalize_phone(ct.phone)
+  WHERE ct.customer_id = c.id
+  AND ct.deleted_at IS NULL
+)
+AND (
+  (r.id IS NULL AND NOT EXISTS (SELECT 1 FROM "Call" ca WHERE ca.customer_id = c.id))
+  OR (r.user_id = sqlc.arg(user_id) AND r.scheduled_at <= now())
+)
+ORDER BY r.id IS NULL, r.scheduled_at, c.created_at, c.id
+LIMIT 1
+FOR UPDATE OF c SKIP LOCKED;
+
+-- name: AcquireDialLease :one
+-- 期限切れまたは自分のリースのみ上書きする。他人の有効なリースがある場合は行を返さない。
+INSERT INTO "DialLease" (customer_id, user_id, expires_at)
+SELECT sqlc.arg(customer_id)::uuid, sqlc.arg(user_id)::uuid, sqlc.arg(expires_at)::timestamptz
+WHERE customer_in_organization(sqlc.arg(customer_id))
+ON CONFLICT (customer_id) DO UPDATE
+SET
+  user_id = EXCLUDED.user_id,
+  expires_at = EXCLUDED.expires_at,
+  created_at = now()
+WHERE "DialLease".expires_at <= now()
+OR "DialLease".user_id = EXCLUDED.user_id
+RETURNING *;
+
+-- name: ExtendDialLease :one
+UPDATE "DialLease"
+SET expires_at = sqlc.arg(expires_at)
+WHERE customer_id = sqlc.arg(customer_id) AND customer_in_organization(customer_id)
+AND user_id = sqlc.arg(user_id)
+RETURNING *;
+
+-- name: ReleaseDialLease :execrows
+DELETE FROM "DialLease"
+WHERE customer_id = sqlc.arg(customer_id) AND customer_in_organization(customer_id)
+AND user_id = sqlc.arg(user_id);
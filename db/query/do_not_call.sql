@@ -0,0 +1,41 @@
+-- name: CreateDoNotCall :one
+INSERT INTO "DoNotCall" (phone, reason, source, user_id, call_id)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (organization_id, phone) DO UPDATE
+SET reason = COALESCE(EXCLUDED.reason, "DoNotCall".reason)
+RETURNING *;
+
+-- name: ImportDoNotCall :execrows
+INSERT INTO "DoNotCall" (phone, reason, source, user_id)
+SELECT unnest(sqlc.arg(phones)::varchar[]), sqlc.narg(reason)::text, 'import', sqlc.narg(user_id)::uuid
+ON CONFLICT (organization_id, phone) DO NOTHING;
+
+-- name: CreateDoNotCallFromCall :execrows
+-- 顧客の連絡先の電話番号をまとめて架電禁止リストに登録する
+INSERT INTO "DoNotCall" (phone, reason, source, user_id, call_id)
+SELECT DISTINCT normalize_phone(ct.phone), sqlc.narg(reason)::text, 'call', sqlc.arg(user_id)::uuid, sqlc.arg(call_id)::uuid
+FROM "Contact" ct
+WHERE ct.customer_id = sqlc.arg(customer_id) AND customer_in_organization(ct.customer_id)
+AND ct.deleted_at IS NULL
+AND normalize_phone(ct.phone) <> ''
+ON CONFLICT (organization_id, phone) DO NOTHING;
+
+-- name: GetDoNotCall :one
+SELECT * FROM "DoNotCall"
+WHERE organization_id = current_organization_id()
+AND phone = $1 LIMIT 1;
+
+-- name: ListDoNotCall :many
+SELECT * FROM "DoNotCall"
+WHERE organization_id = current_organization_id()
+ORDER BY created_at DESC, phone
+LIMIT $1 OFFSET $2;
+
+-- name: CountDoNotCall :one
+SELECT count(*) FROM "DoNotCall"
+WHERE organization_id = current_organization_id();
+
+-- name: DeleteDoNotCall :execrows
+DELETE FROM "DoNotCall"
+WHERE organization_id = current_organization_id()
+AND phone = sqlc.arg(phone);
@@ -0,0 +1,39 @@
+-- name: ReserveIdempotencyKey :one
+-- キーを処理中として登録する。期限切れか、処理中のまま放置されたキーは上書きする。
+-- 使用中の場合は行を返さない
+INSERT INTO "IdempotencyKey" (user_id, key, rpc, request_hash, expires_at)
+VALUES (
+  sqlc.arg(user_id),
+  sqlc.arg(key),
+  sqlc.arg(rpc),
+  sqlc.arg(request_hash),
+  sqlc.arg(expires_at)
+)
+ON CONFLICT (user_id, key) DO UPDATE
+SET
+  rpc = EXCLUDED.rpc,
+  request_hash = EXCLUDED.request_hash,
+  response = NULL,
+  version = NULL,
+  created_at = now(),
+  expires_at = EXCLUDED.expires_at
+WHERE "IdempotencyKey".expires_at < now()
+OR ("IdempotencyKey".response IS NULL AND "IdempotencyKey".created_at < sqlc.arg(stale_before)::timestamptz)
+RETURNING *;
+
+-- name: GetIdempotencyKey :one
+SELECT * FROM "IdempotencyKey"
+WHERE user_id = $1 AND key = $2 LIMIT 1;
+
+-- name: SaveIdempotencyResponse :exec
+UPDATE "IdempotencyKey"
+SET response = sqlc.arg(response), version = sqlc.narg(version)
+WHERE user_id = sqlc.arg(user_id) AND key = sqlc.arg(key);
+
+-- name: DeleteIdempotencyKey :exec
+DELETE FROM "IdempotencyKey"
+WHERE user_id = $1 AND key = $2;
+
+-- name: DeleteExpiredIdempotencyKeys :execrows
+DELETE FROM "IdempotencyKey"
+WHERE expires_at < now();
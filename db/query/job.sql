@@ -0,0 +1,143 @@
+-- name: CreateJob :one
+INSERT INTO "Job" (id, type, params, max_attempts, user_id)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetJob :one
+SELECT * FROM "Job"
+WHERE id = $1 AND organization_id = current_organization_id() LIMIT 1;
+
+-- name: ListJobs :many
+SELECT * FROM "Job"
+WHERE organization_id = current_organization_id()
+AND (sqlc.narg(user_id)::uuid IS NULL OR user_id = sqlc.narg(user_id))
+AND (sqlc.narg(type)::varchar IS NULL OR type = sqlc.narg(type))
+AND (sqlc.narg(status)::job_status IS NULL OR status = sqlc.narg(status))
+ORDER BY created_at DESC, id
+LIMIT sqlc.arg(row_limit) OFFSET sqlc.arg(row_offset);
+
+-- name: ClaimJob :one
+-- 実行待ちのジョブか、ワーカーが停止して期限切れになったジョブを全組織から1件取得する。
+-- 期限切れのジョブのうち試行回数を使い切ったものは、同じ文で失敗にする
+WITH exhausted AS (
+  UPDATE "Job"
+  SET
+    status = 'failed',
+    error = 'job lease expired on the last attempt',
+    locked_by = NULL,
+    locked_until = NULL,
+    finished_at = now()
+  WHERE "Job".type = ANY (sqlc.arg(types)::varchar[])
+  AND "Job".status = 'running'
+  AND "Job".locked_until < now()
+  AND "Job".attempts >= "Job".max_attempts
+)
+UPDATE "Job"
+SET
+  status = 'running',
+  attempts = attempts + 1,
+  locked_by = sqlc.arg(locked_by)::varchar,
+  locked_until = sqlc.arg(locked_until)::timestamptz,
+  started_at = COALESCE(started_at, now())
+WHERE id = (
+  SELECT j.id FROM "Job" j
+  WHERE j.type = ANY (sqlc.arg(types)::varchar[])
+  AND NOT j.cancel_requested
+  AND (
+    (j.status = 'queued' AND j.run_after <= now())
+    OR (j.status = 'running' AND j.locked_until < now() AND j.attempts < j.max_attempts)
+  )
+  ORDER BY j.run_after, j.created_at
+  LIMIT 1
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: HeartbeatJob :one
+-- 進捗を更新してロックを延長する。キャンセルが要求されているかを返す
+UPDATE "Job"
+SET
+  progress = GREATEST(progress, sqlc.arg(progress)::int),
+  locked_until = sqlc.arg(locked_until)::timestamptz
+WHERE id = sqlc.arg(id) AND locked_by = sqlc.arg(locked_by)::varchar AND status = 'running'
+RETURNING cancel_requested;
+
+-- name: CompleteJob :exec
+UPDATE "Job"
+SET
+  status = 'succeeded',
+  progress = 100,
+  result = sqlc.narg(result),
+  result_location = sqlc.narg(result_location),
+  error = NULL,
+  locked_by = NULL,
+  locked_until = NULL,
+  finished_at = now()
+WHERE id = sqlc.arg(id) AND locked_by = sqlc.arg(locked_by)::varchar;
+
+-- name: FailJob :one
+-- 試行回数が残っていれば run_after 以降に再実行する
+UPDATE "Job"
+SET
+  status = CASE
+    WHEN sqlc.arg(retryable)::bool AND attempts < max_attempts THEN 'queued'::job_status
+    ELSE 'failed'::job_status
+  END,
+  error = sqlc.arg(error)::text,
+  run_after = sqlc.arg(run_after),
+  locked_by = NULL,
+  locked_until = NULL,
+  finished_at = CASE
+    WHEN sqlc.arg(retryable)::bool AND attempts < max_attempts THEN NULL
+    ELSE now()
+  END
+WHERE id = sqlc.arg(id) AND locked_by = sqlc.arg(locked_by)::varchar
+RETURNING *;
+
+-- name: FinishCanceledJob :exec
+UPDATE "Job"
+SET
+  status = 'canceled',
+  locked_by = NULL,
+  locked_until = NULL,
+  finished_at = now()
+WHERE id = sqlc.arg(id) AND locked_by = sqlc.arg(locked_by)::varchar;
+
+-- name: ReleaseJob :exec
+-- シャットダウンで中断したジョブを試行回数を戻して実行待ちにする
+UPDATE "Job"
+SET
+  status = 'queued',
+  attempts = GREATEST(attempts - 1, 0),
+  locked_by = NULL,
+  locked_until = NULL
+WHERE id = sqlc.arg(id) AND locked_by = sqlc.arg(locked_by)::varchar AND status = 'running';
+
+-- name: CancelJob :one
+-- 実行待ちのジョブと、ワーカーが停止して期限切れになったジョブはすぐにキャンセルし、
+-- 実行中のジョブはワーカーに中断を要求する
+UPDATE "Job"
+SET
+  cancel_requested = true,
+  status = CASE
+    WHEN status = 'queued' OR locked_until < now() THEN 'canceled'::job_status
+    ELSE status
+  END,
+  finished_at = CASE
+    WHEN status = 'queued' OR locked_until < now() THEN now()
+    ELSE finished_at
+  END
+WHERE id = sqlc.arg(id) AND organization_id = current_organization_id()
+AND status IN ('queued', 'running')
+RETURNING *;
+
+-- name: SaveJobFile :exec
+-- 再試行で作り直したファイルは上書きする
+INSERT INTO "JobFile" (job_id, content_type, content)
+VALUES ($1, $2, $3)
+ON CONFLICT (job_id) DO UPDATE
+SET content_type = EXCLUDED.content_type, content = EXCLUDED.content, created_at = now();
+
+-- name: GetJobFile :one
+SELECT * FROM "JobFile"
+WHERE job_id = $1 LIMIT 1;
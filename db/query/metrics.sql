@@ -0,0 +1,14 @@
+-- name: CountActiveJobs :many
+SELECT type, status, count(*) AS jobs FROM "Job"
+WHERE status IN ('queued', 'running')
+GROUP BY type, status;
+
+-- name: CountCallsSince :one
+SELECT count(*) FROM "Call"
+WHERE created_at >= sqlc.arg(from_time);
+
+-- name: CountOverdueRedials :one
+SELECT count(*) FROM "Redial" r
+JOIN "Customer" c ON c.id = r.id
+WHERE c.deleted_at IS NULL
+AND r.scheduled_at <= sqlc.arg(now);
@@ -0,0 +1,21 @@
+-- name: CreateNote :one
+INSERT INTO "Note" (id, customer_id, user_id, body)
+SELECT sqlc.arg(id)::uuid, sqlc.arg(customer_id)::uuid, sqlc.arg(user_id)::uuid, sqlc.arg(body)::text
+WHERE customer_in_organization(sqlc.arg(customer_id))
+RETURNING *;
+
+-- name: GetNote :one
+SELECT * FROM "Note"
+WHERE id = $1 AND customer_in_organization(customer_id) LIMIT 1;
+
+-- name: UpdateNote :one
+UPDATE "Note"
+SET
+  body = sqlc.arg(body),
+  updated_at = now()
+WHERE id = sqlc.arg(id) AND customer_in_organization(customer_id)
+RETURNING *;
+
+-- name: DeleteNote :exec
+DELETE FROM "Note"
+WHERE id = sqlc.arg(id) AND customer_in_organization(customer_id);
@@ -0,0 +1,33 @@
+-- name: SetOrganizationContext :exec
+-- 行レベルセキュリティのポリシーが参照する組織を現在のトランザクションに設定する
+SELECT set_config('prism.organization_id', sqlc.arg(organization_id)::text, true);
+
+-- name: CreateOrganization :one
+INSERT INTO "Organization" (id, name)
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: GetOrganization :one
+SELECT * FROM "Organization"
+WHERE id = $1 AND id = current_organization_id() LIMIT 1;
+
+-- name: UpdateOrganization :one
+UPDATE "Organization"
+SET name = COALESCE(sqlc.narg(name), name)
+WHERE id = sqlc.arg(id) AND id = current_organization_id()
+RETURNING *;
+
+-- name: CreateOrganizationUser :one
+-- 組織を指定してユーザーを作成する。組織の作成時に最初のオーナーを登録する
+INSERT INTO "User" (id, email, name, role, organization_id)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: ListOrganizationUsers :many
+SELECT * FROM "User"
+WHERE organization_id = current_organization_id()
+ORDER BY created_at, id;
+
+-- name: CountOrganizationOwners :one
+SELECT count(*) FROM "User"
+WHERE organization_id = current_organization_id() AND role = 'owner';
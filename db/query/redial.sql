@@ -1,21 +1,49 @@
 -- name: CreateRedial :one
-INSERT INTO "Redial" (id, user_id, date, time)
-VALUES ($1, $2, $3, $4)
+INSERT INTO "Redial" (id, user_id, scheduled_at)
+SELECT sqlc.arg(id)::uuid, sqlc.arg(user_id)::uuid, sqlc.arg(scheduled_at)::timestamptz
+WHERE customer_in_organization(sqlc.arg(id))
+RETURNING *;
+
+-- name: UpsertRedial :one
+INSERT INTO "Redial" (id, user_id, scheduled_at, booked_by)
+SELECT sqlc.arg(id)::uuid, sqlc.arg(user_id)::uuid, sqlc.arg(scheduled_at)::timestamptz, sqlc.narg(booked_by)::uuid
+WHERE customer_in_organization(sqlc.arg(id))
+ON CONFLICT (id) DO UPDATE
+SET
+  user_id = EXCLUDED.user_id,
+  scheduled_at = EXCLUDED.scheduled_at,
+  booked_by = EXCLUDED.booked_by,
+  booked_at = now()
 RETURNING *;
 
 -- name: GetRedial :one
 SELECT * FROM "Redial"
-WHERE id = $1 LIMIT 1;
+WHERE id = $1 AND customer_in_organization(id) LIMIT 1;
+
+-- name: ListRedialsByUser :many
+SELECT * FROM "Redial"
+WHERE user_id = sqlc.arg(user_id) AND customer_in_organization(id)
+AND scheduled_at >= sqlc.arg(from_time)
+AND scheduled_at < sqlc.arg(to_time)
+ORDER BY scheduled_at;
 
 -- name: UpdateRedial :one
 UPDATE "Redial"
 SET 
   user_id = COALESCE(sqlc.narg(user_id), user_id),
-  date = COALESCE(sqlc.narg(date), date),
-  time = COALESCE(sqlc.narg(time), time)
-WHERE id = sqlc.arg(id)
+  scheduled_at = COALESCE(sqlc.narg(scheduled_at), scheduled_at)
+WHERE id = sqlc.arg(id) AND customer_in_organization(id)
 RETURNING *;
 
 -- name: DeleteRedial :exec
 DELETE FROM "Redial"
-WHERE id = sqlc.arg(id);
\ No newline at end of file
+WHERE id = sqlc.arg(id) AND customer_in_organization(id);
+
+-- name: ListDueRedials :many
+-- 全組織が対象
+SELECT r.*, c.book_id FROM "Redial" r
+JOIN "Customer" c ON c.id = r.id
+WHERE c.deleted_at IS NULL
+AND r.scheduled_at > sqlc.arg(from_time)
+AND r.scheduled_at <= sqlc.arg(to_time)
+ORDER BY r.scheduled_at;
@@ -0,0 +1,154 @@
+-- name: ReportCallsByBook :many
+SELECT
+  b.id AS book_id,
+  b.name AS book_name,
+  count(*) AS calls,
+  count(*) FILTER (WHERE s.effective) AS effective,
+  count(*) FILTER (WHERE s.ng) AS ng,
+  count(DISTINCT ca.customer_id) AS customers
+FROM "Call" ca
+JOIN "Customer" c ON c.id = ca.customer_id AND c.deleted_at IS NULL AND book_in_organization(c.book_id)
+JOIN "Book" b ON b.id = c.book_id AND b.organization_id = current_organization_id()
+LEFT JOIN "Status" s ON s.id = ca.status_id
+WHERE ca.created_at >= sqlc.arg(from_time)
+AND ca.created_at < sqlc.arg(to_time)
+AND (sqlc.narg(book_id)::uuid IS NULL OR c.book_id = sqlc.narg(book_id))
+AND (sqlc.narg(user_id)::uuid IS NULL OR ca.user_id = sqlc.narg(user_id))
+GROUP BY b.id, b.name
+ORDER BY calls DESC, b.name;
+
+-- name: ReportCallsByUser :many
+SELECT
+  u.id AS user_id,
+  u.name AS user_name,
+  count(*) AS calls,
+  count(*) FILTER (WHERE s.effective) AS effective,
+  count(*) FILTER (WHERE s.ng) AS ng,
+  count(DISTINCT ca.customer_id) AS customers
+FROM "Call" ca
+JOIN "Customer" c ON c.id = ca.customer_id AND c.deleted_at IS NULL AND book_in_organization(c.book_id)
+JOIN "User" u ON u.id = ca.user_id
+LEFT JOIN "Status" s ON s.id = ca.status_id
+WHERE ca.created_at >= sqlc.arg(from_time)
+AND ca.created_at < sqlc.arg(to_time)
+AND (sqlc.narg(book_id)::uuid IS NULL OR c.book_id = sqlc.narg(book_id))
+AND (sqlc.narg(user_id)::uuid IS NULL OR ca.user_id = sqlc.narg(user_id))
+GROUP BY u.id, u.name
+ORDER BY calls DESC, u.name;
+
+-- name: ReportCallsByDay :many
+SELECT
+  (ca.created_at AT TIME ZONE sqlc.arg(timezone)::text)::date AS day,
+  count(*) AS calls,
+  count(*) FILTER (WHERE s.effective) AS effective,
+  count(*) FILTER (WHERE s.ng) AS ng,
+  count(DISTINCT ca.customer_id) AS customers
+FROM "Call" ca
+JOIN "Customer" c ON c.id = ca.customer_id AND c.deleted_at IS NULL AND book_in_organization(c.book_id)
+LEFT JOIN "Status" s ON s.id = ca.status_id
+WHERE ca.created_at >= sqlc.arg(from_time)
+AND ca.created_at < sqlc.arg(to_time)
+AND (sqlc.narg(book_id)::uuid IS NULL OR c.book_id = sqlc.narg(book_id))
+AND (sqlc.narg(user_id)::uuid IS NULL OR ca.user_id = sqlc.narg(user_id))
+GROUP BY day
+ORDER BY day;
+
+-- name: ReportCallsByHour :many
+SELECT
+  extract(hour FROM ca.created_at AT TIME ZONE sqlc.arg(timezone)::text)::int AS hour,
+  count(*) AS calls,
+  count(*) FILTER (WHERE s.effective) AS effective,
+  count(*) FILTER (WHERE s.ng) AS ng,
+  count(DISTINCT ca.customer_id) AS customers
+FROM "Call" ca
+JOIN "Customer" c ON c.id = ca.customer_id AND c.deleted_at IS NULL AND book_in_organization(c.book_id)
+LEFT JOIN "Status" s ON s.id = ca.status_id
+WHERE ca.created_at >= sqlc.arg(from_time)
+AND ca.created_at < sqlc.arg(to_time)
+AND (sqlc.narg(book_id)::uuid IS NULL OR c.book_id = sqlc.narg(book_id))
+AND (sqlc.narg(user_id)::uuid IS NULL OR ca.user_id = sqlc.narg(user_id))
+GROUP BY hour
+ORDER BY hour;
+
+-- name: ReportCallsTotal :one
+SELECT
+  count(*) AS calls,
+  count(*) FILTER (WHERE s.effective) AS effective,
+  count(*) FILTER (WHERE s.ng) AS ng,
+  count(DISTINCT ca.customer_id) AS customers
+FROM "Call" ca
+JOIN "Customer" c ON c.id = ca.customer_id AND c.deleted_at IS NULL AND book_in_organization(c.book_id)
+LEFT JOIN "Status" s ON s.id = ca.status_id
+WHERE ca.created_at >= sqlc.arg(from_time)
+AND ca.created_at < sqlc.arg(to_time)
+AND (sqlc.narg(book_id)::uuid IS NULL OR c.book_id = sqlc.narg(book_id))
+AND (sqlc.narg(user_id)::uuid IS NULL OR ca.user_id = sqlc.narg(user_id));
+
+-- name: ReportLeaderboard :many
+WITH call_counts AS (
+  SELECT
+    ca.user_id,
+    count(*) AS calls,
+    count(*) FILTER (WHERE s.effective) AS effective,
+    count(*) FILTER (WHERE s.ng) AS ng
+  FROM "Call" ca
+  JOIN "Customer" c ON c.id = ca.customer_id AND c.deleted_at IS NULL AND book_in_organization(c.book_id)
+  LEFT JOIN "Status" s ON s.id = ca.status_id
+  WHERE ca.created_at >= sqlc.arg(from_time)
+  AND ca.created_at < sqlc.arg(to_time)
+  AND (sqlc.narg(book_id)::uuid IS NULL OR c.book_id = sqlc.narg(book_id))
+  GROUP BY ca.user_id
+), redial_counts AS (
+  -- Redial は予約し直すと上書きされるため、予約履歴から数える
+  SELECT
+    rb.booked_by AS user_id,
+    count(*) AS redials
+  FROM "RedialBooking" rb
+  JOIN "Customer" c ON c.id = rb.customer_id AND c.deleted_at IS NULL AND book_in_organization(c.book_id)
+  WHERE rb.booked_at >= sqlc.arg(from_time)
+  AND rb.booked_at < sqlc.arg(to_time)
+  AND (sqlc.narg(book_id)::uuid IS NULL OR c.book_id = sqlc.narg(book_id))
+  GROUP BY rb.booked_by
+)
+SELECT
+  u.id AS user_id,
+  u.name AS user_name,
+  COALESCE(cc.calls, 0)::bigint AS calls,
+  COALESCE(cc.effective, 0)::bigint AS effective,
+  COALESCE(cc.ng, 0)::bigint AS ng,
+  COALESCE(rc.redials, 0)::bigint AS redials
+FROM "User" u
+LEFT JOIN call_counts cc ON cc.user_id = u.id
+LEFT JOIN redial_counts rc ON rc.user_id = u.id
+WHERE u.organization_id = current_organization_id()
+ORDER BY calls DESC, u.name;
+
+-- name: ListUserCallActivity :many
+SELECT
+  ca.id AS call_id,
+  ca.created_at,
+  c.id AS customer_id,
+  c.name AS customer_name,
+  s.name AS status_name,
+  COALESCE(s.effective, false)::bool AS effective,
+  COALESCE(s.ng, false)::bool AS ng
+FROM "Call" ca
+JOIN "Customer" c ON c.id = ca.customer_id AND c.deleted_at IS NULL AND book_in_organization(c.book_id)
+LEFT JOIN "Status" s ON s.id = ca.status_id
+WHERE ca.user_id = sqlc.arg(user_id)
+AND ca.created_at >= sqlc.arg(from_time)
+AND ca.created_at < sqlc.arg(to_time)
+ORDER BY ca.created_at;
+
+-- name: ListUserRedialActivity :many
+SELECT
+  rb.booked_at,
+  rb.scheduled_at,
+  c.id AS customer_id,
+  c.name AS customer_name
+FROM "RedialBooking" rb
+JOIN "Customer" c ON c.id = rb.customer_id AND c.deleted_at IS NULL AND book_in_organization(c.book_id)
+WHERE rb.booked_by = sqlc.arg(user_id)
+AND rb.booked_at >= sqlc.arg(from_time)
+AND rb.booked_at < sqlc.arg(to_time)
+ORDER BY rb.booked_at;
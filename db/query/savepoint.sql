@@ -0,0 +1,9 @@
+-- name: SavepointBatchItem :exec
+-- 一括操作で1件の失敗がトランザクション全体を中断しないよう、項目ごとにセーブポイントを作る
+SAVEPOINT batch_item;
+
+-- name: RollbackToBatchItem :exec
+ROLLBACK TO SAVEPOINT batch_item;
+
+-- name: ReleaseBatchItem :exec
+RELEASE SAVEPOINT batch_item;
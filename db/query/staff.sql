@@ -5,16 +5,17 @@ RETURNING *;
 
 -- name: GetStaff :one
 SELECT * FROM "Staff"
-WHERE id = $1 LIMIT 1;
+WHERE id = $1 AND organization_id = current_organization_id() LIMIT 1;
 
 -- name: UpdateStaff :one
 UPDATE "Staff"
 SET 
   name = COALESCE(sqlc.narg(name), name),
   sex = COALESCE(sqlc.narg(sex), sex)
-WHERE id = sqlc.arg(id)
+WHERE id = sqlc.arg(id) AND organization_id = current_organization_id()
+AND (sqlc.narg(version)::bigint IS NULL OR version = sqlc.narg(version))
 RETURNING *;
 
 -- name: DeleteStaff :exec
 DELETE FROM "Staff"
-WHERE id = sqlc.arg(id);
\ No newline at end of file
+WHERE id = sqlc.arg(id) AND organization_id = current_organization_id();
\ No newline at end of file
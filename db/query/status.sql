@@ -1,21 +1,22 @@
 -- name: CreateStatus :one
-INSERT INTO "Status" (id, name, effective, ng)
-VALUES ($1, $2, $3, $4)
+INSERT INTO "Status" (id, name, effective, ng, dnc)
+VALUES ($1, $2, $3, $4, $5)
 RETURNING *;
 
 -- name: GetStatus :one
 SELECT * FROM "Status"
-WHERE id = $1 LIMIT 1;
+WHERE id = $1 AND organization_id = current_organization_id() LIMIT 1;
 
 -- name: UpdateStatus :one
 UPDATE "Status"
 SET 
   name = COALESCE(sqlc.narg(name), name),
   effective = COALESCE(sqlc.narg(effective), effective),
-  ng = COALESCE(sqlc.narg(ng), ng)
-WHERE id = sqlc.arg(id)
+  ng = COALESCE(sqlc.narg(ng), ng),
+  dnc = COALESCE(sqlc.narg(dnc), dnc)
+WHERE id = sqlc.arg(id) AND organization_id = current_organization_id()
 RETURNING *;
 
 -- name: DeleteStatus :exec
 DELETE FROM "Status"
-WHERE id = sqlc.arg(id);
\ No newline at end of file
+WHERE id = sqlc.arg(id) AND organization_id = current_organization_id();
\ No newline at end of file
@@ -0,0 +1,61 @@
+-- name: UpsertTags :many
+-- 名前で指定したタグを作成し、既存のタグはそのまま返す
+INSERT INTO "Tag" (id, book_id, name)
+SELECT gen_random_uuid(), sqlc.arg(book_id)::uuid, unnest(sqlc.arg(names)::varchar[])
+WHERE book_in_organization(sqlc.arg(book_id))
+ON CONFLICT (book_id, name) DO UPDATE
+SET name = EXCLUDED.name
+RETURNING *;
+
+-- name: ListTagsByName :many
+SELECT * FROM "Tag"
+WHERE book_id = sqlc.arg(book_id) AND book_in_organization(book_id)
+AND name = ANY (sqlc.arg(names)::varchar[]);
+
+-- name: GetTag :one
+SELECT * FROM "Tag"
+WHERE id = $1 AND book_in_organization(book_id) LIMIT 1;
+
+-- name: ListTagsWithUsage :many
+SELECT
+  t.*,
+  count(c.id) AS customers
+FROM "Tag" t
+LEFT JOIN "CustomerTag" ct ON ct.tag_id = t.id
+LEFT JOIN "Customer" c ON c.id = ct.customer_id AND c.deleted_at IS NULL
+WHERE t.book_id = sqlc.arg(book_id) AND book_in_organization(t.book_id)
+GROUP BY t.id
+ORDER BY t.name;
+
+-- name: DeleteTag :exec
+DELETE FROM "Tag"
+WHERE id = sqlc.arg(id) AND book_in_organization(book_id);
+
+-- name: AddCustomerTags :execrows
+-- 同じリストの顧客にのみタグを付ける。付与済みの組み合わせは無視する
+INSERT INTO "CustomerTag" (customer_id, tag_id, user_id)
+SELECT c.id, t.id, sqlc.narg(user_id)::uuid
+FROM "Customer" c
+JOIN "Tag" t ON t.book_id = c.book_id
+WHERE c.id = ANY (sqlc.arg(customer_ids)::uuid[])
+AND book_in_organization(c.book_id)
+AND c.deleted_at IS NULL
+AND t.id = ANY (sqlc.arg(tag_ids)::uuid[])
+ON CONFLICT (customer_id, tag_id) DO NOTHING;
+
+-- name: RemoveCustomerTags :execrows
+DELETE FROM "CustomerTag"
+WHERE customer_id = ANY (sqlc.arg(customer_ids)::uuid[])
+AND customer_in_organization(customer_id)
+AND tag_id = ANY (sqlc.arg(tag_ids)::uuid[]);
+
+-- name: ClearCustomerTags :exec
+DELETE FROM "CustomerTag"
+WHERE customer_id = sqlc.arg(customer_id) AND customer_in_organization(customer_id);
+
+-- name: ListCustomerTagNames :many
+SELECT ct.customer_id, t.name FROM "CustomerTag" ct
+JOIN "Tag" t ON t.id = ct.tag_id
+WHERE ct.customer_id = ANY (sqlc.arg(customer_ids)::uuid[])
+AND customer_in_organization(ct.customer_id)
+ORDER BY t.name;
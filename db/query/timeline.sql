@@ -0,0 +1,57 @@
+-- name: ListCustomerTimeline :many
+-- 顧客の再架電・メモ・架電・顧客と連絡先とタグの変更履歴を新しい順にまとめる
+SELECT
+  'redial'::text AS kind,
+  c.id,
+  r.booked_at::timestamptz AS occurred_at,
+  r.booked_by AS user_id,
+  u.name AS user_name,
+  NULL::text AS note_body,
+  NULL::uuid AS status_id,
+  NULL::varchar AS status_name,
+  NULL::bool AS effective,
+  NULL::bool AS ng,
+  r.scheduled_at,
+  NULL::varchar AS entity_type,
+  NULL::varchar AS entity_id,
+  NULL::audit_action AS action,
+  NULL::jsonb AS before,
+  NULL::jsonb AS after
+FROM "Customer" c
+LEFT JOIN "Redial" r ON r.id = c.id
+LEFT JOIN "User" u ON u.id = r.booked_by
+WHERE c.id = sqlc.arg(customer_id) AND book_in_organization(c.book_id)
+AND r.id IS NOT NULL
+UNION ALL
+SELECT
+  'note', n.id, n.created_at, n.user_id, u.name, n.body,
+  NULL, NULL, NULL, NULL,
+  NULL, NULL, NULL, NULL, NULL, NULL
+FROM "Note" n
+LEFT JOIN "User" u ON u.id = n.user_id
+WHERE n.customer_id = sqlc.arg(customer_id) AND customer_in_organization(n.customer_id)
+UNION ALL
+SELECT
+  'call', ca.id, ca.created_at, ca.user_id, u.name, NULL,
+  ca.status_id, s.name, s.effective, s.ng,
+  NULL, NULL, NULL, NULL, NULL, NULL
+FROM "Call" ca
+LEFT JOIN "User" u ON u.id = ca.user_id
+LEFT JOIN "Status" s ON s.id = ca.status_id
+WHERE ca.customer_id = sqlc.arg(customer_id) AND customer_in_organization(ca.customer_id)
+UNION ALL
+SELECT
+  'change', a.id, a.created_at, a.actor_id, u.name, NULL,
+  NULL, NULL, NULL, NULL,
+  NULL, a.entity_type, a.entity_id, a.action, a.before, a.after
+FROM "AuditEvent" a
+LEFT JOIN "User" u ON u.id = a.actor_id
+WHERE a.organization_id = current_organization_id()
+AND (
+  (a.entity_type IN ('Customer', 'CustomerTag') AND a.entity_id = sqlc.arg(customer_id)::text)
+  OR (a.entity_type = 'Contact' AND a.entity_id IN (
+    SELECT ct.id::text FROM "Contact" ct WHERE ct.customer_id = sqlc.arg(customer_id)
+  ))
+)
+ORDER BY occurred_at DESC, id
+LIMIT sqlc.arg(row_limit) OFFSET sqlc.arg(row_offset);
@@ -0,0 +1,27 @@
+-- name: ListTrash :many
+-- ゴミ箱の一覧。リストと一緒に削除された顧客はリスト側にまとめて表示する
+SELECT
+  'book'::text AS entity_type,
+  b.id,
+  b.name,
+  NULL::uuid AS book_id,
+  b.deleted_at::timestamptz AS deleted_at
+FROM "Book" b
+WHERE b.organization_id = current_organization_id()
+AND b.deleted_at IS NOT NULL
+AND (sqlc.narg(entity_type)::text IS NULL OR sqlc.narg(entity_type)::text = 'book')
+UNION ALL
+SELECT
+  'customer'::text AS entity_type,
+  c.id,
+  c.name,
+  c.book_id,
+  c.deleted_at::timestamptz AS deleted_at
+FROM "Customer" c
+JOIN "Book" b ON b.id = c.book_id
+WHERE b.organization_id = current_organization_id()
+AND c.deleted_at IS NOT NULL
+AND b.deleted_at IS DISTINCT FROM c.deleted_at
+AND (sqlc.narg(entity_type)::text IS NULL OR sqlc.narg(entity_type)::text = 'customer')
+ORDER BY deleted_at DESC, id
+LIMIT sqlc.arg(row_limit) OFFSET sqlc.arg(row_offset);
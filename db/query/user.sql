@@ -5,6 +5,11 @@ RETURNING *;
 
 -- name: GetUser :one
 SELECT * FROM "User"
+WHERE id = $1 AND organization_id = current_organization_id() LIMIT 1;
+
+-- name: AuthenticateUser :one
+-- リクエストの組織を決めるため、組織を限定せずに取得する。認証以外では GetUser を使う
+SELECT * FROM "User"
 WHERE id = $1 LIMIT 1;
 
 -- name: UpdateUser :one
@@ -13,9 +18,9 @@ SET
   email = COALESCE(sqlc.narg(email), email),
   name = COALESCE(sqlc.narg(name), name),
   role = COALESCE(sqlc.narg(role), role)
-WHERE id = sqlc.arg(id)
+WHERE id = sqlc.arg(id) AND organization_id = current_organization_id()
 RETURNING *;
 
 -- name: DeleteUser :exec
 DELETE FROM "User"
-WHERE id = sqlc.arg(id);
\ No newline at end of file
+WHERE id = sqlc.arg(id) AND organization_id = current_organization_id();
\ No newline at end of file
@@ -0,0 +1,146 @@
+-- name: CreateWebhook :one
+INSERT INTO "Webhook" (id, url, secret, event_types, description, user_id)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetWebhook :one
+SELECT * FROM "Webhook"
+WHERE id = $1 AND organization_id = current_organization_id() LIMIT 1;
+
+-- name: ListWebhooks :many
+SELECT * FROM "Webhook"
+WHERE organization_id = current_organization_id()
+ORDER BY created_at, id;
+
+-- name: UpdateWebhook :one
+UPDATE "Webhook"
+SET
+  url = COALESCE(sqlc.narg(url), url),
+  event_types = COALESCE(sqlc.narg(event_types)::varchar[], event_types),
+  description = COALESCE(sqlc.narg(description), description),
+  active = COALESCE(sqlc.narg(active), active),
+  updated_at = now()
+WHERE id = sqlc.arg(id) AND organization_id = current_organization_id()
+RETURNING *;
+
+-- name: RotateWebhookSecret :one
+UPDATE "Webhook"
+SET secret = $2, updated_at = now()
+WHERE id = $1 AND organization_id = current_organization_id()
+RETURNING *;
+
+-- name: DeleteWebhook :execrows
+DELETE FROM "Webhook"
+WHERE id = $1 AND organization_id = current_organization_id();
+
+-- name: FanOutOutboxEvents :execrows
+-- 全組織の未処理のイベントを、同じ組織で購読している Webhook ごとの送信に振り分ける
+WITH events AS (
+  SELECT o.id, o.event_type, o.organization_id FROM "OutboxEvent" o
+  WHERE o.dispatched_at IS NULL
+  ORDER BY o.created_at
+  LIMIT sqlc.arg(row_limit)
+  FOR UPDATE SKIP LOCKED
+), deliveries AS (
+  INSERT INTO "WebhookDelivery" (webhook_id, event_id)
+  SELECT w.id, e.id
+  FROM events e
+  JOIN "Webhook" w ON w.organization_id = e.organization_id
+  AND w.active AND (cardinality(w.event_types) = 0 OR e.event_type = ANY (w.event_types))
+  ON CONFLICT (webhook_id, event_id) DO NOTHING
+)
+UPDATE "OutboxEvent" o
+SET dispatched_at = now()
+FROM events e
+WHERE o.id = e.id;
+
+-- name: ClaimWebhookDeliveries :many
+-- 送信時刻を過ぎた送信をロックして送信先とイベントと一緒に返す
+UPDATE "WebhookDelivery" d
+SET
+  attempts = d.attempts + 1,
+  locked_until = sqlc.arg(locked_until)::timestamptz
+FROM "Webhook" w, "OutboxEvent" e
+WHERE d.id IN (
+  SELECT p.id FROM "WebhookDelivery" p
+  WHERE p.status = 'pending'
+  AND p.next_attempt_at <= now()
+  AND (p.locked_until IS NULL OR p.locked_until < now())
+  ORDER BY p.next_attempt_at
+  LIMIT sqlc.arg(row_limit)
+  FOR UPDATE SKIP LOCKED
+)
+AND w.id = d.webhook_id
+AND e.id = d.event_id
+RETURNING
+  d.id,
+  d.attempts,
+  w.url,
+  w.secret,
+  e.id AS event_id,
+  e.event_type,
+  e.payload,
+  e.created_at AS event_created_at;
+
+-- name: MarkWebhookDelivered :exec
+UPDATE "WebhookDelivery"
+SET
+  status = 'succeeded',
+  locked_until = NULL,
+  last_status_code = sqlc.arg(status_code)::int,
+  last_error = NULL,
+  delivered_at = now()
+WHERE id = sqlc.arg(id);
+
+-- name: MarkWebhookDeliveryFailed :exec
+-- 試行回数が上限に達した場合は dead にする
+UPDATE "WebhookDelivery"
+SET
+  status = CASE
+    WHEN attempts >= sqlc.arg(max_attempts)::int THEN 'dead'::webhook_delivery_status
+    ELSE 'pending'::webhook_delivery_status
+  END,
+  locked_until = NULL,
+  next_attempt_at = sqlc.arg(next_attempt_at)::timestamptz,
+  last_status_code = sqlc.narg(status_code)::int,
+  last_error = sqlc.arg(error)::text
+WHERE id = sqlc.arg(id);
+
+-- name: ListWebhookDeliveries :many
+SELECT
+  d.*,
+  e.event_type,
+  e.payload
+FROM "WebhookDelivery" d
+JOIN "OutboxEvent" e ON e.id = d.event_id
+WHERE e.organization_id = current_organization_id()
+AND (sqlc.narg(webhook_id)::uuid IS NULL OR d.webhook_id = sqlc.narg(webhook_id))
+AND (sqlc.narg(status)::webhook_delivery_status IS NULL OR d.status = sqlc.narg(status))
+ORDER BY d.created_at DESC, d.id
+LIMIT sqlc.arg(row_limit) OFFSET sqlc.arg(row_offset);
+
+-- name: ReplayWebhookDelivery :one
+-- 送信済みや dead の送信を試行回数を戻して再送する
+UPDATE "WebhookDelivery" d
+SET
+  status = 'pending',
+  attempts = 0,
+  next_attempt_at = now(),
+  locked_until = NULL
+FROM "OutboxEvent" e
+WHERE d.id = sqlc.arg(id) AND e.id = d.event_id
+AND e.organization_id = current_organization_id()
+RETURNING
+  d.*,
+  e.event_type,
+  e.payload;
+
+-- name: ReplayDeadWebhookDeliveries :execrows
+UPDATE "WebhookDelivery"
+SET
+  status = 'pending',
+  attempts = 0,
+  next_attempt_at = now(),
+  locked_until = NULL
+WHERE webhook_id = $1 AND status = 'dead'
+AND webhook_id IN (SELECT w.id FROM "Webhook" w WHERE w.organization_id = current_organization_id());
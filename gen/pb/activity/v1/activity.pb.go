@@ -0,0 +1,309 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: activity/v1/activity.proto
+
+package activityv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EventType int32
+
+const (
+	EventType_EVENT_TYPE_UNSPECIFIED      EventType = 0
+	EventType_EVENT_TYPE_CALL_LOGGED      EventType = 1
+	EventType_EVENT_TYPE_CUSTOMER_CREATED EventType = 2
+	EventType_EVENT_TYPE_CUSTOMER_UPDATED EventType = 3
+	EventType_EVENT_TYPE_REDIAL_DUE       EventType = 4
+)
+
+// Enum value maps for EventType.
+var (
+	EventType_name = map[int32]string{
+		0: "EVENT_TYPE_UNSPECIFIED",
+		1: "EVENT_TYPE_CALL_LOGGED",
+		2: "EVENT_TYPE_CUSTOMER_CREATED",
+		3: "EVENT_TYPE_CUSTOMER_UPDATED",
+		4: "EVENT_TYPE_REDIAL_DUE",
+	}
+	EventType_value = map[string]int32{
+		"EVENT_TYPE_UNSPECIFIED":      0,
+		"EVENT_TYPE_CALL_LOGGED":      1,
+		"EVENT_TYPE_CUSTOMER_CREATED": 2,
+		"EVENT_TYPE_CUSTOMER_UPDATED": 3,
+		"EVENT_TYPE_REDIAL_DUE":       4,
+	}
+)
+
+func (x EventType) Enum() *EventType {
+	p := new(EventType)
+	*p = x
+	return p
+}
+
+func (x EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_activity_v1_activity_proto_enumTypes[0].Descriptor()
+}
+
+func (EventType) Type() protoreflect.EnumType {
+	return &file_activity_v1_activity_proto_enumTypes[0]
+}
+
+func (x EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventType.Descriptor instead.
+func (EventType) EnumDescriptor() ([]byte, []int) {
+	return file_activity_v1_activity_proto_rawDescGZIP(), []int{0}
+}
+
+type WatchActivityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 省略した場合は閲覧できる全ての顧客リスト
+	BookIds       []string `protobuf:"bytes,1,rep,name=book_ids,json=bookIds,proto3" json:"book_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchActivityRequest) Reset() {
+	*x = WatchActivityRequest{}
+	mi := &file_activity_v1_activity_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchActivityRequest) ProtoMessage() {}
+
+func (x *WatchActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_v1_activity_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchActivityRequest.ProtoReflect.Descriptor instead.
+func (*WatchActivityRequest) Descriptor() ([]byte, []int) {
+	return file_activity_v1_activity_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WatchActivityRequest) GetBookIds() []string {
+	if x != nil {
+		return x.BookIds
+	}
+	return nil
+}
+
+type ActivityEvent struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Type              EventType              `protobuf:"varint,1,opt,name=type,proto3,enum=activity.v1.EventType" json:"type,omitempty"`
+	BookId            string                 `protobuf:"bytes,2,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	CustomerId        string                 `protobuf:"bytes,3,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	UserId            string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CallId            string                 `protobuf:"bytes,5,opt,name=call_id,json=callId,proto3" json:"call_id,omitempty"`
+	StatusId          string                 `protobuf:"bytes,6,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
+	RedialScheduledAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=redial_scheduled_at,json=redialScheduledAt,proto3" json:"redial_scheduled_at,omitempty"`
+	OccurredAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ActivityEvent) Reset() {
+	*x = ActivityEvent{}
+	mi := &file_activity_v1_activity_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityEvent) ProtoMessage() {}
+
+func (x *ActivityEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_v1_activity_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityEvent.ProtoReflect.Descriptor instead.
+func (*ActivityEvent) Descriptor() ([]byte, []int) {
+	return file_activity_v1_activity_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ActivityEvent) GetType() EventType {
+	if x != nil {
+		return x.Type
+	}
+	return EventType_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *ActivityEvent) GetBookId() string {
+	if x != nil {
+		return x.BookId
+	}
+	return ""
+}
+
+func (x *ActivityEvent) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *ActivityEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ActivityEvent) GetCallId() string {
+	if x != nil {
+		return x.CallId
+	}
+	return ""
+}
+
+func (x *ActivityEvent) GetStatusId() string {
+	if x != nil {
+		return x.StatusId
+	}
+	return ""
+}
+
+func (x *ActivityEvent) GetRedialScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RedialScheduledAt
+	}
+	return nil
+}
+
+func (x *ActivityEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+var File_activity_v1_activity_proto protoreflect.FileDescriptor
+
+const file_activity_v1_activity_proto_rawDesc = "" +
+	"\n" +
+	"\x1aactivity/v1/activity.proto\x12\vactivity.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"1\n" +
+	"\x14WatchActivityRequest\x12\x19\n" +
+	"\bbook_ids\x18\x01 \x03(\tR\abookIds\"\xcd\x02\n" +
+	"\rActivityEvent\x12*\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x16.activity.v1.EventTypeR\x04type\x12\x17\n" +
+	"\abook_id\x18\x02 \x01(\tR\x06bookId\x12\x1f\n" +
+	"\vcustomer_id\x18\x03 \x01(\tR\n" +
+	"customerId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x17\n" +
+	"\acall_id\x18\x05 \x01(\tR\x06callId\x12\x1b\n" +
+	"\tstatus_id\x18\x06 \x01(\tR\bstatusId\x12J\n" +
+	"\x13redial_scheduled_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x11redialScheduledAt\x12;\n" +
+	"\voccurred_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt*\xa0\x01\n" +
+	"\tEventType\x12\x1a\n" +
+	"\x16EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16EVENT_TYPE_CALL_LOGGED\x10\x01\x12\x1f\n" +
+	"\x1bEVENT_TYPE_CUSTOMER_CREATED\x10\x02\x12\x1f\n" +
+	"\x1bEVENT_TYPE_CUSTOMER_UPDATED\x10\x03\x12\x19\n" +
+	"\x15EVENT_TYPE_REDIAL_DUE\x10\x042c\n" +
+	"\x0fActivityService\x12P\n" +
+	"\rWatchActivity\x12!.activity.v1.WatchActivityRequest\x1a\x1a.activity.v1.ActivityEvent0\x01B\xb2\x01\n" +
+	"\x0fcom.activity.v1B\rActivityProtoP\x01ZCgithub.com/0utl1er-tech/prism-backend/gen/pb/activity/v1;activityv1\xa2\x02\x03AXX\xaa\x02\vActivity.V1\xca\x02\vActivity\\V1\xe2\x02\x17Activity\\V1\\GPBMetadata\xea\x02\fActivity::V1b\x06proto3"
+
+var (
+	file_activity_v1_activity_proto_rawDescOnce sync.Once
+	file_activity_v1_activity_proto_rawDescData []byte
+)
+
+func file_activity_v1_activity_proto_rawDescGZIP() []byte {
+	file_activity_v1_activity_proto_rawDescOnce.Do(func() {
+		file_activity_v1_activity_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_activity_v1_activity_proto_rawDesc), len(file_activity_v1_activity_proto_rawDesc)))
+	})
+	return file_activity_v1_activity_proto_rawDescData
+}
+
+var file_activity_v1_activity_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_activity_v1_activity_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_activity_v1_activity_proto_goTypes = []any{
+	(EventType)(0),                // 0: activity.v1.EventType
+	(*WatchActivityRequest)(nil),  // 1: activity.v1.WatchActivityRequest
+	(*ActivityEvent)(nil),         // 2: activity.v1.ActivityEvent
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_activity_v1_activity_proto_depIdxs = []int32{
+	0, // 0: activity.v1.ActivityEvent.type:type_name -> activity.v1.EventType
+	3, // 1: activity.v1.ActivityEvent.redial_scheduled_at:type_name -> google.protobuf.Timestamp
+	3, // 2: activity.v1.ActivityEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	1, // 3: activity.v1.ActivityService.WatchActivity:input_type -> activity.v1.WatchActivityRequest
+	2, // 4: activity.v1.ActivityService.WatchActivity:output_type -> activity.v1.ActivityEvent
+	4, // [4:5] is the sub-list for method output_type
+	3, // [3:4] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_activity_v1_activity_proto_init() }
+func file_activity_v1_activity_proto_init() {
+	if File_activity_v1_activity_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_activity_v1_activity_proto_rawDesc), len(file_activity_v1_activity_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_activity_v1_activity_proto_goTypes,
+		DependencyIndexes: file_activity_v1_activity_proto_depIdxs,
+		EnumInfos:         file_activity_v1_activity_proto_enumTypes,
+		MessageInfos:      file_activity_v1_activity_proto_msgTypes,
+	}.Build()
+	File_activity_v1_activity_proto = out.File
+	file_activity_v1_activity_proto_goTypes = nil
+	file_activity_v1_activity_proto_depIdxs = nil
+}
@@ -0,0 +1,128 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: activity/v1/activity.proto
+
+package activityv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ActivityService_WatchActivity_FullMethodName = "/activity.v1.ActivityService/WatchActivity"
+)
+
+// ActivityServiceClient is the client API for ActivityService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 架電状況のリアルタイム配信。HTTP からは /v1/activity/stream の Server-Sent Events を使う
+type ActivityServiceClient interface {
+	WatchActivity(ctx context.Context, in *WatchActivityRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ActivityEvent], error)
+}
+
+type activityServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewActivityServiceClient(cc grpc.ClientConnInterface) ActivityServiceClient {
+	return &activityServiceClient{cc}
+}
+
+func (c *activityServiceClient) WatchActivity(ctx context.Context, in *WatchActivityRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ActivityEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ActivityService_ServiceDesc.Streams[0], ActivityService_WatchActivity_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchActivityRequest, ActivityEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ActivityService_WatchActivityClient = grpc.ServerStreamingClient[ActivityEvent]
+
+// ActivityServiceServer is the server API for ActivityService service.
+// All implementations must embed UnimplementedActivityServiceServer
+// for forward compatibility.
+//
+// 架電状況のリアルタイム配信。HTTP からは /v1/activity/stream の Server-Sent Events を使う
+type ActivityServiceServer interface {
+	WatchActivity(*WatchActivityRequest, grpc.ServerStreamingServer[ActivityEvent]) error
+	mustEmbedUnimplementedActivityServiceServer()
+}
+
+// UnimplementedActivityServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedActivityServiceServer struct{}
+
+func (UnimplementedActivityServiceServer) WatchActivity(*WatchActivityRequest, grpc.ServerStreamingServer[ActivityEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchActivity not implemented")
+}
+func (UnimplementedActivityServiceServer) mustEmbedUnimplementedActivityServiceServer() {}
+func (UnimplementedActivityServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeActivityServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ActivityServiceServer will
+// result in compilation errors.
+type UnsafeActivityServiceServer interface {
+	mustEmbedUnimplementedActivityServiceServer()
+}
+
+func RegisterActivityServiceServer(s grpc.ServiceRegistrar, srv ActivityServiceServer) {
+	// If the following call pancis, it indicates UnimplementedActivityServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ActivityService_ServiceDesc, srv)
+}
+
+func _ActivityService_WatchActivity_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchActivityRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ActivityServiceServer).WatchActivity(m, &grpc.GenericServerStream[WatchActivityRequest, ActivityEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ActivityService_WatchActivityServer = grpc.ServerStreamingServer[ActivityEvent]
+
+// ActivityService_ServiceDesc is the grpc.ServiceDesc for ActivityService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ActivityService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "activity.v1.ActivityService",
+	HandlerType: (*ActivityServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchActivity",
+			Handler:       _ActivityService_WatchActivity_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "activity/v1/activity.proto",
+}
@@ -23,9 +23,11 @@ const (
 )
 
 type UpdateBookRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	// 取得時の etag。If-Match ヘッダーでも指定できる。古い場合は Aborted (HTTP 412) になる
+	Etag          *string `protobuf:"bytes,3,opt,name=etag,proto3,oneof" json:"etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -74,10 +76,18 @@ func (x *UpdateBookRequest) GetName() string {
 	return ""
 }
 
+func (x *UpdateBookRequest) GetEtag() string {
+	if x != nil && x.Etag != nil {
+		return *x.Etag
+	}
+	return ""
+}
+
 type UpdateBookResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Etag          string                 `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -126,6 +136,13 @@ func (x *UpdateBookResponse) GetName() string {
 	return ""
 }
 
+func (x *UpdateBookResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type GetBookRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -294,17 +311,117 @@ func (*DeleteBookResponse) Descriptor() ([]byte, []int) {
 	return file_book_v1_service_proto_rawDescGZIP(), []int{5}
 }
 
+type RestoreBookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreBookRequest) Reset() {
+	*x = RestoreBookRequest{}
+	mi := &file_book_v1_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreBookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreBookRequest) ProtoMessage() {}
+
+func (x *RestoreBookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_book_v1_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreBookRequest.ProtoReflect.Descriptor instead.
+func (*RestoreBookRequest) Descriptor() ([]byte, []int) {
+	return file_book_v1_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RestoreBookRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RestoreBookResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Book  *Book                  `protobuf:"bytes,1,opt,name=book,proto3" json:"book,omitempty"`
+	// 一緒に戻した顧客の数
+	RestoredCustomers int64 `protobuf:"varint,2,opt,name=restored_customers,json=restoredCustomers,proto3" json:"restored_customers,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RestoreBookResponse) Reset() {
+	*x = RestoreBookResponse{}
+	mi := &file_book_v1_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreBookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreBookResponse) ProtoMessage() {}
+
+func (x *RestoreBookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_book_v1_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreBookResponse.ProtoReflect.Descriptor instead.
+func (*RestoreBookResponse) Descriptor() ([]byte, []int) {
+	return file_book_v1_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RestoreBookResponse) GetBook() *Book {
+	if x != nil {
+		return x.Book
+	}
+	return nil
+}
+
+func (x *RestoreBookResponse) GetRestoredCustomers() int64 {
+	if x != nil {
+		return x.RestoredCustomers
+	}
+	return 0
+}
+
 type Book struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Timezone      string                 `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	SkipHolidays  bool                   `protobuf:"varint,4,opt,name=skip_holidays,json=skipHolidays,proto3" json:"skip_holidays,omitempty"`
+	Etag          string                 `protobuf:"bytes,5,opt,name=etag,proto3" json:"etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Book) Reset() {
 	*x = Book{}
-	mi := &file_book_v1_service_proto_msgTypes[6]
+	mi := &file_book_v1_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -316,7 +433,7 @@ func (x *Book) String() string {
 func (*Book) ProtoMessage() {}
 
 func (x *Book) ProtoReflect() protoreflect.Message {
-	mi := &file_book_v1_service_proto_msgTypes[6]
+	mi := &file_book_v1_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -329,7 +446,7 @@ func (x *Book) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Book.ProtoReflect.Descriptor instead.
 func (*Book) Descriptor() ([]byte, []int) {
-	return file_book_v1_service_proto_rawDescGZIP(), []int{6}
+	return file_book_v1_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Book) GetId() string {
@@ -346,34 +463,397 @@ func (x *Book) GetName() string {
 	return ""
 }
 
+func (x *Book) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Book) GetSkipHolidays() bool {
+	if x != nil {
+		return x.SkipHolidays
+	}
+	return false
+}
+
+func (x *Book) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type CallingWindow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0が日曜日
+	Weekday int32 `protobuf:"varint,1,opt,name=weekday,proto3" json:"weekday,omitempty"`
+	// HH:MM 形式
+	StartTime string `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// HH:MM 形式。この時刻は含まない
+	EndTime       string `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CallingWindow) Reset() {
+	*x = CallingWindow{}
+	mi := &file_book_v1_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallingWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallingWindow) ProtoMessage() {}
+
+func (x *CallingWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_book_v1_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallingWindow.ProtoReflect.Descriptor instead.
+func (*CallingWindow) Descriptor() ([]byte, []int) {
+	return file_book_v1_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CallingWindow) GetWeekday() int32 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
+
+func (x *CallingWindow) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *CallingWindow) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+type CallingHours struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// IANA タイムゾーン名
+	Timezone string `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// 祝日と年末年始は架電しない
+	SkipHolidays bool `protobuf:"varint,2,opt,name=skip_holidays,json=skipHolidays,proto3" json:"skip_holidays,omitempty"`
+	// 空の場合は平日9時〜18時
+	Windows       []*CallingWindow `protobuf:"bytes,3,rep,name=windows,proto3" json:"windows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CallingHours) Reset() {
+	*x = CallingHours{}
+	mi := &file_book_v1_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallingHours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallingHours) ProtoMessage() {}
+
+func (x *CallingHours) ProtoReflect() protoreflect.Message {
+	mi := &file_book_v1_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallingHours.ProtoReflect.Descriptor instead.
+func (*CallingHours) Descriptor() ([]byte, []int) {
+	return file_book_v1_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CallingHours) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *CallingHours) GetSkipHolidays() bool {
+	if x != nil {
+		return x.SkipHolidays
+	}
+	return false
+}
+
+func (x *CallingHours) GetWindows() []*CallingWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+type GetCallingHoursRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookId        string                 `protobuf:"bytes,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCallingHoursRequest) Reset() {
+	*x = GetCallingHoursRequest{}
+	mi := &file_book_v1_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCallingHoursRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCallingHoursRequest) ProtoMessage() {}
+
+func (x *GetCallingHoursRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_book_v1_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCallingHoursRequest.ProtoReflect.Descriptor instead.
+func (*GetCallingHoursRequest) Descriptor() ([]byte, []int) {
+	return file_book_v1_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetCallingHoursRequest) GetBookId() string {
+	if x != nil {
+		return x.BookId
+	}
+	return ""
+}
+
+type GetCallingHoursResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CallingHours  *CallingHours          `protobuf:"bytes,1,opt,name=calling_hours,json=callingHours,proto3" json:"calling_hours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCallingHoursResponse) Reset() {
+	*x = GetCallingHoursResponse{}
+	mi := &file_book_v1_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCallingHoursResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCallingHoursResponse) ProtoMessage() {}
+
+func (x *GetCallingHoursResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_book_v1_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCallingHoursResponse.ProtoReflect.Descriptor instead.
+func (*GetCallingHoursResponse) Descriptor() ([]byte, []int) {
+	return file_book_v1_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetCallingHoursResponse) GetCallingHours() *CallingHours {
+	if x != nil {
+		return x.CallingHours
+	}
+	return nil
+}
+
+type UpdateCallingHoursRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookId        string                 `protobuf:"bytes,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	CallingHours  *CallingHours          `protobuf:"bytes,2,opt,name=calling_hours,json=callingHours,proto3" json:"calling_hours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCallingHoursRequest) Reset() {
+	*x = UpdateCallingHoursRequest{}
+	mi := &file_book_v1_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCallingHoursRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCallingHoursRequest) ProtoMessage() {}
+
+func (x *UpdateCallingHoursRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_book_v1_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCallingHoursRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCallingHoursRequest) Descriptor() ([]byte, []int) {
+	return file_book_v1_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateCallingHoursRequest) GetBookId() string {
+	if x != nil {
+		return x.BookId
+	}
+	return ""
+}
+
+func (x *UpdateCallingHoursRequest) GetCallingHours() *CallingHours {
+	if x != nil {
+		return x.CallingHours
+	}
+	return nil
+}
+
+type UpdateCallingHoursResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CallingHours  *CallingHours          `protobuf:"bytes,1,opt,name=calling_hours,json=callingHours,proto3" json:"calling_hours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCallingHoursResponse) Reset() {
+	*x = UpdateCallingHoursResponse{}
+	mi := &file_book_v1_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCallingHoursResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCallingHoursResponse) ProtoMessage() {}
+
+func (x *UpdateCallingHoursResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_book_v1_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCallingHoursResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCallingHoursResponse) Descriptor() ([]byte, []int) {
+	return file_book_v1_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateCallingHoursResponse) GetCallingHours() *CallingHours {
+	if x != nil {
+		return x.CallingHours
+	}
+	return nil
+}
+
 var File_book_v1_service_proto protoreflect.FileDescriptor
 
 const file_book_v1_service_proto_rawDesc = "" +
 	"\n" +
-	"\x15book/v1/service.proto\x12\abook.v1\x1a\x1cgoogle/api/annotations.proto\"E\n" +
+	"\x15book/v1/service.proto\x12\abook.v1\x1a\x1cgoogle/api/annotations.proto\"g\n" +
 	"\x11UpdateBookRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
-	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01B\a\n" +
-	"\x05_name\"8\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x17\n" +
+	"\x04etag\x18\x03 \x01(\tH\x01R\x04etag\x88\x01\x01B\a\n" +
+	"\x05_nameB\a\n" +
+	"\x05_etag\"L\n" +
 	"\x12UpdateBookResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\" \n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04etag\x18\x03 \x01(\tR\x04etag\" \n" +
 	"\x0eGetBookRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"6\n" +
 	"\x0fGetBookResponse\x12#\n" +
 	"\x05books\x18\x01 \x03(\v2\r.book.v1.BookR\x05books\"#\n" +
 	"\x11DeleteBookRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x14\n" +
-	"\x12DeleteBookResponse\"*\n" +
+	"\x12DeleteBookResponse\"$\n" +
+	"\x12RestoreBookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"g\n" +
+	"\x13RestoreBookResponse\x12!\n" +
+	"\x04book\x18\x01 \x01(\v2\r.book.v1.BookR\x04book\x12-\n" +
+	"\x12restored_customers\x18\x02 \x01(\x03R\x11restoredCustomers\"\x7f\n" +
 	"\x04Book\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name2\xa1\x02\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\btimezone\x18\x03 \x01(\tR\btimezone\x12#\n" +
+	"\rskip_holidays\x18\x04 \x01(\bR\fskipHolidays\x12\x12\n" +
+	"\x04etag\x18\x05 \x01(\tR\x04etag\"c\n" +
+	"\rCallingWindow\x12\x18\n" +
+	"\aweekday\x18\x01 \x01(\x05R\aweekday\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x03 \x01(\tR\aendTime\"\x81\x01\n" +
+	"\fCallingHours\x12\x1a\n" +
+	"\btimezone\x18\x01 \x01(\tR\btimezone\x12#\n" +
+	"\rskip_holidays\x18\x02 \x01(\bR\fskipHolidays\x120\n" +
+	"\awindows\x18\x03 \x03(\v2\x16.book.v1.CallingWindowR\awindows\"1\n" +
+	"\x16GetCallingHoursRequest\x12\x17\n" +
+	"\abook_id\x18\x01 \x01(\tR\x06bookId\"U\n" +
+	"\x17GetCallingHoursResponse\x12:\n" +
+	"\rcalling_hours\x18\x01 \x01(\v2\x15.book.v1.CallingHoursR\fcallingHours\"p\n" +
+	"\x19UpdateCallingHoursRequest\x12\x17\n" +
+	"\abook_id\x18\x01 \x01(\tR\x06bookId\x12:\n" +
+	"\rcalling_hours\x18\x02 \x01(\v2\x15.book.v1.CallingHoursR\fcallingHours\"X\n" +
+	"\x1aUpdateCallingHoursResponse\x12:\n" +
+	"\rcalling_hours\x18\x01 \x01(\v2\x15.book.v1.CallingHoursR\fcallingHours2\x9a\x05\n" +
 	"\vBookService\x12S\n" +
 	"\aGetBook\x12\x17.book.v1.GetBookRequest\x1a\x18.book.v1.GetBookResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/book/{id}\x12_\n" +
 	"\n" +
 	"UpdateBook\x12\x1a.book.v1.UpdateBookRequest\x1a\x1b.book.v1.UpdateBookResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\x1a\r/v1/book/{id}\x12\\\n" +
 	"\n" +
-	"DeleteBook\x12\x1a.book.v1.DeleteBookRequest\x1a\x1b.book.v1.DeleteBookResponse\"\x15\x82\xd3\xe4\x93\x02\x0f*\r/v1/book/{id}B\x95\x01\n" +
+	"DeleteBook\x12\x1a.book.v1.DeleteBookRequest\x1a\x1b.book.v1.DeleteBookResponse\"\x15\x82\xd3\xe4\x93\x02\x0f*\r/v1/book/{id}\x12j\n" +
+	"\vRestoreBook\x12\x1b.book.v1.RestoreBookRequest\x1a\x1c.book.v1.RestoreBookResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/book/{id}/restore\x12~\n" +
+	"\x0fGetCallingHours\x12\x1f.book.v1.GetCallingHoursRequest\x1a .book.v1.GetCallingHoursResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /v1/book/{book_id}/calling-hours\x12\x8a\x01\n" +
+	"\x12UpdateCallingHours\x12\".book.v1.UpdateCallingHoursRequest\x1a#.book.v1.UpdateCallingHoursResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\x1a /v1/book/{book_id}/calling-hoursB\x95\x01\n" +
 	"\vcom.book.v1B\fServiceProtoP\x01Z;github.com/0utl1er-tech/prism-backend/gen/pb/book/v1;bookv1\xa2\x02\x03BXX\xaa\x02\aBook.V1\xca\x02\aBook\\V1\xe2\x02\x13Book\\V1\\GPBMetadata\xea\x02\bBook::V1b\x06proto3"
 
 var (
@@ -388,29 +868,48 @@ func file_book_v1_service_proto_rawDescGZIP() []byte {
 	return file_book_v1_service_proto_rawDescData
 }
 
-var file_book_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_book_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_book_v1_service_proto_goTypes = []any{
-	(*UpdateBookRequest)(nil),  // 0: book.v1.UpdateBookRequest
-	(*UpdateBookResponse)(nil), // 1: book.v1.UpdateBookResponse
-	(*GetBookRequest)(nil),     // 2: book.v1.GetBookRequest
-	(*GetBookResponse)(nil),    // 3: book.v1.GetBookResponse
-	(*DeleteBookRequest)(nil),  // 4: book.v1.DeleteBookRequest
-	(*DeleteBookResponse)(nil), // 5: book.v1.DeleteBookResponse
-	(*Book)(nil),               // 6: book.v1.Book
+	(*UpdateBookRequest)(nil),          // 0: book.v1.UpdateBookRequest
+	(*UpdateBookResponse)(nil),         // 1: book.v1.UpdateBookResponse
+	(*GetBookRequest)(nil),             // 2: book.v1.GetBookRequest
+	(*GetBookResponse)(nil),            // 3: book.v1.GetBookResponse
+	(*DeleteBookRequest)(nil),          // 4: book.v1.DeleteBookRequest
+	(*DeleteBookResponse)(nil),         // 5: book.v1.DeleteBookResponse
+	(*RestoreBookRequest)(nil),         // 6: book.v1.RestoreBookRequest
+	(*RestoreBookResponse)(nil),        // 7: book.v1.RestoreBookResponse
+	(*Book)(nil),                       // 8: book.v1.Book
+	(*CallingWindow)(nil),              // 9: book.v1.CallingWindow
+	(*CallingHours)(nil),               // 10: book.v1.CallingHours
+	(*GetCallingHoursRequest)(nil),     // 11: book.v1.GetCallingHoursRequest
+	(*GetCallingHoursResponse)(nil),    // 12: book.v1.GetCallingHoursResponse
+	(*UpdateCallingHoursRequest)(nil),  // 13: book.v1.UpdateCallingHoursRequest
+	(*UpdateCallingHoursResponse)(nil), // 14: book.v1.UpdateCallingHoursResponse
 }
 var file_book_v1_service_proto_depIdxs = []int32{
-	6, // 0: book.v1.GetBookResponse.books:type_name -> book.v1.Book
-	2, // 1: book.v1.BookService.GetBook:input_type -> book.v1.GetBookRequest
-	0, // 2: book.v1.BookService.UpdateBook:input_type -> book.v1.UpdateBookRequest
-	4, // 3: book.v1.BookService.DeleteBook:input_type -> book.v1.DeleteBookRequest
-	3, // 4: book.v1.BookService.GetBook:output_type -> book.v1.GetBookResponse
-	1, // 5: book.v1.BookService.UpdateBook:output_type -> book.v1.UpdateBookResponse
-	5, // 6: book.v1.BookService.DeleteBook:output_type -> book.v1.DeleteBookResponse
-	4, // [4:7] is the sub-list for method output_type
-	1, // [1:4] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	8,  // 0: book.v1.GetBookResponse.books:type_name -> book.v1.Book
+	8,  // 1: book.v1.RestoreBookResponse.book:type_name -> book.v1.Book
+	9,  // 2: book.v1.CallingHours.windows:type_name -> book.v1.CallingWindow
+	10, // 3: book.v1.GetCallingHoursResponse.calling_hours:type_name -> book.v1.CallingHours
+	10, // 4: book.v1.UpdateCallingHoursRequest.calling_hours:type_name -> book.v1.CallingHours
+	10, // 5: book.v1.UpdateCallingHoursResponse.calling_hours:type_name -> book.v1.CallingHours
+	2,  // 6: book.v1.BookService.GetBook:input_type -> book.v1.GetBookRequest
+	0,  // 7: book.v1.BookService.UpdateBook:input_type -> book.v1.UpdateBookRequest
+	4,  // 8: book.v1.BookService.DeleteBook:input_type -> book.v1.DeleteBookRequest
+	6,  // 9: book.v1.BookService.RestoreBook:input_type -> book.v1.RestoreBookRequest
+	11, // 10: book.v1.BookService.GetCallingHours:input_type -> book.v1.GetCallingHoursRequest
+	13, // 11: book.v1.BookService.UpdateCallingHours:input_type -> book.v1.UpdateCallingHoursRequest
+	3,  // 12: book.v1.BookService.GetBook:output_type -> book.v1.GetBookResponse
+	1,  // 13: book.v1.BookService.UpdateBook:output_type -> book.v1.UpdateBookResponse
+	5,  // 14: book.v1.BookService.DeleteBook:output_type -> book.v1.DeleteBookResponse
+	7,  // 15: book.v1.BookService.RestoreBook:output_type -> book.v1.RestoreBookResponse
+	12, // 16: book.v1.BookService.GetCallingHours:output_type -> book.v1.GetCallingHoursResponse
+	14, // 17: book.v1.BookService.UpdateCallingHours:output_type -> book.v1.UpdateCallingHoursResponse
+	12, // [12:18] is the sub-list for method output_type
+	6,  // [6:12] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_book_v1_service_proto_init() }
@@ -425,7 +924,7 @@ func file_book_v1_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_book_v1_service_proto_rawDesc), len(file_book_v1_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,9 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BookService_GetBook_FullMethodName    = "/book.v1.BookService/GetBook"
-	BookService_UpdateBook_FullMethodName = "/book.v1.BookService/UpdateBook"
-	BookService_DeleteBook_FullMethodName = "/book.v1.BookService/DeleteBook"
+	BookService_GetBook_FullMethodName            = "/book.v1.BookService/GetBook"
+	BookService_UpdateBook_FullMethodName         = "/book.v1.BookService/UpdateBook"
+	BookService_DeleteBook_FullMethodName         = "/book.v1.BookService/DeleteBook"
+	BookService_RestoreBook_FullMethodName        = "/book.v1.BookService/RestoreBook"
+	BookService_GetCallingHours_FullMethodName    = "/book.v1.BookService/GetCallingHours"
+	BookService_UpdateCallingHours_FullMethodName = "/book.v1.BookService/UpdateCallingHours"
 )
 
 // BookServiceClient is the client API for BookService service.
@@ -30,7 +33,14 @@ const (
 type BookServiceClient interface {
 	GetBook(ctx context.Context, in *GetBookRequest, opts ...grpc.CallOption) (*GetBookResponse, error)
 	UpdateBook(ctx context.Context, in *UpdateBookRequest, opts ...grpc.CallOption) (*UpdateBookResponse, error)
+	// リストと顧客をゴミ箱に移動する。保持期間を過ぎると完全に削除される
 	DeleteBook(ctx context.Context, in *DeleteBookRequest, opts ...grpc.CallOption) (*DeleteBookResponse, error)
+	// ゴミ箱からリストと一緒に削除された顧客を戻す
+	RestoreBook(ctx context.Context, in *RestoreBookRequest, opts ...grpc.CallOption) (*RestoreBookResponse, error)
+	// 架電可能時間帯を取得する
+	GetCallingHours(ctx context.Context, in *GetCallingHoursRequest, opts ...grpc.CallOption) (*GetCallingHoursResponse, error)
+	// 架電可能時間帯を置き換える
+	UpdateCallingHours(ctx context.Context, in *UpdateCallingHoursRequest, opts ...grpc.CallOption) (*UpdateCallingHoursResponse, error)
 }
 
 type bookServiceClient struct {
@@ -71,13 +81,50 @@ func (c *bookServiceClient) DeleteBook(ctx context.Context, in *DeleteBookReques
 	return out, nil
 }
 
+func (c *bookServiceClient) RestoreBook(ctx context.Context, in *RestoreBookRequest, opts ...grpc.CallOption) (*RestoreBookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreBookResponse)
+	err := c.cc.Invoke(ctx, BookService_RestoreBook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) GetCallingHours(ctx context.Context, in *GetCallingHoursRequest, opts ...grpc.CallOption) (*GetCallingHoursResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCallingHoursResponse)
+	err := c.cc.Invoke(ctx, BookService_GetCallingHours_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) UpdateCallingHours(ctx context.Context, in *UpdateCallingHoursRequest, opts ...grpc.CallOption) (*UpdateCallingHoursResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCallingHoursResponse)
+	err := c.cc.Invoke(ctx, BookService_UpdateCallingHours_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BookServiceServer is the server API for BookService service.
 // All implementations must embed UnimplementedBookServiceServer
 // for forward compatibility.
 type BookServiceServer interface {
 	GetBook(context.Context, *GetBookRequest) (*GetBookResponse, error)
 	UpdateBook(context.Context, *UpdateBookRequest) (*UpdateBookResponse, error)
+	// リストと顧客をゴミ箱に移動する。保持期間を過ぎると完全に削除される
 	DeleteBook(context.Context, *DeleteBookRequest) (*DeleteBookResponse, error)
+	// ゴミ箱からリストと一緒に削除された顧客を戻す
+	RestoreBook(context.Context, *RestoreBookRequest) (*RestoreBookResponse, error)
+	// 架電可能時間帯を取得する
+	GetCallingHours(context.Context, *GetCallingHoursRequest) (*GetCallingHoursResponse, error)
+	// 架電可能時間帯を置き換える
+	UpdateCallingHours(context.Context, *UpdateCallingHoursRequest) (*UpdateCallingHoursResponse, error)
 	mustEmbedUnimplementedBookServiceServer()
 }
 
@@ -97,6 +144,15 @@ func (UnimplementedBookServiceServer) UpdateBook(context.Context, *UpdateBookReq
 func (UnimplementedBookServiceServer) DeleteBook(context.Context, *DeleteBookRequest) (*DeleteBookResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteBook not implemented")
 }
+func (UnimplementedBookServiceServer) RestoreBook(context.Context, *RestoreBookRequest) (*RestoreBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreBook not implemented")
+}
+func (UnimplementedBookServiceServer) GetCallingHours(context.Context, *GetCallingHoursRequest) (*GetCallingHoursResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCallingHours not implemented")
+}
+func (UnimplementedBookServiceServer) UpdateCallingHours(context.Context, *UpdateCallingHoursRequest) (*UpdateCallingHoursResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCallingHours not implemented")
+}
 func (UnimplementedBookServiceServer) mustEmbedUnimplementedBookServiceServer() {}
 func (UnimplementedBookServiceServer) testEmbeddedByValue()                     {}
 
@@ -172,6 +228,60 @@ func _BookService_DeleteBook_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookService_RestoreBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).RestoreBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_RestoreBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).RestoreBook(ctx, req.(*RestoreBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_GetCallingHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCallingHoursRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).GetCallingHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_GetCallingHours_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).GetCallingHours(ctx, req.(*GetCallingHoursRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_UpdateCallingHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCallingHoursRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).UpdateCallingHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_UpdateCallingHours_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).UpdateCallingHours(ctx, req.(*UpdateCallingHoursRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BookService_ServiceDesc is the grpc.ServiceDesc for BookService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,6 +301,18 @@ var BookService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteBook",
 			Handler:    _BookService_DeleteBook_Handler,
 		},
+		{
+			MethodName: "RestoreBook",
+			Handler:    _BookService_RestoreBook_Handler,
+		},
+		{
+			MethodName: "GetCallingHours",
+			Handler:    _BookService_GetCallingHours_Handler,
+		},
+		{
+			MethodName: "UpdateCallingHours",
+			Handler:    _BookService_UpdateCallingHours_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "book/v1/service.proto",
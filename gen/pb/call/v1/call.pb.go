@@ -0,0 +1,404 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: call/v1/call.proto
+
+package callv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Call struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StatusId      string                 `protobuf:"bytes,4,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Call) Reset() {
+	*x = Call{}
+	mi := &file_call_v1_call_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Call) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Call) ProtoMessage() {}
+
+func (x *Call) ProtoReflect() protoreflect.Message {
+	mi := &file_call_v1_call_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Call.ProtoReflect.Descriptor instead.
+func (*Call) Descriptor() ([]byte, []int) {
+	return file_call_v1_call_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Call) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Call) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *Call) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Call) GetStatusId() string {
+	if x != nil {
+		return x.StatusId
+	}
+	return ""
+}
+
+func (x *Call) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type LogCallRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	StatusId      *string                `protobuf:"bytes,2,opt,name=status_id,json=statusId,proto3,oneof" json:"status_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogCallRequest) Reset() {
+	*x = LogCallRequest{}
+	mi := &file_call_v1_call_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogCallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogCallRequest) ProtoMessage() {}
+
+func (x *LogCallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_call_v1_call_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogCallRequest.ProtoReflect.Descriptor instead.
+func (*LogCallRequest) Descriptor() ([]byte, []int) {
+	return file_call_v1_call_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LogCallRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *LogCallRequest) GetStatusId() string {
+	if x != nil && x.StatusId != nil {
+		return *x.StatusId
+	}
+	return ""
+}
+
+type LogCallResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Call  *Call                  `protobuf:"bytes,1,opt,name=call,proto3" json:"call,omitempty"`
+	// ステータスにより架電禁止リストへ登録された電話番号の件数
+	DncRegistered int32 `protobuf:"varint,2,opt,name=dnc_registered,json=dncRegistered,proto3" json:"dnc_registered,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogCallResponse) Reset() {
+	*x = LogCallResponse{}
+	mi := &file_call_v1_call_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogCallResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogCallResponse) ProtoMessage() {}
+
+func (x *LogCallResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_call_v1_call_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogCallResponse.ProtoReflect.Descriptor instead.
+func (*LogCallResponse) Descriptor() ([]byte, []int) {
+	return file_call_v1_call_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LogCallResponse) GetCall() *Call {
+	if x != nil {
+		return x.Call
+	}
+	return nil
+}
+
+func (x *LogCallResponse) GetDncRegistered() int32 {
+	if x != nil {
+		return x.DncRegistered
+	}
+	return 0
+}
+
+type ListCallsByCustomerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCallsByCustomerRequest) Reset() {
+	*x = ListCallsByCustomerRequest{}
+	mi := &file_call_v1_call_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCallsByCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCallsByCustomerRequest) ProtoMessage() {}
+
+func (x *ListCallsByCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_call_v1_call_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCallsByCustomerRequest.ProtoReflect.Descriptor instead.
+func (*ListCallsByCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_call_v1_call_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListCallsByCustomerRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *ListCallsByCustomerRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCallsByCustomerRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListCallsByCustomerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Calls         []*Call                `protobuf:"bytes,1,rep,name=calls,proto3" json:"calls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCallsByCustomerResponse) Reset() {
+	*x = ListCallsByCustomerResponse{}
+	mi := &file_call_v1_call_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCallsByCustomerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCallsByCustomerResponse) ProtoMessage() {}
+
+func (x *ListCallsByCustomerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_call_v1_call_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCallsByCustomerResponse.ProtoReflect.Descriptor instead.
+func (*ListCallsByCustomerResponse) Descriptor() ([]byte, []int) {
+	return file_call_v1_call_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListCallsByCustomerResponse) GetCalls() []*Call {
+	if x != nil {
+		return x.Calls
+	}
+	return nil
+}
+
+var File_call_v1_call_proto protoreflect.FileDescriptor
+
+const file_call_v1_call_proto_rawDesc = "" +
+	"\n" +
+	"\x12call/v1/call.proto\x12\acall.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa8\x01\n" +
+	"\x04Call\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\tR\n" +
+	"customerId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tstatus_id\x18\x04 \x01(\tR\bstatusId\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"a\n" +
+	"\x0eLogCallRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12 \n" +
+	"\tstatus_id\x18\x02 \x01(\tH\x00R\bstatusId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_status_id\"[\n" +
+	"\x0fLogCallResponse\x12!\n" +
+	"\x04call\x18\x01 \x01(\v2\r.call.v1.CallR\x04call\x12%\n" +
+	"\x0ednc_registered\x18\x02 \x01(\x05R\rdncRegistered\"g\n" +
+	"\x1aListCallsByCustomerRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"B\n" +
+	"\x1bListCallsByCustomerResponse\x12#\n" +
+	"\x05calls\x18\x01 \x03(\v2\r.call.v1.CallR\x05calls2\xef\x01\n" +
+	"\vCallService\x12R\n" +
+	"\aLogCall\x12\x17.call.v1.LogCallRequest\x1a\x18.call.v1.LogCallResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/calls\x12\x8b\x01\n" +
+	"\x13ListCallsByCustomer\x12#.call.v1.ListCallsByCustomerRequest\x1a$.call.v1.ListCallsByCustomerResponse\")\x82\xd3\xe4\x93\x02#\x12!/v1/customers/{customer_id}/callsB\x92\x01\n" +
+	"\vcom.call.v1B\tCallProtoP\x01Z;github.com/0utl1er-tech/prism-backend/gen/pb/call/v1;callv1\xa2\x02\x03CXX\xaa\x02\aCall.V1\xca\x02\aCall\\V1\xe2\x02\x13Call\\V1\\GPBMetadata\xea\x02\bCall::V1b\x06proto3"
+
+var (
+	file_call_v1_call_proto_rawDescOnce sync.Once
+	file_call_v1_call_proto_rawDescData []byte
+)
+
+func file_call_v1_call_proto_rawDescGZIP() []byte {
+	file_call_v1_call_proto_rawDescOnce.Do(func() {
+		file_call_v1_call_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_call_v1_call_proto_rawDesc), len(file_call_v1_call_proto_rawDesc)))
+	})
+	return file_call_v1_call_proto_rawDescData
+}
+
+var file_call_v1_call_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_call_v1_call_proto_goTypes = []any{
+	(*Call)(nil),                        // 0: call.v1.Call
+	(*LogCallRequest)(nil),              // 1: call.v1.LogCallRequest
+	(*LogCallResponse)(nil),             // 2: call.v1.LogCallResponse
+	(*ListCallsByCustomerRequest)(nil),  // 3: call.v1.ListCallsByCustomerRequest
+	(*ListCallsByCustomerResponse)(nil), // 4: call.v1.ListCallsByCustomerResponse
+	(*timestamppb.Timestamp)(nil),       // 5: google.protobuf.Timestamp
+}
+var file_call_v1_call_proto_depIdxs = []int32{
+	5, // 0: call.v1.Call.created_at:type_name -> google.protobuf.Timestamp
+	0, // 1: call.v1.LogCallResponse.call:type_name -> call.v1.Call
+	0, // 2: call.v1.ListCallsByCustomerResponse.calls:type_name -> call.v1.Call
+	1, // 3: call.v1.CallService.LogCall:input_type -> call.v1.LogCallRequest
+	3, // 4: call.v1.CallService.ListCallsByCustomer:input_type -> call.v1.ListCallsByCustomerRequest
+	2, // 5: call.v1.CallService.LogCall:output_type -> call.v1.LogCallResponse
+	4, // 6: call.v1.CallService.ListCallsByCustomer:output_type -> call.v1.ListCallsByCustomerResponse
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_call_v1_call_proto_init() }
+func file_call_v1_call_proto_init() {
+	if File_call_v1_call_proto != nil {
+		return
+	}
+	file_call_v1_call_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_call_v1_call_proto_rawDesc), len(file_call_v1_call_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_call_v1_call_proto_goTypes,
+		DependencyIndexes: file_call_v1_call_proto_depIdxs,
+		MessageInfos:      file_call_v1_call_proto_msgTypes,
+	}.Build()
+	File_call_v1_call_proto = out.File
+	file_call_v1_call_proto_goTypes = nil
+	file_call_v1_call_proto_depIdxs = nil
+}
@@ -0,0 +1,161 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: call/v1/call.proto
+
+package callv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CallService_LogCall_FullMethodName             = "/call.v1.CallService/LogCall"
+	CallService_ListCallsByCustomer_FullMethodName = "/call.v1.CallService/ListCallsByCustomer"
+)
+
+// CallServiceClient is the client API for CallService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CallServiceClient interface {
+	// 架電結果を記録する
+	LogCall(ctx context.Context, in *LogCallRequest, opts ...grpc.CallOption) (*LogCallResponse, error)
+	ListCallsByCustomer(ctx context.Context, in *ListCallsByCustomerRequest, opts ...grpc.CallOption) (*ListCallsByCustomerResponse, error)
+}
+
+type callServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCallServiceClient(cc grpc.ClientConnInterface) CallServiceClient {
+	return &callServiceClient{cc}
+}
+
+func (c *callServiceClient) LogCall(ctx context.Context, in *LogCallRequest, opts ...grpc.CallOption) (*LogCallResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LogCallResponse)
+	err := c.cc.Invoke(ctx, CallService_LogCall_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *callServiceClient) ListCallsByCustomer(ctx context.Context, in *ListCallsByCustomerRequest, opts ...grpc.CallOption) (*ListCallsByCustomerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCallsByCustomerResponse)
+	err := c.cc.Invoke(ctx, CallService_ListCallsByCustomer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CallServiceServer is the server API for CallService service.
+// All implementations must embed UnimplementedCallServiceServer
+// for forward compatibility.
+type CallServiceServer interface {
+	// 架電結果を記録する
+	LogCall(context.Context, *LogCallRequest) (*LogCallResponse, error)
+	ListCallsByCustomer(context.Context, *ListCallsByCustomerRequest) (*ListCallsByCustomerResponse, error)
+	mustEmbedUnimplementedCallServiceServer()
+}
+
+// UnimplementedCallServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCallServiceServer struct{}
+
+func (UnimplementedCallServiceServer) LogCall(context.Context, *LogCallRequest) (*LogCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogCall not implemented")
+}
+func (UnimplementedCallServiceServer) ListCallsByCustomer(context.Context, *ListCallsByCustomerRequest) (*ListCallsByCustomerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCallsByCustomer not implemented")
+}
+func (UnimplementedCallServiceServer) mustEmbedUnimplementedCallServiceServer() {}
+func (UnimplementedCallServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeCallServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CallServiceServer will
+// result in compilation errors.
+type UnsafeCallServiceServer interface {
+	mustEmbedUnimplementedCallServiceServer()
+}
+
+func RegisterCallServiceServer(s grpc.ServiceRegistrar, srv CallServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCallServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CallService_ServiceDesc, srv)
+}
+
+func _CallService_LogCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallServiceServer).LogCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CallService_LogCall_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallServiceServer).LogCall(ctx, req.(*LogCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CallService_ListCallsByCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCallsByCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallServiceServer).ListCallsByCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CallService_ListCallsByCustomer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallServiceServer).ListCallsByCustomer(ctx, req.(*ListCallsByCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CallService_ServiceDesc is the grpc.ServiceDesc for CallService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CallService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "call.v1.CallService",
+	HandlerType: (*CallServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LogCall",
+			Handler:    _CallService_LogCall_Handler,
+		},
+		{
+			MethodName: "ListCallsByCustomer",
+			Handler:    _CallService_ListCallsByCustomer_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "call/v1/call.proto",
+}
@@ -31,6 +31,7 @@ type Contact struct {
 	Phone         string                 `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
 	Fax           string                 `protobuf:"bytes,5,opt,name=fax,proto3" json:"fax,omitempty"`
 	Staff         *v1.Staff              `protobuf:"bytes,6,opt,name=staff,proto3,oneof" json:"staff,omitempty"`
+	Etag          string                 `protobuf:"bytes,7,opt,name=etag,proto3" json:"etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -107,6 +108,13 @@ func (x *Contact) GetStaff() *v1.Staff {
 	return nil
 }
 
+func (x *Contact) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type CreateContactRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -339,19 +347,141 @@ func (x *GetContactResponse) GetMessage() string {
 	return ""
 }
 
+type UpdateContactRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Phone *string                `protobuf:"bytes,2,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
+	Mail  *string                `protobuf:"bytes,3,opt,name=mail,proto3,oneof" json:"mail,omitempty"`
+	Fax   *string                `protobuf:"bytes,4,opt,name=fax,proto3,oneof" json:"fax,omitempty"`
+	// 取得時の etag。If-Match ヘッダーでも指定できる。古い場合は Aborted (HTTP 412) になる
+	Etag          *string `protobuf:"bytes,5,opt,name=etag,proto3,oneof" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateContactRequest) Reset() {
+	*x = UpdateContactRequest{}
+	mi := &file_contact_v1_contact_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateContactRequest) ProtoMessage() {}
+
+func (x *UpdateContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contact_v1_contact_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateContactRequest.ProtoReflect.Descriptor instead.
+func (*UpdateContactRequest) Descriptor() ([]byte, []int) {
+	return file_contact_v1_contact_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateContactRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateContactRequest) GetPhone() string {
+	if x != nil && x.Phone != nil {
+		return *x.Phone
+	}
+	return ""
+}
+
+func (x *UpdateContactRequest) GetMail() string {
+	if x != nil && x.Mail != nil {
+		return *x.Mail
+	}
+	return ""
+}
+
+func (x *UpdateContactRequest) GetFax() string {
+	if x != nil && x.Fax != nil {
+		return *x.Fax
+	}
+	return ""
+}
+
+func (x *UpdateContactRequest) GetEtag() string {
+	if x != nil && x.Etag != nil {
+		return *x.Etag
+	}
+	return ""
+}
+
+type UpdateContactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contact       *Contact               `protobuf:"bytes,1,opt,name=contact,proto3" json:"contact,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateContactResponse) Reset() {
+	*x = UpdateContactResponse{}
+	mi := &file_contact_v1_contact_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateContactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateContactResponse) ProtoMessage() {}
+
+func (x *UpdateContactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contact_v1_contact_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateContactResponse.ProtoReflect.Descriptor instead.
+func (*UpdateContactResponse) Descriptor() ([]byte, []int) {
+	return file_contact_v1_contact_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateContactResponse) GetContact() *Contact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
 var File_contact_v1_contact_proto protoreflect.FileDescriptor
 
 const file_contact_v1_contact_proto_rawDesc = "" +
 	"\n" +
 	"\x18contact/v1/contact.proto\x12\n" +
-	"contact.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x16staff/v1/service.proto\"\x9f\x01\n" +
+	"contact.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x16staff/v1/service.proto\"\xb3\x01\n" +
 	"\aContact\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
 	"\x04mail\x18\x03 \x01(\tR\x04mail\x12\x14\n" +
 	"\x05phone\x18\x04 \x01(\tR\x05phone\x12\x10\n" +
 	"\x03fax\x18\x05 \x01(\tR\x03fax\x12*\n" +
-	"\x05staff\x18\x06 \x01(\v2\x0f.staff.v1.StaffH\x00R\x05staff\x88\x01\x01B\b\n" +
+	"\x05staff\x18\x06 \x01(\v2\x0f.staff.v1.StaffH\x00R\x05staff\x88\x01\x01\x12\x12\n" +
+	"\x04etag\x18\a \x01(\tR\x04etagB\b\n" +
 	"\x06_staff\"p\n" +
 	"\x14CreateContactRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
@@ -367,11 +497,24 @@ const file_contact_v1_contact_proto_rawDesc = "" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
 	"\x05email\x18\x03 \x01(\tR\x05email\x12\x14\n" +
 	"\x05phone\x18\x04 \x01(\tR\x05phone\x12\x18\n" +
-	"\amessage\x18\x05 \x01(\tR\amessage2\xe5\x01\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\"\xae\x01\n" +
+	"\x14UpdateContactRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\x05phone\x18\x02 \x01(\tH\x00R\x05phone\x88\x01\x01\x12\x17\n" +
+	"\x04mail\x18\x03 \x01(\tH\x01R\x04mail\x88\x01\x01\x12\x15\n" +
+	"\x03fax\x18\x04 \x01(\tH\x02R\x03fax\x88\x01\x01\x12\x17\n" +
+	"\x04etag\x18\x05 \x01(\tH\x03R\x04etag\x88\x01\x01B\b\n" +
+	"\x06_phoneB\a\n" +
+	"\x05_mailB\x06\n" +
+	"\x04_faxB\a\n" +
+	"\x05_etag\"F\n" +
+	"\x15UpdateContactResponse\x12-\n" +
+	"\acontact\x18\x01 \x01(\v2\x13.contact.v1.ContactR\acontact2\xd8\x02\n" +
 	"\x0eContactService\x12l\n" +
 	"\rCreateContact\x12 .contact.v1.CreateContactRequest\x1a!.contact.v1.CreateContactResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/contact\x12e\n" +
 	"\n" +
-	"GetContact\x12\x1d.contact.v1.GetContactRequest\x1a\x1e.contact.v1.GetContactResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/contact/{id}B\xaa\x01\n" +
+	"GetContact\x12\x1d.contact.v1.GetContactRequest\x1a\x1e.contact.v1.GetContactResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/contact/{id}\x12q\n" +
+	"\rUpdateContact\x12 .contact.v1.UpdateContactRequest\x1a!.contact.v1.UpdateContactResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\x1a\x10/v1/contact/{id}B\xaa\x01\n" +
 	"\x0ecom.contact.v1B\fContactProtoP\x01ZAgithub.com/0utl1er-tech/prism-backend/gen/pb/contact/v1;contactv1\xa2\x02\x03CXX\xaa\x02\n" +
 	"Contact.V1\xca\x02\n" +
 	"Contact\\V1\xe2\x02\x16Contact\\V1\\GPBMetadata\xea\x02\vContact::V1b\x06proto3"
@@ -388,26 +531,31 @@ func file_contact_v1_contact_proto_rawDescGZIP() []byte {
 	return file_contact_v1_contact_proto_rawDescData
 }
 
-var file_contact_v1_contact_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_contact_v1_contact_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_contact_v1_contact_proto_goTypes = []any{
 	(*Contact)(nil),               // 0: contact.v1.Contact
 	(*CreateContactRequest)(nil),  // 1: contact.v1.CreateContactRequest
 	(*CreateContactResponse)(nil), // 2: contact.v1.CreateContactResponse
 	(*GetContactRequest)(nil),     // 3: contact.v1.GetContactRequest
 	(*GetContactResponse)(nil),    // 4: contact.v1.GetContactResponse
-	(*v1.Staff)(nil),              // 5: staff.v1.Staff
+	(*UpdateContactRequest)(nil),  // 5: contact.v1.UpdateContactRequest
+	(*UpdateContactResponse)(nil), // 6: contact.v1.UpdateContactResponse
+	(*v1.Staff)(nil),              // 7: staff.v1.Staff
 }
 var file_contact_v1_contact_proto_depIdxs = []int32{
-	5, // 0: contact.v1.Contact.staff:type_name -> staff.v1.Staff
-	1, // 1: contact.v1.ContactService.CreateContact:input_type -> contact.v1.CreateContactRequest
-	3, // 2: contact.v1.ContactService.GetContact:input_type -> contact.v1.GetContactRequest
-	2, // 3: contact.v1.ContactService.CreateContact:output_type -> contact.v1.CreateContactResponse
-	4, // 4: contact.v1.ContactService.GetContact:output_type -> contact.v1.GetContactResponse
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	7, // 0: contact.v1.Contact.staff:type_name -> staff.v1.Staff
+	0, // 1: contact.v1.UpdateContactResponse.contact:type_name -> contact.v1.Contact
+	1, // 2: contact.v1.ContactService.CreateContact:input_type -> contact.v1.CreateContactRequest
+	3, // 3: contact.v1.ContactService.GetContact:input_type -> contact.v1.GetContactRequest
+	5, // 4: contact.v1.ContactService.UpdateContact:input_type -> contact.v1.UpdateContactRequest
+	2, // 5: contact.v1.ContactService.CreateContact:output_type -> contact.v1.CreateContactResponse
+	4, // 6: contact.v1.ContactService.GetContact:output_type -> contact.v1.GetContactResponse
+	6, // 7: contact.v1.ContactService.UpdateContact:output_type -> contact.v1.UpdateContactResponse
+	5, // [5:8] is the sub-list for method output_type
+	2, // [2:5] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_contact_v1_contact_proto_init() }
@@ -416,13 +564,14 @@ func file_contact_v1_contact_proto_init() {
 		return
 	}
 	file_contact_v1_contact_proto_msgTypes[0].OneofWrappers = []any{}
+	file_contact_v1_contact_proto_msgTypes[5].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_contact_v1_contact_proto_rawDesc), len(file_contact_v1_contact_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	ContactService_CreateContact_FullMethodName = "/contact.v1.ContactService/CreateContact"
 	ContactService_GetContact_FullMethodName    = "/contact.v1.ContactService/GetContact"
+	ContactService_UpdateContact_FullMethodName = "/contact.v1.ContactService/UpdateContact"
 )
 
 // ContactServiceClient is the client API for ContactService service.
@@ -29,6 +30,8 @@ const (
 type ContactServiceClient interface {
 	CreateContact(ctx context.Context, in *CreateContactRequest, opts ...grpc.CallOption) (*CreateContactResponse, error)
 	GetContact(ctx context.Context, in *GetContactRequest, opts ...grpc.CallOption) (*GetContactResponse, error)
+	// 指定した項目のみ更新する。成功時は新しい ETag をヘッダーで返す
+	UpdateContact(ctx context.Context, in *UpdateContactRequest, opts ...grpc.CallOption) (*UpdateContactResponse, error)
 }
 
 type contactServiceClient struct {
@@ -59,12 +62,24 @@ func (c *contactServiceClient) GetContact(ctx context.Context, in *GetContactReq
 	return out, nil
 }
 
+func (c *contactServiceClient) UpdateContact(ctx context.Context, in *UpdateContactRequest, opts ...grpc.CallOption) (*UpdateContactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateContactResponse)
+	err := c.cc.Invoke(ctx, ContactService_UpdateContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ContactServiceServer is the server API for ContactService service.
 // All implementations must embed UnimplementedContactServiceServer
 // for forward compatibility.
 type ContactServiceServer interface {
 	CreateContact(context.Context, *CreateContactRequest) (*CreateContactResponse, error)
 	GetContact(context.Context, *GetContactRequest) (*GetContactResponse, error)
+	// 指定した項目のみ更新する。成功時は新しい ETag をヘッダーで返す
+	UpdateContact(context.Context, *UpdateContactRequest) (*UpdateContactResponse, error)
 	mustEmbedUnimplementedContactServiceServer()
 }
 
@@ -81,6 +96,9 @@ func (UnimplementedContactServiceServer) CreateContact(context.Context, *CreateC
 func (UnimplementedContactServiceServer) GetContact(context.Context, *GetContactRequest) (*GetContactResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetContact not implemented")
 }
+func (UnimplementedContactServiceServer) UpdateContact(context.Context, *UpdateContactRequest) (*UpdateContactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateContact not implemented")
+}
 func (UnimplementedContactServiceServer) mustEmbedUnimplementedContactServiceServer() {}
 func (UnimplementedContactServiceServer) testEmbeddedByValue()                        {}
 
@@ -138,6 +156,24 @@ func _ContactService_GetContact_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ContactService_UpdateContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactServiceServer).UpdateContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactService_UpdateContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactServiceServer).UpdateContact(ctx, req.(*UpdateContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ContactService_ServiceDesc is the grpc.ServiceDesc for ContactService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,6 +189,10 @@ var ContactService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetContact",
 			Handler:    _ContactService_GetContact_Handler,
 		},
+		{
+			MethodName: "UpdateContact",
+			Handler:    _ContactService_UpdateContact_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "contact/v1/contact.proto",
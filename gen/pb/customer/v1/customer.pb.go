@@ -11,6 +11,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -23,19 +24,90 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type CustomFieldFilter_Op int32
+
+const (
+	CustomFieldFilter_OP_UNSPECIFIED CustomFieldFilter_Op = 0
+	// 値が入力されている
+	CustomFieldFilter_OP_SET CustomFieldFilter_Op = 1
+	CustomFieldFilter_OP_EQ  CustomFieldFilter_Op = 2
+	CustomFieldFilter_OP_NE  CustomFieldFilter_Op = 3
+	CustomFieldFilter_OP_LT  CustomFieldFilter_Op = 4
+	CustomFieldFilter_OP_LTE CustomFieldFilter_Op = 5
+	CustomFieldFilter_OP_GT  CustomFieldFilter_Op = 6
+	CustomFieldFilter_OP_GTE CustomFieldFilter_Op = 7
+	// 部分一致
+	CustomFieldFilter_OP_CONTAINS CustomFieldFilter_Op = 8
+)
+
+// Enum value maps for CustomFieldFilter_Op.
+var (
+	CustomFieldFilter_Op_name = map[int32]string{
+		0: "OP_UNSPECIFIED",
+		1: "OP_SET",
+		2: "OP_EQ",
+		3: "OP_NE",
+		4: "OP_LT",
+		5: "OP_LTE",
+		6: "OP_GT",
+		7: "OP_GTE",
+		8: "OP_CONTAINS",
+	}
+	CustomFieldFilter_Op_value = map[string]int32{
+		"OP_UNSPECIFIED": 0,
+		"OP_SET":         1,
+		"OP_EQ":          2,
+		"OP_NE":          3,
+		"OP_LT":          4,
+		"OP_LTE":         5,
+		"OP_GT":          6,
+		"OP_GTE":         7,
+		"OP_CONTAINS":    8,
+	}
+)
+
+func (x CustomFieldFilter_Op) Enum() *CustomFieldFilter_Op {
+	p := new(CustomFieldFilter_Op)
+	*p = x
+	return p
+}
+
+func (x CustomFieldFilter_Op) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CustomFieldFilter_Op) Descriptor() protoreflect.EnumDescriptor {
+	return file_customer_v1_customer_proto_enumTypes[0].Descriptor()
+}
+
+func (CustomFieldFilter_Op) Type() protoreflect.EnumType {
+	return &file_customer_v1_customer_proto_enumTypes[0]
+}
+
+func (x CustomFieldFilter_Op) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CustomFieldFilter_Op.Descriptor instead.
+func (CustomFieldFilter_Op) EnumDescriptor() ([]byte, []int) {
+	return file_customer_v1_customer_proto_rawDescGZIP(), []int{2, 0}
+}
+
 type CreateCustomerRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BookId        string                 `protobuf:"bytes,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Phone         string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
-	Corporation   *string                `protobuf:"bytes,4,opt,name=corporation,proto3,oneof" json:"corporation,omitempty"`
-	Address       *string                `protobuf:"bytes,5,opt,name=address,proto3,oneof" json:"address,omitempty"`
-	Memo          *string                `protobuf:"bytes,6,opt,name=memo,proto3,oneof" json:"memo,omitempty"`
-	Leader        *string                `protobuf:"bytes,7,opt,name=leader,proto3,oneof" json:"leader,omitempty"`
-	LeaderSex     *string                `protobuf:"bytes,8,opt,name=leader_sex,json=leaderSex,proto3,oneof" json:"leader_sex,omitempty"`
-	Pic           *string                `protobuf:"bytes,9,opt,name=pic,proto3,oneof" json:"pic,omitempty"`
-	PicSex        *string                `protobuf:"bytes,10,opt,name=pic_sex,json=picSex,proto3,oneof" json:"pic_sex,omitempty"`
-	Contact       *v1.Contact            `protobuf:"bytes,11,opt,name=contact,proto3,oneof" json:"contact,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	BookId      string                 `protobuf:"bytes,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Phone       string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	Corporation *string                `protobuf:"bytes,4,opt,name=corporation,proto3,oneof" json:"corporation,omitempty"`
+	Address     *string                `protobuf:"bytes,5,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	Memo        *string                `protobuf:"bytes,6,opt,name=memo,proto3,oneof" json:"memo,omitempty"`
+	Leader      *string                `protobuf:"bytes,7,opt,name=leader,proto3,oneof" json:"leader,omitempty"`
+	LeaderSex   *string                `protobuf:"bytes,8,opt,name=leader_sex,json=leaderSex,proto3,oneof" json:"leader_sex,omitempty"`
+	Pic         *string                `protobuf:"bytes,9,opt,name=pic,proto3,oneof" json:"pic,omitempty"`
+	PicSex      *string                `protobuf:"bytes,10,opt,name=pic_sex,json=picSex,proto3,oneof" json:"pic_sex,omitempty"`
+	Contact     *v1.Contact            `protobuf:"bytes,11,opt,name=contact,proto3,oneof" json:"contact,omitempty"`
+	// 独自項目の値。キーは CustomField.key
+	CustomFields  *structpb.Struct `protobuf:"bytes,12,opt,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -147,6 +219,13 @@ func (x *CreateCustomerRequest) GetContact() *v1.Contact {
 	return nil
 }
 
+func (x *CreateCustomerRequest) GetCustomFields() *structpb.Struct {
+	if x != nil {
+		return x.CustomFields
+	}
+	return nil
+}
+
 type CreateCustomerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -155,6 +234,8 @@ type CreateCustomerResponse struct {
 	Corporation   string                 `protobuf:"bytes,4,opt,name=corporation,proto3" json:"corporation,omitempty"`
 	Address       string                 `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
 	Memo          string                 `protobuf:"bytes,6,opt,name=memo,proto3" json:"memo,omitempty"`
+	CustomFields  *structpb.Struct       `protobuf:"bytes,7,opt,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty"`
+	Etag          string                 `protobuf:"bytes,8,opt,name=etag,proto3" json:"etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -231,22 +312,167 @@ func (x *CreateCustomerResponse) GetMemo() string {
 	return ""
 }
 
-type SearchCustomerRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BookId        string                 `protobuf:"bytes,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
-	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Corporation   *string                `protobuf:"bytes,3,opt,name=corporation,proto3,oneof" json:"corporation,omitempty"`
-	Address       *string                `protobuf:"bytes,4,opt,name=address,proto3,oneof" json:"address,omitempty"`
-	Phone         *string                `protobuf:"bytes,5,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
-	Memo          *string                `protobuf:"bytes,7,opt,name=memo,proto3,oneof" json:"memo,omitempty"`
-	Contact       *v1.Contact            `protobuf:"bytes,8,opt,name=contact,proto3,oneof" json:"contact,omitempty"`
+func (x *CreateCustomerResponse) GetCustomFields() *structpb.Struct {
+	if x != nil {
+		return x.CustomFields
+	}
+	return nil
+}
+
+func (x *CreateCustomerResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type CustomFieldFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Op    CustomFieldFilter_Op   `protobuf:"varint,2,opt,name=op,proto3,enum=customer.v1.CustomFieldFilter_Op" json:"op,omitempty"`
+	// number は数値、date は YYYY-MM-DD、boolean は true/false
+	Value         string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomFieldFilter) Reset() {
+	*x = CustomFieldFilter{}
+	mi := &file_customer_v1_customer_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomFieldFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomFieldFilter) ProtoMessage() {}
+
+func (x *CustomFieldFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_v1_customer_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomFieldFilter.ProtoReflect.Descriptor instead.
+func (*CustomFieldFilter) Descriptor() ([]byte, []int) {
+	return file_customer_v1_customer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CustomFieldFilter) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CustomFieldFilter) GetOp() CustomFieldFilter_Op {
+	if x != nil {
+		return x.Op
+	}
+	return CustomFieldFilter_OP_UNSPECIFIED
+}
+
+func (x *CustomFieldFilter) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// タグでの絞り込み。タグ名で指定する
+type TagFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// いずれかのタグが付いている
+	Any []string `protobuf:"bytes,1,rep,name=any,proto3" json:"any,omitempty"`
+	// すべてのタグが付いている
+	All []string `protobuf:"bytes,2,rep,name=all,proto3" json:"all,omitempty"`
+	// いずれのタグも付いていない
+	None          []string `protobuf:"bytes,3,rep,name=none,proto3" json:"none,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *TagFilter) Reset() {
+	*x = TagFilter{}
+	mi := &file_customer_v1_customer_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagFilter) ProtoMessage() {}
+
+func (x *TagFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_v1_customer_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagFilter.ProtoReflect.Descriptor instead.
+func (*TagFilter) Descriptor() ([]byte, []int) {
+	return file_customer_v1_customer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TagFilter) GetAny() []string {
+	if x != nil {
+		return x.Any
+	}
+	return nil
+}
+
+func (x *TagFilter) GetAll() []string {
+	if x != nil {
+		return x.All
+	}
+	return nil
+}
+
+func (x *TagFilter) GetNone() []string {
+	if x != nil {
+		return x.None
+	}
+	return nil
+}
+
+type SearchCustomerRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	BookId      string                 `protobuf:"bytes,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	Name        *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Corporation *string                `protobuf:"bytes,3,opt,name=corporation,proto3,oneof" json:"corporation,omitempty"`
+	Address     *string                `protobuf:"bytes,4,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	Phone       *string                `protobuf:"bytes,5,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
+	Memo        *string                `protobuf:"bytes,7,opt,name=memo,proto3,oneof" json:"memo,omitempty"`
+	Contact     *v1.Contact            `protobuf:"bytes,8,opt,name=contact,proto3,oneof" json:"contact,omitempty"`
+	// 独自項目での絞り込み。book_id の指定が必要
+	CustomFieldFilters []*CustomFieldFilter `protobuf:"bytes,9,rep,name=custom_field_filters,json=customFieldFilters,proto3" json:"custom_field_filters,omitempty"`
+	// 独自項目のキーで並べ替える。book_id の指定が必要
+	SortCustomField *string    `protobuf:"bytes,10,opt,name=sort_custom_field,json=sortCustomField,proto3,oneof" json:"sort_custom_field,omitempty"`
+	SortDesc        bool       `protobuf:"varint,11,opt,name=sort_desc,json=sortDesc,proto3" json:"sort_desc,omitempty"`
+	TagFilter       *TagFilter `protobuf:"bytes,12,opt,name=tag_filter,json=tagFilter,proto3" json:"tag_filter,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
 func (x *SearchCustomerRequest) Reset() {
 	*x = SearchCustomerRequest{}
-	mi := &file_customer_v1_customer_proto_msgTypes[2]
+	mi := &file_customer_v1_customer_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -258,7 +484,7 @@ func (x *SearchCustomerRequest) String() string {
 func (*SearchCustomerRequest) ProtoMessage() {}
 
 func (x *SearchCustomerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_customer_v1_customer_proto_msgTypes[2]
+	mi := &file_customer_v1_customer_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -271,7 +497,7 @@ func (x *SearchCustomerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchCustomerRequest.ProtoReflect.Descriptor instead.
 func (*SearchCustomerRequest) Descriptor() ([]byte, []int) {
-	return file_customer_v1_customer_proto_rawDescGZIP(), []int{2}
+	return file_customer_v1_customer_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SearchCustomerRequest) GetBookId() string {
@@ -323,6 +549,34 @@ func (x *SearchCustomerRequest) GetContact() *v1.Contact {
 	return nil
 }
 
+func (x *SearchCustomerRequest) GetCustomFieldFilters() []*CustomFieldFilter {
+	if x != nil {
+		return x.CustomFieldFilters
+	}
+	return nil
+}
+
+func (x *SearchCustomerRequest) GetSortCustomField() string {
+	if x != nil && x.SortCustomField != nil {
+		return *x.SortCustomField
+	}
+	return ""
+}
+
+func (x *SearchCustomerRequest) GetSortDesc() bool {
+	if x != nil {
+		return x.SortDesc
+	}
+	return false
+}
+
+func (x *SearchCustomerRequest) GetTagFilter() *TagFilter {
+	if x != nil {
+		return x.TagFilter
+	}
+	return nil
+}
+
 type SearchCustomerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Customers     []*Customer            `protobuf:"bytes,1,rep,name=customers,proto3" json:"customers,omitempty"`
@@ -332,7 +586,7 @@ type SearchCustomerResponse struct {
 
 func (x *SearchCustomerResponse) Reset() {
 	*x = SearchCustomerResponse{}
-	mi := &file_customer_v1_customer_proto_msgTypes[3]
+	mi := &file_customer_v1_customer_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -344,7 +598,7 @@ func (x *SearchCustomerResponse) String() string {
 func (*SearchCustomerResponse) ProtoMessage() {}
 
 func (x *SearchCustomerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_customer_v1_customer_proto_msgTypes[3]
+	mi := &file_customer_v1_customer_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -357,7 +611,7 @@ func (x *SearchCustomerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchCustomerResponse.ProtoReflect.Descriptor instead.
 func (*SearchCustomerResponse) Descriptor() ([]byte, []int) {
-	return file_customer_v1_customer_proto_rawDescGZIP(), []int{3}
+	return file_customer_v1_customer_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SearchCustomerResponse) GetCustomers() []*Customer {
@@ -376,7 +630,7 @@ type GetCustomerRequest struct {
 
 func (x *GetCustomerRequest) Reset() {
 	*x = GetCustomerRequest{}
-	mi := &file_customer_v1_customer_proto_msgTypes[4]
+	mi := &file_customer_v1_customer_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -388,7 +642,7 @@ func (x *GetCustomerRequest) String() string {
 func (*GetCustomerRequest) ProtoMessage() {}
 
 func (x *GetCustomerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_customer_v1_customer_proto_msgTypes[4]
+	mi := &file_customer_v1_customer_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -401,7 +655,7 @@ func (x *GetCustomerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCustomerRequest.ProtoReflect.Descriptor instead.
 func (*GetCustomerRequest) Descriptor() ([]byte, []int) {
-	return file_customer_v1_customer_proto_rawDescGZIP(), []int{4}
+	return file_customer_v1_customer_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetCustomerRequest) GetId() string {
@@ -412,28 +666,34 @@ func (x *GetCustomerRequest) GetId() string {
 }
 
 type GetCustomerResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Job           string                 `protobuf:"bytes,3,opt,name=job,proto3" json:"job,omitempty"`
-	Corporation   string                 `protobuf:"bytes,4,opt,name=corporation,proto3" json:"corporation,omitempty"`
-	Address       string                 `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
-	Phone         string                 `protobuf:"bytes,6,opt,name=phone,proto3" json:"phone,omitempty"`
-	Leader        string                 `protobuf:"bytes,7,opt,name=leader,proto3" json:"leader,omitempty"`
-	LeaderSex     string                 `protobuf:"bytes,8,opt,name=leader_sex,json=leaderSex,proto3" json:"leader_sex,omitempty"`
-	Pic           string                 `protobuf:"bytes,9,opt,name=pic,proto3" json:"pic,omitempty"`
-	PicSex        string                 `protobuf:"bytes,10,opt,name=pic_sex,json=picSex,proto3" json:"pic_sex,omitempty"`
-	Memo          string                 `protobuf:"bytes,11,opt,name=memo,proto3" json:"memo,omitempty"`
-	Mail          string                 `protobuf:"bytes,12,opt,name=mail,proto3" json:"mail,omitempty"`
-	Fax           string                 `protobuf:"bytes,13,opt,name=fax,proto3" json:"fax,omitempty"`
-	Contact       *v1.Contact            `protobuf:"bytes,14,opt,name=contact,proto3" json:"contact,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Job          string                 `protobuf:"bytes,3,opt,name=job,proto3" json:"job,omitempty"`
+	Corporation  string                 `protobuf:"bytes,4,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	Address      string                 `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Phone        string                 `protobuf:"bytes,6,opt,name=phone,proto3" json:"phone,omitempty"`
+	Leader       string                 `protobuf:"bytes,7,opt,name=leader,proto3" json:"leader,omitempty"`
+	LeaderSex    string                 `protobuf:"bytes,8,opt,name=leader_sex,json=leaderSex,proto3" json:"leader_sex,omitempty"`
+	Pic          string                 `protobuf:"bytes,9,opt,name=pic,proto3" json:"pic,omitempty"`
+	PicSex       string                 `protobuf:"bytes,10,opt,name=pic_sex,json=picSex,proto3" json:"pic_sex,omitempty"`
+	Memo         string                 `protobuf:"bytes,11,opt,name=memo,proto3" json:"memo,omitempty"`
+	Mail         string                 `protobuf:"bytes,12,opt,name=mail,proto3" json:"mail,omitempty"`
+	Fax          string                 `protobuf:"bytes,13,opt,name=fax,proto3" json:"fax,omitempty"`
+	Contact      *v1.Contact            `protobuf:"bytes,14,opt,name=contact,proto3" json:"contact,omitempty"`
+	CustomFields *structpb.Struct       `protobuf:"bytes,15,opt,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty"`
+	Tags         []string               `protobuf:"bytes,16,rep,name=tags,proto3" json:"tags,omitempty"`
+	Etag         string                 `protobuf:"bytes,17,opt,name=etag,proto3" json:"etag,omitempty"`
+	// StaffService で参照・更新する代表者と担当者のID
+	LeaderId      string `protobuf:"bytes,18,opt,name=leader_id,json=leaderId,proto3" json:"leader_id,omitempty"`
+	PicId         string `protobuf:"bytes,19,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetCustomerResponse) Reset() {
 	*x = GetCustomerResponse{}
-	mi := &file_customer_v1_customer_proto_msgTypes[5]
+	mi := &file_customer_v1_customer_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -445,7 +705,7 @@ func (x *GetCustomerResponse) String() string {
 func (*GetCustomerResponse) ProtoMessage() {}
 
 func (x *GetCustomerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_customer_v1_customer_proto_msgTypes[5]
+	mi := &file_customer_v1_customer_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -458,7 +718,7 @@ func (x *GetCustomerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCustomerResponse.ProtoReflect.Descriptor instead.
 func (*GetCustomerResponse) Descriptor() ([]byte, []int) {
-	return file_customer_v1_customer_proto_rawDescGZIP(), []int{5}
+	return file_customer_v1_customer_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetCustomerResponse) GetId() string {
@@ -559,26 +819,65 @@ func (x *GetCustomerResponse) GetContact() *v1.Contact {
 	return nil
 }
 
+func (x *GetCustomerResponse) GetCustomFields() *structpb.Struct {
+	if x != nil {
+		return x.CustomFields
+	}
+	return nil
+}
+
+func (x *GetCustomerResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *GetCustomerResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *GetCustomerResponse) GetLeaderId() string {
+	if x != nil {
+		return x.LeaderId
+	}
+	return ""
+}
+
+func (x *GetCustomerResponse) GetPicId() string {
+	if x != nil {
+		return x.PicId
+	}
+	return ""
+}
+
 type Customer struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Job           string                 `protobuf:"bytes,3,opt,name=job,proto3" json:"job,omitempty"`
-	Corporation   string                 `protobuf:"bytes,4,opt,name=corporation,proto3" json:"corporation,omitempty"`
-	Address       string                 `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
-	Phone         string                 `protobuf:"bytes,6,opt,name=phone,proto3" json:"phone,omitempty"`
-	Leader        string                 `protobuf:"bytes,7,opt,name=leader,proto3" json:"leader,omitempty"`
-	LeaderSex     string                 `protobuf:"bytes,8,opt,name=leader_sex,json=leaderSex,proto3" json:"leader_sex,omitempty"`
-	Pic           string                 `protobuf:"bytes,9,opt,name=pic,proto3" json:"pic,omitempty"`
-	PicSex        string                 `protobuf:"bytes,10,opt,name=pic_sex,json=picSex,proto3" json:"pic_sex,omitempty"`
-	Memo          string                 `protobuf:"bytes,11,opt,name=memo,proto3" json:"memo,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Job          string                 `protobuf:"bytes,3,opt,name=job,proto3" json:"job,omitempty"`
+	Corporation  string                 `protobuf:"bytes,4,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	Address      string                 `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Phone        string                 `protobuf:"bytes,6,opt,name=phone,proto3" json:"phone,omitempty"`
+	Leader       string                 `protobuf:"bytes,7,opt,name=leader,proto3" json:"leader,omitempty"`
+	LeaderSex    string                 `protobuf:"bytes,8,opt,name=leader_sex,json=leaderSex,proto3" json:"leader_sex,omitempty"`
+	Pic          string                 `protobuf:"bytes,9,opt,name=pic,proto3" json:"pic,omitempty"`
+	PicSex       string                 `protobuf:"bytes,10,opt,name=pic_sex,json=picSex,proto3" json:"pic_sex,omitempty"`
+	Memo         string                 `protobuf:"bytes,11,opt,name=memo,proto3" json:"memo,omitempty"`
+	CustomFields *structpb.Struct       `protobuf:"bytes,12,opt,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty"`
+	Tags         []string               `protobuf:"bytes,13,rep,name=tags,proto3" json:"tags,omitempty"`
+	// 更新時に etag か If-Match ヘッダーに指定する
+	Etag          string `protobuf:"bytes,14,opt,name=etag,proto3" json:"etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Customer) Reset() {
 	*x = Customer{}
-	mi := &file_customer_v1_customer_proto_msgTypes[6]
+	mi := &file_customer_v1_customer_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -590,7 +889,7 @@ func (x *Customer) String() string {
 func (*Customer) ProtoMessage() {}
 
 func (x *Customer) ProtoReflect() protoreflect.Message {
-	mi := &file_customer_v1_customer_proto_msgTypes[6]
+	mi := &file_customer_v1_customer_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -603,7 +902,7 @@ func (x *Customer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Customer.ProtoReflect.Descriptor instead.
 func (*Customer) Descriptor() ([]byte, []int) {
-	return file_customer_v1_customer_proto_rawDescGZIP(), []int{6}
+	return file_customer_v1_customer_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Customer) GetId() string {
@@ -683,18 +982,40 @@ func (x *Customer) GetMemo() string {
 	return ""
 }
 
+func (x *Customer) GetCustomFields() *structpb.Struct {
+	if x != nil {
+		return x.CustomFields
+	}
+	return nil
+}
+
+func (x *Customer) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Customer) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type GetCustomerByBookIdRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	BookId        string                 `protobuf:"bytes,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
 	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
 	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	TagFilter     *TagFilter             `protobuf:"bytes,4,opt,name=tag_filter,json=tagFilter,proto3" json:"tag_filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetCustomerByBookIdRequest) Reset() {
 	*x = GetCustomerByBookIdRequest{}
-	mi := &file_customer_v1_customer_proto_msgTypes[7]
+	mi := &file_customer_v1_customer_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -706,7 +1027,7 @@ func (x *GetCustomerByBookIdRequest) String() string {
 func (*GetCustomerByBookIdRequest) ProtoMessage() {}
 
 func (x *GetCustomerByBookIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_customer_v1_customer_proto_msgTypes[7]
+	mi := &file_customer_v1_customer_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -719,7 +1040,7 @@ func (x *GetCustomerByBookIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCustomerByBookIdRequest.ProtoReflect.Descriptor instead.
 func (*GetCustomerByBookIdRequest) Descriptor() ([]byte, []int) {
-	return file_customer_v1_customer_proto_rawDescGZIP(), []int{7}
+	return file_customer_v1_customer_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetCustomerByBookIdRequest) GetBookId() string {
@@ -743,6 +1064,13 @@ func (x *GetCustomerByBookIdRequest) GetLimit() int32 {
 	return 0
 }
 
+func (x *GetCustomerByBookIdRequest) GetTagFilter() *TagFilter {
+	if x != nil {
+		return x.TagFilter
+	}
+	return nil
+}
+
 type GetCustomerByBookIdResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Customers     []*Customer            `protobuf:"bytes,1,rep,name=customers,proto3" json:"customers,omitempty"`
@@ -755,7 +1083,7 @@ type GetCustomerByBookIdResponse struct {
 
 func (x *GetCustomerByBookIdResponse) Reset() {
 	*x = GetCustomerByBookIdResponse{}
-	mi := &file_customer_v1_customer_proto_msgTypes[8]
+	mi := &file_customer_v1_customer_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -767,7 +1095,7 @@ func (x *GetCustomerByBookIdResponse) String() string {
 func (*GetCustomerByBookIdResponse) ProtoMessage() {}
 
 func (x *GetCustomerByBookIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_customer_v1_customer_proto_msgTypes[8]
+	mi := &file_customer_v1_customer_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -780,7 +1108,7 @@ func (x *GetCustomerByBookIdResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCustomerByBookIdResponse.ProtoReflect.Descriptor instead.
 func (*GetCustomerByBookIdResponse) Descriptor() ([]byte, []int) {
-	return file_customer_v1_customer_proto_rawDescGZIP(), []int{8}
+	return file_customer_v1_customer_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetCustomerByBookIdResponse) GetCustomers() []*Customer {
@@ -811,43 +1139,915 @@ func (x *GetCustomerByBookIdResponse) GetLimit() int32 {
 	return 0
 }
 
-var File_customer_v1_customer_proto protoreflect.FileDescriptor
+type DeleteCustomerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_customer_v1_customer_proto_rawDesc = "" +
-	"\n" +
-	"\x1acustomer/v1/customer.proto\x12\vcustomer.v1\x1a\x18contact/v1/contact.proto\x1a\x1cgoogle/api/annotations.proto\"\xc2\x03\n" +
-	"\x15CreateCustomerRequest\x12\x17\n" +
-	"\abook_id\x18\x01 \x01(\tR\x06bookId\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05phone\x18\x03 \x01(\tR\x05phone\x12%\n" +
-	"\vcorporation\x18\x04 \x01(\tH\x00R\vcorporation\x88\x01\x01\x12\x1d\n" +
-	"\aaddress\x18\x05 \x01(\tH\x01R\aaddress\x88\x01\x01\x12\x17\n" +
-	"\x04memo\x18\x06 \x01(\tH\x02R\x04memo\x88\x01\x01\x12\x1b\n" +
-	"\x06leader\x18\a \x01(\tH\x03R\x06leader\x88\x01\x01\x12\"\n" +
-	"\n" +
-	"leader_sex\x18\b \x01(\tH\x04R\tleaderSex\x88\x01\x01\x12\x15\n" +
-	"\x03pic\x18\t \x01(\tH\x05R\x03pic\x88\x01\x01\x12\x1c\n" +
-	"\apic_sex\x18\n" +
-	" \x01(\tH\x06R\x06picSex\x88\x01\x01\x122\n" +
-	"\acontact\x18\v \x01(\v2\x13.contact.v1.ContactH\aR\acontact\x88\x01\x01B\x0e\n" +
-	"\f_corporationB\n" +
-	"\n" +
-	"\b_addressB\a\n" +
-	"\x05_memoB\t\n" +
-	"\a_leaderB\r\n" +
-	"\v_leader_sexB\x06\n" +
+func (x *DeleteCustomerRequest) Reset() {
+	*x = DeleteCustomerRequest{}
+	mi := &file_customer_v1_customer_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCustomerRequest) ProtoMessage() {}
+
+func (x *DeleteCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_v1_customer_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCustomerRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_customer_v1_customer_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteCustomerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteCustomerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCustomerResponse) Reset() {
+	*x = DeleteCustomerResponse{}
+	mi := &file_customer_v1_customer_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCustomerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCustomerResponse) ProtoMessage() {}
+
+func (x *DeleteCustomerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_v1_customer_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCustomerResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCustomerResponse) Descriptor() ([]byte, []int) {
+	return file_customer_v1_customer_proto_rawDescGZIP(), []int{12}
+}
+
+type RestoreCustomerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCustomerRequest) Reset() {
+	*x = RestoreCustomerRequest{}
+	mi := &file_customer_v1_customer_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCustomerRequest) ProtoMessage() {}
+
+func (x *RestoreCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_v1_customer_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCustomerRequest.ProtoReflect.Descriptor instead.
+func (*RestoreCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_customer_v1_customer_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RestoreCustomerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RestoreCustomerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Customer      *Customer              `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCustomerResponse) Reset() {
+	*x = RestoreCustomerResponse{}
+	mi := &file_customer_v1_customer_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCustomerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCustomerResponse) ProtoMessage() {}
+
+func (x *RestoreCustomerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_v1_customer_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCustomerResponse.ProtoReflect.Descriptor instead.
+func (*RestoreCustomerResponse) Descriptor() ([]byte, []int) {
+	return file_customer_v1_customer_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RestoreCustomerResponse) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+type UpdateCustomerRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Corporation *string                `protobuf:"bytes,3,opt,name=corporation,proto3,oneof" json:"corporation,omitempty"`
+	Address     *string                `protobuf:"bytes,4,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	Memo        *string                `protobuf:"bytes,5,opt,name=memo,proto3,oneof" json:"memo,omitempty"`
+	// 指定したキーのみ更新する。null を指定したキーは削除する
+	CustomFields *structpb.Struct `protobuf:"bytes,6,opt,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty"`
+	// 取得時の etag。If-Match ヘッダーでも指定できる。古い場合は Aborted (HTTP 412) になる
+	Etag          *string `protobuf:"bytes,7,opt,name=etag,proto3,oneof" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCustomerRequest) Reset() {
+	*x = UpdateCustomerRequest{}
+	mi := &file_customer_v1_customer_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCustomerRequest) ProtoMessage() {}
+
+func (x *UpdateCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_v1_customer_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCustomerRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_customer_v1_customer_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateCustomerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateCustomerRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCustomerRequest) GetCorporation() string {
+	if x != nil && x.Corporation != nil {
+		return *x.Corporation
+	}
+	return ""
+}
+
+func (x *UpdateCustomerRequest) GetAddress() string {
+	if x != nil && x.Address != nil {
+		return *x.Address
+	}
+	return ""
+}
+
+func (x *UpdateCustomerRequest) GetMemo() string {
+	if x != nil && x.Memo != nil {
+		return *x.Memo
+	}
+	return ""
+}
+
+func (x *UpdateCustomerRequest) GetCustomFields() *structpb.Struct {
+	if x != nil {
+		return x.CustomFields
+	}
+	return nil
+}
+
+func (x *UpdateCustomerRequest) GetEtag() string {
+	if x != nil && x.Etag != nil {
+		return *x.Etag
+	}
+	return ""
+}
+
+type UpdateCustomerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Customer      *Customer              `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCustomerResponse) Reset() {
+	*x = UpdateCustomerResponse{}
+	mi := &file_customer_v1_customer_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCustomerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCustomerResponse) ProtoMessage() {}
+
+func (x *UpdateCustomerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_v1_customer_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCustomerResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCustomerResponse) Descriptor() ([]byte, []int) {
+	return file_customer_v1_customer_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateCustomerResponse) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+// 一括操作の対象。ID の指定か SearchCustomer の条件のどちらか
+type CustomerSelection struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Selection:
+	//
+	//	*CustomerSelection_Ids_
+	//	*CustomerSelection_Filter
+	Selection     isCustomerSelection_Selection `protobuf_oneof:"selection"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomerSelection) Reset() {
+	*x = CustomerSelection{}
+	mi := &file_customer_v1_customer_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomerSelection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerSelection) ProtoMessage() {}
+
+func (x *CustomerSelection) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_v1_customer_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerSelection.ProtoReflect.Descriptor instead.
+func (*CustomerSelection) Descriptor() ([]byte, []int) {
+	return file_customer_v1_customer_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CustomerSelection) GetSelection() isCustomerSelection_Selection {
+	if x != nil {
+		return x.Selection
+	}
+	return nil
+}
+
+func (x *CustomerSelection) GetIds() *CustomerSelection_Ids {
+	if x != nil {
+		if x, ok := x.Selection.(*CustomerSelection_Ids_); ok {
+			return x.Ids
+		}
+	}
+	return nil
+}
+
+func (x *CustomerSelection) GetFilter() *SearchCustomerRequest {
+	if x != nil {
+		if x, ok := x.Selection.(*CustomerSelection_Filter); ok {
+			return x.Filter
+		}
+	}
+	return nil
+}
+
+type isCustomerSelection_Selection interface {
+	isCustomerSelection_Selection()
+}
+
+type CustomerSelection_Ids_ struct {
+	Ids *CustomerSelection_Ids `protobuf:"bytes,1,opt,name=ids,proto3,oneof"`
+}
+
+type CustomerSelection_Filter struct {
+	Filter *SearchCustomerRequest `protobuf:"bytes,2,opt,name=filter,proto3,oneof"`
+}
+
+func (*CustomerSelection_Ids_) isCustomerSelection_Selection() {}
+
+func (*CustomerSelection_Filter) isCustomerSelection_Selection() {}
+
+type BatchItemResult struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Success bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	// 失敗した場合の gRPC ステータスコード名 (NotFound など)
+	ErrorCode     string `protobuf:"bytes,3,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	ErrorMessage  string `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchItemResult) Reset() {
+	*x = BatchItemResult{}
+	mi := &file_customer_v1_customer_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchItemResult) ProtoMessage() {}
+
+func (x *BatchItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_v1_customer_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchItemResult.ProtoReflect.Descriptor instead.
+func (*BatchItemResult) Descriptor() ([]byte, []int) {
+	return file_customer_v1_customer_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BatchItemResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BatchItemResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BatchItemResult) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *BatchItemResult) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type BatchUpdateCustomersRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Selection  *CustomerSelection     `protobuf:"bytes,1,opt,name=selection,proto3" json:"selection,omitempty"`
+	CategoryId *string                `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	Memo       *string                `protobuf:"bytes,3,opt,name=memo,proto3,oneof" json:"memo,omitempty"`
+	// 指定したキーのみ更新する。null を指定したキーは削除する
+	CustomFields  *structpb.Struct `protobuf:"bytes,4,opt,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateCustomersRequest) Reset() {
+	*x = BatchUpdateCustomersRequest{}
+	mi := &file_customer_v1_customer_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateCustomersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateCustomersRequest) ProtoMessage() {}
+
+func (x *BatchUpdateCustomersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_v1_customer_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateCustomersRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateCustomersRequest) Descriptor() ([]byte, []int) {
+	return file_customer_v1_customer_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BatchUpdateCustomersRequest) GetSelection() *CustomerSelection {
+	if x != nil {
+		return x.Selection
+	}
+	return nil
+}
+
+func (x *BatchUpdateCustomersRequest) GetCategoryId() string {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return ""
+}
+
+func (x *BatchUpdateCustomersRequest) GetMemo() string {
+	if x != nil && x.Memo != nil {
+		return *x.Memo
+	}
+	return ""
+}
+
+func (x *BatchUpdateCustomersRequest) GetCustomFields() *structpb.Struct {
+	if x != nil {
+		return x.CustomFields
+	}
+	return nil
+}
+
+type BatchUpdateCustomersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BatchItemResult     `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Succeeded     int32                  `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int32                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateCustomersResponse) Reset() {
+	*x = BatchUpdateCustomersResponse{}
+	mi := &file_customer_v1_customer_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateCustomersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateCustomersResponse) ProtoMessage() {}
+
+func (x *BatchUpdateCustomersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_v1_customer_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateCustomersResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdateCustomersResponse) Descriptor() ([]byte, []int) {
+	return file_customer_v1_customer_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BatchUpdateCustomersResponse) GetResults() []*BatchItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchUpdateCustomersResponse) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *BatchUpdateCustomersResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type BatchDeleteCustomersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Selection     *CustomerSelection     `protobuf:"bytes,1,opt,name=selection,proto3" json:"selection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteCustomersRequest) Reset() {
+	*x = BatchDeleteCustomersRequest{}
+	mi := &file_customer_v1_customer_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteCustomersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteCustomersRequest) ProtoMessage() {}
+
+func (x *BatchDeleteCustomersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_v1_customer_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteCustomersRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteCustomersRequest) Descriptor() ([]byte, []int) {
+	return file_customer_v1_customer_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BatchDeleteCustomersRequest) GetSelection() *CustomerSelection {
+	if x != nil {
+		return x.Selection
+	}
+	return nil
+}
+
+type BatchDeleteCustomersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BatchItemResult     `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Succeeded     int32                  `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int32                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteCustomersResponse) Reset() {
+	*x = BatchDeleteCustomersResponse{}
+	mi := &file_customer_v1_customer_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteCustomersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteCustomersResponse) ProtoMessage() {}
+
+func (x *BatchDeleteCustomersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_v1_customer_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteCustomersResponse.ProtoReflect.Descriptor instead.
+func (*BatchDeleteCustomersResponse) Descriptor() ([]byte, []int) {
+	return file_customer_v1_customer_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BatchDeleteCustomersResponse) GetResults() []*BatchItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchDeleteCustomersResponse) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *BatchDeleteCustomersResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type MoveCustomersRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Selection *CustomerSelection     `protobuf:"bytes,1,opt,name=selection,proto3" json:"selection,omitempty"`
+	// 移動先のリスト
+	BookId        string `protobuf:"bytes,2,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveCustomersRequest) Reset() {
+	*x = MoveCustomersRequest{}
+	mi := &file_customer_v1_customer_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveCustomersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCustomersRequest) ProtoMessage() {}
+
+func (x *MoveCustomersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_v1_customer_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveCustomersRequest.ProtoReflect.Descriptor instead.
+func (*MoveCustomersRequest) Descriptor() ([]byte, []int) {
+	return file_customer_v1_customer_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *MoveCustomersRequest) GetSelection() *CustomerSelection {
+	if x != nil {
+		return x.Selection
+	}
+	return nil
+}
+
+func (x *MoveCustomersRequest) GetBookId() string {
+	if x != nil {
+		return x.BookId
+	}
+	return ""
+}
+
+type MoveCustomersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BatchItemResult     `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Succeeded     int32                  `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int32                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveCustomersResponse) Reset() {
+	*x = MoveCustomersResponse{}
+	mi := &file_customer_v1_customer_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveCustomersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCustomersResponse) ProtoMessage() {}
+
+func (x *MoveCustomersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_v1_customer_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveCustomersResponse.ProtoReflect.Descriptor instead.
+func (*MoveCustomersResponse) Descriptor() ([]byte, []int) {
+	return file_customer_v1_customer_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MoveCustomersResponse) GetResults() []*BatchItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *MoveCustomersResponse) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *MoveCustomersResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type CustomerSelection_Ids struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomerSelection_Ids) Reset() {
+	*x = CustomerSelection_Ids{}
+	mi := &file_customer_v1_customer_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomerSelection_Ids) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerSelection_Ids) ProtoMessage() {}
+
+func (x *CustomerSelection_Ids) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_v1_customer_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerSelection_Ids.ProtoReflect.Descriptor instead.
+func (*CustomerSelection_Ids) Descriptor() ([]byte, []int) {
+	return file_customer_v1_customer_proto_rawDescGZIP(), []int{17, 0}
+}
+
+func (x *CustomerSelection_Ids) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+var File_customer_v1_customer_proto protoreflect.FileDescriptor
+
+const file_customer_v1_customer_proto_rawDesc = "" +
+	"\n" +
+	"\x1acustomer/v1/customer.proto\x12\vcustomer.v1\x1a\x18contact/v1/contact.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1cgoogle/protobuf/struct.proto\"\x80\x04\n" +
+	"\x15CreateCustomerRequest\x12\x17\n" +
+	"\abook_id\x18\x01 \x01(\tR\x06bookId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05phone\x18\x03 \x01(\tR\x05phone\x12%\n" +
+	"\vcorporation\x18\x04 \x01(\tH\x00R\vcorporation\x88\x01\x01\x12\x1d\n" +
+	"\aaddress\x18\x05 \x01(\tH\x01R\aaddress\x88\x01\x01\x12\x17\n" +
+	"\x04memo\x18\x06 \x01(\tH\x02R\x04memo\x88\x01\x01\x12\x1b\n" +
+	"\x06leader\x18\a \x01(\tH\x03R\x06leader\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"leader_sex\x18\b \x01(\tH\x04R\tleaderSex\x88\x01\x01\x12\x15\n" +
+	"\x03pic\x18\t \x01(\tH\x05R\x03pic\x88\x01\x01\x12\x1c\n" +
+	"\apic_sex\x18\n" +
+	" \x01(\tH\x06R\x06picSex\x88\x01\x01\x122\n" +
+	"\acontact\x18\v \x01(\v2\x13.contact.v1.ContactH\aR\acontact\x88\x01\x01\x12<\n" +
+	"\rcustom_fields\x18\f \x01(\v2\x17.google.protobuf.StructR\fcustomFieldsB\x0e\n" +
+	"\f_corporationB\n" +
+	"\n" +
+	"\b_addressB\a\n" +
+	"\x05_memoB\t\n" +
+	"\a_leaderB\r\n" +
+	"\v_leader_sexB\x06\n" +
 	"\x04_picB\n" +
 	"\n" +
 	"\b_pic_sexB\n" +
 	"\n" +
-	"\b_contact\"\xa5\x01\n" +
+	"\b_contact\"\xf7\x01\n" +
 	"\x16CreateCustomerResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\abook_id\x18\x02 \x01(\tR\x06bookId\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
 	"\vcorporation\x18\x04 \x01(\tR\vcorporation\x12\x18\n" +
 	"\aaddress\x18\x05 \x01(\tR\aaddress\x12\x12\n" +
-	"\x04memo\x18\x06 \x01(\tR\x04memo\"\xbb\x02\n" +
+	"\x04memo\x18\x06 \x01(\tR\x04memo\x12<\n" +
+	"\rcustom_fields\x18\a \x01(\v2\x17.google.protobuf.StructR\fcustomFields\x12\x12\n" +
+	"\x04etag\x18\b \x01(\tR\x04etag\"\xe9\x01\n" +
+	"\x11CustomFieldFilter\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x121\n" +
+	"\x02op\x18\x02 \x01(\x0e2!.customer.v1.CustomFieldFilter.OpR\x02op\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\"y\n" +
+	"\x02Op\x12\x12\n" +
+	"\x0eOP_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06OP_SET\x10\x01\x12\t\n" +
+	"\x05OP_EQ\x10\x02\x12\t\n" +
+	"\x05OP_NE\x10\x03\x12\t\n" +
+	"\x05OP_LT\x10\x04\x12\n" +
+	"\n" +
+	"\x06OP_LTE\x10\x05\x12\t\n" +
+	"\x05OP_GT\x10\x06\x12\n" +
+	"\n" +
+	"\x06OP_GTE\x10\a\x12\x0f\n" +
+	"\vOP_CONTAINS\x10\b\"C\n" +
+	"\tTagFilter\x12\x10\n" +
+	"\x03any\x18\x01 \x03(\tR\x03any\x12\x10\n" +
+	"\x03all\x18\x02 \x03(\tR\x03all\x12\x12\n" +
+	"\x04none\x18\x03 \x03(\tR\x04none\"\xa8\x04\n" +
 	"\x15SearchCustomerRequest\x12\x17\n" +
 	"\abook_id\x18\x01 \x01(\tR\x06bookId\x12\x17\n" +
 	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
@@ -855,7 +2055,13 @@ const file_customer_v1_customer_proto_rawDesc = "" +
 	"\aaddress\x18\x04 \x01(\tH\x02R\aaddress\x88\x01\x01\x12\x19\n" +
 	"\x05phone\x18\x05 \x01(\tH\x03R\x05phone\x88\x01\x01\x12\x17\n" +
 	"\x04memo\x18\a \x01(\tH\x04R\x04memo\x88\x01\x01\x122\n" +
-	"\acontact\x18\b \x01(\v2\x13.contact.v1.ContactH\x05R\acontact\x88\x01\x01B\a\n" +
+	"\acontact\x18\b \x01(\v2\x13.contact.v1.ContactH\x05R\acontact\x88\x01\x01\x12P\n" +
+	"\x14custom_field_filters\x18\t \x03(\v2\x1e.customer.v1.CustomFieldFilterR\x12customFieldFilters\x12/\n" +
+	"\x11sort_custom_field\x18\n" +
+	" \x01(\tH\x06R\x0fsortCustomField\x88\x01\x01\x12\x1b\n" +
+	"\tsort_desc\x18\v \x01(\bR\bsortDesc\x125\n" +
+	"\n" +
+	"tag_filter\x18\f \x01(\v2\x16.customer.v1.TagFilterR\ttagFilterB\a\n" +
 	"\x05_nameB\x0e\n" +
 	"\f_corporationB\n" +
 	"\n" +
@@ -863,11 +2069,12 @@ const file_customer_v1_customer_proto_rawDesc = "" +
 	"\x06_phoneB\a\n" +
 	"\x05_memoB\n" +
 	"\n" +
-	"\b_contact\"M\n" +
+	"\b_contactB\x14\n" +
+	"\x12_sort_custom_field\"M\n" +
 	"\x16SearchCustomerResponse\x123\n" +
 	"\tcustomers\x18\x01 \x03(\v2\x15.customer.v1.CustomerR\tcustomers\"$\n" +
 	"\x12GetCustomerRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\xe8\x02\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x82\x04\n" +
 	"\x13GetCustomerResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
@@ -884,7 +2091,12 @@ const file_customer_v1_customer_proto_rawDesc = "" +
 	"\x04memo\x18\v \x01(\tR\x04memo\x12\x12\n" +
 	"\x04mail\x18\f \x01(\tR\x04mail\x12\x10\n" +
 	"\x03fax\x18\r \x01(\tR\x03fax\x12-\n" +
-	"\acontact\x18\x0e \x01(\v2\x13.contact.v1.ContactR\acontact\"\x88\x02\n" +
+	"\acontact\x18\x0e \x01(\v2\x13.contact.v1.ContactR\acontact\x12<\n" +
+	"\rcustom_fields\x18\x0f \x01(\v2\x17.google.protobuf.StructR\fcustomFields\x12\x12\n" +
+	"\x04tags\x18\x10 \x03(\tR\x04tags\x12\x12\n" +
+	"\x04etag\x18\x11 \x01(\tR\x04etag\x12\x1b\n" +
+	"\tleader_id\x18\x12 \x01(\tR\bleaderId\x12\x15\n" +
+	"\x06pic_id\x18\x13 \x01(\tR\x05picId\"\xee\x02\n" +
 	"\bCustomer\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
@@ -898,21 +2110,93 @@ const file_customer_v1_customer_proto_rawDesc = "" +
 	"\x03pic\x18\t \x01(\tR\x03pic\x12\x17\n" +
 	"\apic_sex\x18\n" +
 	" \x01(\tR\x06picSex\x12\x12\n" +
-	"\x04memo\x18\v \x01(\tR\x04memo\"_\n" +
+	"\x04memo\x18\v \x01(\tR\x04memo\x12<\n" +
+	"\rcustom_fields\x18\f \x01(\v2\x17.google.protobuf.StructR\fcustomFields\x12\x12\n" +
+	"\x04tags\x18\r \x03(\tR\x04tags\x12\x12\n" +
+	"\x04etag\x18\x0e \x01(\tR\x04etag\"\x96\x01\n" +
 	"\x1aGetCustomerByBookIdRequest\x12\x17\n" +
 	"\abook_id\x18\x01 \x01(\tR\x06bookId\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\x92\x01\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x125\n" +
+	"\n" +
+	"tag_filter\x18\x04 \x01(\v2\x16.customer.v1.TagFilterR\ttagFilter\"\x92\x01\n" +
 	"\x1bGetCustomerByBookIdResponse\x123\n" +
 	"\tcustomers\x18\x01 \x03(\v2\x15.customer.v1.CustomerR\tcustomers\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x04 \x01(\x05R\x05limit2\xfa\x03\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"'\n" +
+	"\x15DeleteCustomerRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x18\n" +
+	"\x16DeleteCustomerResponse\"(\n" +
+	"\x16RestoreCustomerRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"L\n" +
+	"\x17RestoreCustomerResponse\x121\n" +
+	"\bcustomer\x18\x01 \x01(\v2\x15.customer.v1.CustomerR\bcustomer\"\xad\x02\n" +
+	"\x15UpdateCustomerRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vcorporation\x18\x03 \x01(\tH\x01R\vcorporation\x88\x01\x01\x12\x1d\n" +
+	"\aaddress\x18\x04 \x01(\tH\x02R\aaddress\x88\x01\x01\x12\x17\n" +
+	"\x04memo\x18\x05 \x01(\tH\x03R\x04memo\x88\x01\x01\x12<\n" +
+	"\rcustom_fields\x18\x06 \x01(\v2\x17.google.protobuf.StructR\fcustomFields\x12\x17\n" +
+	"\x04etag\x18\a \x01(\tH\x04R\x04etag\x88\x01\x01B\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_corporationB\n" +
+	"\n" +
+	"\b_addressB\a\n" +
+	"\x05_memoB\a\n" +
+	"\x05_etag\"K\n" +
+	"\x16UpdateCustomerResponse\x121\n" +
+	"\bcustomer\x18\x01 \x01(\v2\x15.customer.v1.CustomerR\bcustomer\"\xaf\x01\n" +
+	"\x11CustomerSelection\x126\n" +
+	"\x03ids\x18\x01 \x01(\v2\".customer.v1.CustomerSelection.IdsH\x00R\x03ids\x12<\n" +
+	"\x06filter\x18\x02 \x01(\v2\".customer.v1.SearchCustomerRequestH\x00R\x06filter\x1a\x17\n" +
+	"\x03Ids\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03idsB\v\n" +
+	"\tselection\"\x7f\n" +
+	"\x0fBatchItemResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x03 \x01(\tR\terrorCode\x12#\n" +
+	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\"\xf1\x01\n" +
+	"\x1bBatchUpdateCustomersRequest\x12<\n" +
+	"\tselection\x18\x01 \x01(\v2\x1e.customer.v1.CustomerSelectionR\tselection\x12$\n" +
+	"\vcategory_id\x18\x02 \x01(\tH\x00R\n" +
+	"categoryId\x88\x01\x01\x12\x17\n" +
+	"\x04memo\x18\x03 \x01(\tH\x01R\x04memo\x88\x01\x01\x12<\n" +
+	"\rcustom_fields\x18\x04 \x01(\v2\x17.google.protobuf.StructR\fcustomFieldsB\x0e\n" +
+	"\f_category_idB\a\n" +
+	"\x05_memo\"\x8c\x01\n" +
+	"\x1cBatchUpdateCustomersResponse\x126\n" +
+	"\aresults\x18\x01 \x03(\v2\x1c.customer.v1.BatchItemResultR\aresults\x12\x1c\n" +
+	"\tsucceeded\x18\x02 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\"[\n" +
+	"\x1bBatchDeleteCustomersRequest\x12<\n" +
+	"\tselection\x18\x01 \x01(\v2\x1e.customer.v1.CustomerSelectionR\tselection\"\x8c\x01\n" +
+	"\x1cBatchDeleteCustomersResponse\x126\n" +
+	"\aresults\x18\x01 \x03(\v2\x1c.customer.v1.BatchItemResultR\aresults\x12\x1c\n" +
+	"\tsucceeded\x18\x02 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\"m\n" +
+	"\x14MoveCustomersRequest\x12<\n" +
+	"\tselection\x18\x01 \x01(\v2\x1e.customer.v1.CustomerSelectionR\tselection\x12\x17\n" +
+	"\abook_id\x18\x02 \x01(\tR\x06bookId\"\x85\x01\n" +
+	"\x15MoveCustomersResponse\x126\n" +
+	"\aresults\x18\x01 \x03(\v2\x1c.customer.v1.BatchItemResultR\aresults\x12\x1c\n" +
+	"\tsucceeded\x18\x02 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed2\x90\n" +
+	"\n" +
 	"\x0fCustomerService\x12s\n" +
 	"\x0eCreateCustomer\x12\".customer.v1.CreateCustomerRequest\x1a#.customer.v1.CreateCustomerResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/customers\x12l\n" +
 	"\vGetCustomer\x12\x1f.customer.v1.GetCustomerRequest\x1a .customer.v1.GetCustomerResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/customers/{id}\x12\x87\x01\n" +
 	"\x13GetCustomerByBookId\x12'.customer.v1.GetCustomerByBookIdRequest\x1a(.customer.v1.GetCustomerByBookIdResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/customers/book\x12z\n" +
-	"\x0eSearchCustomer\x12\".customer.v1.SearchCustomerRequest\x1a#.customer.v1.SearchCustomerResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/customers/searchB\xb2\x01\n" +
+	"\x0eSearchCustomer\x12\".customer.v1.SearchCustomerRequest\x1a#.customer.v1.SearchCustomerResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/customers/search\x12x\n" +
+	"\x0eUpdateCustomer\x12\".customer.v1.UpdateCustomerRequest\x1a#.customer.v1.UpdateCustomerResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\x1a\x12/v1/customers/{id}\x12\x91\x01\n" +
+	"\x14BatchUpdateCustomers\x12(.customer.v1.BatchUpdateCustomersRequest\x1a).customer.v1.BatchUpdateCustomersResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/customers:batchUpdate\x12\x91\x01\n" +
+	"\x14BatchDeleteCustomers\x12(.customer.v1.BatchDeleteCustomersRequest\x1a).customer.v1.BatchDeleteCustomersResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/customers:batchDelete\x12u\n" +
+	"\rMoveCustomers\x12!.customer.v1.MoveCustomersRequest\x1a\".customer.v1.MoveCustomersResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/customers:move\x12u\n" +
+	"\x0eDeleteCustomer\x12\".customer.v1.DeleteCustomerRequest\x1a#.customer.v1.DeleteCustomerResponse\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/v1/customers/{id}\x12\x83\x01\n" +
+	"\x0fRestoreCustomer\x12#.customer.v1.RestoreCustomerRequest\x1a$.customer.v1.RestoreCustomerResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/customers/{id}/restoreB\xb2\x01\n" +
 	"\x0fcom.customer.v1B\rCustomerProtoP\x01ZCgithub.com/0utl1er-tech/prism-backend/gen/pb/customer/v1;customerv1\xa2\x02\x03CXX\xaa\x02\vCustomer.V1\xca\x02\vCustomer\\V1\xe2\x02\x17Customer\\V1\\GPBMetadata\xea\x02\fCustomer::V1b\x06proto3"
 
 var (
@@ -927,38 +2211,90 @@ func file_customer_v1_customer_proto_rawDescGZIP() []byte {
 	return file_customer_v1_customer_proto_rawDescData
 }
 
-var file_customer_v1_customer_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_customer_v1_customer_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_customer_v1_customer_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_customer_v1_customer_proto_goTypes = []any{
-	(*CreateCustomerRequest)(nil),       // 0: customer.v1.CreateCustomerRequest
-	(*CreateCustomerResponse)(nil),      // 1: customer.v1.CreateCustomerResponse
-	(*SearchCustomerRequest)(nil),       // 2: customer.v1.SearchCustomerRequest
-	(*SearchCustomerResponse)(nil),      // 3: customer.v1.SearchCustomerResponse
-	(*GetCustomerRequest)(nil),          // 4: customer.v1.GetCustomerRequest
-	(*GetCustomerResponse)(nil),         // 5: customer.v1.GetCustomerResponse
-	(*Customer)(nil),                    // 6: customer.v1.Customer
-	(*GetCustomerByBookIdRequest)(nil),  // 7: customer.v1.GetCustomerByBookIdRequest
-	(*GetCustomerByBookIdResponse)(nil), // 8: customer.v1.GetCustomerByBookIdResponse
-	(*v1.Contact)(nil),                  // 9: contact.v1.Contact
+	(CustomFieldFilter_Op)(0),            // 0: customer.v1.CustomFieldFilter.Op
+	(*CreateCustomerRequest)(nil),        // 1: customer.v1.CreateCustomerRequest
+	(*CreateCustomerResponse)(nil),       // 2: customer.v1.CreateCustomerResponse
+	(*CustomFieldFilter)(nil),            // 3: customer.v1.CustomFieldFilter
+	(*TagFilter)(nil),                    // 4: customer.v1.TagFilter
+	(*SearchCustomerRequest)(nil),        // 5: customer.v1.SearchCustomerRequest
+	(*SearchCustomerResponse)(nil),       // 6: customer.v1.SearchCustomerResponse
+	(*GetCustomerRequest)(nil),           // 7: customer.v1.GetCustomerRequest
+	(*GetCustomerResponse)(nil),          // 8: customer.v1.GetCustomerResponse
+	(*Customer)(nil),                     // 9: customer.v1.Customer
+	(*GetCustomerByBookIdRequest)(nil),   // 10: customer.v1.GetCustomerByBookIdRequest
+	(*GetCustomerByBookIdResponse)(nil),  // 11: customer.v1.GetCustomerByBookIdResponse
+	(*DeleteCustomerRequest)(nil),        // 12: customer.v1.DeleteCustomerRequest
+	(*DeleteCustomerResponse)(nil),       // 13: customer.v1.DeleteCustomerResponse
+	(*RestoreCustomerRequest)(nil),       // 14: customer.v1.RestoreCustomerRequest
+	(*RestoreCustomerResponse)(nil),      // 15: customer.v1.RestoreCustomerResponse
+	(*UpdateCustomerRequest)(nil),        // 16: customer.v1.UpdateCustomerRequest
+	(*UpdateCustomerResponse)(nil),       // 17: customer.v1.UpdateCustomerResponse
+	(*CustomerSelection)(nil),            // 18: customer.v1.CustomerSelection
+	(*BatchItemResult)(nil),              // 19: customer.v1.BatchItemResult
+	(*BatchUpdateCustomersRequest)(nil),  // 20: customer.v1.BatchUpdateCustomersRequest
+	(*BatchUpdateCustomersResponse)(nil), // 21: customer.v1.BatchUpdateCustomersResponse
+	(*BatchDeleteCustomersRequest)(nil),  // 22: customer.v1.BatchDeleteCustomersRequest
+	(*BatchDeleteCustomersResponse)(nil), // 23: customer.v1.BatchDeleteCustomersResponse
+	(*MoveCustomersRequest)(nil),         // 24: customer.v1.MoveCustomersRequest
+	(*MoveCustomersResponse)(nil),        // 25: customer.v1.MoveCustomersResponse
+	(*CustomerSelection_Ids)(nil),        // 26: customer.v1.CustomerSelection.Ids
+	(*v1.Contact)(nil),                   // 27: contact.v1.Contact
+	(*structpb.Struct)(nil),              // 28: google.protobuf.Struct
 }
 var file_customer_v1_customer_proto_depIdxs = []int32{
-	9, // 0: customer.v1.CreateCustomerRequest.contact:type_name -> contact.v1.Contact
-	9, // 1: customer.v1.SearchCustomerRequest.contact:type_name -> contact.v1.Contact
-	6, // 2: customer.v1.SearchCustomerResponse.customers:type_name -> customer.v1.Customer
-	9, // 3: customer.v1.GetCustomerResponse.contact:type_name -> contact.v1.Contact
-	6, // 4: customer.v1.GetCustomerByBookIdResponse.customers:type_name -> customer.v1.Customer
-	0, // 5: customer.v1.CustomerService.CreateCustomer:input_type -> customer.v1.CreateCustomerRequest
-	4, // 6: customer.v1.CustomerService.GetCustomer:input_type -> customer.v1.GetCustomerRequest
-	7, // 7: customer.v1.CustomerService.GetCustomerByBookId:input_type -> customer.v1.GetCustomerByBookIdRequest
-	2, // 8: customer.v1.CustomerService.SearchCustomer:input_type -> customer.v1.SearchCustomerRequest
-	1, // 9: customer.v1.CustomerService.CreateCustomer:output_type -> customer.v1.CreateCustomerResponse
-	5, // 10: customer.v1.CustomerService.GetCustomer:output_type -> customer.v1.GetCustomerResponse
-	8, // 11: customer.v1.CustomerService.GetCustomerByBookId:output_type -> customer.v1.GetCustomerByBookIdResponse
-	3, // 12: customer.v1.CustomerService.SearchCustomer:output_type -> customer.v1.SearchCustomerResponse
-	9, // [9:13] is the sub-list for method output_type
-	5, // [5:9] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	27, // 0: customer.v1.CreateCustomerRequest.contact:type_name -> contact.v1.Contact
+	28, // 1: customer.v1.CreateCustomerRequest.custom_fields:type_name -> google.protobuf.Struct
+	28, // 2: customer.v1.CreateCustomerResponse.custom_fields:type_name -> google.protobuf.Struct
+	0,  // 3: customer.v1.CustomFieldFilter.op:type_name -> customer.v1.CustomFieldFilter.Op
+	27, // 4: customer.v1.SearchCustomerRequest.contact:type_name -> contact.v1.Contact
+	3,  // 5: customer.v1.SearchCustomerRequest.custom_field_filters:type_name -> customer.v1.CustomFieldFilter
+	4,  // 6: customer.v1.SearchCustomerRequest.tag_filter:type_name -> customer.v1.TagFilter
+	9,  // 7: customer.v1.SearchCustomerResponse.customers:type_name -> customer.v1.Customer
+	27, // 8: customer.v1.GetCustomerResponse.contact:type_name -> contact.v1.Contact
+	28, // 9: customer.v1.GetCustomerResponse.custom_fields:type_name -> google.protobuf.Struct
+	28, // 10: customer.v1.Customer.custom_fields:type_name -> google.protobuf.Struct
+	4,  // 11: customer.v1.GetCustomerByBookIdRequest.tag_filter:type_name -> customer.v1.TagFilter
+	9,  // 12: customer.v1.GetCustomerByBookIdResponse.customers:type_name -> customer.v1.Customer
+	9,  // 13: customer.v1.RestoreCustomerResponse.customer:type_name -> customer.v1.Customer
+	28, // 14: customer.v1.UpdateCustomerRequest.custom_fields:type_name -> google.protobuf.Struct
+	9,  // 15: customer.v1.UpdateCustomerResponse.customer:type_name -> customer.v1.Customer
+	26, // 16: customer.v1.CustomerSelection.ids:type_name -> customer.v1.CustomerSelection.Ids
+	5,  // 17: customer.v1.CustomerSelection.filter:type_name -> customer.v1.SearchCustomerRequest
+	18, // 18: customer.v1.BatchUpdateCustomersRequest.selection:type_name -> customer.v1.CustomerSelection
+	28, // 19: customer.v1.BatchUpdateCustomersRequest.custom_fields:type_name -> google.protobuf.Struct
+	19, // 20: customer.v1.BatchUpdateCustomersResponse.results:type_name -> customer.v1.BatchItemResult
+	18, // 21: customer.v1.BatchDeleteCustomersRequest.selection:type_name -> customer.v1.CustomerSelection
+	19, // 22: customer.v1.BatchDeleteCustomersResponse.results:type_name -> customer.v1.BatchItemResult
+	18, // 23: customer.v1.MoveCustomersRequest.selection:type_name -> customer.v1.CustomerSelection
+	19, // 24: customer.v1.MoveCustomersResponse.results:type_name -> customer.v1.BatchItemResult
+	1,  // 25: customer.v1.CustomerService.CreateCustomer:input_type -> customer.v1.CreateCustomerRequest
+	7,  // 26: customer.v1.CustomerService.GetCustomer:input_type -> customer.v1.GetCustomerRequest
+	10, // 27: customer.v1.CustomerService.GetCustomerByBookId:input_type -> customer.v1.GetCustomerByBookIdRequest
+	5,  // 28: customer.v1.CustomerService.SearchCustomer:input_type -> customer.v1.SearchCustomerRequest
+	16, // 29: customer.v1.CustomerService.UpdateCustomer:input_type -> customer.v1.UpdateCustomerRequest
+	20, // 30: customer.v1.CustomerService.BatchUpdateCustomers:input_type -> customer.v1.BatchUpdateCustomersRequest
+	22, // 31: customer.v1.CustomerService.BatchDeleteCustomers:input_type -> customer.v1.BatchDeleteCustomersRequest
+	24, // 32: customer.v1.CustomerService.MoveCustomers:input_type -> customer.v1.MoveCustomersRequest
+	12, // 33: customer.v1.CustomerService.DeleteCustomer:input_type -> customer.v1.DeleteCustomerRequest
+	14, // 34: customer.v1.CustomerService.RestoreCustomer:input_type -> customer.v1.RestoreCustomerRequest
+	2,  // 35: customer.v1.CustomerService.CreateCustomer:output_type -> customer.v1.CreateCustomerResponse
+	8,  // 36: customer.v1.CustomerService.GetCustomer:output_type -> customer.v1.GetCustomerResponse
+	11, // 37: customer.v1.CustomerService.GetCustomerByBookId:output_type -> customer.v1.GetCustomerByBookIdResponse
+	6,  // 38: customer.v1.CustomerService.SearchCustomer:output_type -> customer.v1.SearchCustomerResponse
+	17, // 39: customer.v1.CustomerService.UpdateCustomer:output_type -> customer.v1.UpdateCustomerResponse
+	21, // 40: customer.v1.CustomerService.BatchUpdateCustomers:output_type -> customer.v1.BatchUpdateCustomersResponse
+	23, // 41: customer.v1.CustomerService.BatchDeleteCustomers:output_type -> customer.v1.BatchDeleteCustomersResponse
+	25, // 42: customer.v1.CustomerService.MoveCustomers:output_type -> customer.v1.MoveCustomersResponse
+	13, // 43: customer.v1.CustomerService.DeleteCustomer:output_type -> customer.v1.DeleteCustomerResponse
+	15, // 44: customer.v1.CustomerService.RestoreCustomer:output_type -> customer.v1.RestoreCustomerResponse
+	35, // [35:45] is the sub-list for method output_type
+	25, // [25:35] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_customer_v1_customer_proto_init() }
@@ -967,19 +2303,26 @@ func file_customer_v1_customer_proto_init() {
 		return
 	}
 	file_customer_v1_customer_proto_msgTypes[0].OneofWrappers = []any{}
-	file_customer_v1_customer_proto_msgTypes[2].OneofWrappers = []any{}
+	file_customer_v1_customer_proto_msgTypes[4].OneofWrappers = []any{}
+	file_customer_v1_customer_proto_msgTypes[15].OneofWrappers = []any{}
+	file_customer_v1_customer_proto_msgTypes[17].OneofWrappers = []any{
+		(*CustomerSelection_Ids_)(nil),
+		(*CustomerSelection_Filter)(nil),
+	}
+	file_customer_v1_customer_proto_msgTypes[19].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_customer_v1_customer_proto_rawDesc), len(file_customer_v1_customer_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      1,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_customer_v1_customer_proto_goTypes,
 		DependencyIndexes: file_customer_v1_customer_proto_depIdxs,
+		EnumInfos:         file_customer_v1_customer_proto_enumTypes,
 		MessageInfos:      file_customer_v1_customer_proto_msgTypes,
 	}.Build()
 	File_customer_v1_customer_proto = out.File
@@ -19,10 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CustomerService_CreateCustomer_FullMethodName      = "/customer.v1.CustomerService/CreateCustomer"
-	CustomerService_GetCustomer_FullMethodName         = "/customer.v1.CustomerService/GetCustomer"
-	CustomerService_GetCustomerByBookId_FullMethodName = "/customer.v1.CustomerService/GetCustomerByBookId"
-	CustomerService_SearchCustomer_FullMethodName      = "/customer.v1.CustomerService/SearchCustomer"
+	CustomerService_CreateCustomer_FullMethodName       = "/customer.v1.CustomerService/CreateCustomer"
+	CustomerService_GetCustomer_FullMethodName          = "/customer.v1.CustomerService/GetCustomer"
+	CustomerService_GetCustomerByBookId_FullMethodName  = "/customer.v1.CustomerService/GetCustomerByBookId"
+	CustomerService_SearchCustomer_FullMethodName       = "/customer.v1.CustomerService/SearchCustomer"
+	CustomerService_UpdateCustomer_FullMethodName       = "/customer.v1.CustomerService/UpdateCustomer"
+	CustomerService_BatchUpdateCustomers_FullMethodName = "/customer.v1.CustomerService/BatchUpdateCustomers"
+	CustomerService_BatchDeleteCustomers_FullMethodName = "/customer.v1.CustomerService/BatchDeleteCustomers"
+	CustomerService_MoveCustomers_FullMethodName        = "/customer.v1.CustomerService/MoveCustomers"
+	CustomerService_DeleteCustomer_FullMethodName       = "/customer.v1.CustomerService/DeleteCustomer"
+	CustomerService_RestoreCustomer_FullMethodName      = "/customer.v1.CustomerService/RestoreCustomer"
 )
 
 // CustomerServiceClient is the client API for CustomerService service.
@@ -33,6 +39,19 @@ type CustomerServiceClient interface {
 	GetCustomer(ctx context.Context, in *GetCustomerRequest, opts ...grpc.CallOption) (*GetCustomerResponse, error)
 	GetCustomerByBookId(ctx context.Context, in *GetCustomerByBookIdRequest, opts ...grpc.CallOption) (*GetCustomerByBookIdResponse, error)
 	SearchCustomer(ctx context.Context, in *SearchCustomerRequest, opts ...grpc.CallOption) (*SearchCustomerResponse, error)
+	// 指定した項目のみ更新する
+	UpdateCustomer(ctx context.Context, in *UpdateCustomerRequest, opts ...grpc.CallOption) (*UpdateCustomerResponse, error)
+	// 選択した顧客をまとめて更新する
+	BatchUpdateCustomers(ctx context.Context, in *BatchUpdateCustomersRequest, opts ...grpc.CallOption) (*BatchUpdateCustomersResponse, error)
+	// 選択した顧客をまとめてゴミ箱に移動する
+	BatchDeleteCustomers(ctx context.Context, in *BatchDeleteCustomersRequest, opts ...grpc.CallOption) (*BatchDeleteCustomersResponse, error)
+	// 選択した顧客を別のリストに移動する。
+	// 移動先に同じキーと型の独自項目がない値は削除し、タグは移動先のリストに同じ名前で付け直す
+	MoveCustomers(ctx context.Context, in *MoveCustomersRequest, opts ...grpc.CallOption) (*MoveCustomersResponse, error)
+	// 顧客と連絡先をゴミ箱に移動する
+	DeleteCustomer(ctx context.Context, in *DeleteCustomerRequest, opts ...grpc.CallOption) (*DeleteCustomerResponse, error)
+	// ゴミ箱から顧客を戻す。リストがゴミ箱にある場合は先にリストを戻す必要がある
+	RestoreCustomer(ctx context.Context, in *RestoreCustomerRequest, opts ...grpc.CallOption) (*RestoreCustomerResponse, error)
 }
 
 type customerServiceClient struct {
@@ -83,6 +102,66 @@ func (c *customerServiceClient) SearchCustomer(ctx context.Context, in *SearchCu
 	return out, nil
 }
 
+func (c *customerServiceClient) UpdateCustomer(ctx context.Context, in *UpdateCustomerRequest, opts ...grpc.CallOption) (*UpdateCustomerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCustomerResponse)
+	err := c.cc.Invoke(ctx, CustomerService_UpdateCustomer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) BatchUpdateCustomers(ctx context.Context, in *BatchUpdateCustomersRequest, opts ...grpc.CallOption) (*BatchUpdateCustomersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchUpdateCustomersResponse)
+	err := c.cc.Invoke(ctx, CustomerService_BatchUpdateCustomers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) BatchDeleteCustomers(ctx context.Context, in *BatchDeleteCustomersRequest, opts ...grpc.CallOption) (*BatchDeleteCustomersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchDeleteCustomersResponse)
+	err := c.cc.Invoke(ctx, CustomerService_BatchDeleteCustomers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) MoveCustomers(ctx context.Context, in *MoveCustomersRequest, opts ...grpc.CallOption) (*MoveCustomersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveCustomersResponse)
+	err := c.cc.Invoke(ctx, CustomerService_MoveCustomers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) DeleteCustomer(ctx context.Context, in *DeleteCustomerRequest, opts ...grpc.CallOption) (*DeleteCustomerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCustomerResponse)
+	err := c.cc.Invoke(ctx, CustomerService_DeleteCustomer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) RestoreCustomer(ctx context.Context, in *RestoreCustomerRequest, opts ...grpc.CallOption) (*RestoreCustomerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreCustomerResponse)
+	err := c.cc.Invoke(ctx, CustomerService_RestoreCustomer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CustomerServiceServer is the server API for CustomerService service.
 // All implementations must embed UnimplementedCustomerServiceServer
 // for forward compatibility.
@@ -91,6 +170,19 @@ type CustomerServiceServer interface {
 	GetCustomer(context.Context, *GetCustomerRequest) (*GetCustomerResponse, error)
 	GetCustomerByBookId(context.Context, *GetCustomerByBookIdRequest) (*GetCustomerByBookIdResponse, error)
 	SearchCustomer(context.Context, *SearchCustomerRequest) (*SearchCustomerResponse, error)
+	// 指定した項目のみ更新する
+	UpdateCustomer(context.Context, *UpdateCustomerRequest) (*UpdateCustomerResponse, error)
+	// 選択した顧客をまとめて更新する
+	BatchUpdateCustomers(context.Context, *BatchUpdateCustomersRequest) (*BatchUpdateCustomersResponse, error)
+	// 選択した顧客をまとめてゴミ箱に移動する
+	BatchDeleteCustomers(context.Context, *BatchDeleteCustomersRequest) (*BatchDeleteCustomersResponse, error)
+	// 選択した顧客を別のリストに移動する。
+	// 移動先に同じキーと型の独自項目がない値は削除し、タグは移動先のリストに同じ名前で付け直す
+	MoveCustomers(context.Context, *MoveCustomersRequest) (*MoveCustomersResponse, error)
+	// 顧客と連絡先をゴミ箱に移動する
+	DeleteCustomer(context.Context, *DeleteCustomerRequest) (*DeleteCustomerResponse, error)
+	// ゴミ箱から顧客を戻す。リストがゴミ箱にある場合は先にリストを戻す必要がある
+	RestoreCustomer(context.Context, *RestoreCustomerRequest) (*RestoreCustomerResponse, error)
 	mustEmbedUnimplementedCustomerServiceServer()
 }
 
@@ -113,6 +205,24 @@ func (UnimplementedCustomerServiceServer) GetCustomerByBookId(context.Context, *
 func (UnimplementedCustomerServiceServer) SearchCustomer(context.Context, *SearchCustomerRequest) (*SearchCustomerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchCustomer not implemented")
 }
+func (UnimplementedCustomerServiceServer) UpdateCustomer(context.Context, *UpdateCustomerRequest) (*UpdateCustomerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCustomer not implemented")
+}
+func (UnimplementedCustomerServiceServer) BatchUpdateCustomers(context.Context, *BatchUpdateCustomersRequest) (*BatchUpdateCustomersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateCustomers not implemented")
+}
+func (UnimplementedCustomerServiceServer) BatchDeleteCustomers(context.Context, *BatchDeleteCustomersRequest) (*BatchDeleteCustomersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteCustomers not implemented")
+}
+func (UnimplementedCustomerServiceServer) MoveCustomers(context.Context, *MoveCustomersRequest) (*MoveCustomersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveCustomers not implemented")
+}
+func (UnimplementedCustomerServiceServer) DeleteCustomer(context.Context, *DeleteCustomerRequest) (*DeleteCustomerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCustomer not implemented")
+}
+func (UnimplementedCustomerServiceServer) RestoreCustomer(context.Context, *RestoreCustomerRequest) (*RestoreCustomerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCustomer not implemented")
+}
 func (UnimplementedCustomerServiceServer) mustEmbedUnimplementedCustomerServiceServer() {}
 func (UnimplementedCustomerServiceServer) testEmbeddedByValue()                         {}
 
@@ -206,6 +316,114 @@ func _CustomerService_SearchCustomer_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CustomerService_UpdateCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).UpdateCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_UpdateCustomer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).UpdateCustomer(ctx, req.(*UpdateCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_BatchUpdateCustomers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateCustomersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).BatchUpdateCustomers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_BatchUpdateCustomers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).BatchUpdateCustomers(ctx, req.(*BatchUpdateCustomersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_BatchDeleteCustomers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteCustomersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).BatchDeleteCustomers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_BatchDeleteCustomers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).BatchDeleteCustomers(ctx, req.(*BatchDeleteCustomersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_MoveCustomers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveCustomersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).MoveCustomers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_MoveCustomers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).MoveCustomers(ctx, req.(*MoveCustomersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_DeleteCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).DeleteCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_DeleteCustomer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).DeleteCustomer(ctx, req.(*DeleteCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_RestoreCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).RestoreCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_RestoreCustomer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).RestoreCustomer(ctx, req.(*RestoreCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CustomerService_ServiceDesc is the grpc.ServiceDesc for CustomerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,6 +447,30 @@ var CustomerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchCustomer",
 			Handler:    _CustomerService_SearchCustomer_Handler,
 		},
+		{
+			MethodName: "UpdateCustomer",
+			Handler:    _CustomerService_UpdateCustomer_Handler,
+		},
+		{
+			MethodName: "BatchUpdateCustomers",
+			Handler:    _CustomerService_BatchUpdateCustomers_Handler,
+		},
+		{
+			MethodName: "BatchDeleteCustomers",
+			Handler:    _CustomerService_BatchDeleteCustomers_Handler,
+		},
+		{
+			MethodName: "MoveCustomers",
+			Handler:    _CustomerService_MoveCustomers_Handler,
+		},
+		{
+			MethodName: "DeleteCustomer",
+			Handler:    _CustomerService_DeleteCustomer_Handler,
+		},
+		{
+			MethodName: "RestoreCustomer",
+			Handler:    _CustomerService_RestoreCustomer_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "customer/v1/customer.proto",
@@ -0,0 +1,740 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: customfield/v1/customfield.proto
+
+package customfieldv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CustomFieldType int32
+
+const (
+	CustomFieldType_CUSTOM_FIELD_TYPE_UNSPECIFIED CustomFieldType = 0
+	CustomFieldType_CUSTOM_FIELD_TYPE_TEXT        CustomFieldType = 1
+	CustomFieldType_CUSTOM_FIELD_TYPE_NUMBER      CustomFieldType = 2
+	// YYYY-MM-DD 形式の文字列
+	CustomFieldType_CUSTOM_FIELD_TYPE_DATE CustomFieldType = 3
+	// options のいずれかの文字列
+	CustomFieldType_CUSTOM_FIELD_TYPE_SELECT  CustomFieldType = 4
+	CustomFieldType_CUSTOM_FIELD_TYPE_BOOLEAN CustomFieldType = 5
+)
+
+// Enum value maps for CustomFieldType.
+var (
+	CustomFieldType_name = map[int32]string{
+		0: "CUSTOM_FIELD_TYPE_UNSPECIFIED",
+		1: "CUSTOM_FIELD_TYPE_TEXT",
+		2: "CUSTOM_FIELD_TYPE_NUMBER",
+		3: "CUSTOM_FIELD_TYPE_DATE",
+		4: "CUSTOM_FIELD_TYPE_SELECT",
+		5: "CUSTOM_FIELD_TYPE_BOOLEAN",
+	}
+	CustomFieldType_value = map[string]int32{
+		"CUSTOM_FIELD_TYPE_UNSPECIFIED": 0,
+		"CUSTOM_FIELD_TYPE_TEXT":        1,
+		"CUSTOM_FIELD_TYPE_NUMBER":      2,
+		"CUSTOM_FIELD_TYPE_DATE":        3,
+		"CUSTOM_FIELD_TYPE_SELECT":      4,
+		"CUSTOM_FIELD_TYPE_BOOLEAN":     5,
+	}
+)
+
+func (x CustomFieldType) Enum() *CustomFieldType {
+	p := new(CustomFieldType)
+	*p = x
+	return p
+}
+
+func (x CustomFieldType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CustomFieldType) Descriptor() protoreflect.EnumDescriptor {
+	return file_customfield_v1_customfield_proto_enumTypes[0].Descriptor()
+}
+
+func (CustomFieldType) Type() protoreflect.EnumType {
+	return &file_customfield_v1_customfield_proto_enumTypes[0]
+}
+
+func (x CustomFieldType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CustomFieldType.Descriptor instead.
+func (CustomFieldType) EnumDescriptor() ([]byte, []int) {
+	return file_customfield_v1_customfield_proto_rawDescGZIP(), []int{0}
+}
+
+type CustomField struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BookId string                 `protobuf:"bytes,2,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	// Customer.custom_fields のキー
+	Key           string          `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Label         string          `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	Type          CustomFieldType `protobuf:"varint,5,opt,name=type,proto3,enum=customfield.v1.CustomFieldType" json:"type,omitempty"`
+	Options       []string        `protobuf:"bytes,6,rep,name=options,proto3" json:"options,omitempty"`
+	Required      bool            `protobuf:"varint,7,opt,name=required,proto3" json:"required,omitempty"`
+	Position      int32           `protobuf:"varint,8,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomField) Reset() {
+	*x = CustomField{}
+	mi := &file_customfield_v1_customfield_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomField) ProtoMessage() {}
+
+func (x *CustomField) ProtoReflect() protoreflect.Message {
+	mi := &file_customfield_v1_customfield_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomField.ProtoReflect.Descriptor instead.
+func (*CustomField) Descriptor() ([]byte, []int) {
+	return file_customfield_v1_customfield_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CustomField) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CustomField) GetBookId() string {
+	if x != nil {
+		return x.BookId
+	}
+	return ""
+}
+
+func (x *CustomField) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CustomField) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *CustomField) GetType() CustomFieldType {
+	if x != nil {
+		return x.Type
+	}
+	return CustomFieldType_CUSTOM_FIELD_TYPE_UNSPECIFIED
+}
+
+func (x *CustomField) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CustomField) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *CustomField) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type ListCustomFieldsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookId        string                 `protobuf:"bytes,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCustomFieldsRequest) Reset() {
+	*x = ListCustomFieldsRequest{}
+	mi := &file_customfield_v1_customfield_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCustomFieldsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCustomFieldsRequest) ProtoMessage() {}
+
+func (x *ListCustomFieldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customfield_v1_customfield_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCustomFieldsRequest.ProtoReflect.Descriptor instead.
+func (*ListCustomFieldsRequest) Descriptor() ([]byte, []int) {
+	return file_customfield_v1_customfield_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListCustomFieldsRequest) GetBookId() string {
+	if x != nil {
+		return x.BookId
+	}
+	return ""
+}
+
+type ListCustomFieldsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomFields  []*CustomField         `protobuf:"bytes,1,rep,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCustomFieldsResponse) Reset() {
+	*x = ListCustomFieldsResponse{}
+	mi := &file_customfield_v1_customfield_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCustomFieldsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCustomFieldsResponse) ProtoMessage() {}
+
+func (x *ListCustomFieldsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customfield_v1_customfield_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCustomFieldsResponse.ProtoReflect.Descriptor instead.
+func (*ListCustomFieldsResponse) Descriptor() ([]byte, []int) {
+	return file_customfield_v1_customfield_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListCustomFieldsResponse) GetCustomFields() []*CustomField {
+	if x != nil {
+		return x.CustomFields
+	}
+	return nil
+}
+
+type CreateCustomFieldRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	BookId string                 `protobuf:"bytes,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	// 英小文字・数字・アンダースコア
+	Key     string          `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Label   string          `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Type    CustomFieldType `protobuf:"varint,4,opt,name=type,proto3,enum=customfield.v1.CustomFieldType" json:"type,omitempty"`
+	Options []string        `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty"`
+	// 既存の顧客に値がない場合は作成できない
+	Required      bool  `protobuf:"varint,6,opt,name=required,proto3" json:"required,omitempty"`
+	Position      int32 `protobuf:"varint,7,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCustomFieldRequest) Reset() {
+	*x = CreateCustomFieldRequest{}
+	mi := &file_customfield_v1_customfield_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCustomFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCustomFieldRequest) ProtoMessage() {}
+
+func (x *CreateCustomFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customfield_v1_customfield_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCustomFieldRequest.ProtoReflect.Descriptor instead.
+func (*CreateCustomFieldRequest) Descriptor() ([]byte, []int) {
+	return file_customfield_v1_customfield_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateCustomFieldRequest) GetBookId() string {
+	if x != nil {
+		return x.BookId
+	}
+	return ""
+}
+
+func (x *CreateCustomFieldRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CreateCustomFieldRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *CreateCustomFieldRequest) GetType() CustomFieldType {
+	if x != nil {
+		return x.Type
+	}
+	return CustomFieldType_CUSTOM_FIELD_TYPE_UNSPECIFIED
+}
+
+func (x *CreateCustomFieldRequest) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CreateCustomFieldRequest) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *CreateCustomFieldRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type CreateCustomFieldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomField   *CustomField           `protobuf:"bytes,1,opt,name=custom_field,json=customField,proto3" json:"custom_field,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCustomFieldResponse) Reset() {
+	*x = CreateCustomFieldResponse{}
+	mi := &file_customfield_v1_customfield_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCustomFieldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCustomFieldResponse) ProtoMessage() {}
+
+func (x *CreateCustomFieldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customfield_v1_customfield_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCustomFieldResponse.ProtoReflect.Descriptor instead.
+func (*CreateCustomFieldResponse) Descriptor() ([]byte, []int) {
+	return file_customfield_v1_customfield_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateCustomFieldResponse) GetCustomField() *CustomField {
+	if x != nil {
+		return x.CustomField
+	}
+	return nil
+}
+
+type UpdateCustomFieldRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label *string                `protobuf:"bytes,2,opt,name=label,proto3,oneof" json:"label,omitempty"`
+	// select の場合のみ。使われている選択肢は外せない
+	Options       []string `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
+	Required      *bool    `protobuf:"varint,4,opt,name=required,proto3,oneof" json:"required,omitempty"`
+	Position      *int32   `protobuf:"varint,5,opt,name=position,proto3,oneof" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCustomFieldRequest) Reset() {
+	*x = UpdateCustomFieldRequest{}
+	mi := &file_customfield_v1_customfield_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCustomFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCustomFieldRequest) ProtoMessage() {}
+
+func (x *UpdateCustomFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customfield_v1_customfield_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCustomFieldRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCustomFieldRequest) Descriptor() ([]byte, []int) {
+	return file_customfield_v1_customfield_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateCustomFieldRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateCustomFieldRequest) GetLabel() string {
+	if x != nil && x.Label != nil {
+		return *x.Label
+	}
+	return ""
+}
+
+func (x *UpdateCustomFieldRequest) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *UpdateCustomFieldRequest) GetRequired() bool {
+	if x != nil && x.Required != nil {
+		return *x.Required
+	}
+	return false
+}
+
+func (x *UpdateCustomFieldRequest) GetPosition() int32 {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return 0
+}
+
+type UpdateCustomFieldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomField   *CustomField           `protobuf:"bytes,1,opt,name=custom_field,json=customField,proto3" json:"custom_field,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCustomFieldResponse) Reset() {
+	*x = UpdateCustomFieldResponse{}
+	mi := &file_customfield_v1_customfield_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCustomFieldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCustomFieldResponse) ProtoMessage() {}
+
+func (x *UpdateCustomFieldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customfield_v1_customfield_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCustomFieldResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCustomFieldResponse) Descriptor() ([]byte, []int) {
+	return file_customfield_v1_customfield_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateCustomFieldResponse) GetCustomField() *CustomField {
+	if x != nil {
+		return x.CustomField
+	}
+	return nil
+}
+
+type DeleteCustomFieldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCustomFieldRequest) Reset() {
+	*x = DeleteCustomFieldRequest{}
+	mi := &file_customfield_v1_customfield_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCustomFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCustomFieldRequest) ProtoMessage() {}
+
+func (x *DeleteCustomFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customfield_v1_customfield_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCustomFieldRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCustomFieldRequest) Descriptor() ([]byte, []int) {
+	return file_customfield_v1_customfield_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteCustomFieldRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteCustomFieldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCustomFieldResponse) Reset() {
+	*x = DeleteCustomFieldResponse{}
+	mi := &file_customfield_v1_customfield_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCustomFieldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCustomFieldResponse) ProtoMessage() {}
+
+func (x *DeleteCustomFieldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customfield_v1_customfield_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCustomFieldResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCustomFieldResponse) Descriptor() ([]byte, []int) {
+	return file_customfield_v1_customfield_proto_rawDescGZIP(), []int{8}
+}
+
+var File_customfield_v1_customfield_proto protoreflect.FileDescriptor
+
+const file_customfield_v1_customfield_proto_rawDesc = "" +
+	"\n" +
+	" customfield/v1/customfield.proto\x12\x0ecustomfield.v1\x1a\x1cgoogle/api/annotations.proto\"\xe5\x01\n" +
+	"\vCustomField\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\abook_id\x18\x02 \x01(\tR\x06bookId\x12\x10\n" +
+	"\x03key\x18\x03 \x01(\tR\x03key\x12\x14\n" +
+	"\x05label\x18\x04 \x01(\tR\x05label\x123\n" +
+	"\x04type\x18\x05 \x01(\x0e2\x1f.customfield.v1.CustomFieldTypeR\x04type\x12\x18\n" +
+	"\aoptions\x18\x06 \x03(\tR\aoptions\x12\x1a\n" +
+	"\brequired\x18\a \x01(\bR\brequired\x12\x1a\n" +
+	"\bposition\x18\b \x01(\x05R\bposition\"2\n" +
+	"\x17ListCustomFieldsRequest\x12\x17\n" +
+	"\abook_id\x18\x01 \x01(\tR\x06bookId\"\\\n" +
+	"\x18ListCustomFieldsResponse\x12@\n" +
+	"\rcustom_fields\x18\x01 \x03(\v2\x1b.customfield.v1.CustomFieldR\fcustomFields\"\xe2\x01\n" +
+	"\x18CreateCustomFieldRequest\x12\x17\n" +
+	"\abook_id\x18\x01 \x01(\tR\x06bookId\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x123\n" +
+	"\x04type\x18\x04 \x01(\x0e2\x1f.customfield.v1.CustomFieldTypeR\x04type\x12\x18\n" +
+	"\aoptions\x18\x05 \x03(\tR\aoptions\x12\x1a\n" +
+	"\brequired\x18\x06 \x01(\bR\brequired\x12\x1a\n" +
+	"\bposition\x18\a \x01(\x05R\bposition\"[\n" +
+	"\x19CreateCustomFieldResponse\x12>\n" +
+	"\fcustom_field\x18\x01 \x01(\v2\x1b.customfield.v1.CustomFieldR\vcustomField\"\xc5\x01\n" +
+	"\x18UpdateCustomFieldRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\x05label\x18\x02 \x01(\tH\x00R\x05label\x88\x01\x01\x12\x18\n" +
+	"\aoptions\x18\x03 \x03(\tR\aoptions\x12\x1f\n" +
+	"\brequired\x18\x04 \x01(\bH\x01R\brequired\x88\x01\x01\x12\x1f\n" +
+	"\bposition\x18\x05 \x01(\x05H\x02R\bposition\x88\x01\x01B\b\n" +
+	"\x06_labelB\v\n" +
+	"\t_requiredB\v\n" +
+	"\t_position\"[\n" +
+	"\x19UpdateCustomFieldResponse\x12>\n" +
+	"\fcustom_field\x18\x01 \x01(\v2\x1b.customfield.v1.CustomFieldR\vcustomField\"*\n" +
+	"\x18DeleteCustomFieldRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1b\n" +
+	"\x19DeleteCustomFieldResponse*\xc7\x01\n" +
+	"\x0fCustomFieldType\x12!\n" +
+	"\x1dCUSTOM_FIELD_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16CUSTOM_FIELD_TYPE_TEXT\x10\x01\x12\x1c\n" +
+	"\x18CUSTOM_FIELD_TYPE_NUMBER\x10\x02\x12\x1a\n" +
+	"\x16CUSTOM_FIELD_TYPE_DATE\x10\x03\x12\x1c\n" +
+	"\x18CUSTOM_FIELD_TYPE_SELECT\x10\x04\x12\x1d\n" +
+	"\x19CUSTOM_FIELD_TYPE_BOOLEAN\x10\x052\xd7\x04\n" +
+	"\x12CustomFieldService\x12\x8f\x01\n" +
+	"\x10ListCustomFields\x12'.customfield.v1.ListCustomFieldsRequest\x1a(.customfield.v1.ListCustomFieldsResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /v1/book/{book_id}/custom-fields\x12\x95\x01\n" +
+	"\x11CreateCustomField\x12(.customfield.v1.CreateCustomFieldRequest\x1a).customfield.v1.CreateCustomFieldResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/book/{book_id}/custom-fields\x12\x8b\x01\n" +
+	"\x11UpdateCustomField\x12(.customfield.v1.UpdateCustomFieldRequest\x1a).customfield.v1.UpdateCustomFieldResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\x1a\x16/v1/custom-fields/{id}\x12\x88\x01\n" +
+	"\x11DeleteCustomField\x12(.customfield.v1.DeleteCustomFieldRequest\x1a).customfield.v1.DeleteCustomFieldResponse\"\x1e\x82\xd3\xe4\x93\x02\x18*\x16/v1/custom-fields/{id}B\xca\x01\n" +
+	"\x12com.customfield.v1B\x10CustomfieldProtoP\x01ZIgithub.com/0utl1er-tech/prism-backend/gen/pb/customfield/v1;customfieldv1\xa2\x02\x03CXX\xaa\x02\x0eCustomfield.V1\xca\x02\x0eCustomfield\\V1\xe2\x02\x1aCustomfield\\V1\\GPBMetadata\xea\x02\x0fCustomfield::V1b\x06proto3"
+
+var (
+	file_customfield_v1_customfield_proto_rawDescOnce sync.Once
+	file_customfield_v1_customfield_proto_rawDescData []byte
+)
+
+func file_customfield_v1_customfield_proto_rawDescGZIP() []byte {
+	file_customfield_v1_customfield_proto_rawDescOnce.Do(func() {
+		file_customfield_v1_customfield_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_customfield_v1_customfield_proto_rawDesc), len(file_customfield_v1_customfield_proto_rawDesc)))
+	})
+	return file_customfield_v1_customfield_proto_rawDescData
+}
+
+var file_customfield_v1_customfield_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_customfield_v1_customfield_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_customfield_v1_customfield_proto_goTypes = []any{
+	(CustomFieldType)(0),              // 0: customfield.v1.CustomFieldType
+	(*CustomField)(nil),               // 1: customfield.v1.CustomField
+	(*ListCustomFieldsRequest)(nil),   // 2: customfield.v1.ListCustomFieldsRequest
+	(*ListCustomFieldsResponse)(nil),  // 3: customfield.v1.ListCustomFieldsResponse
+	(*CreateCustomFieldRequest)(nil),  // 4: customfield.v1.CreateCustomFieldRequest
+	(*CreateCustomFieldResponse)(nil), // 5: customfield.v1.CreateCustomFieldResponse
+	(*UpdateCustomFieldRequest)(nil),  // 6: customfield.v1.UpdateCustomFieldRequest
+	(*UpdateCustomFieldResponse)(nil), // 7: customfield.v1.UpdateCustomFieldResponse
+	(*DeleteCustomFieldRequest)(nil),  // 8: customfield.v1.DeleteCustomFieldRequest
+	(*DeleteCustomFieldResponse)(nil), // 9: customfield.v1.DeleteCustomFieldResponse
+}
+var file_customfield_v1_customfield_proto_depIdxs = []int32{
+	0, // 0: customfield.v1.CustomField.type:type_name -> customfield.v1.CustomFieldType
+	1, // 1: customfield.v1.ListCustomFieldsResponse.custom_fields:type_name -> customfield.v1.CustomField
+	0, // 2: customfield.v1.CreateCustomFieldRequest.type:type_name -> customfield.v1.CustomFieldType
+	1, // 3: customfield.v1.CreateCustomFieldResponse.custom_field:type_name -> customfield.v1.CustomField
+	1, // 4: customfield.v1.UpdateCustomFieldResponse.custom_field:type_name -> customfield.v1.CustomField
+	2, // 5: customfield.v1.CustomFieldService.ListCustomFields:input_type -> customfield.v1.ListCustomFieldsRequest
+	4, // 6: customfield.v1.CustomFieldService.CreateCustomField:input_type -> customfield.v1.CreateCustomFieldRequest
+	6, // 7: customfield.v1.CustomFieldService.UpdateCustomField:input_type -> customfield.v1.UpdateCustomFieldRequest
+	8, // 8: customfield.v1.CustomFieldService.DeleteCustomField:input_type -> customfield.v1.DeleteCustomFieldRequest
+	3, // 9: customfield.v1.CustomFieldService.ListCustomFields:output_type -> customfield.v1.ListCustomFieldsResponse
+	5, // 10: customfield.v1.CustomFieldService.CreateCustomField:output_type -> customfield.v1.CreateCustomFieldResponse
+	7, // 11: customfield.v1.CustomFieldService.UpdateCustomField:output_type -> customfield.v1.UpdateCustomFieldResponse
+	9, // 12: customfield.v1.CustomFieldService.DeleteCustomField:output_type -> customfield.v1.DeleteCustomFieldResponse
+	9, // [9:13] is the sub-list for method output_type
+	5, // [5:9] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_customfield_v1_customfield_proto_init() }
+func file_customfield_v1_customfield_proto_init() {
+	if File_customfield_v1_customfield_proto != nil {
+		return
+	}
+	file_customfield_v1_customfield_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_customfield_v1_customfield_proto_rawDesc), len(file_customfield_v1_customfield_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_customfield_v1_customfield_proto_goTypes,
+		DependencyIndexes: file_customfield_v1_customfield_proto_depIdxs,
+		EnumInfos:         file_customfield_v1_customfield_proto_enumTypes,
+		MessageInfos:      file_customfield_v1_customfield_proto_msgTypes,
+	}.Build()
+	File_customfield_v1_customfield_proto = out.File
+	file_customfield_v1_customfield_proto_goTypes = nil
+	file_customfield_v1_customfield_proto_depIdxs = nil
+}
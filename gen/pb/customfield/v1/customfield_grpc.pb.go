@@ -0,0 +1,243 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: customfield/v1/customfield.proto
+
+package customfieldv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CustomFieldService_ListCustomFields_FullMethodName  = "/customfield.v1.CustomFieldService/ListCustomFields"
+	CustomFieldService_CreateCustomField_FullMethodName = "/customfield.v1.CustomFieldService/CreateCustomField"
+	CustomFieldService_UpdateCustomField_FullMethodName = "/customfield.v1.CustomFieldService/UpdateCustomField"
+	CustomFieldService_DeleteCustomField_FullMethodName = "/customfield.v1.CustomFieldService/DeleteCustomField"
+)
+
+// CustomFieldServiceClient is the client API for CustomFieldService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 顧客リストごとの独自項目
+type CustomFieldServiceClient interface {
+	ListCustomFields(ctx context.Context, in *ListCustomFieldsRequest, opts ...grpc.CallOption) (*ListCustomFieldsResponse, error)
+	CreateCustomField(ctx context.Context, in *CreateCustomFieldRequest, opts ...grpc.CallOption) (*CreateCustomFieldResponse, error)
+	// key と type は変更できない
+	UpdateCustomField(ctx context.Context, in *UpdateCustomFieldRequest, opts ...grpc.CallOption) (*UpdateCustomFieldResponse, error)
+	// 顧客に入力済みの値も削除する
+	DeleteCustomField(ctx context.Context, in *DeleteCustomFieldRequest, opts ...grpc.CallOption) (*DeleteCustomFieldResponse, error)
+}
+
+type customFieldServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCustomFieldServiceClient(cc grpc.ClientConnInterface) CustomFieldServiceClient {
+	return &customFieldServiceClient{cc}
+}
+
+func (c *customFieldServiceClient) ListCustomFields(ctx context.Context, in *ListCustomFieldsRequest, opts ...grpc.CallOption) (*ListCustomFieldsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCustomFieldsResponse)
+	err := c.cc.Invoke(ctx, CustomFieldService_ListCustomFields_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customFieldServiceClient) CreateCustomField(ctx context.Context, in *CreateCustomFieldRequest, opts ...grpc.CallOption) (*CreateCustomFieldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCustomFieldResponse)
+	err := c.cc.Invoke(ctx, CustomFieldService_CreateCustomField_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customFieldServiceClient) UpdateCustomField(ctx context.Context, in *UpdateCustomFieldRequest, opts ...grpc.CallOption) (*UpdateCustomFieldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCustomFieldResponse)
+	err := c.cc.Invoke(ctx, CustomFieldService_UpdateCustomField_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customFieldServiceClient) DeleteCustomField(ctx context.Context, in *DeleteCustomFieldRequest, opts ...grpc.CallOption) (*DeleteCustomFieldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCustomFieldResponse)
+	err := c.cc.Invoke(ctx, CustomFieldService_DeleteCustomField_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CustomFieldServiceServer is the server API for CustomFieldService service.
+// All implementations must embed UnimplementedCustomFieldServiceServer
+// for forward compatibility.
+//
+// 顧客リストごとの独自項目
+type CustomFieldServiceServer interface {
+	ListCustomFields(context.Context, *ListCustomFieldsRequest) (*ListCustomFieldsResponse, error)
+	CreateCustomField(context.Context, *CreateCustomFieldRequest) (*CreateCustomFieldResponse, error)
+	// key と type は変更できない
+	UpdateCustomField(context.Context, *UpdateCustomFieldRequest) (*UpdateCustomFieldResponse, error)
+	// 顧客に入力済みの値も削除する
+	DeleteCustomField(context.Context, *DeleteCustomFieldRequest) (*DeleteCustomFieldResponse, error)
+	mustEmbedUnimplementedCustomFieldServiceServer()
+}
+
+// UnimplementedCustomFieldServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCustomFieldServiceServer struct{}
+
+func (UnimplementedCustomFieldServiceServer) ListCustomFields(context.Context, *ListCustomFieldsRequest) (*ListCustomFieldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCustomFields not implemented")
+}
+func (UnimplementedCustomFieldServiceServer) CreateCustomField(context.Context, *CreateCustomFieldRequest) (*CreateCustomFieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCustomField not implemented")
+}
+func (UnimplementedCustomFieldServiceServer) UpdateCustomField(context.Context, *UpdateCustomFieldRequest) (*UpdateCustomFieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCustomField not implemented")
+}
+func (UnimplementedCustomFieldServiceServer) DeleteCustomField(context.Context, *DeleteCustomFieldRequest) (*DeleteCustomFieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCustomField not implemented")
+}
+func (UnimplementedCustomFieldServiceServer) mustEmbedUnimplementedCustomFieldServiceServer() {}
+func (UnimplementedCustomFieldServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeCustomFieldServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CustomFieldServiceServer will
+// result in compilation errors.
+type UnsafeCustomFieldServiceServer interface {
+	mustEmbedUnimplementedCustomFieldServiceServer()
+}
+
+func RegisterCustomFieldServiceServer(s grpc.ServiceRegistrar, srv CustomFieldServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCustomFieldServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CustomFieldService_ServiceDesc, srv)
+}
+
+func _CustomFieldService_ListCustomFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCustomFieldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomFieldServiceServer).ListCustomFields(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomFieldService_ListCustomFields_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomFieldServiceServer).ListCustomFields(ctx, req.(*ListCustomFieldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomFieldService_CreateCustomField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCustomFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomFieldServiceServer).CreateCustomField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomFieldService_CreateCustomField_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomFieldServiceServer).CreateCustomField(ctx, req.(*CreateCustomFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomFieldService_UpdateCustomField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCustomFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomFieldServiceServer).UpdateCustomField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomFieldService_UpdateCustomField_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomFieldServiceServer).UpdateCustomField(ctx, req.(*UpdateCustomFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomFieldService_DeleteCustomField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCustomFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomFieldServiceServer).DeleteCustomField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomFieldService_DeleteCustomField_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomFieldServiceServer).DeleteCustomField(ctx, req.(*DeleteCustomFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CustomFieldService_ServiceDesc is the grpc.ServiceDesc for CustomFieldService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CustomFieldService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "customfield.v1.CustomFieldService",
+	HandlerType: (*CustomFieldServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListCustomFields",
+			Handler:    _CustomFieldService_ListCustomFields_Handler,
+		},
+		{
+			MethodName: "CreateCustomField",
+			Handler:    _CustomFieldService_CreateCustomField_Handler,
+		},
+		{
+			MethodName: "UpdateCustomField",
+			Handler:    _CustomFieldService_UpdateCustomField_Handler,
+		},
+		{
+			MethodName: "DeleteCustomField",
+			Handler:    _CustomFieldService_DeleteCustomField_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "customfield/v1/customfield.proto",
+}
@@ -0,0 +1,289 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: dialqueue/v1/dialqueue.proto
+
+package dialqueuev1
+
+import (
+	v1 "github.com/0utl1er-tech/prism-backend/gen/pb/customer/v1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type NextCustomerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookId        string                 `protobuf:"bytes,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NextCustomerRequest) Reset() {
+	*x = NextCustomerRequest{}
+	mi := &file_dialqueue_v1_dialqueue_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NextCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NextCustomerRequest) ProtoMessage() {}
+
+func (x *NextCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dialqueue_v1_dialqueue_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextCustomerRequest.ProtoReflect.Descriptor instead.
+func (*NextCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_dialqueue_v1_dialqueue_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NextCustomerRequest) GetBookId() string {
+	if x != nil {
+		return x.BookId
+	}
+	return ""
+}
+
+type NextCustomerResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Customer       *v1.Customer           `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
+	LeaseExpiresAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=lease_expires_at,json=leaseExpiresAt,proto3" json:"lease_expires_at,omitempty"`
+	// 再架電予定による払い出しかどうか
+	Redial        bool `protobuf:"varint,3,opt,name=redial,proto3" json:"redial,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NextCustomerResponse) Reset() {
+	*x = NextCustomerResponse{}
+	mi := &file_dialqueue_v1_dialqueue_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NextCustomerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NextCustomerResponse) ProtoMessage() {}
+
+func (x *NextCustomerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dialqueue_v1_dialqueue_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextCustomerResponse.ProtoReflect.Descriptor instead.
+func (*NextCustomerResponse) Descriptor() ([]byte, []int) {
+	return file_dialqueue_v1_dialqueue_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NextCustomerResponse) GetCustomer() *v1.Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+func (x *NextCustomerResponse) GetLeaseExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LeaseExpiresAt
+	}
+	return nil
+}
+
+func (x *NextCustomerResponse) GetRedial() bool {
+	if x != nil {
+		return x.Redial
+	}
+	return false
+}
+
+type ReleaseCustomerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseCustomerRequest) Reset() {
+	*x = ReleaseCustomerRequest{}
+	mi := &file_dialqueue_v1_dialqueue_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseCustomerRequest) ProtoMessage() {}
+
+func (x *ReleaseCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dialqueue_v1_dialqueue_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseCustomerRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_dialqueue_v1_dialqueue_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReleaseCustomerRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+type ReleaseCustomerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseCustomerResponse) Reset() {
+	*x = ReleaseCustomerResponse{}
+	mi := &file_dialqueue_v1_dialqueue_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseCustomerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseCustomerResponse) ProtoMessage() {}
+
+func (x *ReleaseCustomerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dialqueue_v1_dialqueue_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseCustomerResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseCustomerResponse) Descriptor() ([]byte, []int) {
+	return file_dialqueue_v1_dialqueue_proto_rawDescGZIP(), []int{3}
+}
+
+var File_dialqueue_v1_dialqueue_proto protoreflect.FileDescriptor
+
+const file_dialqueue_v1_dialqueue_proto_rawDesc = "" +
+	"\n" +
+	"\x1cdialqueue/v1/dialqueue.proto\x12\fdialqueue.v1\x1a\x1acustomer/v1/customer.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\".\n" +
+	"\x13NextCustomerRequest\x12\x17\n" +
+	"\abook_id\x18\x01 \x01(\tR\x06bookId\"\xa7\x01\n" +
+	"\x14NextCustomerResponse\x121\n" +
+	"\bcustomer\x18\x01 \x01(\v2\x15.customer.v1.CustomerR\bcustomer\x12D\n" +
+	"\x10lease_expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x0eleaseExpiresAt\x12\x16\n" +
+	"\x06redial\x18\x03 \x01(\bR\x06redial\"9\n" +
+	"\x16ReleaseCustomerRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\"\x19\n" +
+	"\x17ReleaseCustomerResponse2\x8b\x02\n" +
+	"\x10DialQueueService\x12t\n" +
+	"\fNextCustomer\x12!.dialqueue.v1.NextCustomerRequest\x1a\".dialqueue.v1.NextCustomerResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/dialqueue/next\x12\x80\x01\n" +
+	"\x0fReleaseCustomer\x12$.dialqueue.v1.ReleaseCustomerRequest\x1a%.dialqueue.v1.ReleaseCustomerResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/dialqueue/releaseB\xba\x01\n" +
+	"\x10com.dialqueue.v1B\x0eDialqueueProtoP\x01ZEgithub.com/0utl1er-tech/prism-backend/gen/pb/dialqueue/v1;dialqueuev1\xa2\x02\x03DXX\xaa\x02\fDialqueue.V1\xca\x02\fDialqueue\\V1\xe2\x02\x18Dialqueue\\V1\\GPBMetadata\xea\x02\rDialqueue::V1b\x06proto3"
+
+var (
+	file_dialqueue_v1_dialqueue_proto_rawDescOnce sync.Once
+	file_dialqueue_v1_dialqueue_proto_rawDescData []byte
+)
+
+func file_dialqueue_v1_dialqueue_proto_rawDescGZIP() []byte {
+	file_dialqueue_v1_dialqueue_proto_rawDescOnce.Do(func() {
+		file_dialqueue_v1_dialqueue_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_dialqueue_v1_dialqueue_proto_rawDesc), len(file_dialqueue_v1_dialqueue_proto_rawDesc)))
+	})
+	return file_dialqueue_v1_dialqueue_proto_rawDescData
+}
+
+var file_dialqueue_v1_dialqueue_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_dialqueue_v1_dialqueue_proto_goTypes = []any{
+	(*NextCustomerRequest)(nil),     // 0: dialqueue.v1.NextCustomerRequest
+	(*NextCustomerResponse)(nil),    // 1: dialqueue.v1.NextCustomerResponse
+	(*ReleaseCustomerRequest)(nil),  // 2: dialqueue.v1.ReleaseCustomerRequest
+	(*ReleaseCustomerResponse)(nil), // 3: dialqueue.v1.ReleaseCustomerResponse
+	(*v1.Customer)(nil),             // 4: customer.v1.Customer
+	(*timestamppb.Timestamp)(nil),   // 5: google.protobuf.Timestamp
+}
+var file_dialqueue_v1_dialqueue_proto_depIdxs = []int32{
+	4, // 0: dialqueue.v1.NextCustomerResponse.customer:type_name -> customer.v1.Customer
+	5, // 1: dialqueue.v1.NextCustomerResponse.lease_expires_at:type_name -> google.protobuf.Timestamp
+	0, // 2: dialqueue.v1.DialQueueService.NextCustomer:input_type -> dialqueue.v1.NextCustomerRequest
+	2, // 3: dialqueue.v1.DialQueueService.ReleaseCustomer:input_type -> dialqueue.v1.ReleaseCustomerRequest
+	1, // 4: dialqueue.v1.DialQueueService.NextCustomer:output_type -> dialqueue.v1.NextCustomerResponse
+	3, // 5: dialqueue.v1.DialQueueService.ReleaseCustomer:output_type -> dialqueue.v1.ReleaseCustomerResponse
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_dialqueue_v1_dialqueue_proto_init() }
+func file_dialqueue_v1_dialqueue_proto_init() {
+	if File_dialqueue_v1_dialqueue_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_dialqueue_v1_dialqueue_proto_rawDesc), len(file_dialqueue_v1_dialqueue_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_dialqueue_v1_dialqueue_proto_goTypes,
+		DependencyIndexes: file_dialqueue_v1_dialqueue_proto_depIdxs,
+		MessageInfos:      file_dialqueue_v1_dialqueue_proto_msgTypes,
+	}.Build()
+	File_dialqueue_v1_dialqueue_proto = out.File
+	file_dialqueue_v1_dialqueue_proto_goTypes = nil
+	file_dialqueue_v1_dialqueue_proto_depIdxs = nil
+}
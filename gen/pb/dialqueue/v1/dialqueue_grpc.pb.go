@@ -0,0 +1,163 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: dialqueue/v1/dialqueue.proto
+
+package dialqueuev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DialQueueService_NextCustomer_FullMethodName    = "/dialqueue.v1.DialQueueService/NextCustomer"
+	DialQueueService_ReleaseCustomer_FullMethodName = "/dialqueue.v1.DialQueueService/ReleaseCustomer"
+)
+
+// DialQueueServiceClient is the client API for DialQueueService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DialQueueServiceClient interface {
+	// 架電するユーザーに次の顧客を払い出す
+	NextCustomer(ctx context.Context, in *NextCustomerRequest, opts ...grpc.CallOption) (*NextCustomerResponse, error)
+	// 払い出された顧客を返却する
+	ReleaseCustomer(ctx context.Context, in *ReleaseCustomerRequest, opts ...grpc.CallOption) (*ReleaseCustomerResponse, error)
+}
+
+type dialQueueServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDialQueueServiceClient(cc grpc.ClientConnInterface) DialQueueServiceClient {
+	return &dialQueueServiceClient{cc}
+}
+
+func (c *dialQueueServiceClient) NextCustomer(ctx context.Context, in *NextCustomerRequest, opts ...grpc.CallOption) (*NextCustomerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NextCustomerResponse)
+	err := c.cc.Invoke(ctx, DialQueueService_NextCustomer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dialQueueServiceClient) ReleaseCustomer(ctx context.Context, in *ReleaseCustomerRequest, opts ...grpc.CallOption) (*ReleaseCustomerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseCustomerResponse)
+	err := c.cc.Invoke(ctx, DialQueueService_ReleaseCustomer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DialQueueServiceServer is the server API for DialQueueService service.
+// All implementations must embed UnimplementedDialQueueServiceServer
+// for forward compatibility.
+type DialQueueServiceServer interface {
+	// 架電するユーザーに次の顧客を払い出す
+	NextCustomer(context.Context, *NextCustomerRequest) (*NextCustomerResponse, error)
+	// 払い出された顧客を返却する
+	ReleaseCustomer(context.Context, *ReleaseCustomerRequest) (*ReleaseCustomerResponse, error)
+	mustEmbedUnimplementedDialQueueServiceServer()
+}
+
+// UnimplementedDialQueueServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDialQueueServiceServer struct{}
+
+func (UnimplementedDialQueueServiceServer) NextCustomer(context.Context, *NextCustomerRequest) (*NextCustomerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextCustomer not implemented")
+}
+func (UnimplementedDialQueueServiceServer) ReleaseCustomer(context.Context, *ReleaseCustomerRequest) (*ReleaseCustomerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseCustomer not implemented")
+}
+func (UnimplementedDialQueueServiceServer) mustEmbedUnimplementedDialQueueServiceServer() {}
+func (UnimplementedDialQueueServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeDialQueueServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DialQueueServiceServer will
+// result in compilation errors.
+type UnsafeDialQueueServiceServer interface {
+	mustEmbedUnimplementedDialQueueServiceServer()
+}
+
+func RegisterDialQueueServiceServer(s grpc.ServiceRegistrar, srv DialQueueServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDialQueueServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DialQueueService_ServiceDesc, srv)
+}
+
+func _DialQueueService_NextCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NextCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DialQueueServiceServer).NextCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DialQueueService_NextCustomer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DialQueueServiceServer).NextCustomer(ctx, req.(*NextCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DialQueueService_ReleaseCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DialQueueServiceServer).ReleaseCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DialQueueService_ReleaseCustomer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DialQueueServiceServer).ReleaseCustomer(ctx, req.(*ReleaseCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DialQueueService_ServiceDesc is the grpc.ServiceDesc for DialQueueService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DialQueueService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dialqueue.v1.DialQueueService",
+	HandlerType: (*DialQueueServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "NextCustomer",
+			Handler:    _DialQueueService_NextCustomer_Handler,
+		},
+		{
+			MethodName: "ReleaseCustomer",
+			Handler:    _DialQueueService_ReleaseCustomer_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dialqueue/v1/dialqueue.proto",
+}
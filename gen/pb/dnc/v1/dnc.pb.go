@@ -0,0 +1,700 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: dnc/v1/dnc.proto
+
+package dncv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DncSource int32
+
+const (
+	DncSource_DNC_SOURCE_UNSPECIFIED DncSource = 0
+	DncSource_DNC_SOURCE_MANUAL      DncSource = 1
+	DncSource_DNC_SOURCE_IMPORT      DncSource = 2
+	DncSource_DNC_SOURCE_CALL        DncSource = 3
+)
+
+// Enum value maps for DncSource.
+var (
+	DncSource_name = map[int32]string{
+		0: "DNC_SOURCE_UNSPECIFIED",
+		1: "DNC_SOURCE_MANUAL",
+		2: "DNC_SOURCE_IMPORT",
+		3: "DNC_SOURCE_CALL",
+	}
+	DncSource_value = map[string]int32{
+		"DNC_SOURCE_UNSPECIFIED": 0,
+		"DNC_SOURCE_MANUAL":      1,
+		"DNC_SOURCE_IMPORT":      2,
+		"DNC_SOURCE_CALL":        3,
+	}
+)
+
+func (x DncSource) Enum() *DncSource {
+	p := new(DncSource)
+	*p = x
+	return p
+}
+
+func (x DncSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DncSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_dnc_v1_dnc_proto_enumTypes[0].Descriptor()
+}
+
+func (DncSource) Type() protoreflect.EnumType {
+	return &file_dnc_v1_dnc_proto_enumTypes[0]
+}
+
+func (x DncSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DncSource.Descriptor instead.
+func (DncSource) EnumDescriptor() ([]byte, []int) {
+	return file_dnc_v1_dnc_proto_rawDescGZIP(), []int{0}
+}
+
+type Dnc struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 正規化済みの電話番号
+	Phone         string                 `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Source        DncSource              `protobuf:"varint,3,opt,name=source,proto3,enum=dnc.v1.DncSource" json:"source,omitempty"`
+	UserId        string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CallId        string                 `protobuf:"bytes,5,opt,name=call_id,json=callId,proto3" json:"call_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Dnc) Reset() {
+	*x = Dnc{}
+	mi := &file_dnc_v1_dnc_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Dnc) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Dnc) ProtoMessage() {}
+
+func (x *Dnc) ProtoReflect() protoreflect.Message {
+	mi := &file_dnc_v1_dnc_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Dnc.ProtoReflect.Descriptor instead.
+func (*Dnc) Descriptor() ([]byte, []int) {
+	return file_dnc_v1_dnc_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Dnc) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Dnc) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Dnc) GetSource() DncSource {
+	if x != nil {
+		return x.Source
+	}
+	return DncSource_DNC_SOURCE_UNSPECIFIED
+}
+
+func (x *Dnc) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Dnc) GetCallId() string {
+	if x != nil {
+		return x.CallId
+	}
+	return ""
+}
+
+func (x *Dnc) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type AddDncRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Phone         string                 `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
+	Reason        *string                `protobuf:"bytes,2,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddDncRequest) Reset() {
+	*x = AddDncRequest{}
+	mi := &file_dnc_v1_dnc_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddDncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDncRequest) ProtoMessage() {}
+
+func (x *AddDncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dnc_v1_dnc_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDncRequest.ProtoReflect.Descriptor instead.
+func (*AddDncRequest) Descriptor() ([]byte, []int) {
+	return file_dnc_v1_dnc_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AddDncRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *AddDncRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+type AddDncResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dnc           *Dnc                   `protobuf:"bytes,1,opt,name=dnc,proto3" json:"dnc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddDncResponse) Reset() {
+	*x = AddDncResponse{}
+	mi := &file_dnc_v1_dnc_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddDncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDncResponse) ProtoMessage() {}
+
+func (x *AddDncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dnc_v1_dnc_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDncResponse.ProtoReflect.Descriptor instead.
+func (*AddDncResponse) Descriptor() ([]byte, []int) {
+	return file_dnc_v1_dnc_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AddDncResponse) GetDnc() *Dnc {
+	if x != nil {
+		return x.Dnc
+	}
+	return nil
+}
+
+type RemoveDncRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Phone         string                 `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDncRequest) Reset() {
+	*x = RemoveDncRequest{}
+	mi := &file_dnc_v1_dnc_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDncRequest) ProtoMessage() {}
+
+func (x *RemoveDncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dnc_v1_dnc_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDncRequest.ProtoReflect.Descriptor instead.
+func (*RemoveDncRequest) Descriptor() ([]byte, []int) {
+	return file_dnc_v1_dnc_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RemoveDncRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type RemoveDncResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDncResponse) Reset() {
+	*x = RemoveDncResponse{}
+	mi := &file_dnc_v1_dnc_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDncResponse) ProtoMessage() {}
+
+func (x *RemoveDncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dnc_v1_dnc_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDncResponse.ProtoReflect.Descriptor instead.
+func (*RemoveDncResponse) Descriptor() ([]byte, []int) {
+	return file_dnc_v1_dnc_proto_rawDescGZIP(), []int{4}
+}
+
+type ImportDncRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Phones        []string               `protobuf:"bytes,1,rep,name=phones,proto3" json:"phones,omitempty"`
+	Reason        *string                `protobuf:"bytes,2,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportDncRequest) Reset() {
+	*x = ImportDncRequest{}
+	mi := &file_dnc_v1_dnc_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportDncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportDncRequest) ProtoMessage() {}
+
+func (x *ImportDncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dnc_v1_dnc_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportDncRequest.ProtoReflect.Descriptor instead.
+func (*ImportDncRequest) Descriptor() ([]byte, []int) {
+	return file_dnc_v1_dnc_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ImportDncRequest) GetPhones() []string {
+	if x != nil {
+		return x.Phones
+	}
+	return nil
+}
+
+func (x *ImportDncRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+type ImportDncResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 新しく登録された件数
+	Imported int32 `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+	// 既に登録済みだった件数
+	Duplicated int32 `protobuf:"varint,2,opt,name=duplicated,proto3" json:"duplicated,omitempty"`
+	// 電話番号として不正だった入力
+	Invalid       []string `protobuf:"bytes,3,rep,name=invalid,proto3" json:"invalid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportDncResponse) Reset() {
+	*x = ImportDncResponse{}
+	mi := &file_dnc_v1_dnc_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportDncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportDncResponse) ProtoMessage() {}
+
+func (x *ImportDncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dnc_v1_dnc_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportDncResponse.ProtoReflect.Descriptor instead.
+func (*ImportDncResponse) Descriptor() ([]byte, []int) {
+	return file_dnc_v1_dnc_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ImportDncResponse) GetImported() int32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportDncResponse) GetDuplicated() int32 {
+	if x != nil {
+		return x.Duplicated
+	}
+	return 0
+}
+
+func (x *ImportDncResponse) GetInvalid() []string {
+	if x != nil {
+		return x.Invalid
+	}
+	return nil
+}
+
+type ListDncRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDncRequest) Reset() {
+	*x = ListDncRequest{}
+	mi := &file_dnc_v1_dnc_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDncRequest) ProtoMessage() {}
+
+func (x *ListDncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dnc_v1_dnc_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDncRequest.ProtoReflect.Descriptor instead.
+func (*ListDncRequest) Descriptor() ([]byte, []int) {
+	return file_dnc_v1_dnc_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListDncRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListDncRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListDncResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dncs          []*Dnc                 `protobuf:"bytes,1,rep,name=dncs,proto3" json:"dncs,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDncResponse) Reset() {
+	*x = ListDncResponse{}
+	mi := &file_dnc_v1_dnc_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDncResponse) ProtoMessage() {}
+
+func (x *ListDncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dnc_v1_dnc_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDncResponse.ProtoReflect.Descriptor instead.
+func (*ListDncResponse) Descriptor() ([]byte, []int) {
+	return file_dnc_v1_dnc_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListDncResponse) GetDncs() []*Dnc {
+	if x != nil {
+		return x.Dncs
+	}
+	return nil
+}
+
+func (x *ListDncResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListDncResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListDncResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+var File_dnc_v1_dnc_proto protoreflect.FileDescriptor
+
+const file_dnc_v1_dnc_proto_rawDesc = "" +
+	"\n" +
+	"\x10dnc/v1/dnc.proto\x12\x06dnc.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xcb\x01\n" +
+	"\x03Dnc\x12\x14\n" +
+	"\x05phone\x18\x01 \x01(\tR\x05phone\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12)\n" +
+	"\x06source\x18\x03 \x01(\x0e2\x11.dnc.v1.DncSourceR\x06source\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x17\n" +
+	"\acall_id\x18\x05 \x01(\tR\x06callId\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"M\n" +
+	"\rAddDncRequest\x12\x14\n" +
+	"\x05phone\x18\x01 \x01(\tR\x05phone\x12\x1b\n" +
+	"\x06reason\x18\x02 \x01(\tH\x00R\x06reason\x88\x01\x01B\t\n" +
+	"\a_reason\"/\n" +
+	"\x0eAddDncResponse\x12\x1d\n" +
+	"\x03dnc\x18\x01 \x01(\v2\v.dnc.v1.DncR\x03dnc\"(\n" +
+	"\x10RemoveDncRequest\x12\x14\n" +
+	"\x05phone\x18\x01 \x01(\tR\x05phone\"\x13\n" +
+	"\x11RemoveDncResponse\"R\n" +
+	"\x10ImportDncRequest\x12\x16\n" +
+	"\x06phones\x18\x01 \x03(\tR\x06phones\x12\x1b\n" +
+	"\x06reason\x18\x02 \x01(\tH\x00R\x06reason\x88\x01\x01B\t\n" +
+	"\a_reason\"i\n" +
+	"\x11ImportDncResponse\x12\x1a\n" +
+	"\bimported\x18\x01 \x01(\x05R\bimported\x12\x1e\n" +
+	"\n" +
+	"duplicated\x18\x02 \x01(\x05R\n" +
+	"duplicated\x12\x18\n" +
+	"\ainvalid\x18\x03 \x03(\tR\ainvalid\":\n" +
+	"\x0eListDncRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"r\n" +
+	"\x0fListDncResponse\x12\x1f\n" +
+	"\x04dncs\x18\x01 \x03(\v2\v.dnc.v1.DncR\x04dncs\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit*j\n" +
+	"\tDncSource\x12\x1a\n" +
+	"\x16DNC_SOURCE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11DNC_SOURCE_MANUAL\x10\x01\x12\x15\n" +
+	"\x11DNC_SOURCE_IMPORT\x10\x02\x12\x13\n" +
+	"\x0fDNC_SOURCE_CALL\x10\x032\xde\x02\n" +
+	"\n" +
+	"DncService\x12K\n" +
+	"\x06AddDnc\x12\x15.dnc.v1.AddDncRequest\x1a\x16.dnc.v1.AddDncResponse\"\x12\x82\xd3\xe4\x93\x02\f:\x01*\"\a/v1/dnc\x12Y\n" +
+	"\tRemoveDnc\x12\x18.dnc.v1.RemoveDncRequest\x1a\x19.dnc.v1.RemoveDncResponse\"\x17\x82\xd3\xe4\x93\x02\x11*\x0f/v1/dnc/{phone}\x12[\n" +
+	"\tImportDnc\x12\x18.dnc.v1.ImportDncRequest\x1a\x19.dnc.v1.ImportDncResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/dnc/import\x12K\n" +
+	"\aListDnc\x12\x16.dnc.v1.ListDncRequest\x1a\x17.dnc.v1.ListDncResponse\"\x0f\x82\xd3\xe4\x93\x02\t\x12\a/v1/dncB\x8a\x01\n" +
+	"\n" +
+	"com.dnc.v1B\bDncProtoP\x01Z9github.com/0utl1er-tech/prism-backend/gen/pb/dnc/v1;dncv1\xa2\x02\x03DXX\xaa\x02\x06Dnc.V1\xca\x02\x06Dnc\\V1\xe2\x02\x12Dnc\\V1\\GPBMetadata\xea\x02\aDnc::V1b\x06proto3"
+
+var (
+	file_dnc_v1_dnc_proto_rawDescOnce sync.Once
+	file_dnc_v1_dnc_proto_rawDescData []byte
+)
+
+func file_dnc_v1_dnc_proto_rawDescGZIP() []byte {
+	file_dnc_v1_dnc_proto_rawDescOnce.Do(func() {
+		file_dnc_v1_dnc_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_dnc_v1_dnc_proto_rawDesc), len(file_dnc_v1_dnc_proto_rawDesc)))
+	})
+	return file_dnc_v1_dnc_proto_rawDescData
+}
+
+var file_dnc_v1_dnc_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_dnc_v1_dnc_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_dnc_v1_dnc_proto_goTypes = []any{
+	(DncSource)(0),                // 0: dnc.v1.DncSource
+	(*Dnc)(nil),                   // 1: dnc.v1.Dnc
+	(*AddDncRequest)(nil),         // 2: dnc.v1.AddDncRequest
+	(*AddDncResponse)(nil),        // 3: dnc.v1.AddDncResponse
+	(*RemoveDncRequest)(nil),      // 4: dnc.v1.RemoveDncRequest
+	(*RemoveDncResponse)(nil),     // 5: dnc.v1.RemoveDncResponse
+	(*ImportDncRequest)(nil),      // 6: dnc.v1.ImportDncRequest
+	(*ImportDncResponse)(nil),     // 7: dnc.v1.ImportDncResponse
+	(*ListDncRequest)(nil),        // 8: dnc.v1.ListDncRequest
+	(*ListDncResponse)(nil),       // 9: dnc.v1.ListDncResponse
+	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
+}
+var file_dnc_v1_dnc_proto_depIdxs = []int32{
+	0,  // 0: dnc.v1.Dnc.source:type_name -> dnc.v1.DncSource
+	10, // 1: dnc.v1.Dnc.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 2: dnc.v1.AddDncResponse.dnc:type_name -> dnc.v1.Dnc
+	1,  // 3: dnc.v1.ListDncResponse.dncs:type_name -> dnc.v1.Dnc
+	2,  // 4: dnc.v1.DncService.AddDnc:input_type -> dnc.v1.AddDncRequest
+	4,  // 5: dnc.v1.DncService.RemoveDnc:input_type -> dnc.v1.RemoveDncRequest
+	6,  // 6: dnc.v1.DncService.ImportDnc:input_type -> dnc.v1.ImportDncRequest
+	8,  // 7: dnc.v1.DncService.ListDnc:input_type -> dnc.v1.ListDncRequest
+	3,  // 8: dnc.v1.DncService.AddDnc:output_type -> dnc.v1.AddDncResponse
+	5,  // 9: dnc.v1.DncService.RemoveDnc:output_type -> dnc.v1.RemoveDncResponse
+	7,  // 10: dnc.v1.DncService.ImportDnc:output_type -> dnc.v1.ImportDncResponse
+	9,  // 11: dnc.v1.DncService.ListDnc:output_type -> dnc.v1.ListDncResponse
+	8,  // [8:12] is the sub-list for method output_type
+	4,  // [4:8] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_dnc_v1_dnc_proto_init() }
+func file_dnc_v1_dnc_proto_init() {
+	if File_dnc_v1_dnc_proto != nil {
+		return
+	}
+	file_dnc_v1_dnc_proto_msgTypes[1].OneofWrappers = []any{}
+	file_dnc_v1_dnc_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_dnc_v1_dnc_proto_rawDesc), len(file_dnc_v1_dnc_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_dnc_v1_dnc_proto_goTypes,
+		DependencyIndexes: file_dnc_v1_dnc_proto_depIdxs,
+		EnumInfos:         file_dnc_v1_dnc_proto_enumTypes,
+		MessageInfos:      file_dnc_v1_dnc_proto_msgTypes,
+	}.Build()
+	File_dnc_v1_dnc_proto = out.File
+	file_dnc_v1_dnc_proto_goTypes = nil
+	file_dnc_v1_dnc_proto_depIdxs = nil
+}
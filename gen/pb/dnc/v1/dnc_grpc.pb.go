@@ -0,0 +1,239 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: dnc/v1/dnc.proto
+
+package dncv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DncService_AddDnc_FullMethodName    = "/dnc.v1.DncService/AddDnc"
+	DncService_RemoveDnc_FullMethodName = "/dnc.v1.DncService/RemoveDnc"
+	DncService_ImportDnc_FullMethodName = "/dnc.v1.DncService/ImportDnc"
+	DncService_ListDnc_FullMethodName   = "/dnc.v1.DncService/ListDnc"
+)
+
+// DncServiceClient is the client API for DncService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 架電禁止リスト
+type DncServiceClient interface {
+	AddDnc(ctx context.Context, in *AddDncRequest, opts ...grpc.CallOption) (*AddDncResponse, error)
+	RemoveDnc(ctx context.Context, in *RemoveDncRequest, opts ...grpc.CallOption) (*RemoveDncResponse, error)
+	ImportDnc(ctx context.Context, in *ImportDncRequest, opts ...grpc.CallOption) (*ImportDncResponse, error)
+	ListDnc(ctx context.Context, in *ListDncRequest, opts ...grpc.CallOption) (*ListDncResponse, error)
+}
+
+type dncServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDncServiceClient(cc grpc.ClientConnInterface) DncServiceClient {
+	return &dncServiceClient{cc}
+}
+
+func (c *dncServiceClient) AddDnc(ctx context.Context, in *AddDncRequest, opts ...grpc.CallOption) (*AddDncResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddDncResponse)
+	err := c.cc.Invoke(ctx, DncService_AddDnc_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dncServiceClient) RemoveDnc(ctx context.Context, in *RemoveDncRequest, opts ...grpc.CallOption) (*RemoveDncResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveDncResponse)
+	err := c.cc.Invoke(ctx, DncService_RemoveDnc_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dncServiceClient) ImportDnc(ctx context.Context, in *ImportDncRequest, opts ...grpc.CallOption) (*ImportDncResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportDncResponse)
+	err := c.cc.Invoke(ctx, DncService_ImportDnc_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dncServiceClient) ListDnc(ctx context.Context, in *ListDncRequest, opts ...grpc.CallOption) (*ListDncResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDncResponse)
+	err := c.cc.Invoke(ctx, DncService_ListDnc_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DncServiceServer is the server API for DncService service.
+// All implementations must embed UnimplementedDncServiceServer
+// for forward compatibility.
+//
+// 架電禁止リスト
+type DncServiceServer interface {
+	AddDnc(context.Context, *AddDncRequest) (*AddDncResponse, error)
+	RemoveDnc(context.Context, *RemoveDncRequest) (*RemoveDncResponse, error)
+	ImportDnc(context.Context, *ImportDncRequest) (*ImportDncResponse, error)
+	ListDnc(context.Context, *ListDncRequest) (*ListDncResponse, error)
+	mustEmbedUnimplementedDncServiceServer()
+}
+
+// UnimplementedDncServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDncServiceServer struct{}
+
+func (UnimplementedDncServiceServer) AddDnc(context.Context, *AddDncRequest) (*AddDncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDnc not implemented")
+}
+func (UnimplementedDncServiceServer) RemoveDnc(context.Context, *RemoveDncRequest) (*RemoveDncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDnc not implemented")
+}
+func (UnimplementedDncServiceServer) ImportDnc(context.Context, *ImportDncRequest) (*ImportDncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportDnc not implemented")
+}
+func (UnimplementedDncServiceServer) ListDnc(context.Context, *ListDncRequest) (*ListDncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDnc not implemented")
+}
+func (UnimplementedDncServiceServer) mustEmbedUnimplementedDncServiceServer() {}
+func (UnimplementedDncServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeDncServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DncServiceServer will
+// result in compilation errors.
+type UnsafeDncServiceServer interface {
+	mustEmbedUnimplementedDncServiceServer()
+}
+
+func RegisterDncServiceServer(s grpc.ServiceRegistrar, srv DncServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDncServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DncService_ServiceDesc, srv)
+}
+
+func _DncService_AddDnc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DncServiceServer).AddDnc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DncService_AddDnc_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DncServiceServer).AddDnc(ctx, req.(*AddDncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DncService_RemoveDnc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DncServiceServer).RemoveDnc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DncService_RemoveDnc_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DncServiceServer).RemoveDnc(ctx, req.(*RemoveDncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DncService_ImportDnc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportDncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DncServiceServer).ImportDnc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DncService_ImportDnc_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DncServiceServer).ImportDnc(ctx, req.(*ImportDncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DncService_ListDnc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DncServiceServer).ListDnc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DncService_ListDnc_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DncServiceServer).ListDnc(ctx, req.(*ListDncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DncService_ServiceDesc is the grpc.ServiceDesc for DncService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DncService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dnc.v1.DncService",
+	HandlerType: (*DncServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddDnc",
+			Handler:    _DncService_AddDnc_Handler,
+		},
+		{
+			MethodName: "RemoveDnc",
+			Handler:    _DncService_RemoveDnc_Handler,
+		},
+		{
+			MethodName: "ImportDnc",
+			Handler:    _DncService_ImportDnc_Handler,
+		},
+		{
+			MethodName: "ListDnc",
+			Handler:    _DncService_ListDnc_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dnc/v1/dnc.proto",
+}
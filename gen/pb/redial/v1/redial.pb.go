@@ -0,0 +1,632 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: redial/v1/redial.proto
+
+package redialv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Redial struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId  string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	UserId      string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ScheduledAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// 再架電を予約したユーザー
+	BookedBy      string                 `protobuf:"bytes,5,opt,name=booked_by,json=bookedBy,proto3" json:"booked_by,omitempty"`
+	BookedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=booked_at,json=bookedAt,proto3" json:"booked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Redial) Reset() {
+	*x = Redial{}
+	mi := &file_redial_v1_redial_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Redial) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Redial) ProtoMessage() {}
+
+func (x *Redial) ProtoReflect() protoreflect.Message {
+	mi := &file_redial_v1_redial_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Redial.ProtoReflect.Descriptor instead.
+func (*Redial) Descriptor() ([]byte, []int) {
+	return file_redial_v1_redial_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Redial) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *Redial) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Redial) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *Redial) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Redial) GetBookedBy() string {
+	if x != nil {
+		return x.BookedBy
+	}
+	return ""
+}
+
+func (x *Redial) GetBookedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BookedAt
+	}
+	return nil
+}
+
+type ScheduleRedialRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId  string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	ScheduledAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	// 省略した場合は呼び出したユーザー
+	UserId *string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	// 架電可能時間帯の外であれば次の架電可能時刻にずらす。false の場合はエラーにする
+	AutoShift     bool `protobuf:"varint,4,opt,name=auto_shift,json=autoShift,proto3" json:"auto_shift,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleRedialRequest) Reset() {
+	*x = ScheduleRedialRequest{}
+	mi := &file_redial_v1_redial_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleRedialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleRedialRequest) ProtoMessage() {}
+
+func (x *ScheduleRedialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_redial_v1_redial_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleRedialRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleRedialRequest) Descriptor() ([]byte, []int) {
+	return file_redial_v1_redial_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ScheduleRedialRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *ScheduleRedialRequest) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *ScheduleRedialRequest) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *ScheduleRedialRequest) GetAutoShift() bool {
+	if x != nil {
+		return x.AutoShift
+	}
+	return false
+}
+
+type ScheduleRedialResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Redial *Redial                `protobuf:"bytes,1,opt,name=redial,proto3" json:"redial,omitempty"`
+	// 架電可能時間帯に合わせて時刻をずらしたかどうか
+	Shifted       bool `protobuf:"varint,2,opt,name=shifted,proto3" json:"shifted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleRedialResponse) Reset() {
+	*x = ScheduleRedialResponse{}
+	mi := &file_redial_v1_redial_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleRedialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleRedialResponse) ProtoMessage() {}
+
+func (x *ScheduleRedialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_redial_v1_redial_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleRedialResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleRedialResponse) Descriptor() ([]byte, []int) {
+	return file_redial_v1_redial_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ScheduleRedialResponse) GetRedial() *Redial {
+	if x != nil {
+		return x.Redial
+	}
+	return nil
+}
+
+func (x *ScheduleRedialResponse) GetShifted() bool {
+	if x != nil {
+		return x.Shifted
+	}
+	return false
+}
+
+type GetRedialRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRedialRequest) Reset() {
+	*x = GetRedialRequest{}
+	mi := &file_redial_v1_redial_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRedialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRedialRequest) ProtoMessage() {}
+
+func (x *GetRedialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_redial_v1_redial_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRedialRequest.ProtoReflect.Descriptor instead.
+func (*GetRedialRequest) Descriptor() ([]byte, []int) {
+	return file_redial_v1_redial_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetRedialRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+type GetRedialResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Redial        *Redial                `protobuf:"bytes,1,opt,name=redial,proto3" json:"redial,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRedialResponse) Reset() {
+	*x = GetRedialResponse{}
+	mi := &file_redial_v1_redial_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRedialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRedialResponse) ProtoMessage() {}
+
+func (x *GetRedialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_redial_v1_redial_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRedialResponse.ProtoReflect.Descriptor instead.
+func (*GetRedialResponse) Descriptor() ([]byte, []int) {
+	return file_redial_v1_redial_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetRedialResponse) GetRedial() *Redial {
+	if x != nil {
+		return x.Redial
+	}
+	return nil
+}
+
+type ListRedialsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 省略した場合は呼び出したユーザー
+	UserId        *string                `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRedialsRequest) Reset() {
+	*x = ListRedialsRequest{}
+	mi := &file_redial_v1_redial_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRedialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRedialsRequest) ProtoMessage() {}
+
+func (x *ListRedialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_redial_v1_redial_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRedialsRequest.ProtoReflect.Descriptor instead.
+func (*ListRedialsRequest) Descriptor() ([]byte, []int) {
+	return file_redial_v1_redial_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListRedialsRequest) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *ListRedialsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListRedialsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type ListRedialsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Redials       []*Redial              `protobuf:"bytes,1,rep,name=redials,proto3" json:"redials,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRedialsResponse) Reset() {
+	*x = ListRedialsResponse{}
+	mi := &file_redial_v1_redial_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRedialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRedialsResponse) ProtoMessage() {}
+
+func (x *ListRedialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_redial_v1_redial_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRedialsResponse.ProtoReflect.Descriptor instead.
+func (*ListRedialsResponse) Descriptor() ([]byte, []int) {
+	return file_redial_v1_redial_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListRedialsResponse) GetRedials() []*Redial {
+	if x != nil {
+		return x.Redials
+	}
+	return nil
+}
+
+type DeleteRedialRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRedialRequest) Reset() {
+	*x = DeleteRedialRequest{}
+	mi := &file_redial_v1_redial_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRedialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRedialRequest) ProtoMessage() {}
+
+func (x *DeleteRedialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_redial_v1_redial_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRedialRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRedialRequest) Descriptor() ([]byte, []int) {
+	return file_redial_v1_redial_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteRedialRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+type DeleteRedialResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRedialResponse) Reset() {
+	*x = DeleteRedialResponse{}
+	mi := &file_redial_v1_redial_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRedialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRedialResponse) ProtoMessage() {}
+
+func (x *DeleteRedialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_redial_v1_redial_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRedialResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRedialResponse) Descriptor() ([]byte, []int) {
+	return file_redial_v1_redial_proto_rawDescGZIP(), []int{8}
+}
+
+var File_redial_v1_redial_proto protoreflect.FileDescriptor
+
+const file_redial_v1_redial_proto_rawDesc = "" +
+	"\n" +
+	"\x16redial/v1/redial.proto\x12\tredial.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x92\x02\n" +
+	"\x06Redial\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12=\n" +
+	"\fscheduled_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1b\n" +
+	"\tbooked_by\x18\x05 \x01(\tR\bbookedBy\x127\n" +
+	"\tbooked_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bbookedAt\"\xc0\x01\n" +
+	"\x15ScheduleRedialRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12=\n" +
+	"\fscheduled_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\x12\x1c\n" +
+	"\auser_id\x18\x03 \x01(\tH\x00R\x06userId\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"auto_shift\x18\x04 \x01(\bR\tautoShiftB\n" +
+	"\n" +
+	"\b_user_id\"]\n" +
+	"\x16ScheduleRedialResponse\x12)\n" +
+	"\x06redial\x18\x01 \x01(\v2\x11.redial.v1.RedialR\x06redial\x12\x18\n" +
+	"\ashifted\x18\x02 \x01(\bR\ashifted\"3\n" +
+	"\x10GetRedialRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\">\n" +
+	"\x11GetRedialResponse\x12)\n" +
+	"\x06redial\x18\x01 \x01(\v2\x11.redial.v1.RedialR\x06redial\"\x9a\x01\n" +
+	"\x12ListRedialsRequest\x12\x1c\n" +
+	"\auser_id\x18\x01 \x01(\tH\x00R\x06userId\x88\x01\x01\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02toB\n" +
+	"\n" +
+	"\b_user_id\"B\n" +
+	"\x13ListRedialsResponse\x12+\n" +
+	"\aredials\x18\x01 \x03(\v2\x11.redial.v1.RedialR\aredials\"6\n" +
+	"\x13DeleteRedialRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\"\x16\n" +
+	"\x14DeleteRedialResponse2\xce\x03\n" +
+	"\rRedialService\x12{\n" +
+	"\x0eScheduleRedial\x12 .redial.v1.ScheduleRedialRequest\x1a!.redial.v1.ScheduleRedialResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\x1a\x19/v1/redials/{customer_id}\x12i\n" +
+	"\tGetRedial\x12\x1b.redial.v1.GetRedialRequest\x1a\x1c.redial.v1.GetRedialResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/redials/{customer_id}\x12a\n" +
+	"\vListRedials\x12\x1d.redial.v1.ListRedialsRequest\x1a\x1e.redial.v1.ListRedialsResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/redials\x12r\n" +
+	"\fDeleteRedial\x12\x1e.redial.v1.DeleteRedialRequest\x1a\x1f.redial.v1.DeleteRedialResponse\"!\x82\xd3\xe4\x93\x02\x1b*\x19/v1/redials/{customer_id}B\xa2\x01\n" +
+	"\rcom.redial.v1B\vRedialProtoP\x01Z?github.com/0utl1er-tech/prism-backend/gen/pb/redial/v1;redialv1\xa2\x02\x03RXX\xaa\x02\tRedial.V1\xca\x02\tRedial\\V1\xe2\x02\x15Redial\\V1\\GPBMetadata\xea\x02\n" +
+	"Redial::V1b\x06proto3"
+
+var (
+	file_redial_v1_redial_proto_rawDescOnce sync.Once
+	file_redial_v1_redial_proto_rawDescData []byte
+)
+
+func file_redial_v1_redial_proto_rawDescGZIP() []byte {
+	file_redial_v1_redial_proto_rawDescOnce.Do(func() {
+		file_redial_v1_redial_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_redial_v1_redial_proto_rawDesc), len(file_redial_v1_redial_proto_rawDesc)))
+	})
+	return file_redial_v1_redial_proto_rawDescData
+}
+
+var file_redial_v1_redial_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_redial_v1_redial_proto_goTypes = []any{
+	(*Redial)(nil),                 // 0: redial.v1.Redial
+	(*ScheduleRedialRequest)(nil),  // 1: redial.v1.ScheduleRedialRequest
+	(*ScheduleRedialResponse)(nil), // 2: redial.v1.ScheduleRedialResponse
+	(*GetRedialRequest)(nil),       // 3: redial.v1.GetRedialRequest
+	(*GetRedialResponse)(nil),      // 4: redial.v1.GetRedialResponse
+	(*ListRedialsRequest)(nil),     // 5: redial.v1.ListRedialsRequest
+	(*ListRedialsResponse)(nil),    // 6: redial.v1.ListRedialsResponse
+	(*DeleteRedialRequest)(nil),    // 7: redial.v1.DeleteRedialRequest
+	(*DeleteRedialResponse)(nil),   // 8: redial.v1.DeleteRedialResponse
+	(*timestamppb.Timestamp)(nil),  // 9: google.protobuf.Timestamp
+}
+var file_redial_v1_redial_proto_depIdxs = []int32{
+	9,  // 0: redial.v1.Redial.scheduled_at:type_name -> google.protobuf.Timestamp
+	9,  // 1: redial.v1.Redial.created_at:type_name -> google.protobuf.Timestamp
+	9,  // 2: redial.v1.Redial.booked_at:type_name -> google.protobuf.Timestamp
+	9,  // 3: redial.v1.ScheduleRedialRequest.scheduled_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: redial.v1.ScheduleRedialResponse.redial:type_name -> redial.v1.Redial
+	0,  // 5: redial.v1.GetRedialResponse.redial:type_name -> redial.v1.Redial
+	9,  // 6: redial.v1.ListRedialsRequest.from:type_name -> google.protobuf.Timestamp
+	9,  // 7: redial.v1.ListRedialsRequest.to:type_name -> google.protobuf.Timestamp
+	0,  // 8: redial.v1.ListRedialsResponse.redials:type_name -> redial.v1.Redial
+	1,  // 9: redial.v1.RedialService.ScheduleRedial:input_type -> redial.v1.ScheduleRedialRequest
+	3,  // 10: redial.v1.RedialService.GetRedial:input_type -> redial.v1.GetRedialRequest
+	5,  // 11: redial.v1.RedialService.ListRedials:input_type -> redial.v1.ListRedialsRequest
+	7,  // 12: redial.v1.RedialService.DeleteRedial:input_type -> redial.v1.DeleteRedialRequest
+	2,  // 13: redial.v1.RedialService.ScheduleRedial:output_type -> redial.v1.ScheduleRedialResponse
+	4,  // 14: redial.v1.RedialService.GetRedial:output_type -> redial.v1.GetRedialResponse
+	6,  // 15: redial.v1.RedialService.ListRedials:output_type -> redial.v1.ListRedialsResponse
+	8,  // 16: redial.v1.RedialService.DeleteRedial:output_type -> redial.v1.DeleteRedialResponse
+	13, // [13:17] is the sub-list for method output_type
+	9,  // [9:13] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_redial_v1_redial_proto_init() }
+func file_redial_v1_redial_proto_init() {
+	if File_redial_v1_redial_proto != nil {
+		return
+	}
+	file_redial_v1_redial_proto_msgTypes[1].OneofWrappers = []any{}
+	file_redial_v1_redial_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_redial_v1_redial_proto_rawDesc), len(file_redial_v1_redial_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_redial_v1_redial_proto_goTypes,
+		DependencyIndexes: file_redial_v1_redial_proto_depIdxs,
+		MessageInfos:      file_redial_v1_redial_proto_msgTypes,
+	}.Build()
+	File_redial_v1_redial_proto = out.File
+	file_redial_v1_redial_proto_goTypes = nil
+	file_redial_v1_redial_proto_depIdxs = nil
+}
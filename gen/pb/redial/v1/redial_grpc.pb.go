@@ -0,0 +1,237 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: redial/v1/redial.proto
+
+package redialv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RedialService_ScheduleRedial_FullMethodName = "/redial.v1.RedialService/ScheduleRedial"
+	RedialService_GetRedial_FullMethodName      = "/redial.v1.RedialService/GetRedial"
+	RedialService_ListRedials_FullMethodName    = "/redial.v1.RedialService/ListRedials"
+	RedialService_DeleteRedial_FullMethodName   = "/redial.v1.RedialService/DeleteRedial"
+)
+
+// RedialServiceClient is the client API for RedialService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RedialServiceClient interface {
+	// 再架電を予約する。顧客ごとに1件で、既にあれば上書きする
+	ScheduleRedial(ctx context.Context, in *ScheduleRedialRequest, opts ...grpc.CallOption) (*ScheduleRedialResponse, error)
+	GetRedial(ctx context.Context, in *GetRedialRequest, opts ...grpc.CallOption) (*GetRedialResponse, error)
+	ListRedials(ctx context.Context, in *ListRedialsRequest, opts ...grpc.CallOption) (*ListRedialsResponse, error)
+	DeleteRedial(ctx context.Context, in *DeleteRedialRequest, opts ...grpc.CallOption) (*DeleteRedialResponse, error)
+}
+
+type redialServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRedialServiceClient(cc grpc.ClientConnInterface) RedialServiceClient {
+	return &redialServiceClient{cc}
+}
+
+func (c *redialServiceClient) ScheduleRedial(ctx context.Context, in *ScheduleRedialRequest, opts ...grpc.CallOption) (*ScheduleRedialResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleRedialResponse)
+	err := c.cc.Invoke(ctx, RedialService_ScheduleRedial_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redialServiceClient) GetRedial(ctx context.Context, in *GetRedialRequest, opts ...grpc.CallOption) (*GetRedialResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRedialResponse)
+	err := c.cc.Invoke(ctx, RedialService_GetRedial_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redialServiceClient) ListRedials(ctx context.Context, in *ListRedialsRequest, opts ...grpc.CallOption) (*ListRedialsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRedialsResponse)
+	err := c.cc.Invoke(ctx, RedialService_ListRedials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redialServiceClient) DeleteRedial(ctx context.Context, in *DeleteRedialRequest, opts ...grpc.CallOption) (*DeleteRedialResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRedialResponse)
+	err := c.cc.Invoke(ctx, RedialService_DeleteRedial_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RedialServiceServer is the server API for RedialService service.
+// All implementations must embed UnimplementedRedialServiceServer
+// for forward compatibility.
+type RedialServiceServer interface {
+	// 再架電を予約する。顧客ごとに1件で、既にあれば上書きする
+	ScheduleRedial(context.Context, *ScheduleRedialRequest) (*ScheduleRedialResponse, error)
+	GetRedial(context.Context, *GetRedialRequest) (*GetRedialResponse, error)
+	ListRedials(context.Context, *ListRedialsRequest) (*ListRedialsResponse, error)
+	DeleteRedial(context.Context, *DeleteRedialRequest) (*DeleteRedialResponse, error)
+	mustEmbedUnimplementedRedialServiceServer()
+}
+
+// UnimplementedRedialServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRedialServiceServer struct{}
+
+func (UnimplementedRedialServiceServer) ScheduleRedial(context.Context, *ScheduleRedialRequest) (*ScheduleRedialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleRedial not implemented")
+}
+func (UnimplementedRedialServiceServer) GetRedial(context.Context, *GetRedialRequest) (*GetRedialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRedial not implemented")
+}
+func (UnimplementedRedialServiceServer) ListRedials(context.Context, *ListRedialsRequest) (*ListRedialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRedials not implemented")
+}
+func (UnimplementedRedialServiceServer) DeleteRedial(context.Context, *DeleteRedialRequest) (*DeleteRedialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRedial not implemented")
+}
+func (UnimplementedRedialServiceServer) mustEmbedUnimplementedRedialServiceServer() {}
+func (UnimplementedRedialServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeRedialServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RedialServiceServer will
+// result in compilation errors.
+type UnsafeRedialServiceServer interface {
+	mustEmbedUnimplementedRedialServiceServer()
+}
+
+func RegisterRedialServiceServer(s grpc.ServiceRegistrar, srv RedialServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRedialServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RedialService_ServiceDesc, srv)
+}
+
+func _RedialService_ScheduleRedial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleRedialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedialServiceServer).ScheduleRedial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RedialService_ScheduleRedial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedialServiceServer).ScheduleRedial(ctx, req.(*ScheduleRedialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RedialService_GetRedial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRedialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedialServiceServer).GetRedial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RedialService_GetRedial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedialServiceServer).GetRedial(ctx, req.(*GetRedialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RedialService_ListRedials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRedialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedialServiceServer).ListRedials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RedialService_ListRedials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedialServiceServer).ListRedials(ctx, req.(*ListRedialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RedialService_DeleteRedial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRedialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedialServiceServer).DeleteRedial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RedialService_DeleteRedial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedialServiceServer).DeleteRedial(ctx, req.(*DeleteRedialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RedialService_ServiceDesc is the grpc.ServiceDesc for RedialService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RedialService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "redial.v1.RedialService",
+	HandlerType: (*RedialServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ScheduleRedial",
+			Handler:    _RedialService_ScheduleRedial_Handler,
+		},
+		{
+			MethodName: "GetRedial",
+			Handler:    _RedialService_GetRedial_Handler,
+		},
+		{
+			MethodName: "ListRedials",
+			Handler:    _RedialService_ListRedials_Handler,
+		},
+		{
+			MethodName: "DeleteRedial",
+			Handler:    _RedialService_DeleteRedial_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "redial/v1/redial.proto",
+}
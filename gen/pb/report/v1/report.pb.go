@@ -0,0 +1,1546 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: report/v1/report.proto
+
+package reportv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Period int32
+
+const (
+	Period_PERIOD_UNSPECIFIED Period = 0
+	Period_PERIOD_TODAY       Period = 1
+	// 月曜日始まり
+	Period_PERIOD_THIS_WEEK  Period = 2
+	Period_PERIOD_THIS_MONTH Period = 3
+	// from と to で期間を指定する
+	Period_PERIOD_CUSTOM Period = 4
+)
+
+// Enum value maps for Period.
+var (
+	Period_name = map[int32]string{
+		0: "PERIOD_UNSPECIFIED",
+		1: "PERIOD_TODAY",
+		2: "PERIOD_THIS_WEEK",
+		3: "PERIOD_THIS_MONTH",
+		4: "PERIOD_CUSTOM",
+	}
+	Period_value = map[string]int32{
+		"PERIOD_UNSPECIFIED": 0,
+		"PERIOD_TODAY":       1,
+		"PERIOD_THIS_WEEK":   2,
+		"PERIOD_THIS_MONTH":  3,
+		"PERIOD_CUSTOM":      4,
+	}
+)
+
+func (x Period) Enum() *Period {
+	p := new(Period)
+	*p = x
+	return p
+}
+
+func (x Period) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Period) Descriptor() protoreflect.EnumDescriptor {
+	return file_report_v1_report_proto_enumTypes[0].Descriptor()
+}
+
+func (Period) Type() protoreflect.EnumType {
+	return &file_report_v1_report_proto_enumTypes[0]
+}
+
+func (x Period) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Period.Descriptor instead.
+func (Period) EnumDescriptor() ([]byte, []int) {
+	return file_report_v1_report_proto_rawDescGZIP(), []int{0}
+}
+
+type RankBy int32
+
+const (
+	RankBy_RANK_BY_UNSPECIFIED RankBy = 0
+	RankBy_RANK_BY_CALLS       RankBy = 1
+	RankBy_RANK_BY_EFFECTIVE   RankBy = 2
+	RankBy_RANK_BY_NG          RankBy = 3
+	RankBy_RANK_BY_REDIALS     RankBy = 4
+)
+
+// Enum value maps for RankBy.
+var (
+	RankBy_name = map[int32]string{
+		0: "RANK_BY_UNSPECIFIED",
+		1: "RANK_BY_CALLS",
+		2: "RANK_BY_EFFECTIVE",
+		3: "RANK_BY_NG",
+		4: "RANK_BY_REDIALS",
+	}
+	RankBy_value = map[string]int32{
+		"RANK_BY_UNSPECIFIED": 0,
+		"RANK_BY_CALLS":       1,
+		"RANK_BY_EFFECTIVE":   2,
+		"RANK_BY_NG":          3,
+		"RANK_BY_REDIALS":     4,
+	}
+)
+
+func (x RankBy) Enum() *RankBy {
+	p := new(RankBy)
+	*p = x
+	return p
+}
+
+func (x RankBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RankBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_report_v1_report_proto_enumTypes[1].Descriptor()
+}
+
+func (RankBy) Type() protoreflect.EnumType {
+	return &file_report_v1_report_proto_enumTypes[1]
+}
+
+func (x RankBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RankBy.Descriptor instead.
+func (RankBy) EnumDescriptor() ([]byte, []int) {
+	return file_report_v1_report_proto_rawDescGZIP(), []int{1}
+}
+
+type ActivityType int32
+
+const (
+	ActivityType_ACTIVITY_TYPE_UNSPECIFIED   ActivityType = 0
+	ActivityType_ACTIVITY_TYPE_CALL          ActivityType = 1
+	ActivityType_ACTIVITY_TYPE_REDIAL_BOOKED ActivityType = 2
+)
+
+// Enum value maps for ActivityType.
+var (
+	ActivityType_name = map[int32]string{
+		0: "ACTIVITY_TYPE_UNSPECIFIED",
+		1: "ACTIVITY_TYPE_CALL",
+		2: "ACTIVITY_TYPE_REDIAL_BOOKED",
+	}
+	ActivityType_value = map[string]int32{
+		"ACTIVITY_TYPE_UNSPECIFIED":   0,
+		"ACTIVITY_TYPE_CALL":          1,
+		"ACTIVITY_TYPE_REDIAL_BOOKED": 2,
+	}
+)
+
+func (x ActivityType) Enum() *ActivityType {
+	p := new(ActivityType)
+	*p = x
+	return p
+}
+
+func (x ActivityType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ActivityType) Descriptor() protoreflect.EnumDescriptor {
+	return file_report_v1_report_proto_enumTypes[2].Descriptor()
+}
+
+func (ActivityType) Type() protoreflect.EnumType {
+	return &file_report_v1_report_proto_enumTypes[2]
+}
+
+func (x ActivityType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ActivityType.Descriptor instead.
+func (ActivityType) EnumDescriptor() ([]byte, []int) {
+	return file_report_v1_report_proto_rawDescGZIP(), []int{2}
+}
+
+type ReportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 集計期間の開始。省略した場合は to の30日前
+	From *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// 集計期間の終了(含まない)。省略した場合は現在時刻
+	To     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	BookId *string                `protobuf:"bytes,3,opt,name=book_id,json=bookId,proto3,oneof" json:"book_id,omitempty"`
+	UserId *string                `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	// 日別・時間帯別の集計に使うタイムゾーン。省略した場合は Asia/Tokyo
+	Timezone      string `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportRequest) Reset() {
+	*x = ReportRequest{}
+	mi := &file_report_v1_report_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportRequest) ProtoMessage() {}
+
+func (x *ReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_report_v1_report_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportRequest.ProtoReflect.Descriptor instead.
+func (*ReportRequest) Descriptor() ([]byte, []int) {
+	return file_report_v1_report_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReportRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ReportRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ReportRequest) GetBookId() string {
+	if x != nil && x.BookId != nil {
+		return *x.BookId
+	}
+	return ""
+}
+
+func (x *ReportRequest) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *ReportRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type CallStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Calls int64                  `protobuf:"varint,1,opt,name=calls,proto3" json:"calls,omitempty"`
+	// 有効数としてカウントするステータスの架電数
+	Effective int64 `protobuf:"varint,2,opt,name=effective,proto3" json:"effective,omitempty"`
+	Ng        int64 `protobuf:"varint,3,opt,name=ng,proto3" json:"ng,omitempty"`
+	// 架電した顧客数
+	Customers int64 `protobuf:"varint,4,opt,name=customers,proto3" json:"customers,omitempty"`
+	// effective / calls
+	EffectiveRate float64 `protobuf:"fixed64,5,opt,name=effective_rate,json=effectiveRate,proto3" json:"effective_rate,omitempty"`
+	// ng / calls
+	NgRate        float64 `protobuf:"fixed64,6,opt,name=ng_rate,json=ngRate,proto3" json:"ng_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CallStats) Reset() {
+	*x = CallStats{}
+	mi := &file_report_v1_report_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallStats) ProtoMessage() {}
+
+func (x *CallStats) ProtoReflect() protoreflect.Message {
+	mi := &file_report_v1_report_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallStats.ProtoReflect.Descriptor instead.
+func (*CallStats) Descriptor() ([]byte, []int) {
+	return file_report_v1_report_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CallStats) GetCalls() int64 {
+	if x != nil {
+		return x.Calls
+	}
+	return 0
+}
+
+func (x *CallStats) GetEffective() int64 {
+	if x != nil {
+		return x.Effective
+	}
+	return 0
+}
+
+func (x *CallStats) GetNg() int64 {
+	if x != nil {
+		return x.Ng
+	}
+	return 0
+}
+
+func (x *CallStats) GetCustomers() int64 {
+	if x != nil {
+		return x.Customers
+	}
+	return 0
+}
+
+func (x *CallStats) GetEffectiveRate() float64 {
+	if x != nil {
+		return x.EffectiveRate
+	}
+	return 0
+}
+
+func (x *CallStats) GetNgRate() float64 {
+	if x != nil {
+		return x.NgRate
+	}
+	return 0
+}
+
+type BookStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookId        string                 `protobuf:"bytes,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	BookName      string                 `protobuf:"bytes,2,opt,name=book_name,json=bookName,proto3" json:"book_name,omitempty"`
+	Stats         *CallStats             `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookStats) Reset() {
+	*x = BookStats{}
+	mi := &file_report_v1_report_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookStats) ProtoMessage() {}
+
+func (x *BookStats) ProtoReflect() protoreflect.Message {
+	mi := &file_report_v1_report_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookStats.ProtoReflect.Descriptor instead.
+func (*BookStats) Descriptor() ([]byte, []int) {
+	return file_report_v1_report_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BookStats) GetBookId() string {
+	if x != nil {
+		return x.BookId
+	}
+	return ""
+}
+
+func (x *BookStats) GetBookName() string {
+	if x != nil {
+		return x.BookName
+	}
+	return ""
+}
+
+func (x *BookStats) GetStats() *CallStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type UserStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName      string                 `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	Stats         *CallStats             `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserStats) Reset() {
+	*x = UserStats{}
+	mi := &file_report_v1_report_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserStats) ProtoMessage() {}
+
+func (x *UserStats) ProtoReflect() protoreflect.Message {
+	mi := &file_report_v1_report_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserStats.ProtoReflect.Descriptor instead.
+func (*UserStats) Descriptor() ([]byte, []int) {
+	return file_report_v1_report_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UserStats) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserStats) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *UserStats) GetStats() *CallStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type DailyStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// YYYY-MM-DD
+	Date          string     `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Stats         *CallStats `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailyStats) Reset() {
+	*x = DailyStats{}
+	mi := &file_report_v1_report_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyStats) ProtoMessage() {}
+
+func (x *DailyStats) ProtoReflect() protoreflect.Message {
+	mi := &file_report_v1_report_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyStats.ProtoReflect.Descriptor instead.
+func (*DailyStats) Descriptor() ([]byte, []int) {
+	return file_report_v1_report_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DailyStats) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DailyStats) GetStats() *CallStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type HourlyStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hour          int32                  `protobuf:"varint,1,opt,name=hour,proto3" json:"hour,omitempty"`
+	Stats         *CallStats             `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HourlyStats) Reset() {
+	*x = HourlyStats{}
+	mi := &file_report_v1_report_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HourlyStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HourlyStats) ProtoMessage() {}
+
+func (x *HourlyStats) ProtoReflect() protoreflect.Message {
+	mi := &file_report_v1_report_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HourlyStats.ProtoReflect.Descriptor instead.
+func (*HourlyStats) Descriptor() ([]byte, []int) {
+	return file_report_v1_report_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *HourlyStats) GetHour() int32 {
+	if x != nil {
+		return x.Hour
+	}
+	return 0
+}
+
+func (x *HourlyStats) GetStats() *CallStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type GetBookReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Books         []*BookStats           `protobuf:"bytes,1,rep,name=books,proto3" json:"books,omitempty"`
+	Total         *CallStats             `protobuf:"bytes,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookReportResponse) Reset() {
+	*x = GetBookReportResponse{}
+	mi := &file_report_v1_report_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookReportResponse) ProtoMessage() {}
+
+func (x *GetBookReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_report_v1_report_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookReportResponse.ProtoReflect.Descriptor instead.
+func (*GetBookReportResponse) Descriptor() ([]byte, []int) {
+	return file_report_v1_report_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetBookReportResponse) GetBooks() []*BookStats {
+	if x != nil {
+		return x.Books
+	}
+	return nil
+}
+
+func (x *GetBookReportResponse) GetTotal() *CallStats {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+type GetUserReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*UserStats           `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Total         *CallStats             `protobuf:"bytes,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserReportResponse) Reset() {
+	*x = GetUserReportResponse{}
+	mi := &file_report_v1_report_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserReportResponse) ProtoMessage() {}
+
+func (x *GetUserReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_report_v1_report_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserReportResponse.ProtoReflect.Descriptor instead.
+func (*GetUserReportResponse) Descriptor() ([]byte, []int) {
+	return file_report_v1_report_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetUserReportResponse) GetUsers() []*UserStats {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *GetUserReportResponse) GetTotal() *CallStats {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+type GetDailyReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          []*DailyStats          `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
+	Total         *CallStats             `protobuf:"bytes,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDailyReportResponse) Reset() {
+	*x = GetDailyReportResponse{}
+	mi := &file_report_v1_report_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDailyReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyReportResponse) ProtoMessage() {}
+
+func (x *GetDailyReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_report_v1_report_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDailyReportResponse.ProtoReflect.Descriptor instead.
+func (*GetDailyReportResponse) Descriptor() ([]byte, []int) {
+	return file_report_v1_report_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetDailyReportResponse) GetDays() []*DailyStats {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *GetDailyReportResponse) GetTotal() *CallStats {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+type GetHourlyReportResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0〜23時の24件
+	Hours         []*HourlyStats `protobuf:"bytes,1,rep,name=hours,proto3" json:"hours,omitempty"`
+	Total         *CallStats     `protobuf:"bytes,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHourlyReportResponse) Reset() {
+	*x = GetHourlyReportResponse{}
+	mi := &file_report_v1_report_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHourlyReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHourlyReportResponse) ProtoMessage() {}
+
+func (x *GetHourlyReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_report_v1_report_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHourlyReportResponse.ProtoReflect.Descriptor instead.
+func (*GetHourlyReportResponse) Descriptor() ([]byte, []int) {
+	return file_report_v1_report_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetHourlyReportResponse) GetHours() []*HourlyStats {
+	if x != nil {
+		return x.Hours
+	}
+	return nil
+}
+
+func (x *GetHourlyReportResponse) GetTotal() *CallStats {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+type GetLeaderboardRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 省略した場合は今日
+	Period Period                 `protobuf:"varint,1,opt,name=period,proto3,enum=report.v1.Period" json:"period,omitempty"`
+	From   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// 省略した場合は架電数
+	RankBy RankBy  `protobuf:"varint,4,opt,name=rank_by,json=rankBy,proto3,enum=report.v1.RankBy" json:"rank_by,omitempty"`
+	BookId *string `protobuf:"bytes,5,opt,name=book_id,json=bookId,proto3,oneof" json:"book_id,omitempty"`
+	// 省略した場合は Asia/Tokyo
+	Timezone string `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// 0の場合は全員
+	Limit         int32 `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeaderboardRequest) Reset() {
+	*x = GetLeaderboardRequest{}
+	mi := &file_report_v1_report_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_report_v1_report_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_report_v1_report_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetLeaderboardRequest) GetPeriod() Period {
+	if x != nil {
+		return x.Period
+	}
+	return Period_PERIOD_UNSPECIFIED
+}
+
+func (x *GetLeaderboardRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetLeaderboardRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetLeaderboardRequest) GetRankBy() RankBy {
+	if x != nil {
+		return x.RankBy
+	}
+	return RankBy_RANK_BY_UNSPECIFIED
+}
+
+func (x *GetLeaderboardRequest) GetBookId() string {
+	if x != nil && x.BookId != nil {
+		return *x.BookId
+	}
+	return ""
+}
+
+func (x *GetLeaderboardRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetLeaderboardRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type LeaderboardEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 同じ値のユーザーは同じ順位になる
+	Rank      int32  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	UserId    string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName  string `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	Calls     int64  `protobuf:"varint,4,opt,name=calls,proto3" json:"calls,omitempty"`
+	Effective int64  `protobuf:"varint,5,opt,name=effective,proto3" json:"effective,omitempty"`
+	Ng        int64  `protobuf:"varint,6,opt,name=ng,proto3" json:"ng,omitempty"`
+	// 予約した再架電の件数
+	Redials       int64 `protobuf:"varint,7,opt,name=redials,proto3" json:"redials,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaderboardEntry) Reset() {
+	*x = LeaderboardEntry{}
+	mi := &file_report_v1_report_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardEntry) ProtoMessage() {}
+
+func (x *LeaderboardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_report_v1_report_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardEntry.ProtoReflect.Descriptor instead.
+func (*LeaderboardEntry) Descriptor() ([]byte, []int) {
+	return file_report_v1_report_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *LeaderboardEntry) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LeaderboardEntry) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *LeaderboardEntry) GetCalls() int64 {
+	if x != nil {
+		return x.Calls
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetEffective() int64 {
+	if x != nil {
+		return x.Effective
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetNg() int64 {
+	if x != nil {
+		return x.Ng
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetRedials() int64 {
+	if x != nil {
+		return x.Redials
+	}
+	return 0
+}
+
+type GetLeaderboardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*LeaderboardEntry    `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeaderboardResponse) Reset() {
+	*x = GetLeaderboardResponse{}
+	mi := &file_report_v1_report_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboardResponse) ProtoMessage() {}
+
+func (x *GetLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_report_v1_report_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_report_v1_report_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetLeaderboardResponse) GetEntries() []*LeaderboardEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetLeaderboardResponse) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetLeaderboardResponse) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type GetUserActivityRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// YYYY-MM-DD。省略した場合は今日
+	Date string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	// 省略した場合は Asia/Tokyo
+	Timezone      string `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserActivityRequest) Reset() {
+	*x = GetUserActivityRequest{}
+	mi := &file_report_v1_report_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserActivityRequest) ProtoMessage() {}
+
+func (x *GetUserActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_report_v1_report_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserActivityRequest.ProtoReflect.Descriptor instead.
+func (*GetUserActivityRequest) Descriptor() ([]byte, []int) {
+	return file_report_v1_report_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetUserActivityRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUserActivityRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetUserActivityRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type Activity struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Type         ActivityType           `protobuf:"varint,1,opt,name=type,proto3,enum=report.v1.ActivityType" json:"type,omitempty"`
+	Time         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	CustomerId   string                 `protobuf:"bytes,3,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	CustomerName string                 `protobuf:"bytes,4,opt,name=customer_name,json=customerName,proto3" json:"customer_name,omitempty"`
+	// ACTIVITY_TYPE_CALL のみ
+	CallId     string `protobuf:"bytes,5,opt,name=call_id,json=callId,proto3" json:"call_id,omitempty"`
+	StatusName string `protobuf:"bytes,6,opt,name=status_name,json=statusName,proto3" json:"status_name,omitempty"`
+	Effective  bool   `protobuf:"varint,7,opt,name=effective,proto3" json:"effective,omitempty"`
+	Ng         bool   `protobuf:"varint,8,opt,name=ng,proto3" json:"ng,omitempty"`
+	// ACTIVITY_TYPE_REDIAL_BOOKED のみ
+	RedialScheduledAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=redial_scheduled_at,json=redialScheduledAt,proto3" json:"redial_scheduled_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Activity) Reset() {
+	*x = Activity{}
+	mi := &file_report_v1_report_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Activity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Activity) ProtoMessage() {}
+
+func (x *Activity) ProtoReflect() protoreflect.Message {
+	mi := &file_report_v1_report_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Activity.ProtoReflect.Descriptor instead.
+func (*Activity) Descriptor() ([]byte, []int) {
+	return file_report_v1_report_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Activity) GetType() ActivityType {
+	if x != nil {
+		return x.Type
+	}
+	return ActivityType_ACTIVITY_TYPE_UNSPECIFIED
+}
+
+func (x *Activity) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *Activity) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *Activity) GetCustomerName() string {
+	if x != nil {
+		return x.CustomerName
+	}
+	return ""
+}
+
+func (x *Activity) GetCallId() string {
+	if x != nil {
+		return x.CallId
+	}
+	return ""
+}
+
+func (x *Activity) GetStatusName() string {
+	if x != nil {
+		return x.StatusName
+	}
+	return ""
+}
+
+func (x *Activity) GetEffective() bool {
+	if x != nil {
+		return x.Effective
+	}
+	return false
+}
+
+func (x *Activity) GetNg() bool {
+	if x != nil {
+		return x.Ng
+	}
+	return false
+}
+
+func (x *Activity) GetRedialScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RedialScheduledAt
+	}
+	return nil
+}
+
+type GetUserActivityResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName string                 `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	Date     string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	// 時刻順
+	Activities    []*Activity            `protobuf:"bytes,4,rep,name=activities,proto3" json:"activities,omitempty"`
+	Stats         *CallStats             `protobuf:"bytes,5,opt,name=stats,proto3" json:"stats,omitempty"`
+	Redials       int64                  `protobuf:"varint,6,opt,name=redials,proto3" json:"redials,omitempty"`
+	FirstCallAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=first_call_at,json=firstCallAt,proto3" json:"first_call_at,omitempty"`
+	LastCallAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_call_at,json=lastCallAt,proto3" json:"last_call_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserActivityResponse) Reset() {
+	*x = GetUserActivityResponse{}
+	mi := &file_report_v1_report_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserActivityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserActivityResponse) ProtoMessage() {}
+
+func (x *GetUserActivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_report_v1_report_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserActivityResponse.ProtoReflect.Descriptor instead.
+func (*GetUserActivityResponse) Descriptor() ([]byte, []int) {
+	return file_report_v1_report_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetUserActivityResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUserActivityResponse) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *GetUserActivityResponse) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetUserActivityResponse) GetActivities() []*Activity {
+	if x != nil {
+		return x.Activities
+	}
+	return nil
+}
+
+func (x *GetUserActivityResponse) GetStats() *CallStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *GetUserActivityResponse) GetRedials() int64 {
+	if x != nil {
+		return x.Redials
+	}
+	return 0
+}
+
+func (x *GetUserActivityResponse) GetFirstCallAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstCallAt
+	}
+	return nil
+}
+
+func (x *GetUserActivityResponse) GetLastCallAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastCallAt
+	}
+	return nil
+}
+
+var File_report_v1_report_proto protoreflect.FileDescriptor
+
+const file_report_v1_report_proto_rawDesc = "" +
+	"\n" +
+	"\x16report/v1/report.proto\x12\treport.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdb\x01\n" +
+	"\rReportRequest\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x1c\n" +
+	"\abook_id\x18\x03 \x01(\tH\x00R\x06bookId\x88\x01\x01\x12\x1c\n" +
+	"\auser_id\x18\x04 \x01(\tH\x01R\x06userId\x88\x01\x01\x12\x1a\n" +
+	"\btimezone\x18\x05 \x01(\tR\btimezoneB\n" +
+	"\n" +
+	"\b_book_idB\n" +
+	"\n" +
+	"\b_user_id\"\xad\x01\n" +
+	"\tCallStats\x12\x14\n" +
+	"\x05calls\x18\x01 \x01(\x03R\x05calls\x12\x1c\n" +
+	"\teffective\x18\x02 \x01(\x03R\teffective\x12\x0e\n" +
+	"\x02ng\x18\x03 \x01(\x03R\x02ng\x12\x1c\n" +
+	"\tcustomers\x18\x04 \x01(\x03R\tcustomers\x12%\n" +
+	"\x0eeffective_rate\x18\x05 \x01(\x01R\reffectiveRate\x12\x17\n" +
+	"\ang_rate\x18\x06 \x01(\x01R\x06ngRate\"m\n" +
+	"\tBookStats\x12\x17\n" +
+	"\abook_id\x18\x01 \x01(\tR\x06bookId\x12\x1b\n" +
+	"\tbook_name\x18\x02 \x01(\tR\bbookName\x12*\n" +
+	"\x05stats\x18\x03 \x01(\v2\x14.report.v1.CallStatsR\x05stats\"m\n" +
+	"\tUserStats\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x02 \x01(\tR\buserName\x12*\n" +
+	"\x05stats\x18\x03 \x01(\v2\x14.report.v1.CallStatsR\x05stats\"L\n" +
+	"\n" +
+	"DailyStats\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12*\n" +
+	"\x05stats\x18\x02 \x01(\v2\x14.report.v1.CallStatsR\x05stats\"M\n" +
+	"\vHourlyStats\x12\x12\n" +
+	"\x04hour\x18\x01 \x01(\x05R\x04hour\x12*\n" +
+	"\x05stats\x18\x02 \x01(\v2\x14.report.v1.CallStatsR\x05stats\"o\n" +
+	"\x15GetBookReportResponse\x12*\n" +
+	"\x05books\x18\x01 \x03(\v2\x14.report.v1.BookStatsR\x05books\x12*\n" +
+	"\x05total\x18\x02 \x01(\v2\x14.report.v1.CallStatsR\x05total\"o\n" +
+	"\x15GetUserReportResponse\x12*\n" +
+	"\x05users\x18\x01 \x03(\v2\x14.report.v1.UserStatsR\x05users\x12*\n" +
+	"\x05total\x18\x02 \x01(\v2\x14.report.v1.CallStatsR\x05total\"o\n" +
+	"\x16GetDailyReportResponse\x12)\n" +
+	"\x04days\x18\x01 \x03(\v2\x15.report.v1.DailyStatsR\x04days\x12*\n" +
+	"\x05total\x18\x02 \x01(\v2\x14.report.v1.CallStatsR\x05total\"s\n" +
+	"\x17GetHourlyReportResponse\x12,\n" +
+	"\x05hours\x18\x01 \x03(\v2\x16.report.v1.HourlyStatsR\x05hours\x12*\n" +
+	"\x05total\x18\x02 \x01(\v2\x14.report.v1.CallStatsR\x05total\"\xa6\x02\n" +
+	"\x15GetLeaderboardRequest\x12)\n" +
+	"\x06period\x18\x01 \x01(\x0e2\x11.report.v1.PeriodR\x06period\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12*\n" +
+	"\arank_by\x18\x04 \x01(\x0e2\x11.report.v1.RankByR\x06rankBy\x12\x1c\n" +
+	"\abook_id\x18\x05 \x01(\tH\x00R\x06bookId\x88\x01\x01\x12\x1a\n" +
+	"\btimezone\x18\x06 \x01(\tR\btimezone\x12\x14\n" +
+	"\x05limit\x18\a \x01(\x05R\x05limitB\n" +
+	"\n" +
+	"\b_book_id\"\xba\x01\n" +
+	"\x10LeaderboardEntry\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x05R\x04rank\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x03 \x01(\tR\buserName\x12\x14\n" +
+	"\x05calls\x18\x04 \x01(\x03R\x05calls\x12\x1c\n" +
+	"\teffective\x18\x05 \x01(\x03R\teffective\x12\x0e\n" +
+	"\x02ng\x18\x06 \x01(\x03R\x02ng\x12\x18\n" +
+	"\aredials\x18\a \x01(\x03R\aredials\"\xab\x01\n" +
+	"\x16GetLeaderboardResponse\x125\n" +
+	"\aentries\x18\x01 \x03(\v2\x1b.report.v1.LeaderboardEntryR\aentries\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"a\n" +
+	"\x16GetUserActivityRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x1a\n" +
+	"\btimezone\x18\x03 \x01(\tR\btimezone\"\xe1\x02\n" +
+	"\bActivity\x12+\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x17.report.v1.ActivityTypeR\x04type\x12.\n" +
+	"\x04time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x1f\n" +
+	"\vcustomer_id\x18\x03 \x01(\tR\n" +
+	"customerId\x12#\n" +
+	"\rcustomer_name\x18\x04 \x01(\tR\fcustomerName\x12\x17\n" +
+	"\acall_id\x18\x05 \x01(\tR\x06callId\x12\x1f\n" +
+	"\vstatus_name\x18\x06 \x01(\tR\n" +
+	"statusName\x12\x1c\n" +
+	"\teffective\x18\a \x01(\bR\teffective\x12\x0e\n" +
+	"\x02ng\x18\b \x01(\bR\x02ng\x12J\n" +
+	"\x13redial_scheduled_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x11redialScheduledAt\"\xdc\x02\n" +
+	"\x17GetUserActivityResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x02 \x01(\tR\buserName\x12\x12\n" +
+	"\x04date\x18\x03 \x01(\tR\x04date\x123\n" +
+	"\n" +
+	"activities\x18\x04 \x03(\v2\x13.report.v1.ActivityR\n" +
+	"activities\x12*\n" +
+	"\x05stats\x18\x05 \x01(\v2\x14.report.v1.CallStatsR\x05stats\x12\x18\n" +
+	"\aredials\x18\x06 \x01(\x03R\aredials\x12>\n" +
+	"\rfirst_call_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vfirstCallAt\x12<\n" +
+	"\flast_call_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastCallAt*r\n" +
+	"\x06Period\x12\x16\n" +
+	"\x12PERIOD_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fPERIOD_TODAY\x10\x01\x12\x14\n" +
+	"\x10PERIOD_THIS_WEEK\x10\x02\x12\x15\n" +
+	"\x11PERIOD_THIS_MONTH\x10\x03\x12\x11\n" +
+	"\rPERIOD_CUSTOM\x10\x04*p\n" +
+	"\x06RankBy\x12\x17\n" +
+	"\x13RANK_BY_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rRANK_BY_CALLS\x10\x01\x12\x15\n" +
+	"\x11RANK_BY_EFFECTIVE\x10\x02\x12\x0e\n" +
+	"\n" +
+	"RANK_BY_NG\x10\x03\x12\x13\n" +
+	"\x0fRANK_BY_REDIALS\x10\x04*f\n" +
+	"\fActivityType\x12\x1d\n" +
+	"\x19ACTIVITY_TYPE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12ACTIVITY_TYPE_CALL\x10\x01\x12\x1f\n" +
+	"\x1bACTIVITY_TYPE_REDIAL_BOOKED\x10\x022\xb7\x05\n" +
+	"\rReportService\x12f\n" +
+	"\rGetBookReport\x12\x18.report.v1.ReportRequest\x1a .report.v1.GetBookReportResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/reports/books\x12f\n" +
+	"\rGetUserReport\x12\x18.report.v1.ReportRequest\x1a .report.v1.GetUserReportResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/reports/users\x12h\n" +
+	"\x0eGetDailyReport\x12\x18.report.v1.ReportRequest\x1a!.report.v1.GetDailyReportResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/reports/daily\x12k\n" +
+	"\x0fGetHourlyReport\x12\x18.report.v1.ReportRequest\x1a\".report.v1.GetHourlyReportResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/reports/hourly\x12v\n" +
+	"\x0eGetLeaderboard\x12 .report.v1.GetLeaderboardRequest\x1a!.report.v1.GetLeaderboardResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/reports/leaderboard\x12\x86\x01\n" +
+	"\x0fGetUserActivity\x12!.report.v1.GetUserActivityRequest\x1a\".report.v1.GetUserActivityResponse\",\x82\xd3\xe4\x93\x02&\x12$/v1/reports/users/{user_id}/activityB\xa2\x01\n" +
+	"\rcom.report.v1B\vReportProtoP\x01Z?github.com/0utl1er-tech/prism-backend/gen/pb/report/v1;reportv1\xa2\x02\x03RXX\xaa\x02\tReport.V1\xca\x02\tReport\\V1\xe2\x02\x15Report\\V1\\GPBMetadata\xea\x02\n" +
+	"Report::V1b\x06proto3"
+
+var (
+	file_report_v1_report_proto_rawDescOnce sync.Once
+	file_report_v1_report_proto_rawDescData []byte
+)
+
+func file_report_v1_report_proto_rawDescGZIP() []byte {
+	file_report_v1_report_proto_rawDescOnce.Do(func() {
+		file_report_v1_report_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_report_v1_report_proto_rawDesc), len(file_report_v1_report_proto_rawDesc)))
+	})
+	return file_report_v1_report_proto_rawDescData
+}
+
+var file_report_v1_report_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_report_v1_report_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_report_v1_report_proto_goTypes = []any{
+	(Period)(0),                     // 0: report.v1.Period
+	(RankBy)(0),                     // 1: report.v1.RankBy
+	(ActivityType)(0),               // 2: report.v1.ActivityType
+	(*ReportRequest)(nil),           // 3: report.v1.ReportRequest
+	(*CallStats)(nil),               // 4: report.v1.CallStats
+	(*BookStats)(nil),               // 5: report.v1.BookStats
+	(*UserStats)(nil),               // 6: report.v1.UserStats
+	(*DailyStats)(nil),              // 7: report.v1.DailyStats
+	(*HourlyStats)(nil),             // 8: report.v1.HourlyStats
+	(*GetBookReportResponse)(nil),   // 9: report.v1.GetBookReportResponse
+	(*GetUserReportResponse)(nil),   // 10: report.v1.GetUserReportResponse
+	(*GetDailyReportResponse)(nil),  // 11: report.v1.GetDailyReportResponse
+	(*GetHourlyReportResponse)(nil), // 12: report.v1.GetHourlyReportResponse
+	(*GetLeaderboardRequest)(nil),   // 13: report.v1.GetLeaderboardRequest
+	(*LeaderboardEntry)(nil),        // 14: report.v1.LeaderboardEntry
+	(*GetLeaderboardResponse)(nil),  // 15: report.v1.GetLeaderboardResponse
+	(*GetUserActivityRequest)(nil),  // 16: report.v1.GetUserActivityRequest
+	(*Activity)(nil),                // 17: report.v1.Activity
+	(*GetUserActivityResponse)(nil), // 18: report.v1.GetUserActivityResponse
+	(*timestamppb.Timestamp)(nil),   // 19: google.protobuf.Timestamp
+}
+var file_report_v1_report_proto_depIdxs = []int32{
+	19, // 0: report.v1.ReportRequest.from:type_name -> google.protobuf.Timestamp
+	19, // 1: report.v1.ReportRequest.to:type_name -> google.protobuf.Timestamp
+	4,  // 2: report.v1.BookStats.stats:type_name -> report.v1.CallStats
+	4,  // 3: report.v1.UserStats.stats:type_name -> report.v1.CallStats
+	4,  // 4: report.v1.DailyStats.stats:type_name -> report.v1.CallStats
+	4,  // 5: report.v1.HourlyStats.stats:type_name -> report.v1.CallStats
+	5,  // 6: report.v1.GetBookReportResponse.books:type_name -> report.v1.BookStats
+	4,  // 7: report.v1.GetBookReportResponse.total:type_name -> report.v1.CallStats
+	6,  // 8: report.v1.GetUserReportResponse.users:type_name -> report.v1.UserStats
+	4,  // 9: report.v1.GetUserReportResponse.total:type_name -> report.v1.CallStats
+	7,  // 10: report.v1.GetDailyReportResponse.days:type_name -> report.v1.DailyStats
+	4,  // 11: report.v1.GetDailyReportResponse.total:type_name -> report.v1.CallStats
+	8,  // 12: report.v1.GetHourlyReportResponse.hours:type_name -> report.v1.HourlyStats
+	4,  // 13: report.v1.GetHourlyReportResponse.total:type_name -> report.v1.CallStats
+	0,  // 14: report.v1.GetLeaderboardRequest.period:type_name -> report.v1.Period
+	19, // 15: report.v1.GetLeaderboardRequest.from:type_name -> google.protobuf.Timestamp
+	19, // 16: report.v1.GetLeaderboardRequest.to:type_name -> google.protobuf.Timestamp
+	1,  // 17: report.v1.GetLeaderboardRequest.rank_by:type_name -> report.v1.RankBy
+	14, // 18: report.v1.GetLeaderboardResponse.entries:type_name -> report.v1.LeaderboardEntry
+	19, // 19: report.v1.GetLeaderboardResponse.from:type_name -> google.protobuf.Timestamp
+	19, // 20: report.v1.GetLeaderboardResponse.to:type_name -> google.protobuf.Timestamp
+	2,  // 21: report.v1.Activity.type:type_name -> report.v1.ActivityType
+	19, // 22: report.v1.Activity.time:type_name -> google.protobuf.Timestamp
+	19, // 23: report.v1.Activity.redial_scheduled_at:type_name -> google.protobuf.Timestamp
+	17, // 24: report.v1.GetUserActivityResponse.activities:type_name -> report.v1.Activity
+	4,  // 25: report.v1.GetUserActivityResponse.stats:type_name -> report.v1.CallStats
+	19, // 26: report.v1.GetUserActivityResponse.first_call_at:type_name -> google.protobuf.Timestamp
+	19, // 27: report.v1.GetUserActivityResponse.last_call_at:type_name -> google.protobuf.Timestamp
+	3,  // 28: report.v1.ReportService.GetBookReport:input_type -> report.v1.ReportRequest
+	3,  // 29: report.v1.ReportService.GetUserReport:input_type -> report.v1.ReportRequest
+	3,  // 30: report.v1.ReportService.GetDailyReport:input_type -> report.v1.ReportRequest
+	3,  // 31: report.v1.ReportService.GetHourlyReport:input_type -> report.v1.ReportRequest
+	13, // 32: report.v1.ReportService.GetLeaderboard:input_type -> report.v1.GetLeaderboardRequest
+	16, // 33: report.v1.ReportService.GetUserActivity:input_type -> report.v1.GetUserActivityRequest
+	9,  // 34: report.v1.ReportService.GetBookReport:output_type -> report.v1.GetBookReportResponse
+	10, // 35: report.v1.ReportService.GetUserReport:output_type -> report.v1.GetUserReportResponse
+	11, // 36: report.v1.ReportService.GetDailyReport:output_type -> report.v1.GetDailyReportResponse
+	12, // 37: report.v1.ReportService.GetHourlyReport:output_type -> report.v1.GetHourlyReportResponse
+	15, // 38: report.v1.ReportService.GetLeaderboard:output_type -> report.v1.GetLeaderboardResponse
+	18, // 39: report.v1.ReportService.GetUserActivity:output_type -> report.v1.GetUserActivityResponse
+	34, // [34:40] is the sub-list for method output_type
+	28, // [28:34] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
+}
+
+func init() { file_report_v1_report_proto_init() }
+func file_report_v1_report_proto_init() {
+	if File_report_v1_report_proto != nil {
+		return
+	}
+	file_report_v1_report_proto_msgTypes[0].OneofWrappers = []any{}
+	file_report_v1_report_proto_msgTypes[10].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_report_v1_report_proto_rawDesc), len(file_report_v1_report_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_report_v1_report_proto_goTypes,
+		DependencyIndexes: file_report_v1_report_proto_depIdxs,
+		EnumInfos:         file_report_v1_report_proto_enumTypes,
+		MessageInfos:      file_report_v1_report_proto_msgTypes,
+	}.Build()
+	File_report_v1_report_proto = out.File
+	file_report_v1_report_proto_goTypes = nil
+	file_report_v1_report_proto_depIdxs = nil
+}
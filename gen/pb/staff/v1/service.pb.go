@@ -7,6 +7,7 @@
 package staffv1
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -26,6 +27,7 @@ type Staff struct {
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Sex           string                 `protobuf:"bytes,3,opt,name=sex,proto3" json:"sex,omitempty"`
+	Etag          string                 `protobuf:"bytes,4,opt,name=etag,proto3" json:"etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -81,15 +83,241 @@ func (x *Staff) GetSex() string {
 	return ""
 }
 
+func (x *Staff) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type GetStaffRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStaffRequest) Reset() {
+	*x = GetStaffRequest{}
+	mi := &file_staff_v1_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStaffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStaffRequest) ProtoMessage() {}
+
+func (x *GetStaffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_v1_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStaffRequest.ProtoReflect.Descriptor instead.
+func (*GetStaffRequest) Descriptor() ([]byte, []int) {
+	return file_staff_v1_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetStaffRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetStaffResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Staff         *Staff                 `protobuf:"bytes,1,opt,name=staff,proto3" json:"staff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStaffResponse) Reset() {
+	*x = GetStaffResponse{}
+	mi := &file_staff_v1_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStaffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStaffResponse) ProtoMessage() {}
+
+func (x *GetStaffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_v1_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStaffResponse.ProtoReflect.Descriptor instead.
+func (*GetStaffResponse) Descriptor() ([]byte, []int) {
+	return file_staff_v1_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetStaffResponse) GetStaff() *Staff {
+	if x != nil {
+		return x.Staff
+	}
+	return nil
+}
+
+type UpdateStaffRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Sex   *string                `protobuf:"bytes,3,opt,name=sex,proto3,oneof" json:"sex,omitempty"`
+	// 取得時の etag。If-Match ヘッダーでも指定できる。古い場合は Aborted (HTTP 412) になる
+	Etag          *string `protobuf:"bytes,4,opt,name=etag,proto3,oneof" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateStaffRequest) Reset() {
+	*x = UpdateStaffRequest{}
+	mi := &file_staff_v1_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateStaffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStaffRequest) ProtoMessage() {}
+
+func (x *UpdateStaffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_v1_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateStaffRequest.ProtoReflect.Descriptor instead.
+func (*UpdateStaffRequest) Descriptor() ([]byte, []int) {
+	return file_staff_v1_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateStaffRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateStaffRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateStaffRequest) GetSex() string {
+	if x != nil && x.Sex != nil {
+		return *x.Sex
+	}
+	return ""
+}
+
+func (x *UpdateStaffRequest) GetEtag() string {
+	if x != nil && x.Etag != nil {
+		return *x.Etag
+	}
+	return ""
+}
+
+type UpdateStaffResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Staff         *Staff                 `protobuf:"bytes,1,opt,name=staff,proto3" json:"staff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateStaffResponse) Reset() {
+	*x = UpdateStaffResponse{}
+	mi := &file_staff_v1_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateStaffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStaffResponse) ProtoMessage() {}
+
+func (x *UpdateStaffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_v1_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateStaffResponse.ProtoReflect.Descriptor instead.
+func (*UpdateStaffResponse) Descriptor() ([]byte, []int) {
+	return file_staff_v1_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateStaffResponse) GetStaff() *Staff {
+	if x != nil {
+		return x.Staff
+	}
+	return nil
+}
+
 var File_staff_v1_service_proto protoreflect.FileDescriptor
 
 const file_staff_v1_service_proto_rawDesc = "" +
 	"\n" +
-	"\x16staff/v1/service.proto\x12\bstaff.v1\"=\n" +
+	"\x16staff/v1/service.proto\x12\bstaff.v1\x1a\x1cgoogle/api/annotations.proto\"Q\n" +
 	"\x05Staff\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
-	"\x03sex\x18\x03 \x01(\tR\x03sexB\x9c\x01\n" +
+	"\x03sex\x18\x03 \x01(\tR\x03sex\x12\x12\n" +
+	"\x04etag\x18\x04 \x01(\tR\x04etag\"!\n" +
+	"\x0fGetStaffRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"9\n" +
+	"\x10GetStaffResponse\x12%\n" +
+	"\x05staff\x18\x01 \x01(\v2\x0f.staff.v1.StaffR\x05staff\"\x87\x01\n" +
+	"\x12UpdateStaffRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x15\n" +
+	"\x03sex\x18\x03 \x01(\tH\x01R\x03sex\x88\x01\x01\x12\x17\n" +
+	"\x04etag\x18\x04 \x01(\tH\x02R\x04etag\x88\x01\x01B\a\n" +
+	"\x05_nameB\x06\n" +
+	"\x04_sexB\a\n" +
+	"\x05_etag\"<\n" +
+	"\x13UpdateStaffResponse\x12%\n" +
+	"\x05staff\x18\x01 \x01(\v2\x0f.staff.v1.StaffR\x05staff2\xd0\x01\n" +
+	"\fStaffService\x12Y\n" +
+	"\bGetStaff\x12\x19.staff.v1.GetStaffRequest\x1a\x1a.staff.v1.GetStaffResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/staff/{id}\x12e\n" +
+	"\vUpdateStaff\x12\x1c.staff.v1.UpdateStaffRequest\x1a\x1d.staff.v1.UpdateStaffResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\x1a\x0e/v1/staff/{id}B\x9c\x01\n" +
 	"\fcom.staff.v1B\fServiceProtoP\x01Z=github.com/0utl1er-tech/prism-backend/gen/pb/staff/v1;staffv1\xa2\x02\x03SXX\xaa\x02\bStaff.V1\xca\x02\bStaff\\V1\xe2\x02\x14Staff\\V1\\GPBMetadata\xea\x02\tStaff::V1b\x06proto3"
 
 var (
@@ -104,16 +332,26 @@ func file_staff_v1_service_proto_rawDescGZIP() []byte {
 	return file_staff_v1_service_proto_rawDescData
 }
 
-var file_staff_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_staff_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_staff_v1_service_proto_goTypes = []any{
-	(*Staff)(nil), // 0: staff.v1.Staff
+	(*Staff)(nil),               // 0: staff.v1.Staff
+	(*GetStaffRequest)(nil),     // 1: staff.v1.GetStaffRequest
+	(*GetStaffResponse)(nil),    // 2: staff.v1.GetStaffResponse
+	(*UpdateStaffRequest)(nil),  // 3: staff.v1.UpdateStaffRequest
+	(*UpdateStaffResponse)(nil), // 4: staff.v1.UpdateStaffResponse
 }
 var file_staff_v1_service_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0, // 0: staff.v1.GetStaffResponse.staff:type_name -> staff.v1.Staff
+	0, // 1: staff.v1.UpdateStaffResponse.staff:type_name -> staff.v1.Staff
+	1, // 2: staff.v1.StaffService.GetStaff:input_type -> staff.v1.GetStaffRequest
+	3, // 3: staff.v1.StaffService.UpdateStaff:input_type -> staff.v1.UpdateStaffRequest
+	2, // 4: staff.v1.StaffService.GetStaff:output_type -> staff.v1.GetStaffResponse
+	4, // 5: staff.v1.StaffService.UpdateStaff:output_type -> staff.v1.UpdateStaffResponse
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_staff_v1_service_proto_init() }
@@ -121,15 +359,16 @@ func file_staff_v1_service_proto_init() {
 	if File_staff_v1_service_proto != nil {
 		return
 	}
+	file_staff_v1_service_proto_msgTypes[3].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_staff_v1_service_proto_rawDesc), len(file_staff_v1_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   5,
 			NumExtensions: 0,
-			NumServices:   0,
+			NumServices:   1,
 		},
 		GoTypes:           file_staff_v1_service_proto_goTypes,
 		DependencyIndexes: file_staff_v1_service_proto_depIdxs,
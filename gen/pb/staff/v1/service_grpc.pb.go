@@ -0,0 +1,161 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: staff/v1/service.proto
+
+package staffv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	StaffService_GetStaff_FullMethodName    = "/staff.v1.StaffService/GetStaff"
+	StaffService_UpdateStaff_FullMethodName = "/staff.v1.StaffService/UpdateStaff"
+)
+
+// StaffServiceClient is the client API for StaffService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StaffServiceClient interface {
+	GetStaff(ctx context.Context, in *GetStaffRequest, opts ...grpc.CallOption) (*GetStaffResponse, error)
+	// 指定した項目のみ更新する。成功時は新しい ETag をヘッダーで返す
+	UpdateStaff(ctx context.Context, in *UpdateStaffRequest, opts ...grpc.CallOption) (*UpdateStaffResponse, error)
+}
+
+type staffServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStaffServiceClient(cc grpc.ClientConnInterface) StaffServiceClient {
+	return &staffServiceClient{cc}
+}
+
+func (c *staffServiceClient) GetStaff(ctx context.Context, in *GetStaffRequest, opts ...grpc.CallOption) (*GetStaffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStaffResponse)
+	err := c.cc.Invoke(ctx, StaffService_GetStaff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) UpdateStaff(ctx context.Context, in *UpdateStaffRequest, opts ...grpc.CallOption) (*UpdateStaffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateStaffResponse)
+	err := c.cc.Invoke(ctx, StaffService_UpdateStaff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StaffServiceServer is the server API for StaffService service.
+// All implementations must embed UnimplementedStaffServiceServer
+// for forward compatibility.
+type StaffServiceServer interface {
+	GetStaff(context.Context, *GetStaffRequest) (*GetStaffResponse, error)
+	// 指定した項目のみ更新する。成功時は新しい ETag をヘッダーで返す
+	UpdateStaff(context.Context, *UpdateStaffRequest) (*UpdateStaffResponse, error)
+	mustEmbedUnimplementedStaffServiceServer()
+}
+
+// UnimplementedStaffServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedStaffServiceServer struct{}
+
+func (UnimplementedStaffServiceServer) GetStaff(context.Context, *GetStaffRequest) (*GetStaffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStaff not implemented")
+}
+func (UnimplementedStaffServiceServer) UpdateStaff(context.Context, *UpdateStaffRequest) (*UpdateStaffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStaff not implemented")
+}
+func (UnimplementedStaffServiceServer) mustEmbedUnimplementedStaffServiceServer() {}
+func (UnimplementedStaffServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeStaffServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StaffServiceServer will
+// result in compilation errors.
+type UnsafeStaffServiceServer interface {
+	mustEmbedUnimplementedStaffServiceServer()
+}
+
+func RegisterStaffServiceServer(s grpc.ServiceRegistrar, srv StaffServiceServer) {
+	// If the following call pancis, it indicates UnimplementedStaffServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&StaffService_ServiceDesc, srv)
+}
+
+func _StaffService_GetStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStaffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).GetStaff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_GetStaff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).GetStaff(ctx, req.(*GetStaffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_UpdateStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStaffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).UpdateStaff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_UpdateStaff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).UpdateStaff(ctx, req.(*UpdateStaffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StaffService_ServiceDesc is the grpc.ServiceDesc for StaffService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StaffService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "staff.v1.StaffService",
+	HandlerType: (*StaffServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetStaff",
+			Handler:    _StaffService_GetStaff_Handler,
+		},
+		{
+			MethodName: "UpdateStaff",
+			Handler:    _StaffService_UpdateStaff_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "staff/v1/service.proto",
+}
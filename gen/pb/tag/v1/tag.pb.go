@@ -0,0 +1,790 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: tag/v1/tag.proto
+
+package tagv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Tag struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BookId string                 `protobuf:"bytes,2,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	Name   string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// タグが付いている顧客の数。ゴミ箱の顧客は含まない
+	Customers     int64 `protobuf:"varint,4,opt,name=customers,proto3" json:"customers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	mi := &file_tag_v1_tag_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_v1_tag_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_tag_v1_tag_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Tag) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Tag) GetBookId() string {
+	if x != nil {
+		return x.BookId
+	}
+	return ""
+}
+
+func (x *Tag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tag) GetCustomers() int64 {
+	if x != nil {
+		return x.Customers
+	}
+	return 0
+}
+
+type ListTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookId        string                 `protobuf:"bytes,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	mi := &file_tag_v1_tag_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequest) ProtoMessage() {}
+
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_v1_tag_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_tag_v1_tag_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListTagsRequest) GetBookId() string {
+	if x != nil {
+		return x.BookId
+	}
+	return ""
+}
+
+type ListTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*Tag                 `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	mi := &file_tag_v1_tag_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_v1_tag_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_tag_v1_tag_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListTagsResponse) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type DeleteTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTagRequest) Reset() {
+	*x = DeleteTagRequest{}
+	mi := &file_tag_v1_tag_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTagRequest) ProtoMessage() {}
+
+func (x *DeleteTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_v1_tag_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTagRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTagRequest) Descriptor() ([]byte, []int) {
+	return file_tag_v1_tag_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteTagRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTagResponse) Reset() {
+	*x = DeleteTagResponse{}
+	mi := &file_tag_v1_tag_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTagResponse) ProtoMessage() {}
+
+func (x *DeleteTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_v1_tag_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTagResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTagResponse) Descriptor() ([]byte, []int) {
+	return file_tag_v1_tag_proto_rawDescGZIP(), []int{4}
+}
+
+type AddCustomerTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Tag           string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCustomerTagRequest) Reset() {
+	*x = AddCustomerTagRequest{}
+	mi := &file_tag_v1_tag_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCustomerTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCustomerTagRequest) ProtoMessage() {}
+
+func (x *AddCustomerTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_v1_tag_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCustomerTagRequest.ProtoReflect.Descriptor instead.
+func (*AddCustomerTagRequest) Descriptor() ([]byte, []int) {
+	return file_tag_v1_tag_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AddCustomerTagRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *AddCustomerTagRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type AddCustomerTagResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 顧客に付いているタグ
+	Tags          []string `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCustomerTagResponse) Reset() {
+	*x = AddCustomerTagResponse{}
+	mi := &file_tag_v1_tag_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCustomerTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCustomerTagResponse) ProtoMessage() {}
+
+func (x *AddCustomerTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_v1_tag_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCustomerTagResponse.ProtoReflect.Descriptor instead.
+func (*AddCustomerTagResponse) Descriptor() ([]byte, []int) {
+	return file_tag_v1_tag_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AddCustomerTagResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type RemoveCustomerTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Tag           string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCustomerTagRequest) Reset() {
+	*x = RemoveCustomerTagRequest{}
+	mi := &file_tag_v1_tag_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCustomerTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCustomerTagRequest) ProtoMessage() {}
+
+func (x *RemoveCustomerTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_v1_tag_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCustomerTagRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCustomerTagRequest) Descriptor() ([]byte, []int) {
+	return file_tag_v1_tag_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RemoveCustomerTagRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *RemoveCustomerTagRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type RemoveCustomerTagResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 顧客に付いているタグ
+	Tags          []string `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCustomerTagResponse) Reset() {
+	*x = RemoveCustomerTagResponse{}
+	mi := &file_tag_v1_tag_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCustomerTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCustomerTagResponse) ProtoMessage() {}
+
+func (x *RemoveCustomerTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_v1_tag_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCustomerTagResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCustomerTagResponse) Descriptor() ([]byte, []int) {
+	return file_tag_v1_tag_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RemoveCustomerTagResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type BatchAddTagsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	BookId string                 `protobuf:"bytes,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	// 他のリストの顧客は無視する
+	CustomerIds   []string `protobuf:"bytes,2,rep,name=customer_ids,json=customerIds,proto3" json:"customer_ids,omitempty"`
+	Tags          []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchAddTagsRequest) Reset() {
+	*x = BatchAddTagsRequest{}
+	mi := &file_tag_v1_tag_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchAddTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchAddTagsRequest) ProtoMessage() {}
+
+func (x *BatchAddTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_v1_tag_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchAddTagsRequest.ProtoReflect.Descriptor instead.
+func (*BatchAddTagsRequest) Descriptor() ([]byte, []int) {
+	return file_tag_v1_tag_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BatchAddTagsRequest) GetBookId() string {
+	if x != nil {
+		return x.BookId
+	}
+	return ""
+}
+
+func (x *BatchAddTagsRequest) GetCustomerIds() []string {
+	if x != nil {
+		return x.CustomerIds
+	}
+	return nil
+}
+
+func (x *BatchAddTagsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type BatchAddTagsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 新たに付けた顧客とタグの組み合わせの数
+	Added         int64 `protobuf:"varint,1,opt,name=added,proto3" json:"added,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchAddTagsResponse) Reset() {
+	*x = BatchAddTagsResponse{}
+	mi := &file_tag_v1_tag_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchAddTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchAddTagsResponse) ProtoMessage() {}
+
+func (x *BatchAddTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_v1_tag_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchAddTagsResponse.ProtoReflect.Descriptor instead.
+func (*BatchAddTagsResponse) Descriptor() ([]byte, []int) {
+	return file_tag_v1_tag_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BatchAddTagsResponse) GetAdded() int64 {
+	if x != nil {
+		return x.Added
+	}
+	return 0
+}
+
+type BatchRemoveTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookId        string                 `protobuf:"bytes,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	CustomerIds   []string               `protobuf:"bytes,2,rep,name=customer_ids,json=customerIds,proto3" json:"customer_ids,omitempty"`
+	Tags          []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchRemoveTagsRequest) Reset() {
+	*x = BatchRemoveTagsRequest{}
+	mi := &file_tag_v1_tag_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchRemoveTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchRemoveTagsRequest) ProtoMessage() {}
+
+func (x *BatchRemoveTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_v1_tag_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchRemoveTagsRequest.ProtoReflect.Descriptor instead.
+func (*BatchRemoveTagsRequest) Descriptor() ([]byte, []int) {
+	return file_tag_v1_tag_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BatchRemoveTagsRequest) GetBookId() string {
+	if x != nil {
+		return x.BookId
+	}
+	return ""
+}
+
+func (x *BatchRemoveTagsRequest) GetCustomerIds() []string {
+	if x != nil {
+		return x.CustomerIds
+	}
+	return nil
+}
+
+func (x *BatchRemoveTagsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type BatchRemoveTagsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 外した顧客とタグの組み合わせの数
+	Removed       int64 `protobuf:"varint,1,opt,name=removed,proto3" json:"removed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchRemoveTagsResponse) Reset() {
+	*x = BatchRemoveTagsResponse{}
+	mi := &file_tag_v1_tag_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchRemoveTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchRemoveTagsResponse) ProtoMessage() {}
+
+func (x *BatchRemoveTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_v1_tag_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchRemoveTagsResponse.ProtoReflect.Descriptor instead.
+func (*BatchRemoveTagsResponse) Descriptor() ([]byte, []int) {
+	return file_tag_v1_tag_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BatchRemoveTagsResponse) GetRemoved() int64 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+var File_tag_v1_tag_proto protoreflect.FileDescriptor
+
+const file_tag_v1_tag_proto_rawDesc = "" +
+	"\n" +
+	"\x10tag/v1/tag.proto\x12\x06tag.v1\x1a\x1cgoogle/api/annotations.proto\"`\n" +
+	"\x03Tag\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\abook_id\x18\x02 \x01(\tR\x06bookId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1c\n" +
+	"\tcustomers\x18\x04 \x01(\x03R\tcustomers\"*\n" +
+	"\x0fListTagsRequest\x12\x17\n" +
+	"\abook_id\x18\x01 \x01(\tR\x06bookId\"3\n" +
+	"\x10ListTagsResponse\x12\x1f\n" +
+	"\x04tags\x18\x01 \x03(\v2\v.tag.v1.TagR\x04tags\"\"\n" +
+	"\x10DeleteTagRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x13\n" +
+	"\x11DeleteTagResponse\"J\n" +
+	"\x15AddCustomerTagRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x10\n" +
+	"\x03tag\x18\x02 \x01(\tR\x03tag\",\n" +
+	"\x16AddCustomerTagResponse\x12\x12\n" +
+	"\x04tags\x18\x01 \x03(\tR\x04tags\"M\n" +
+	"\x18RemoveCustomerTagRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x10\n" +
+	"\x03tag\x18\x02 \x01(\tR\x03tag\"/\n" +
+	"\x19RemoveCustomerTagResponse\x12\x12\n" +
+	"\x04tags\x18\x01 \x03(\tR\x04tags\"e\n" +
+	"\x13BatchAddTagsRequest\x12\x17\n" +
+	"\abook_id\x18\x01 \x01(\tR\x06bookId\x12!\n" +
+	"\fcustomer_ids\x18\x02 \x03(\tR\vcustomerIds\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\",\n" +
+	"\x14BatchAddTagsResponse\x12\x14\n" +
+	"\x05added\x18\x01 \x01(\x03R\x05added\"h\n" +
+	"\x16BatchRemoveTagsRequest\x12\x17\n" +
+	"\abook_id\x18\x01 \x01(\tR\x06bookId\x12!\n" +
+	"\fcustomer_ids\x18\x02 \x03(\tR\vcustomerIds\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\"3\n" +
+	"\x17BatchRemoveTagsResponse\x12\x18\n" +
+	"\aremoved\x18\x01 \x01(\x03R\aremoved2\xcb\x05\n" +
+	"\n" +
+	"TagService\x12^\n" +
+	"\bListTags\x12\x17.tag.v1.ListTagsRequest\x1a\x18.tag.v1.ListTagsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/book/{book_id}/tags\x12W\n" +
+	"\tDeleteTag\x12\x18.tag.v1.DeleteTagRequest\x1a\x19.tag.v1.DeleteTagResponse\"\x15\x82\xd3\xe4\x93\x02\x0f*\r/v1/tags/{id}\x12|\n" +
+	"\x0eAddCustomerTag\x12\x1d.tag.v1.AddCustomerTagRequest\x1a\x1e.tag.v1.AddCustomerTagResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/customers/{customer_id}/tags\x12\x88\x01\n" +
+	"\x11RemoveCustomerTag\x12 .tag.v1.RemoveCustomerTagRequest\x1a!.tag.v1.RemoveCustomerTagResponse\".\x82\xd3\xe4\x93\x02(*&/v1/customers/{customer_id}/tags/{tag}\x12v\n" +
+	"\fBatchAddTags\x12\x1b.tag.v1.BatchAddTagsRequest\x1a\x1c.tag.v1.BatchAddTagsResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/book/{book_id}/tags:batchAdd\x12\x82\x01\n" +
+	"\x0fBatchRemoveTags\x12\x1e.tag.v1.BatchRemoveTagsRequest\x1a\x1f.tag.v1.BatchRemoveTagsResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/book/{book_id}/tags:batchRemoveB\x8a\x01\n" +
+	"\n" +
+	"com.tag.v1B\bTagProtoP\x01Z9github.com/0utl1er-tech/prism-backend/gen/pb/tag/v1;tagv1\xa2\x02\x03TXX\xaa\x02\x06Tag.V1\xca\x02\x06Tag\\V1\xe2\x02\x12Tag\\V1\\GPBMetadata\xea\x02\aTag::V1b\x06proto3"
+
+var (
+	file_tag_v1_tag_proto_rawDescOnce sync.Once
+	file_tag_v1_tag_proto_rawDescData []byte
+)
+
+func file_tag_v1_tag_proto_rawDescGZIP() []byte {
+	file_tag_v1_tag_proto_rawDescOnce.Do(func() {
+		file_tag_v1_tag_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tag_v1_tag_proto_rawDesc), len(file_tag_v1_tag_proto_rawDesc)))
+	})
+	return file_tag_v1_tag_proto_rawDescData
+}
+
+var file_tag_v1_tag_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_tag_v1_tag_proto_goTypes = []any{
+	(*Tag)(nil),                       // 0: tag.v1.Tag
+	(*ListTagsRequest)(nil),           // 1: tag.v1.ListTagsRequest
+	(*ListTagsResponse)(nil),          // 2: tag.v1.ListTagsResponse
+	(*DeleteTagRequest)(nil),          // 3: tag.v1.DeleteTagRequest
+	(*DeleteTagResponse)(nil),         // 4: tag.v1.DeleteTagResponse
+	(*AddCustomerTagRequest)(nil),     // 5: tag.v1.AddCustomerTagRequest
+	(*AddCustomerTagResponse)(nil),    // 6: tag.v1.AddCustomerTagResponse
+	(*RemoveCustomerTagRequest)(nil),  // 7: tag.v1.RemoveCustomerTagRequest
+	(*RemoveCustomerTagResponse)(nil), // 8: tag.v1.RemoveCustomerTagResponse
+	(*BatchAddTagsRequest)(nil),       // 9: tag.v1.BatchAddTagsRequest
+	(*BatchAddTagsResponse)(nil),      // 10: tag.v1.BatchAddTagsResponse
+	(*BatchRemoveTagsRequest)(nil),    // 11: tag.v1.BatchRemoveTagsRequest
+	(*BatchRemoveTagsResponse)(nil),   // 12: tag.v1.BatchRemoveTagsResponse
+}
+var file_tag_v1_tag_proto_depIdxs = []int32{
+	0,  // 0: tag.v1.ListTagsResponse.tags:type_name -> tag.v1.Tag
+	1,  // 1: tag.v1.TagService.ListTags:input_type -> tag.v1.ListTagsRequest
+	3,  // 2: tag.v1.TagService.DeleteTag:input_type -> tag.v1.DeleteTagRequest
+	5,  // 3: tag.v1.TagService.AddCustomerTag:input_type -> tag.v1.AddCustomerTagRequest
+	7,  // 4: tag.v1.TagService.RemoveCustomerTag:input_type -> tag.v1.RemoveCustomerTagRequest
+	9,  // 5: tag.v1.TagService.BatchAddTags:input_type -> tag.v1.BatchAddTagsRequest
+	11, // 6: tag.v1.TagService.BatchRemoveTags:input_type -> tag.v1.BatchRemoveTagsRequest
+	2,  // 7: tag.v1.TagService.ListTags:output_type -> tag.v1.ListTagsResponse
+	4,  // 8: tag.v1.TagService.DeleteTag:output_type -> tag.v1.DeleteTagResponse
+	6,  // 9: tag.v1.TagService.AddCustomerTag:output_type -> tag.v1.AddCustomerTagResponse
+	8,  // 10: tag.v1.TagService.RemoveCustomerTag:output_type -> tag.v1.RemoveCustomerTagResponse
+	10, // 11: tag.v1.TagService.BatchAddTags:output_type -> tag.v1.BatchAddTagsResponse
+	12, // 12: tag.v1.TagService.BatchRemoveTags:output_type -> tag.v1.BatchRemoveTagsResponse
+	7,  // [7:13] is the sub-list for method output_type
+	1,  // [1:7] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_tag_v1_tag_proto_init() }
+func file_tag_v1_tag_proto_init() {
+	if File_tag_v1_tag_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tag_v1_tag_proto_rawDesc), len(file_tag_v1_tag_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tag_v1_tag_proto_goTypes,
+		DependencyIndexes: file_tag_v1_tag_proto_depIdxs,
+		MessageInfos:      file_tag_v1_tag_proto_msgTypes,
+	}.Build()
+	File_tag_v1_tag_proto = out.File
+	file_tag_v1_tag_proto_goTypes = nil
+	file_tag_v1_tag_proto_depIdxs = nil
+}
@@ -0,0 +1,323 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: tag/v1/tag.proto
+
+package tagv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TagService_ListTags_FullMethodName          = "/tag.v1.TagService/ListTags"
+	TagService_DeleteTag_FullMethodName         = "/tag.v1.TagService/DeleteTag"
+	TagService_AddCustomerTag_FullMethodName    = "/tag.v1.TagService/AddCustomerTag"
+	TagService_RemoveCustomerTag_FullMethodName = "/tag.v1.TagService/RemoveCustomerTag"
+	TagService_BatchAddTags_FullMethodName      = "/tag.v1.TagService/BatchAddTags"
+	TagService_BatchRemoveTags_FullMethodName   = "/tag.v1.TagService/BatchRemoveTags"
+)
+
+// TagServiceClient is the client API for TagService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 顧客のタグ。顧客リストごとに管理し、存在しない名前を指定すると作成する
+type TagServiceClient interface {
+	// 使用している顧客の数と一緒に返す
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	// 顧客から外し、タグ自体を削除する
+	DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*DeleteTagResponse, error)
+	AddCustomerTag(ctx context.Context, in *AddCustomerTagRequest, opts ...grpc.CallOption) (*AddCustomerTagResponse, error)
+	RemoveCustomerTag(ctx context.Context, in *RemoveCustomerTagRequest, opts ...grpc.CallOption) (*RemoveCustomerTagResponse, error)
+	// 複数の顧客に複数のタグをまとめて付ける
+	BatchAddTags(ctx context.Context, in *BatchAddTagsRequest, opts ...grpc.CallOption) (*BatchAddTagsResponse, error)
+	// 複数の顧客から複数のタグをまとめて外す
+	BatchRemoveTags(ctx context.Context, in *BatchRemoveTagsRequest, opts ...grpc.CallOption) (*BatchRemoveTagsResponse, error)
+}
+
+type tagServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTagServiceClient(cc grpc.ClientConnInterface) TagServiceClient {
+	return &tagServiceClient{cc}
+}
+
+func (c *tagServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, TagService_ListTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*DeleteTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTagResponse)
+	err := c.cc.Invoke(ctx, TagService_DeleteTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) AddCustomerTag(ctx context.Context, in *AddCustomerTagRequest, opts ...grpc.CallOption) (*AddCustomerTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddCustomerTagResponse)
+	err := c.cc.Invoke(ctx, TagService_AddCustomerTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) RemoveCustomerTag(ctx context.Context, in *RemoveCustomerTagRequest, opts ...grpc.CallOption) (*RemoveCustomerTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveCustomerTagResponse)
+	err := c.cc.Invoke(ctx, TagService_RemoveCustomerTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) BatchAddTags(ctx context.Context, in *BatchAddTagsRequest, opts ...grpc.CallOption) (*BatchAddTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchAddTagsResponse)
+	err := c.cc.Invoke(ctx, TagService_BatchAddTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) BatchRemoveTags(ctx context.Context, in *BatchRemoveTagsRequest, opts ...grpc.CallOption) (*BatchRemoveTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchRemoveTagsResponse)
+	err := c.cc.Invoke(ctx, TagService_BatchRemoveTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TagServiceServer is the server API for TagService service.
+// All implementations must embed UnimplementedTagServiceServer
+// for forward compatibility.
+//
+// 顧客のタグ。顧客リストごとに管理し、存在しない名前を指定すると作成する
+type TagServiceServer interface {
+	// 使用している顧客の数と一緒に返す
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	// 顧客から外し、タグ自体を削除する
+	DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagResponse, error)
+	AddCustomerTag(context.Context, *AddCustomerTagRequest) (*AddCustomerTagResponse, error)
+	RemoveCustomerTag(context.Context, *RemoveCustomerTagRequest) (*RemoveCustomerTagResponse, error)
+	// 複数の顧客に複数のタグをまとめて付ける
+	BatchAddTags(context.Context, *BatchAddTagsRequest) (*BatchAddTagsResponse, error)
+	// 複数の顧客から複数のタグをまとめて外す
+	BatchRemoveTags(context.Context, *BatchRemoveTagsRequest) (*BatchRemoveTagsResponse, error)
+	mustEmbedUnimplementedTagServiceServer()
+}
+
+// UnimplementedTagServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTagServiceServer struct{}
+
+func (UnimplementedTagServiceServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedTagServiceServer) DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTag not implemented")
+}
+func (UnimplementedTagServiceServer) AddCustomerTag(context.Context, *AddCustomerTagRequest) (*AddCustomerTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCustomerTag not implemented")
+}
+func (UnimplementedTagServiceServer) RemoveCustomerTag(context.Context, *RemoveCustomerTagRequest) (*RemoveCustomerTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCustomerTag not implemented")
+}
+func (UnimplementedTagServiceServer) BatchAddTags(context.Context, *BatchAddTagsRequest) (*BatchAddTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchAddTags not implemented")
+}
+func (UnimplementedTagServiceServer) BatchRemoveTags(context.Context, *BatchRemoveTagsRequest) (*BatchRemoveTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchRemoveTags not implemented")
+}
+func (UnimplementedTagServiceServer) mustEmbedUnimplementedTagServiceServer() {}
+func (UnimplementedTagServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeTagServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TagServiceServer will
+// result in compilation errors.
+type UnsafeTagServiceServer interface {
+	mustEmbedUnimplementedTagServiceServer()
+}
+
+func RegisterTagServiceServer(s grpc.ServiceRegistrar, srv TagServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTagServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TagService_ServiceDesc, srv)
+}
+
+func _TagService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_ListTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_DeleteTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).DeleteTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_DeleteTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).DeleteTag(ctx, req.(*DeleteTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_AddCustomerTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCustomerTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).AddCustomerTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_AddCustomerTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).AddCustomerTag(ctx, req.(*AddCustomerTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_RemoveCustomerTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCustomerTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).RemoveCustomerTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_RemoveCustomerTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).RemoveCustomerTag(ctx, req.(*RemoveCustomerTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_BatchAddTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchAddTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).BatchAddTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_BatchAddTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).BatchAddTags(ctx, req.(*BatchAddTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_BatchRemoveTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchRemoveTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).BatchRemoveTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_BatchRemoveTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).BatchRemoveTags(ctx, req.(*BatchRemoveTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TagService_ServiceDesc is the grpc.ServiceDesc for TagService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TagService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tag.v1.TagService",
+	HandlerType: (*TagServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListTags",
+			Handler:    _TagService_ListTags_Handler,
+		},
+		{
+			MethodName: "DeleteTag",
+			Handler:    _TagService_DeleteTag_Handler,
+		},
+		{
+			MethodName: "AddCustomerTag",
+			Handler:    _TagService_AddCustomerTag_Handler,
+		},
+		{
+			MethodName: "RemoveCustomerTag",
+			Handler:    _TagService_RemoveCustomerTag_Handler,
+		},
+		{
+			MethodName: "BatchAddTags",
+			Handler:    _TagService_BatchAddTags_Handler,
+		},
+		{
+			MethodName: "BatchRemoveTags",
+			Handler:    _TagService_BatchRemoveTags_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tag/v1/tag.proto",
+}
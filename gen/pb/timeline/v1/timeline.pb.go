@@ -0,0 +1,647 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: timeline/v1/timeline.proto
+
+package timelinev1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type NoteEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Body          string                 `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NoteEntry) Reset() {
+	*x = NoteEntry{}
+	mi := &file_timeline_v1_timeline_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NoteEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteEntry) ProtoMessage() {}
+
+func (x *NoteEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_timeline_v1_timeline_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteEntry.ProtoReflect.Descriptor instead.
+func (*NoteEntry) Descriptor() ([]byte, []int) {
+	return file_timeline_v1_timeline_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NoteEntry) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type CallEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusId      string                 `protobuf:"bytes,1,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
+	StatusName    string                 `protobuf:"bytes,2,opt,name=status_name,json=statusName,proto3" json:"status_name,omitempty"`
+	Effective     bool                   `protobuf:"varint,3,opt,name=effective,proto3" json:"effective,omitempty"`
+	Ng            bool                   `protobuf:"varint,4,opt,name=ng,proto3" json:"ng,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CallEntry) Reset() {
+	*x = CallEntry{}
+	mi := &file_timeline_v1_timeline_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallEntry) ProtoMessage() {}
+
+func (x *CallEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_timeline_v1_timeline_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallEntry.ProtoReflect.Descriptor instead.
+func (*CallEntry) Descriptor() ([]byte, []int) {
+	return file_timeline_v1_timeline_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CallEntry) GetStatusId() string {
+	if x != nil {
+		return x.StatusId
+	}
+	return ""
+}
+
+func (x *CallEntry) GetStatusName() string {
+	if x != nil {
+		return x.StatusName
+	}
+	return ""
+}
+
+func (x *CallEntry) GetEffective() bool {
+	if x != nil {
+		return x.Effective
+	}
+	return false
+}
+
+func (x *CallEntry) GetNg() bool {
+	if x != nil {
+		return x.Ng
+	}
+	return false
+}
+
+type RedialEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledAt   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedialEntry) Reset() {
+	*x = RedialEntry{}
+	mi := &file_timeline_v1_timeline_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedialEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedialEntry) ProtoMessage() {}
+
+func (x *RedialEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_timeline_v1_timeline_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedialEntry.ProtoReflect.Descriptor instead.
+func (*RedialEntry) Descriptor() ([]byte, []int) {
+	return file_timeline_v1_timeline_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RedialEntry) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+type ChangeEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Customer または Contact
+	EntityType string `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	EntityId   string `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	// insert, update, delete
+	Action string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	// 更新の場合は変更された項目のみ
+	Before        *structpb.Struct `protobuf:"bytes,4,opt,name=before,proto3" json:"before,omitempty"`
+	After         *structpb.Struct `protobuf:"bytes,5,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeEntry) Reset() {
+	*x = ChangeEntry{}
+	mi := &file_timeline_v1_timeline_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeEntry) ProtoMessage() {}
+
+func (x *ChangeEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_timeline_v1_timeline_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeEntry.ProtoReflect.Descriptor instead.
+func (*ChangeEntry) Descriptor() ([]byte, []int) {
+	return file_timeline_v1_timeline_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ChangeEntry) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *ChangeEntry) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *ChangeEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ChangeEntry) GetBefore() *structpb.Struct {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *ChangeEntry) GetAfter() *structpb.Struct {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+type TimelineEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// メモ・架電・変更履歴のID。再架電の場合は顧客ID
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// 書いた・架電した・予約した・変更したユーザー
+	UserId   string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName string `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	// Types that are valid to be assigned to Entry:
+	//
+	//	*TimelineEntry_Note
+	//	*TimelineEntry_Call
+	//	*TimelineEntry_Redial
+	//	*TimelineEntry_Change
+	Entry         isTimelineEntry_Entry `protobuf_oneof:"entry"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimelineEntry) Reset() {
+	*x = TimelineEntry{}
+	mi := &file_timeline_v1_timeline_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimelineEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimelineEntry) ProtoMessage() {}
+
+func (x *TimelineEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_timeline_v1_timeline_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimelineEntry.ProtoReflect.Descriptor instead.
+func (*TimelineEntry) Descriptor() ([]byte, []int) {
+	return file_timeline_v1_timeline_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TimelineEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TimelineEntry) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *TimelineEntry) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TimelineEntry) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *TimelineEntry) GetEntry() isTimelineEntry_Entry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *TimelineEntry) GetNote() *NoteEntry {
+	if x != nil {
+		if x, ok := x.Entry.(*TimelineEntry_Note); ok {
+			return x.Note
+		}
+	}
+	return nil
+}
+
+func (x *TimelineEntry) GetCall() *CallEntry {
+	if x != nil {
+		if x, ok := x.Entry.(*TimelineEntry_Call); ok {
+			return x.Call
+		}
+	}
+	return nil
+}
+
+func (x *TimelineEntry) GetRedial() *RedialEntry {
+	if x != nil {
+		if x, ok := x.Entry.(*TimelineEntry_Redial); ok {
+			return x.Redial
+		}
+	}
+	return nil
+}
+
+func (x *TimelineEntry) GetChange() *ChangeEntry {
+	if x != nil {
+		if x, ok := x.Entry.(*TimelineEntry_Change); ok {
+			return x.Change
+		}
+	}
+	return nil
+}
+
+type isTimelineEntry_Entry interface {
+	isTimelineEntry_Entry()
+}
+
+type TimelineEntry_Note struct {
+	Note *NoteEntry `protobuf:"bytes,5,opt,name=note,proto3,oneof"`
+}
+
+type TimelineEntry_Call struct {
+	Call *CallEntry `protobuf:"bytes,6,opt,name=call,proto3,oneof"`
+}
+
+type TimelineEntry_Redial struct {
+	Redial *RedialEntry `protobuf:"bytes,7,opt,name=redial,proto3,oneof"`
+}
+
+type TimelineEntry_Change struct {
+	Change *ChangeEntry `protobuf:"bytes,8,opt,name=change,proto3,oneof"`
+}
+
+func (*TimelineEntry_Note) isTimelineEntry_Entry() {}
+
+func (*TimelineEntry_Call) isTimelineEntry_Entry() {}
+
+func (*TimelineEntry_Redial) isTimelineEntry_Entry() {}
+
+func (*TimelineEntry_Change) isTimelineEntry_Entry() {}
+
+type GetCustomerTimelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCustomerTimelineRequest) Reset() {
+	*x = GetCustomerTimelineRequest{}
+	mi := &file_timeline_v1_timeline_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCustomerTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomerTimelineRequest) ProtoMessage() {}
+
+func (x *GetCustomerTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timeline_v1_timeline_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomerTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetCustomerTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_timeline_v1_timeline_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetCustomerTimelineRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *GetCustomerTimelineRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetCustomerTimelineRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetCustomerTimelineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*TimelineEntry       `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCustomerTimelineResponse) Reset() {
+	*x = GetCustomerTimelineResponse{}
+	mi := &file_timeline_v1_timeline_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCustomerTimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomerTimelineResponse) ProtoMessage() {}
+
+func (x *GetCustomerTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timeline_v1_timeline_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomerTimelineResponse.ProtoReflect.Descriptor instead.
+func (*GetCustomerTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_timeline_v1_timeline_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetCustomerTimelineResponse) GetEntries() []*TimelineEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetCustomerTimelineResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetCustomerTimelineResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+var File_timeline_v1_timeline_proto protoreflect.FileDescriptor
+
+const file_timeline_v1_timeline_proto_rawDesc = "" +
+	"\n" +
+	"\x1atimeline/v1/timeline.proto\x12\vtimeline.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x1f\n" +
+	"\tNoteEntry\x12\x12\n" +
+	"\x04body\x18\x01 \x01(\tR\x04body\"w\n" +
+	"\tCallEntry\x12\x1b\n" +
+	"\tstatus_id\x18\x01 \x01(\tR\bstatusId\x12\x1f\n" +
+	"\vstatus_name\x18\x02 \x01(\tR\n" +
+	"statusName\x12\x1c\n" +
+	"\teffective\x18\x03 \x01(\bR\teffective\x12\x0e\n" +
+	"\x02ng\x18\x04 \x01(\bR\x02ng\"L\n" +
+	"\vRedialEntry\x12=\n" +
+	"\fscheduled_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\"\xc3\x01\n" +
+	"\vChangeEntry\x12\x1f\n" +
+	"\ventity_type\x18\x01 \x01(\tR\n" +
+	"entityType\x12\x1b\n" +
+	"\tentity_id\x18\x02 \x01(\tR\bentityId\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12/\n" +
+	"\x06before\x18\x04 \x01(\v2\x17.google.protobuf.StructR\x06before\x12-\n" +
+	"\x05after\x18\x05 \x01(\v2\x17.google.protobuf.StructR\x05after\"\xdf\x02\n" +
+	"\rTimelineEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12;\n" +
+	"\voccurred_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x04 \x01(\tR\buserName\x12,\n" +
+	"\x04note\x18\x05 \x01(\v2\x16.timeline.v1.NoteEntryH\x00R\x04note\x12,\n" +
+	"\x04call\x18\x06 \x01(\v2\x16.timeline.v1.CallEntryH\x00R\x04call\x122\n" +
+	"\x06redial\x18\a \x01(\v2\x18.timeline.v1.RedialEntryH\x00R\x06redial\x122\n" +
+	"\x06change\x18\b \x01(\v2\x18.timeline.v1.ChangeEntryH\x00R\x06changeB\a\n" +
+	"\x05entry\"g\n" +
+	"\x1aGetCustomerTimelineRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"}\n" +
+	"\x1bGetCustomerTimelineResponse\x124\n" +
+	"\aentries\x18\x01 \x03(\v2\x1a.timeline.v1.TimelineEntryR\aentries\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit2\xaa\x01\n" +
+	"\x0fTimelineService\x12\x96\x01\n" +
+	"\x13GetCustomerTimeline\x12'.timeline.v1.GetCustomerTimelineRequest\x1a(.timeline.v1.GetCustomerTimelineResponse\",\x82\xd3\xe4\x93\x02&\x12$/v1/customers/{customer_id}/timelineB\xb2\x01\n" +
+	"\x0fcom.timeline.v1B\rTimelineProtoP\x01ZCgithub.com/0utl1er-tech/prism-backend/gen/pb/timeline/v1;timelinev1\xa2\x02\x03TXX\xaa\x02\vTimeline.V1\xca\x02\vTimeline\\V1\xe2\x02\x17Timeline\\V1\\GPBMetadata\xea\x02\fTimeline::V1b\x06proto3"
+
+var (
+	file_timeline_v1_timeline_proto_rawDescOnce sync.Once
+	file_timeline_v1_timeline_proto_rawDescData []byte
+)
+
+func file_timeline_v1_timeline_proto_rawDescGZIP() []byte {
+	file_timeline_v1_timeline_proto_rawDescOnce.Do(func() {
+		file_timeline_v1_timeline_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_timeline_v1_timeline_proto_rawDesc), len(file_timeline_v1_timeline_proto_rawDesc)))
+	})
+	return file_timeline_v1_timeline_proto_rawDescData
+}
+
+var file_timeline_v1_timeline_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_timeline_v1_timeline_proto_goTypes = []any{
+	(*NoteEntry)(nil),                   // 0: timeline.v1.NoteEntry
+	(*CallEntry)(nil),                   // 1: timeline.v1.CallEntry
+	(*RedialEntry)(nil),                 // 2: timeline.v1.RedialEntry
+	(*ChangeEntry)(nil),                 // 3: timeline.v1.ChangeEntry
+	(*TimelineEntry)(nil),               // 4: timeline.v1.TimelineEntry
+	(*GetCustomerTimelineRequest)(nil),  // 5: timeline.v1.GetCustomerTimelineRequest
+	(*GetCustomerTimelineResponse)(nil), // 6: timeline.v1.GetCustomerTimelineResponse
+	(*timestamppb.Timestamp)(nil),       // 7: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),             // 8: google.protobuf.Struct
+}
+var file_timeline_v1_timeline_proto_depIdxs = []int32{
+	7,  // 0: timeline.v1.RedialEntry.scheduled_at:type_name -> google.protobuf.Timestamp
+	8,  // 1: timeline.v1.ChangeEntry.before:type_name -> google.protobuf.Struct
+	8,  // 2: timeline.v1.ChangeEntry.after:type_name -> google.protobuf.Struct
+	7,  // 3: timeline.v1.TimelineEntry.occurred_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: timeline.v1.TimelineEntry.note:type_name -> timeline.v1.NoteEntry
+	1,  // 5: timeline.v1.TimelineEntry.call:type_name -> timeline.v1.CallEntry
+	2,  // 6: timeline.v1.TimelineEntry.redial:type_name -> timeline.v1.RedialEntry
+	3,  // 7: timeline.v1.TimelineEntry.change:type_name -> timeline.v1.ChangeEntry
+	4,  // 8: timeline.v1.GetCustomerTimelineResponse.entries:type_name -> timeline.v1.TimelineEntry
+	5,  // 9: timeline.v1.TimelineService.GetCustomerTimeline:input_type -> timeline.v1.GetCustomerTimelineRequest
+	6,  // 10: timeline.v1.TimelineService.GetCustomerTimeline:output_type -> timeline.v1.GetCustomerTimelineResponse
+	10, // [10:11] is the sub-list for method output_type
+	9,  // [9:10] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_timeline_v1_timeline_proto_init() }
+func file_timeline_v1_timeline_proto_init() {
+	if File_timeline_v1_timeline_proto != nil {
+		return
+	}
+	file_timeline_v1_timeline_proto_msgTypes[4].OneofWrappers = []any{
+		(*TimelineEntry_Note)(nil),
+		(*TimelineEntry_Call)(nil),
+		(*TimelineEntry_Redial)(nil),
+		(*TimelineEntry_Change)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_timeline_v1_timeline_proto_rawDesc), len(file_timeline_v1_timeline_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_timeline_v1_timeline_proto_goTypes,
+		DependencyIndexes: file_timeline_v1_timeline_proto_depIdxs,
+		MessageInfos:      file_timeline_v1_timeline_proto_msgTypes,
+	}.Build()
+	File_timeline_v1_timeline_proto = out.File
+	file_timeline_v1_timeline_proto_goTypes = nil
+	file_timeline_v1_timeline_proto_depIdxs = nil
+}
@@ -0,0 +1,127 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: timeline/v1/timeline.proto
+
+package timelinev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TimelineService_GetCustomerTimeline_FullMethodName = "/timeline.v1.TimelineService/GetCustomerTimeline"
+)
+
+// TimelineServiceClient is the client API for TimelineService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 顧客ごとの対応履歴
+type TimelineServiceClient interface {
+	// メモ・架電・再架電・顧客と連絡先の変更を新しい順に返す
+	GetCustomerTimeline(ctx context.Context, in *GetCustomerTimelineRequest, opts ...grpc.CallOption) (*GetCustomerTimelineResponse, error)
+}
+
+type timelineServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTimelineServiceClient(cc grpc.ClientConnInterface) TimelineServiceClient {
+	return &timelineServiceClient{cc}
+}
+
+func (c *timelineServiceClient) GetCustomerTimeline(ctx context.Context, in *GetCustomerTimelineRequest, opts ...grpc.CallOption) (*GetCustomerTimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCustomerTimelineResponse)
+	err := c.cc.Invoke(ctx, TimelineService_GetCustomerTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TimelineServiceServer is the server API for TimelineService service.
+// All implementations must embed UnimplementedTimelineServiceServer
+// for forward compatibility.
+//
+// 顧客ごとの対応履歴
+type TimelineServiceServer interface {
+	// メモ・架電・再架電・顧客と連絡先の変更を新しい順に返す
+	GetCustomerTimeline(context.Context, *GetCustomerTimelineRequest) (*GetCustomerTimelineResponse, error)
+	mustEmbedUnimplementedTimelineServiceServer()
+}
+
+// UnimplementedTimelineServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTimelineServiceServer struct{}
+
+func (UnimplementedTimelineServiceServer) GetCustomerTimeline(context.Context, *GetCustomerTimelineRequest) (*GetCustomerTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCustomerTimeline not implemented")
+}
+func (UnimplementedTimelineServiceServer) mustEmbedUnimplementedTimelineServiceServer() {}
+func (UnimplementedTimelineServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeTimelineServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TimelineServiceServer will
+// result in compilation errors.
+type UnsafeTimelineServiceServer interface {
+	mustEmbedUnimplementedTimelineServiceServer()
+}
+
+func RegisterTimelineServiceServer(s grpc.ServiceRegistrar, srv TimelineServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTimelineServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TimelineService_ServiceDesc, srv)
+}
+
+func _TimelineService_GetCustomerTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCustomerTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimelineServiceServer).GetCustomerTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimelineService_GetCustomerTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimelineServiceServer).GetCustomerTimeline(ctx, req.(*GetCustomerTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TimelineService_ServiceDesc is the grpc.ServiceDesc for TimelineService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TimelineService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "timeline.v1.TimelineService",
+	HandlerType: (*TimelineServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCustomerTimeline",
+			Handler:    _TimelineService_GetCustomerTimeline_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "timeline/v1/timeline.proto",
+}
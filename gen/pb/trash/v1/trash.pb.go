@@ -0,0 +1,374 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: trash/v1/trash.proto
+
+package trashv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TrashEntityType int32
+
+const (
+	TrashEntityType_TRASH_ENTITY_TYPE_UNSPECIFIED TrashEntityType = 0
+	TrashEntityType_TRASH_ENTITY_TYPE_BOOK        TrashEntityType = 1
+	TrashEntityType_TRASH_ENTITY_TYPE_CUSTOMER    TrashEntityType = 2
+)
+
+// Enum value maps for TrashEntityType.
+var (
+	TrashEntityType_name = map[int32]string{
+		0: "TRASH_ENTITY_TYPE_UNSPECIFIED",
+		1: "TRASH_ENTITY_TYPE_BOOK",
+		2: "TRASH_ENTITY_TYPE_CUSTOMER",
+	}
+	TrashEntityType_value = map[string]int32{
+		"TRASH_ENTITY_TYPE_UNSPECIFIED": 0,
+		"TRASH_ENTITY_TYPE_BOOK":        1,
+		"TRASH_ENTITY_TYPE_CUSTOMER":    2,
+	}
+)
+
+func (x TrashEntityType) Enum() *TrashEntityType {
+	p := new(TrashEntityType)
+	*p = x
+	return p
+}
+
+func (x TrashEntityType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TrashEntityType) Descriptor() protoreflect.EnumDescriptor {
+	return file_trash_v1_trash_proto_enumTypes[0].Descriptor()
+}
+
+func (TrashEntityType) Type() protoreflect.EnumType {
+	return &file_trash_v1_trash_proto_enumTypes[0]
+}
+
+func (x TrashEntityType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TrashEntityType.Descriptor instead.
+func (TrashEntityType) EnumDescriptor() ([]byte, []int) {
+	return file_trash_v1_trash_proto_rawDescGZIP(), []int{0}
+}
+
+type TrashItem struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	EntityType TrashEntityType        `protobuf:"varint,1,opt,name=entity_type,json=entityType,proto3,enum=trash.v1.TrashEntityType" json:"entity_type,omitempty"`
+	Id         string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// 顧客の場合のみ
+	BookId    string                 `protobuf:"bytes,4,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// この時刻を過ぎると完全に削除される
+	PurgeAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=purge_at,json=purgeAt,proto3" json:"purge_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrashItem) Reset() {
+	*x = TrashItem{}
+	mi := &file_trash_v1_trash_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrashItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrashItem) ProtoMessage() {}
+
+func (x *TrashItem) ProtoReflect() protoreflect.Message {
+	mi := &file_trash_v1_trash_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrashItem.ProtoReflect.Descriptor instead.
+func (*TrashItem) Descriptor() ([]byte, []int) {
+	return file_trash_v1_trash_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TrashItem) GetEntityType() TrashEntityType {
+	if x != nil {
+		return x.EntityType
+	}
+	return TrashEntityType_TRASH_ENTITY_TYPE_UNSPECIFIED
+}
+
+func (x *TrashItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TrashItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TrashItem) GetBookId() string {
+	if x != nil {
+		return x.BookId
+	}
+	return ""
+}
+
+func (x *TrashItem) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *TrashItem) GetPurgeAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurgeAt
+	}
+	return nil
+}
+
+type ListTrashRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 未指定の場合はすべて
+	EntityType    TrashEntityType `protobuf:"varint,1,opt,name=entity_type,json=entityType,proto3,enum=trash.v1.TrashEntityType" json:"entity_type,omitempty"`
+	Page          int32           `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32           `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashRequest) Reset() {
+	*x = ListTrashRequest{}
+	mi := &file_trash_v1_trash_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashRequest) ProtoMessage() {}
+
+func (x *ListTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trash_v1_trash_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashRequest.ProtoReflect.Descriptor instead.
+func (*ListTrashRequest) Descriptor() ([]byte, []int) {
+	return file_trash_v1_trash_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListTrashRequest) GetEntityType() TrashEntityType {
+	if x != nil {
+		return x.EntityType
+	}
+	return TrashEntityType_TRASH_ENTITY_TYPE_UNSPECIFIED
+}
+
+func (x *ListTrashRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTrashRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListTrashResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 削除日時の新しい順。リストと一緒に削除された顧客は含まない
+	Items         []*TrashItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Page          int32        `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32        `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashResponse) Reset() {
+	*x = ListTrashResponse{}
+	mi := &file_trash_v1_trash_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashResponse) ProtoMessage() {}
+
+func (x *ListTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trash_v1_trash_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashResponse.ProtoReflect.Descriptor instead.
+func (*ListTrashResponse) Descriptor() ([]byte, []int) {
+	return file_trash_v1_trash_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListTrashResponse) GetItems() []*TrashItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListTrashResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTrashResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+var File_trash_v1_trash_proto protoreflect.FileDescriptor
+
+const file_trash_v1_trash_proto_rawDesc = "" +
+	"\n" +
+	"\x14trash/v1/trash.proto\x12\btrash.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf6\x01\n" +
+	"\tTrashItem\x12:\n" +
+	"\ventity_type\x18\x01 \x01(\x0e2\x19.trash.v1.TrashEntityTypeR\n" +
+	"entityType\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x17\n" +
+	"\abook_id\x18\x04 \x01(\tR\x06bookId\x129\n" +
+	"\n" +
+	"deleted_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x125\n" +
+	"\bpurge_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\apurgeAt\"x\n" +
+	"\x10ListTrashRequest\x12:\n" +
+	"\ventity_type\x18\x01 \x01(\x0e2\x19.trash.v1.TrashEntityTypeR\n" +
+	"entityType\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"h\n" +
+	"\x11ListTrashResponse\x12)\n" +
+	"\x05items\x18\x01 \x03(\v2\x13.trash.v1.TrashItemR\x05items\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit*p\n" +
+	"\x0fTrashEntityType\x12!\n" +
+	"\x1dTRASH_ENTITY_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16TRASH_ENTITY_TYPE_BOOK\x10\x01\x12\x1e\n" +
+	"\x1aTRASH_ENTITY_TYPE_CUSTOMER\x10\x022g\n" +
+	"\fTrashService\x12W\n" +
+	"\tListTrash\x12\x1a.trash.v1.ListTrashRequest\x1a\x1b.trash.v1.ListTrashResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/trashB\x9a\x01\n" +
+	"\fcom.trash.v1B\n" +
+	"TrashProtoP\x01Z=github.com/0utl1er-tech/prism-backend/gen/pb/trash/v1;trashv1\xa2\x02\x03TXX\xaa\x02\bTrash.V1\xca\x02\bTrash\\V1\xe2\x02\x14Trash\\V1\\GPBMetadata\xea\x02\tTrash::V1b\x06proto3"
+
+var (
+	file_trash_v1_trash_proto_rawDescOnce sync.Once
+	file_trash_v1_trash_proto_rawDescData []byte
+)
+
+func file_trash_v1_trash_proto_rawDescGZIP() []byte {
+	file_trash_v1_trash_proto_rawDescOnce.Do(func() {
+		file_trash_v1_trash_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_trash_v1_trash_proto_rawDesc), len(file_trash_v1_trash_proto_rawDesc)))
+	})
+	return file_trash_v1_trash_proto_rawDescData
+}
+
+var file_trash_v1_trash_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_trash_v1_trash_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_trash_v1_trash_proto_goTypes = []any{
+	(TrashEntityType)(0),          // 0: trash.v1.TrashEntityType
+	(*TrashItem)(nil),             // 1: trash.v1.TrashItem
+	(*ListTrashRequest)(nil),      // 2: trash.v1.ListTrashRequest
+	(*ListTrashResponse)(nil),     // 3: trash.v1.ListTrashResponse
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+}
+var file_trash_v1_trash_proto_depIdxs = []int32{
+	0, // 0: trash.v1.TrashItem.entity_type:type_name -> trash.v1.TrashEntityType
+	4, // 1: trash.v1.TrashItem.deleted_at:type_name -> google.protobuf.Timestamp
+	4, // 2: trash.v1.TrashItem.purge_at:type_name -> google.protobuf.Timestamp
+	0, // 3: trash.v1.ListTrashRequest.entity_type:type_name -> trash.v1.TrashEntityType
+	1, // 4: trash.v1.ListTrashResponse.items:type_name -> trash.v1.TrashItem
+	2, // 5: trash.v1.TrashService.ListTrash:input_type -> trash.v1.ListTrashRequest
+	3, // 6: trash.v1.TrashService.ListTrash:output_type -> trash.v1.ListTrashResponse
+	6, // [6:7] is the sub-list for method output_type
+	5, // [5:6] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_trash_v1_trash_proto_init() }
+func file_trash_v1_trash_proto_init() {
+	if File_trash_v1_trash_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_trash_v1_trash_proto_rawDesc), len(file_trash_v1_trash_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_trash_v1_trash_proto_goTypes,
+		DependencyIndexes: file_trash_v1_trash_proto_depIdxs,
+		EnumInfos:         file_trash_v1_trash_proto_enumTypes,
+		MessageInfos:      file_trash_v1_trash_proto_msgTypes,
+	}.Build()
+	File_trash_v1_trash_proto = out.File
+	file_trash_v1_trash_proto_goTypes = nil
+	file_trash_v1_trash_proto_depIdxs = nil
+}
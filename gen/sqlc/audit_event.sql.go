@@ -0,0 +1,90 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: audit_event.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const listAuditEvents = `-- name: ListAuditEvents :many
+SELECT id, actor_id, rpc, entity_type, entity_id, action, before, after, created_at, organization_id FROM "AuditEvent"
+WHERE organization_id = current_organization_id()
+AND ($1::varchar IS NULL OR entity_type = $1)
+AND ($2::varchar IS NULL OR entity_id = $2)
+AND ($3::uuid IS NULL OR actor_id = $3)
+AND ($4::timestamptz IS NULL OR created_at >= $4)
+AND ($5::timestamptz IS NULL OR created_at < $5)
+ORDER BY created_at DESC, id
+LIMIT $7 OFFSET $6
+`
+
+type ListAuditEventsParams struct {
+	EntityType pgtype.Text        `json:"entity_type"`
+	EntityID   pgtype.Text        `json:"entity_id"`
+	ActorID    pgtype.UUID        `json:"actor_id"`
+	FromTime   pgtype.Timestamptz `json:"from_time"`
+	ToTime     pgtype.Timestamptz `json:"to_time"`
+	RowOffset  int32              `json:"row_offset"`
+	RowLimit   int32              `json:"row_limit"`
+}
+
+func (q *Queries) ListAuditEvents(ctx context.Context, arg ListAuditEventsParams) ([]AuditEvent, error) {
+	rows, err := q.db.Query(ctx, listAuditEvents,
+		arg.EntityType,
+		arg.EntityID,
+		arg.ActorID,
+		arg.FromTime,
+		arg.ToTime,
+		arg.RowOffset,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AuditEvent{}
+	for rows.Next() {
+		var i AuditEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.ActorID,
+			&i.Rpc,
+			&i.EntityType,
+			&i.EntityID,
+			&i.Action,
+			&i.Before,
+			&i.After,
+			&i.CreatedAt,
+			&i.OrganizationID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setAuditContext = `-- name: SetAuditContext :exec
+SELECT
+  set_config('prism.actor_id', $1::text, true),
+  set_config('prism.rpc', $2::text, true)
+`
+
+type SetAuditContextParams struct {
+	ActorID string `json:"actor_id"`
+	Rpc     string `json:"rpc"`
+}
+
+// トランザクション内の変更を記録するトリガーに操作したユーザーとRPCを渡す
+func (q *Queries) SetAuditContext(ctx context.Context, arg SetAuditContextParams) error {
+	_, err := q.db.Exec(ctx, setAuditContext, arg.ActorID, arg.Rpc)
+	return err
+}
@@ -7,6 +7,7 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -15,7 +16,7 @@ import (
 const createBook = `-- name: CreateBook :one
 INSERT INTO "Book" (id, name)
 VALUES ($1, $2)
-RETURNING id, name, created_at
+RETURNING id, name, created_at, timezone, skip_holidays, deleted_at, version, organization_id
 `
 
 type CreateBookParams struct {
@@ -26,48 +27,191 @@ type CreateBookParams struct {
 func (q *Queries) CreateBook(ctx context.Context, arg CreateBookParams) (Book, error) {
 	row := q.db.QueryRow(ctx, createBook, arg.ID, arg.Name)
 	var i Book
-	err := row.Scan(&i.ID, &i.Name, &i.CreatedAt)
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.Timezone,
+		&i.SkipHolidays,
+		&i.DeletedAt,
+		&i.Version,
+		&i.OrganizationID,
+	)
 	return i, err
 }
 
-const deleteBook = `-- name: DeleteBook :exec
+const getBook = `-- name: GetBook :one
+SELECT id, name, created_at, timezone, skip_holidays, deleted_at, version, organization_id FROM "Book"
+WHERE id = $1 AND organization_id = current_organization_id()
+AND deleted_at IS NULL LIMIT 1
+`
+
+func (q *Queries) GetBook(ctx context.Context, id uuid.UUID) (Book, error) {
+	row := q.db.QueryRow(ctx, getBook, id)
+	var i Book
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.Timezone,
+		&i.SkipHolidays,
+		&i.DeletedAt,
+		&i.Version,
+		&i.OrganizationID,
+	)
+	return i, err
+}
+
+const getDeletedBook = `-- name: GetDeletedBook :one
+SELECT id, name, created_at, timezone, skip_holidays, deleted_at, version, organization_id FROM "Book"
+WHERE id = $1 AND organization_id = current_organization_id()
+AND deleted_at IS NOT NULL LIMIT 1
+FOR UPDATE
+`
+
+func (q *Queries) GetDeletedBook(ctx context.Context, id uuid.UUID) (Book, error) {
+	row := q.db.QueryRow(ctx, getDeletedBook, id)
+	var i Book
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.Timezone,
+		&i.SkipHolidays,
+		&i.DeletedAt,
+		&i.Version,
+		&i.OrganizationID,
+	)
+	return i, err
+}
+
+const listBookIDs = `-- name: ListBookIDs :many
+SELECT id FROM "Book"
+WHERE organization_id = current_organization_id()
+AND deleted_at IS NULL
+ORDER BY created_at
+`
+
+func (q *Queries) ListBookIDs(ctx context.Context) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, listBookIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []uuid.UUID{}
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const purgeBooks = `-- name: PurgeBooks :execrows
 DELETE FROM "Book"
-WHERE id = $1
+WHERE deleted_at < $1::timestamptz
 `
 
-func (q *Queries) DeleteBook(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.Exec(ctx, deleteBook, id)
-	return err
+// 保持期間を過ぎた全組織のリストを完全に削除する。顧客と架電履歴、連絡先、再架電も ON DELETE CASCADE で削除される
+func (q *Queries) PurgeBooks(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeBooks, deletedBefore)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
-const getBook = `-- name: GetBook :one
-SELECT id, name, created_at FROM "Book"
-WHERE id = $1 LIMIT 1
+const restoreBook = `-- name: RestoreBook :one
+UPDATE "Book"
+SET deleted_at = NULL
+WHERE id = $1 AND organization_id = current_organization_id()
+AND deleted_at IS NOT NULL
+RETURNING id, name, created_at, timezone, skip_holidays, deleted_at, version, organization_id
 `
 
-func (q *Queries) GetBook(ctx context.Context, id uuid.UUID) (Book, error) {
-	row := q.db.QueryRow(ctx, getBook, id)
+func (q *Queries) RestoreBook(ctx context.Context, id uuid.UUID) (Book, error) {
+	row := q.db.QueryRow(ctx, restoreBook, id)
+	var i Book
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.Timezone,
+		&i.SkipHolidays,
+		&i.DeletedAt,
+		&i.Version,
+		&i.OrganizationID,
+	)
+	return i, err
+}
+
+const softDeleteBook = `-- name: SoftDeleteBook :one
+UPDATE "Book"
+SET deleted_at = now()
+WHERE id = $1 AND organization_id = current_organization_id()
+AND deleted_at IS NULL
+RETURNING id, name, created_at, timezone, skip_holidays, deleted_at, version, organization_id
+`
+
+func (q *Queries) SoftDeleteBook(ctx context.Context, id uuid.UUID) (Book, error) {
+	row := q.db.QueryRow(ctx, softDeleteBook, id)
 	var i Book
-	err := row.Scan(&i.ID, &i.Name, &i.CreatedAt)
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.Timezone,
+		&i.SkipHolidays,
+		&i.DeletedAt,
+		&i.Version,
+		&i.OrganizationID,
+	)
 	return i, err
 }
 
 const updateBook = `-- name: UpdateBook :one
 UPDATE "Book"
 SET 
-  name = COALESCE($1, name)
-WHERE id = $2
-RETURNING id, name, created_at
+  name = COALESCE($1, name),
+  timezone = COALESCE($2, timezone),
+  skip_holidays = COALESCE($3, skip_holidays)
+WHERE id = $4 AND organization_id = current_organization_id()
+AND deleted_at IS NULL
+AND ($5::bigint IS NULL OR version = $5)
+RETURNING id, name, created_at, timezone, skip_holidays, deleted_at, version, organization_id
 `
 
 type UpdateBookParams struct {
-	Name pgtype.Text `json:"name"`
-	ID   uuid.UUID   `json:"id"`
+	Name         pgtype.Text `json:"name"`
+	Timezone     pgtype.Text `json:"timezone"`
+	SkipHolidays pgtype.Bool `json:"skip_holidays"`
+	ID           uuid.UUID   `json:"id"`
+	Version      pgtype.Int8 `json:"version"`
 }
 
 func (q *Queries) UpdateBook(ctx context.Context, arg UpdateBookParams) (Book, error) {
-	row := q.db.QueryRow(ctx, updateBook, arg.Name, arg.ID)
+	row := q.db.QueryRow(ctx, updateBook,
+		arg.Name,
+		arg.Timezone,
+		arg.SkipHolidays,
+		arg.ID,
+		arg.Version,
+	)
 	var i Book
-	err := row.Scan(&i.ID, &i.Name, &i.CreatedAt)
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.Timezone,
+		&i.SkipHolidays,
+		&i.DeletedAt,
+		&i.Version,
+		&i.OrganizationID,
+	)
 	return i, err
 }
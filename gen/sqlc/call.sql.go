@@ -0,0 +1,112 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: call.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createCall = `-- name: CreateCall :one
+INSERT INTO "Call" (id, customer_id, user_id, status_id)
+SELECT $1::uuid, $2::uuid, $3::uuid, $4::uuid
+WHERE customer_in_organization($2)
+RETURNING id, customer_id, user_id, status_id, created_at
+`
+
+type CreateCallParams struct {
+	ID         uuid.UUID   `json:"id"`
+	CustomerID uuid.UUID   `json:"customer_id"`
+	UserID     uuid.UUID   `json:"user_id"`
+	StatusID   pgtype.UUID `json:"status_id"`
+}
+
+func (q *Queries) CreateCall(ctx context.Context, arg CreateCallParams) (Call, error) {
+	row := q.db.QueryRow(ctx, createCall,
+		arg.ID,
+		arg.CustomerID,
+		arg.UserID,
+		arg.StatusID,
+	)
+	var i Call
+	err := row.Scan(
+		&i.ID,
+		&i.CustomerID,
+		&i.UserID,
+		&i.StatusID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteCall = `-- name: DeleteCall :exec
+DELETE FROM "Call"
+WHERE id = $1 AND customer_in_organization(customer_id)
+`
+
+func (q *Queries) DeleteCall(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteCall, id)
+	return err
+}
+
+const getCall = `-- name: GetCall :one
+SELECT id, customer_id, user_id, status_id, created_at FROM "Call"
+WHERE id = $1 AND customer_in_organization(customer_id) LIMIT 1
+`
+
+func (q *Queries) GetCall(ctx context.Context, id uuid.UUID) (Call, error) {
+	row := q.db.QueryRow(ctx, getCall, id)
+	var i Call
+	err := row.Scan(
+		&i.ID,
+		&i.CustomerID,
+		&i.UserID,
+		&i.StatusID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listCallsByCustomer = `-- name: ListCallsByCustomer :many
+SELECT id, customer_id, user_id, status_id, created_at FROM "Call"
+WHERE customer_id = $1 AND customer_in_organization(customer_id)
+ORDER BY created_at DESC
+LIMIT $2 OFFSET $3
+`
+
+type ListCallsByCustomerParams struct {
+	CustomerID uuid.UUID `json:"customer_id"`
+	Limit      int32     `json:"limit"`
+	Offset     int32     `json:"offset"`
+}
+
+func (q *Queries) ListCallsByCustomer(ctx context.Context, arg ListCallsByCustomerParams) ([]Call, error) {
+	rows, err := q.db.Query(ctx, listCallsByCustomer, arg.CustomerID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Call{}
+	for rows.Next() {
+		var i Call
+		if err := rows.Scan(
+			&i.ID,
+			&i.CustomerID,
+			&i.UserID,
+			&i.StatusID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
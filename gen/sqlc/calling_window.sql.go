@@ -0,0 +1,117 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: calling_window.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createCallingWindow = `-- name: CreateCallingWindow :one
+INSERT INTO "CallingWindow" (id, book_id, weekday, start_time, end_time)
+SELECT $1::uuid, $2::uuid, $3::smallint, $4::time, $5::time
+WHERE book_in_organization($2)
+RETURNING id, book_id, weekday, start_time, end_time, created_at
+`
+
+type CreateCallingWindowParams struct {
+	ID        uuid.UUID   `json:"id"`
+	BookID    uuid.UUID   `json:"book_id"`
+	Weekday   int16       `json:"weekday"`
+	StartTime pgtype.Time `json:"start_time"`
+	EndTime   pgtype.Time `json:"end_time"`
+}
+
+func (q *Queries) CreateCallingWindow(ctx context.Context, arg CreateCallingWindowParams) (CallingWindow, error) {
+	row := q.db.QueryRow(ctx, createCallingWindow,
+		arg.ID,
+		arg.BookID,
+		arg.Weekday,
+		arg.StartTime,
+		arg.EndTime,
+	)
+	var i CallingWindow
+	err := row.Scan(
+		&i.ID,
+		&i.BookID,
+		&i.Weekday,
+		&i.StartTime,
+		&i.EndTime,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteCallingWindows = `-- name: DeleteCallingWindows :exec
+DELETE FROM "CallingWindow"
+WHERE book_id = $1 AND book_in_organization(book_id)
+`
+
+func (q *Queries) DeleteCallingWindows(ctx context.Context, bookID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteCallingWindows, bookID)
+	return err
+}
+
+const getBookByCustomer = `-- name: GetBookByCustomer :one
+SELECT b.id, b.name, b.created_at, b.timezone, b.skip_holidays, b.deleted_at, b.version, b.organization_id FROM "Book" b
+JOIN "Customer" c ON c.book_id = b.id
+WHERE c.id = $1
+AND b.organization_id = current_organization_id()
+AND c.deleted_at IS NULL
+AND b.deleted_at IS NULL
+LIMIT 1
+`
+
+func (q *Queries) GetBookByCustomer(ctx context.Context, id uuid.UUID) (Book, error) {
+	row := q.db.QueryRow(ctx, getBookByCustomer, id)
+	var i Book
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.Timezone,
+		&i.SkipHolidays,
+		&i.DeletedAt,
+		&i.Version,
+		&i.OrganizationID,
+	)
+	return i, err
+}
+
+const listCallingWindows = `-- name: ListCallingWindows :many
+SELECT id, book_id, weekday, start_time, end_time, created_at FROM "CallingWindow"
+WHERE book_id = $1 AND book_in_organization(book_id)
+ORDER BY weekday, start_time
+`
+
+func (q *Queries) ListCallingWindows(ctx context.Context, bookID uuid.UUID) ([]CallingWindow, error) {
+	rows, err := q.db.Query(ctx, listCallingWindows, bookID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CallingWindow{}
+	for rows.Next() {
+		var i CallingWindow
+		if err := rows.Scan(
+			&i.ID,
+			&i.BookID,
+			&i.Weekday,
+			&i.StartTime,
+			&i.EndTime,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -15,7 +15,7 @@ import (
 const createCategory = `-- name: CreateCategory :one
 INSERT INTO "Category" (id, name)
 VALUES ($1, $2)
-RETURNING id, name, created_at
+RETURNING id, name, created_at, organization_id
 `
 
 type CreateCategoryParams struct {
@@ -26,13 +26,18 @@ type CreateCategoryParams struct {
 func (q *Queries) CreateCategory(ctx context.Context, arg CreateCategoryParams) (Category, error) {
 	row := q.db.QueryRow(ctx, createCategory, arg.ID, arg.Name)
 	var i Category
-	err := row.Scan(&i.ID, &i.Name, &i.CreatedAt)
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.OrganizationID,
+	)
 	return i, err
 }
 
 const deleteCategory = `-- name: DeleteCategory :exec
 DELETE FROM "Category"
-WHERE id = $1
+WHERE id = $1 AND organization_id = current_organization_id()
 `
 
 func (q *Queries) DeleteCategory(ctx context.Context, id uuid.UUID) error {
@@ -41,14 +46,19 @@ func (q *Queries) DeleteCategory(ctx context.Context, id uuid.UUID) error {
 }
 
 const getCategory = `-- name: GetCategory :one
-SELECT id, name, created_at FROM "Category"
-WHERE id = $1 LIMIT 1
+SELECT id, name, created_at, organization_id FROM "Category"
+WHERE id = $1 AND organization_id = current_organization_id() LIMIT 1
 `
 
 func (q *Queries) GetCategory(ctx context.Context, id uuid.UUID) (Category, error) {
 	row := q.db.QueryRow(ctx, getCategory, id)
 	var i Category
-	err := row.Scan(&i.ID, &i.Name, &i.CreatedAt)
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.OrganizationID,
+	)
 	return i, err
 }
 
@@ -56,8 +66,8 @@ const updateCategory = `-- name: UpdateCategory :one
 UPDATE "Category"
 SET 
   name = COALESCE($1, name)
-WHERE id = $2
-RETURNING id, name, created_at
+WHERE id = $2 AND organization_id = current_organization_id()
+RETURNING id, name, created_at, organization_id
 `
 
 type UpdateCategoryParams struct {
@@ -68,6 +78,11 @@ type UpdateCategoryParams struct {
 func (q *Queries) UpdateCategory(ctx context.Context, arg UpdateCategoryParams) (Category, error) {
 	row := q.db.QueryRow(ctx, updateCategory, arg.Name, arg.ID)
 	var i Category
-	err := row.Scan(&i.ID, &i.Name, &i.CreatedAt)
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.OrganizationID,
+	)
 	return i, err
 }
@@ -7,6 +7,7 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -14,8 +15,15 @@ import (
 
 const createContact = `-- name: CreateContact :one
 INSERT INTO "Contact" (id, customer_id, staff_id, phone, mail, fax)
-VALUES ($1, $2, $3, $4, $5, $6)
-RETURNING id, customer_id, staff_id, phone, mail, fax, created_at
+SELECT
+  $1::uuid,
+  $2::uuid,
+  $3::uuid,
+  $4::varchar,
+  $5::varchar,
+  $6::varchar
+WHERE customer_in_organization($2)
+RETURNING id, customer_id, staff_id, phone, mail, fax, created_at, deleted_at, version
 `
 
 type CreateContactParams struct {
@@ -45,13 +53,17 @@ func (q *Queries) CreateContact(ctx context.Context, arg CreateContactParams) (C
 		&i.Mail,
 		&i.Fax,
 		&i.CreatedAt,
+		&i.DeletedAt,
+		&i.Version,
 	)
 	return i, err
 }
 
 const deleteContact = `-- name: DeleteContact :exec
-DELETE FROM "Contact"
-WHERE id = $1
+UPDATE "Contact"
+SET deleted_at = now()
+WHERE id = $1 AND customer_in_organization(customer_id)
+AND deleted_at IS NULL
 `
 
 func (q *Queries) DeleteContact(ctx context.Context, id uuid.UUID) error {
@@ -60,8 +72,9 @@ func (q *Queries) DeleteContact(ctx context.Context, id uuid.UUID) error {
 }
 
 const getContact = `-- name: GetContact :one
-SELECT id, customer_id, staff_id, phone, mail, fax, created_at FROM "Contact"
-WHERE id = $1 LIMIT 1
+SELECT id, customer_id, staff_id, phone, mail, fax, created_at, deleted_at, version FROM "Contact"
+WHERE id = $1 AND customer_in_organization(customer_id)
+AND deleted_at IS NULL LIMIT 1
 `
 
 func (q *Queries) GetContact(ctx context.Context, id uuid.UUID) (Contact, error) {
@@ -75,10 +88,113 @@ func (q *Queries) GetContact(ctx context.Context, id uuid.UUID) (Contact, error)
 		&i.Mail,
 		&i.Fax,
 		&i.CreatedAt,
+		&i.DeletedAt,
+		&i.Version,
 	)
 	return i, err
 }
 
+const purgeContacts = `-- name: PurgeContacts :execrows
+DELETE FROM "Contact"
+WHERE deleted_at < $1::timestamptz
+`
+
+// 全組織が対象
+func (q *Queries) PurgeContacts(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeContacts, deletedBefore)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const restoreContactsByBook = `-- name: RestoreContactsByBook :execrows
+UPDATE "Contact" ct
+SET deleted_at = NULL
+FROM "Customer" c
+WHERE c.id = ct.customer_id
+AND c.book_id = $1
+AND book_in_organization(c.book_id)
+AND ct.deleted_at = $2
+`
+
+type RestoreContactsByBookParams struct {
+	BookID    uuid.UUID          `json:"book_id"`
+	DeletedAt pgtype.Timestamptz `json:"deleted_at"`
+}
+
+func (q *Queries) RestoreContactsByBook(ctx context.Context, arg RestoreContactsByBookParams) (int64, error) {
+	result, err := q.db.Exec(ctx, restoreContactsByBook, arg.BookID, arg.DeletedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const restoreContactsByCustomer = `-- name: RestoreContactsByCustomer :execrows
+UPDATE "Contact"
+SET deleted_at = NULL
+WHERE customer_id = $1 AND customer_in_organization(customer_id)
+AND deleted_at = $2
+`
+
+type RestoreContactsByCustomerParams struct {
+	CustomerID uuid.UUID          `json:"customer_id"`
+	DeletedAt  pgtype.Timestamptz `json:"deleted_at"`
+}
+
+// 顧客と一緒にゴミ箱に移動した連絡先のみ戻す
+func (q *Queries) RestoreContactsByCustomer(ctx context.Context, arg RestoreContactsByCustomerParams) (int64, error) {
+	result, err := q.db.Exec(ctx, restoreContactsByCustomer, arg.CustomerID, arg.DeletedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const softDeleteContactsByBook = `-- name: SoftDeleteContactsByBook :execrows
+UPDATE "Contact" ct
+SET deleted_at = $1
+FROM "Customer" c
+WHERE c.id = ct.customer_id
+AND c.book_id = $2
+AND book_in_organization(c.book_id)
+AND ct.deleted_at IS NULL
+`
+
+type SoftDeleteContactsByBookParams struct {
+	DeletedAt pgtype.Timestamptz `json:"deleted_at"`
+	BookID    uuid.UUID          `json:"book_id"`
+}
+
+func (q *Queries) SoftDeleteContactsByBook(ctx context.Context, arg SoftDeleteContactsByBookParams) (int64, error) {
+	result, err := q.db.Exec(ctx, softDeleteContactsByBook, arg.DeletedAt, arg.BookID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const softDeleteContactsByCustomer = `-- name: SoftDeleteContactsByCustomer :execrows
+UPDATE "Contact"
+SET deleted_at = $1
+WHERE customer_id = $2 AND customer_in_organization(customer_id)
+AND deleted_at IS NULL
+`
+
+type SoftDeleteContactsByCustomerParams struct {
+	DeletedAt  pgtype.Timestamptz `json:"deleted_at"`
+	CustomerID uuid.UUID          `json:"customer_id"`
+}
+
+func (q *Queries) SoftDeleteContactsByCustomer(ctx context.Context, arg SoftDeleteContactsByCustomerParams) (int64, error) {
+	result, err := q.db.Exec(ctx, softDeleteContactsByCustomer, arg.DeletedAt, arg.CustomerID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateContact = `-- name: UpdateContact :one
 UPDATE "Contact"
 SET 
@@ -86,15 +202,18 @@ SET
   mail = COALESCE($2, mail),
   fax = COALESCE($3, fax)
 WHERE 
-  id = $4
-RETURNING id, customer_id, staff_id, phone, mail, fax, created_at
+  id = $4 AND customer_in_organization(customer_id)
+  AND deleted_at IS NULL
+  AND ($5::bigint IS NULL OR version = $5)
+RETURNING id, customer_id, staff_id, phone, mail, fax, created_at, deleted_at, version
 `
 
 type UpdateContactParams struct {
-	Phone pgtype.Text `json:"phone"`
-	Mail  pgtype.Text `json:"mail"`
-	Fax   pgtype.Text `json:"fax"`
-	ID    uuid.UUID   `json:"id"`
+	Phone   pgtype.Text `json:"phone"`
+	Mail    pgtype.Text `json:"mail"`
+	Fax     pgtype.Text `json:"fax"`
+	ID      uuid.UUID   `json:"id"`
+	Version pgtype.Int8 `json:"version"`
 }
 
 func (q *Queries) UpdateContact(ctx context.Context, arg UpdateContactParams) (Contact, error) {
@@ -103,6 +222,7 @@ func (q *Queries) UpdateContact(ctx context.Context, arg UpdateContactParams) (C
 		arg.Mail,
 		arg.Fax,
 		arg.ID,
+		arg.Version,
 	)
 	var i Contact
 	err := row.Scan(
@@ -113,6 +233,8 @@ func (q *Queries) UpdateContact(ctx context.Context, arg UpdateContactParams) (C
 		&i.Mail,
 		&i.Fax,
 		&i.CreatedAt,
+		&i.DeletedAt,
+		&i.Version,
 	)
 	return i, err
 }
@@ -0,0 +1,237 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: custom_field.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countCustomFieldValuesNotIn = `-- name: CountCustomFieldValuesNotIn :one
+SELECT count(*) FROM "Customer"
+WHERE book_id = $1 AND book_in_organization(book_id)
+AND custom_fields ? $2::text
+AND NOT (custom_fields ->> $2::text = ANY ($3::varchar[]))
+`
+
+type CountCustomFieldValuesNotInParams struct {
+	BookID  uuid.UUID `json:"book_id"`
+	Key     string    `json:"key"`
+	Options []string  `json:"options"`
+}
+
+// select の選択肢から外す値を使っている顧客の数
+func (q *Queries) CountCustomFieldValuesNotIn(ctx context.Context, arg CountCustomFieldValuesNotInParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countCustomFieldValuesNotIn, arg.BookID, arg.Key, arg.Options)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countCustomersMissingCustomField = `-- name: CountCustomersMissingCustomField :one
+SELECT count(*) FROM "Customer"
+WHERE book_id = $1 AND book_in_organization(book_id)
+AND deleted_at IS NULL
+AND NOT custom_fields ? $2::text
+`
+
+type CountCustomersMissingCustomFieldParams struct {
+	BookID uuid.UUID `json:"book_id"`
+	Key    string    `json:"key"`
+}
+
+// 必須にする項目が未入力の顧客の数
+func (q *Queries) CountCustomersMissingCustomField(ctx context.Context, arg CountCustomersMissingCustomFieldParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countCustomersMissingCustomField, arg.BookID, arg.Key)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createCustomField = `-- name: CreateCustomField :one
+INSERT INTO "CustomField" (id, book_id, key, label, type, options, required, position)
+SELECT
+  $1::uuid,
+  $2::uuid,
+  $3::varchar,
+  $4::varchar,
+  $5::custom_field_type,
+  $6::varchar[],
+  $7::bool,
+  $8::int
+WHERE book_in_organization($2)
+RETURNING id, book_id, key, label, type, options, required, position, created_at
+`
+
+type CreateCustomFieldParams struct {
+	ID       uuid.UUID       `json:"id"`
+	BookID   uuid.UUID       `json:"book_id"`
+	Key      string          `json:"key"`
+	Label    string          `json:"label"`
+	Type     CustomFieldType `json:"type"`
+	Options  []string        `json:"options"`
+	Required bool            `json:"required"`
+	Position int32           `json:"position"`
+}
+
+func (q *Queries) CreateCustomField(ctx context.Context, arg CreateCustomFieldParams) (CustomField, error) {
+	row := q.db.QueryRow(ctx, createCustomField,
+		arg.ID,
+		arg.BookID,
+		arg.Key,
+		arg.Label,
+		arg.Type,
+		arg.Options,
+		arg.Required,
+		arg.Position,
+	)
+	var i CustomField
+	err := row.Scan(
+		&i.ID,
+		&i.BookID,
+		&i.Key,
+		&i.Label,
+		&i.Type,
+		&i.Options,
+		&i.Required,
+		&i.Position,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteCustomField = `-- name: DeleteCustomField :exec
+DELETE FROM "CustomField"
+WHERE id = $1 AND book_in_organization(book_id)
+`
+
+func (q *Queries) DeleteCustomField(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteCustomField, id)
+	return err
+}
+
+const deleteCustomFieldValues = `-- name: DeleteCustomFieldValues :execrows
+UPDATE "Customer"
+SET custom_fields = custom_fields - $1::text
+WHERE book_id = $2 AND book_in_organization(book_id)
+AND custom_fields ? $1::text
+`
+
+type DeleteCustomFieldValuesParams struct {
+	Key    string    `json:"key"`
+	BookID uuid.UUID `json:"book_id"`
+}
+
+// 削除した項目の値を顧客から取り除く
+func (q *Queries) DeleteCustomFieldValues(ctx context.Context, arg DeleteCustomFieldValuesParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteCustomFieldValues, arg.Key, arg.BookID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getCustomField = `-- name: GetCustomField :one
+SELECT id, book_id, key, label, type, options, required, position, created_at FROM "CustomField"
+WHERE id = $1 AND book_in_organization(book_id) LIMIT 1
+`
+
+func (q *Queries) GetCustomField(ctx context.Context, id uuid.UUID) (CustomField, error) {
+	row := q.db.QueryRow(ctx, getCustomField, id)
+	var i CustomField
+	err := row.Scan(
+		&i.ID,
+		&i.BookID,
+		&i.Key,
+		&i.Label,
+		&i.Type,
+		&i.Options,
+		&i.Required,
+		&i.Position,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listCustomFields = `-- name: ListCustomFields :many
+SELECT id, book_id, key, label, type, options, required, position, created_at FROM "CustomField"
+WHERE book_id = $1 AND book_in_organization(book_id)
+ORDER BY position, created_at
+`
+
+func (q *Queries) ListCustomFields(ctx context.Context, bookID uuid.UUID) ([]CustomField, error) {
+	rows, err := q.db.Query(ctx, listCustomFields, bookID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CustomField{}
+	for rows.Next() {
+		var i CustomField
+		if err := rows.Scan(
+			&i.ID,
+			&i.BookID,
+			&i.Key,
+			&i.Label,
+			&i.Type,
+			&i.Options,
+			&i.Required,
+			&i.Position,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateCustomField = `-- name: UpdateCustomField :one
+UPDATE "CustomField"
+SET
+  label = COALESCE($1, label),
+  options = COALESCE($2, options),
+  required = COALESCE($3, required),
+  position = COALESCE($4, position)
+WHERE id = $5 AND book_in_organization(book_id)
+RETURNING id, book_id, key, label, type, options, required, position, created_at
+`
+
+type UpdateCustomFieldParams struct {
+	Label    pgtype.Text `json:"label"`
+	Options  []string    `json:"options"`
+	Required pgtype.Bool `json:"required"`
+	Position pgtype.Int4 `json:"position"`
+	ID       uuid.UUID   `json:"id"`
+}
+
+func (q *Queries) UpdateCustomField(ctx context.Context, arg UpdateCustomFieldParams) (CustomField, error) {
+	row := q.db.QueryRow(ctx, updateCustomField,
+		arg.Label,
+		arg.Options,
+		arg.Required,
+		arg.Position,
+		arg.ID,
+	)
+	var i CustomField
+	err := row.Scan(
+		&i.ID,
+		&i.BookID,
+		&i.Key,
+		&i.Label,
+		&i.Type,
+		&i.Options,
+		&i.Required,
+		&i.Position,
+		&i.CreatedAt,
+	)
+	return i, err
+}
@@ -14,21 +14,37 @@ import (
 )
 
 const createCustomer = `-- name: CreateCustomer :one
-INSERT INTO "Customer" (id, book_id, category_id, name, corporation, address, leader, pic, memo)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
-RETURNING id, book_id, category_id, job, name, corporation, address, leader, pic, memo, created_at
+INSERT INTO "Customer" (id, book_id, category_id, name, corporation, address, leader, pic, memo, custom_fields)
+SELECT
+  $1::uuid,
+  $2::uuid,
+  $3::uuid,
+  $4::varchar,
+  $5::varchar,
+  $6::varchar,
+  $7::uuid,
+  $8::uuid,
+  $9::text,
+  $10::jsonb
+WHERE book_in_organization($2)
+AND ($3 IS NULL OR EXISTS (
+  SELECT 1 FROM "Category" cg
+  WHERE cg.id = $3 AND cg.organization_id = current_organization_id()
+))
+RETURNING id, book_id, category_id, job, name, corporation, address, leader, pic, memo, created_at, deleted_at, custom_fields, version
 `
 
 type CreateCustomerParams struct {
-	ID          uuid.UUID   `json:"id"`
-	BookID      uuid.UUID   `json:"book_id"`
-	CategoryID  pgtype.UUID `json:"category_id"`
-	Name        string      `json:"name"`
-	Corporation pgtype.Text `json:"corporation"`
-	Address     pgtype.Text `json:"address"`
-	Leader      pgtype.UUID `json:"leader"`
-	Pic         pgtype.UUID `json:"pic"`
-	Memo        pgtype.Text `json:"memo"`
+	ID           uuid.UUID   `json:"id"`
+	BookID       uuid.UUID   `json:"book_id"`
+	CategoryID   pgtype.UUID `json:"category_id"`
+	Name         string      `json:"name"`
+	Corporation  pgtype.Text `json:"corporation"`
+	Address      pgtype.Text `json:"address"`
+	Leader       pgtype.UUID `json:"leader"`
+	Pic          pgtype.UUID `json:"pic"`
+	Memo         pgtype.Text `json:"memo"`
+	CustomFields []byte      `json:"custom_fields"`
 }
 
 func (q *Queries) CreateCustomer(ctx context.Context, arg CreateCustomerParams) (Customer, error) {
@@ -42,6 +58,7 @@ func (q *Queries) CreateCustomer(ctx context.Context, arg CreateCustomerParams)
 		arg.Leader,
 		arg.Pic,
 		arg.Memo,
+		arg.CustomFields,
 	)
 	var i Customer
 	err := row.Scan(
@@ -56,18 +73,86 @@ func (q *Queries) CreateCustomer(ctx context.Context, arg CreateCustomerParams)
 		&i.Pic,
 		&i.Memo,
 		&i.CreatedAt,
+		&i.DeletedAt,
+		&i.CustomFields,
+		&i.Version,
 	)
 	return i, err
 }
 
-const deleteCustomer = `-- name: DeleteCustomer :exec
-DELETE FROM "Customer"
-WHERE id = $1
+const exportCustomers = `-- name: ExportCustomers :many
+SELECT DISTINCT ON (c.id)
+  c.id, c.name, c.corporation, c.address, c.memo, c.custom_fields,
+  ct.phone, ct.mail, ct.fax
+FROM "Customer" c
+LEFT JOIN "Contact" ct ON ct.customer_id = c.id AND ct.deleted_at IS NULL
+WHERE c.book_id = $1 AND book_in_organization(c.book_id)
+AND c.deleted_at IS NULL
+AND c.id > $2
+AND (NOT $3::bool OR NOT EXISTS (
+  SELECT 1 FROM "Contact" dct
+  JOIN "DoNotCall" d ON d.organization_id = current_organization_id() AND d.phone = normalize_phone(dct.phone)
+  WHERE dct.customer_id = c.id
+  AND dct.deleted_at IS NULL
+))
+ORDER BY c.id, ct.created_at
+LIMIT $4
 `
 
-func (q *Queries) DeleteCustomer(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.Exec(ctx, deleteCustomer, id)
-	return err
+type ExportCustomersParams struct {
+	BookID     uuid.UUID `json:"book_id"`
+	AfterID    uuid.UUID `json:"after_id"`
+	ForCalling bool      `json:"for_calling"`
+	RowLimit   int32     `json:"row_limit"`
+}
+
+type ExportCustomersRow struct {
+	ID           uuid.UUID   `json:"id"`
+	Name         string      `json:"name"`
+	Corporation  pgtype.Text `json:"corporation"`
+	Address      pgtype.Text `json:"address"`
+	Memo         pgtype.Text `json:"memo"`
+	CustomFields []byte      `json:"custom_fields"`
+	Phone        pgtype.Text `json:"phone"`
+	Mail         pgtype.Text `json:"mail"`
+	Fax          pgtype.Text `json:"fax"`
+}
+
+// 顧客リストのCSV出力。連絡先は最初に登録したものを出力し、id 順にページングする。
+// for_calling の場合は架電キューと同じく、連絡先の番号が架電禁止リストにある顧客を除く
+func (q *Queries) ExportCustomers(ctx context.Context, arg ExportCustomersParams) ([]ExportCustomersRow, error) {
+	rows, err := q.db.Query(ctx, exportCustomers,
+		arg.BookID,
+		arg.AfterID,
+		arg.ForCalling,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ExportCustomersRow{}
+	for rows.Next() {
+		var i ExportCustomersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Corporation,
+			&i.Address,
+			&i.Memo,
+			&i.CustomFields,
+			&i.Phone,
+			&i.Mail,
+			&i.Fax,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const getCustomer = `-- name: GetCustomer :one
@@ -82,38 +167,45 @@ SELECT
     c.leader as customer_leader,
     c.pic as customer_pic,
     c.memo as customer_memo,
+    c.custom_fields as customer_custom_fields,
     c.created_at as customer_created_at,
+    c.version as customer_version,
     ct.id as contact_id,
     ct.customer_id as contact_customer_id,
     ct.staff_id as contact_staff_id,
     ct.phone as contact_phone,
     ct.mail as contact_mail,
     ct.fax as contact_fax,
-    ct.created_at as contact_created_at
+    ct.created_at as contact_created_at,
+    ct.version as contact_version
 FROM "Customer" c
-LEFT JOIN "Contact" ct ON c.id = ct.id
-WHERE c.id = $1
+LEFT JOIN "Contact" ct ON c.id = ct.id AND ct.deleted_at IS NULL
+WHERE c.id = $1 AND book_in_organization(c.book_id)
+AND c.deleted_at IS NULL
 `
 
 type GetCustomerRow struct {
-	CustomerID          uuid.UUID          `json:"customer_id"`
-	CustomerBookID      uuid.UUID          `json:"customer_book_id"`
-	CustomerCategoryID  pgtype.UUID        `json:"customer_category_id"`
-	CustomerJob         pgtype.Text        `json:"customer_job"`
-	CustomerName        string             `json:"customer_name"`
-	CustomerCorporation pgtype.Text        `json:"customer_corporation"`
-	CustomerAddress     pgtype.Text        `json:"customer_address"`
-	CustomerLeader      pgtype.UUID        `json:"customer_leader"`
-	CustomerPic         pgtype.UUID        `json:"customer_pic"`
-	CustomerMemo        pgtype.Text        `json:"customer_memo"`
-	CustomerCreatedAt   time.Time          `json:"customer_created_at"`
-	ContactID           pgtype.UUID        `json:"contact_id"`
-	ContactCustomerID   pgtype.UUID        `json:"contact_customer_id"`
-	ContactStaffID      pgtype.UUID        `json:"contact_staff_id"`
-	ContactPhone        pgtype.Text        `json:"contact_phone"`
-	ContactMail         pgtype.Text        `json:"contact_mail"`
-	ContactFax          pgtype.Text        `json:"contact_fax"`
-	ContactCreatedAt    pgtype.Timestamptz `json:"contact_created_at"`
+	CustomerID           uuid.UUID          `json:"customer_id"`
+	CustomerBookID       uuid.UUID          `json:"customer_book_id"`
+	CustomerCategoryID   pgtype.UUID        `json:"customer_category_id"`
+	CustomerJob          pgtype.Text        `json:"customer_job"`
+	CustomerName         string             `json:"customer_name"`
+	CustomerCorporation  pgtype.Text        `json:"customer_corporation"`
+	CustomerAddress      pgtype.Text        `json:"customer_address"`
+	CustomerLeader       pgtype.UUID        `json:"customer_leader"`
+	CustomerPic          pgtype.UUID        `json:"customer_pic"`
+	CustomerMemo         pgtype.Text        `json:"customer_memo"`
+	CustomerCustomFields []byte             `json:"customer_custom_fields"`
+	CustomerCreatedAt    time.Time          `json:"customer_created_at"`
+	CustomerVersion      int64              `json:"customer_version"`
+	ContactID            pgtype.UUID        `json:"contact_id"`
+	ContactCustomerID    pgtype.UUID        `json:"contact_customer_id"`
+	ContactStaffID       pgtype.UUID        `json:"contact_staff_id"`
+	ContactPhone         pgtype.Text        `json:"contact_phone"`
+	ContactMail          pgtype.Text        `json:"contact_mail"`
+	ContactFax           pgtype.Text        `json:"contact_fax"`
+	ContactCreatedAt     pgtype.Timestamptz `json:"contact_created_at"`
+	ContactVersion       pgtype.Int8        `json:"contact_version"`
 }
 
 func (q *Queries) GetCustomer(ctx context.Context, id uuid.UUID) (GetCustomerRow, error) {
@@ -130,7 +222,9 @@ func (q *Queries) GetCustomer(ctx context.Context, id uuid.UUID) (GetCustomerRow
 		&i.CustomerLeader,
 		&i.CustomerPic,
 		&i.CustomerMemo,
+		&i.CustomerCustomFields,
 		&i.CustomerCreatedAt,
+		&i.CustomerVersion,
 		&i.ContactID,
 		&i.ContactCustomerID,
 		&i.ContactStaffID,
@@ -138,25 +232,50 @@ func (q *Queries) GetCustomer(ctx context.Context, id uuid.UUID) (GetCustomerRow
 		&i.ContactMail,
 		&i.ContactFax,
 		&i.ContactCreatedAt,
+		&i.ContactVersion,
 	)
 	return i, err
 }
 
 const getCustomerByBookId = `-- name: GetCustomerByBookId :many
-SELECT id, book_id, category_id, job, name, corporation, address, leader, pic, memo, created_at FROM "Customer"
-WHERE book_id = $1
-ORDER BY created_at DESC
-LIMIT $2 OFFSET $3
+SELECT c.id, c.book_id, c.category_id, c.job, c.name, c.corporation, c.address, c.leader, c.pic, c.memo, c.created_at, c.deleted_at, c.custom_fields, c.version FROM "Customer" c
+WHERE c.book_id = $1 AND book_in_organization(c.book_id)
+AND c.deleted_at IS NULL
+AND (COALESCE(cardinality($2::varchar[]), 0) = 0 OR EXISTS (
+  SELECT 1 FROM "CustomerTag" ct JOIN "Tag" t ON t.id = ct.tag_id
+  WHERE ct.customer_id = c.id AND t.name = ANY ($2::varchar[])
+))
+AND (COALESCE(cardinality($3::varchar[]), 0) = 0 OR (
+  SELECT count(DISTINCT t.name) FROM "CustomerTag" ct JOIN "Tag" t ON t.id = ct.tag_id
+  WHERE ct.customer_id = c.id AND t.name = ANY ($3::varchar[])
+) = cardinality($3::varchar[]))
+AND NOT EXISTS (
+  SELECT 1 FROM "CustomerTag" ct JOIN "Tag" t ON t.id = ct.tag_id
+  WHERE ct.customer_id = c.id AND t.name = ANY ($4::varchar[])
+)
+ORDER BY c.created_at DESC
+LIMIT $6 OFFSET $5
 `
 
 type GetCustomerByBookIdParams struct {
-	BookID uuid.UUID `json:"book_id"`
-	Limit  int32     `json:"limit"`
-	Offset int32     `json:"offset"`
+	BookID    uuid.UUID `json:"book_id"`
+	TagsAny   []string  `json:"tags_any"`
+	TagsAll   []string  `json:"tags_all"`
+	TagsNone  []string  `json:"tags_none"`
+	RowOffset int32     `json:"row_offset"`
+	RowLimit  int32     `json:"row_limit"`
 }
 
+// tags_any はいずれか、tags_all はすべてのタグが付いた顧客、tags_none はいずれのタグも付いていない顧客に絞り込む
 func (q *Queries) GetCustomerByBookId(ctx context.Context, arg GetCustomerByBookIdParams) ([]Customer, error) {
-	rows, err := q.db.Query(ctx, getCustomerByBookId, arg.BookID, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, getCustomerByBookId,
+		arg.BookID,
+		arg.TagsAny,
+		arg.TagsAll,
+		arg.TagsNone,
+		arg.RowOffset,
+		arg.RowLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -176,6 +295,9 @@ func (q *Queries) GetCustomerByBookId(ctx context.Context, arg GetCustomerByBook
 			&i.Pic,
 			&i.Memo,
 			&i.CreatedAt,
+			&i.DeletedAt,
+			&i.CustomFields,
+			&i.Version,
 		); err != nil {
 			return nil, err
 		}
@@ -187,23 +309,203 @@ func (q *Queries) GetCustomerByBookId(ctx context.Context, arg GetCustomerByBook
 	return items, nil
 }
 
+const getCustomerByID = `-- name: GetCustomerByID :one
+SELECT id, book_id, category_id, job, name, corporation, address, leader, pic, memo, created_at, deleted_at, custom_fields, version FROM "Customer"
+WHERE id = $1 AND book_in_organization(book_id)
+AND deleted_at IS NULL LIMIT 1
+`
+
+func (q *Queries) GetCustomerByID(ctx context.Context, id uuid.UUID) (Customer, error) {
+	row := q.db.QueryRow(ctx, getCustomerByID, id)
+	var i Customer
+	err := row.Scan(
+		&i.ID,
+		&i.BookID,
+		&i.CategoryID,
+		&i.Job,
+		&i.Name,
+		&i.Corporation,
+		&i.Address,
+		&i.Leader,
+		&i.Pic,
+		&i.Memo,
+		&i.CreatedAt,
+		&i.DeletedAt,
+		&i.CustomFields,
+		&i.Version,
+	)
+	return i, err
+}
+
+const getDeletedCustomer = `-- name: GetDeletedCustomer :one
+SELECT id, book_id, category_id, job, name, corporation, address, leader, pic, memo, created_at, deleted_at, custom_fields, version FROM "Customer"
+WHERE id = $1 AND book_in_organization(book_id)
+AND deleted_at IS NOT NULL LIMIT 1
+FOR UPDATE
+`
+
+func (q *Queries) GetDeletedCustomer(ctx context.Context, id uuid.UUID) (Customer, error) {
+	row := q.db.QueryRow(ctx, getDeletedCustomer, id)
+	var i Customer
+	err := row.Scan(
+		&i.ID,
+		&i.BookID,
+		&i.CategoryID,
+		&i.Job,
+		&i.Name,
+		&i.Corporation,
+		&i.Address,
+		&i.Leader,
+		&i.Pic,
+		&i.Memo,
+		&i.CreatedAt,
+		&i.DeletedAt,
+		&i.CustomFields,
+		&i.Version,
+	)
+	return i, err
+}
+
+const purgeCustomers = `-- name: PurgeCustomers :execrows
+DELETE FROM "Customer"
+WHERE deleted_at < $1::timestamptz
+`
+
+// 全組織が対象
+func (q *Queries) PurgeCustomers(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeCustomers, deletedBefore)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const restoreCustomer = `-- name: RestoreCustomer :one
+UPDATE "Customer"
+SET deleted_at = NULL
+WHERE id = $1 AND book_in_organization(book_id)
+AND deleted_at IS NOT NULL
+RETURNING id, book_id, category_id, job, name, corporation, address, leader, pic, memo, created_at, deleted_at, custom_fields, version
+`
+
+func (q *Queries) RestoreCustomer(ctx context.Context, id uuid.UUID) (Customer, error) {
+	row := q.db.QueryRow(ctx, restoreCustomer, id)
+	var i Customer
+	err := row.Scan(
+		&i.ID,
+		&i.BookID,
+		&i.CategoryID,
+		&i.Job,
+		&i.Name,
+		&i.Corporation,
+		&i.Address,
+		&i.Leader,
+		&i.Pic,
+		&i.Memo,
+		&i.CreatedAt,
+		&i.DeletedAt,
+		&i.CustomFields,
+		&i.Version,
+	)
+	return i, err
+}
+
+const restoreCustomersByBook = `-- name: RestoreCustomersByBook :execrows
+UPDATE "Customer"
+SET deleted_at = NULL
+WHERE book_id = $1 AND book_in_organization(book_id)
+AND deleted_at = $2
+`
+
+type RestoreCustomersByBookParams struct {
+	BookID    uuid.UUID          `json:"book_id"`
+	DeletedAt pgtype.Timestamptz `json:"deleted_at"`
+}
+
+// リストと一緒にゴミ箱に移動した顧客のみ戻す
+func (q *Queries) RestoreCustomersByBook(ctx context.Context, arg RestoreCustomersByBookParams) (int64, error) {
+	result, err := q.db.Exec(ctx, restoreCustomersByBook, arg.BookID, arg.DeletedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const searchCustomer = `-- name: SearchCustomer :many
-SELECT id, book_id, category_id, job, name, corporation, address, leader, pic, memo, created_at FROM "Customer"
-WHERE book_id = COALESCE($1, book_id)
-AND name ILIKE '%' || COALESCE($2, name) || '%'
-AND corporation ILIKE '%' || COALESCE($3, corporation) || '%'
-AND address ILIKE '%' || COALESCE($4, address) || '%'
-AND memo ILIKE '%' || COALESCE($5, memo) || '%'
+SELECT c.id, c.book_id, c.category_id, c.job, c.name, c.corporation, c.address, c.leader, c.pic, c.memo, c.created_at, c.deleted_at, c.custom_fields, c.version FROM "Customer" c
+WHERE c.book_id = COALESCE($1, c.book_id)
+AND book_in_organization(c.book_id)
+AND c.name ILIKE '%' || COALESCE($2, c.name) || '%'
+AND c.corporation ILIKE '%' || COALESCE($3, c.corporation) || '%'
+AND c.address ILIKE '%' || COALESCE($4, c.address) || '%'
+AND c.memo ILIKE '%' || COALESCE($5, c.memo) || '%'
+AND c.deleted_at IS NULL
+AND (COALESCE(cardinality($6::varchar[]), 0) = 0 OR EXISTS (
+  SELECT 1 FROM "CustomerTag" ct JOIN "Tag" t ON t.id = ct.tag_id
+  WHERE ct.customer_id = c.id AND t.name = ANY ($6::varchar[])
+))
+AND (COALESCE(cardinality($7::varchar[]), 0) = 0 OR (
+  SELECT count(DISTINCT t.name) FROM "CustomerTag" ct JOIN "Tag" t ON t.id = ct.tag_id
+  WHERE ct.customer_id = c.id AND t.name = ANY ($7::varchar[])
+) = cardinality($7::varchar[]))
+AND NOT EXISTS (
+  SELECT 1 FROM "CustomerTag" ct JOIN "Tag" t ON t.id = ct.tag_id
+  WHERE ct.customer_id = c.id AND t.name = ANY ($8::varchar[])
+)
+AND NOT EXISTS (
+  SELECT 1 FROM jsonb_to_recordset(COALESCE($9::jsonb, '[]'))
+    AS f(key text, type text, op text, value text)
+  WHERE NOT COALESCE(CASE
+    WHEN f.op = 'set' THEN c.custom_fields ? f.key
+    WHEN f.op = 'contains' THEN c.custom_fields ->> f.key ILIKE '%' || f.value || '%'
+    WHEN f.type = 'number' THEN CASE f.op
+      WHEN 'eq' THEN (c.custom_fields ->> f.key)::numeric = f.value::numeric
+      WHEN 'ne' THEN (c.custom_fields ->> f.key)::numeric <> f.value::numeric
+      WHEN 'lt' THEN (c.custom_fields ->> f.key)::numeric < f.value::numeric
+      WHEN 'lte' THEN (c.custom_fields ->> f.key)::numeric <= f.value::numeric
+      WHEN 'gt' THEN (c.custom_fields ->> f.key)::numeric > f.value::numeric
+      WHEN 'gte' THEN (c.custom_fields ->> f.key)::numeric >= f.value::numeric
+    END
+    ELSE CASE f.op
+      WHEN 'eq' THEN c.custom_fields ->> f.key = f.value
+      WHEN 'ne' THEN c.custom_fields ->> f.key <> f.value
+      WHEN 'lt' THEN c.custom_fields ->> f.key < f.value
+      WHEN 'lte' THEN c.custom_fields ->> f.key <= f.value
+      WHEN 'gt' THEN c.custom_fields ->> f.key > f.value
+      WHEN 'gte' THEN c.custom_fields ->> f.key >= f.value
+    END
+  END, false)
+)
+ORDER BY
+  CASE WHEN $10::text = 'number' AND NOT $11::bool
+    THEN (c.custom_fields ->> $12::text)::numeric END ASC,
+  CASE WHEN $10::text = 'number' AND $11::bool
+    THEN (c.custom_fields ->> $12::text)::numeric END DESC,
+  CASE WHEN $10::text <> 'number' AND NOT $11::bool
+    THEN c.custom_fields ->> $12::text END ASC,
+  CASE WHEN $10::text <> 'number' AND $11::bool
+    THEN c.custom_fields ->> $12::text END DESC,
+  c.created_at DESC,
+  c.id
 `
 
 type SearchCustomerParams struct {
-	BookID      pgtype.UUID `json:"book_id"`
-	Name        pgtype.Text `json:"name"`
-	Corporation pgtype.Text `json:"corporation"`
-	Address     pgtype.Text `json:"address"`
-	Memo        pgtype.Text `json:"memo"`
+	BookID             pgtype.UUID `json:"book_id"`
+	Name               pgtype.Text `json:"name"`
+	Corporation        pgtype.Text `json:"corporation"`
+	Address            pgtype.Text `json:"address"`
+	Memo               pgtype.Text `json:"memo"`
+	TagsAny            []string    `json:"tags_any"`
+	TagsAll            []string    `json:"tags_all"`
+	TagsNone           []string    `json:"tags_none"`
+	CustomFieldFilters []byte      `json:"custom_field_filters"`
+	SortType           pgtype.Text `json:"sort_type"`
+	SortDesc           bool        `json:"sort_desc"`
+	SortKey            pgtype.Text `json:"sort_key"`
 }
 
+// custom_field_filters は [{"key", "type", "op", "value"}] の配列。
+// 値は CustomField の型で検証済みのため、number の場合のみ数値として比較する
 func (q *Queries) SearchCustomer(ctx context.Context, arg SearchCustomerParams) ([]Customer, error) {
 	rows, err := q.db.Query(ctx, searchCustomer,
 		arg.BookID,
@@ -211,6 +513,13 @@ func (q *Queries) SearchCustomer(ctx context.Context, arg SearchCustomerParams)
 		arg.Corporation,
 		arg.Address,
 		arg.Memo,
+		arg.TagsAny,
+		arg.TagsAll,
+		arg.TagsNone,
+		arg.CustomFieldFilters,
+		arg.SortType,
+		arg.SortDesc,
+		arg.SortKey,
 	)
 	if err != nil {
 		return nil, err
@@ -231,6 +540,9 @@ func (q *Queries) SearchCustomer(ctx context.Context, arg SearchCustomerParams)
 			&i.Pic,
 			&i.Memo,
 			&i.CreatedAt,
+			&i.DeletedAt,
+			&i.CustomFields,
+			&i.Version,
 		); err != nil {
 			return nil, err
 		}
@@ -242,6 +554,56 @@ func (q *Queries) SearchCustomer(ctx context.Context, arg SearchCustomerParams)
 	return items, nil
 }
 
+const softDeleteCustomer = `-- name: SoftDeleteCustomer :one
+UPDATE "Customer"
+SET deleted_at = now()
+WHERE id = $1 AND book_in_organization(book_id)
+AND deleted_at IS NULL
+RETURNING id, book_id, category_id, job, name, corporation, address, leader, pic, memo, created_at, deleted_at, custom_fields, version
+`
+
+func (q *Queries) SoftDeleteCustomer(ctx context.Context, id uuid.UUID) (Customer, error) {
+	row := q.db.QueryRow(ctx, softDeleteCustomer, id)
+	var i Customer
+	err := row.Scan(
+		&i.ID,
+		&i.BookID,
+		&i.CategoryID,
+		&i.Job,
+		&i.Name,
+		&i.Corporation,
+		&i.Address,
+		&i.Leader,
+		&i.Pic,
+		&i.Memo,
+		&i.CreatedAt,
+		&i.DeletedAt,
+		&i.CustomFields,
+		&i.Version,
+	)
+	return i, err
+}
+
+const softDeleteCustomersByBook = `-- name: SoftDeleteCustomersByBook :execrows
+UPDATE "Customer"
+SET deleted_at = $1
+WHERE book_id = $2 AND book_in_organization(book_id)
+AND deleted_at IS NULL
+`
+
+type SoftDeleteCustomersByBookParams struct {
+	DeletedAt pgtype.Timestamptz `json:"deleted_at"`
+	BookID    uuid.UUID          `json:"book_id"`
+}
+
+func (q *Queries) SoftDeleteCustomersByBook(ctx context.Context, arg SoftDeleteCustomersByBookParams) (int64, error) {
+	result, err := q.db.Exec(ctx, softDeleteCustomersByBook, arg.DeletedAt, arg.BookID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateCustomer = `-- name: UpdateCustomer :one
 UPDATE "Customer"
 SET 
@@ -249,19 +611,32 @@ SET
   book_id = COALESCE($2, book_id),
   corporation = COALESCE($3, corporation),
   address = COALESCE($4, address),
-  memo = COALESCE($5, memo)
+  memo = COALESCE($5, memo),
+  custom_fields = COALESCE($6, custom_fields),
+  category_id = COALESCE($7, category_id)
 WHERE
-  id = $6
-RETURNING id, book_id, category_id, job, name, corporation, address, leader, pic, memo, created_at
+  "Customer".id = $8 AND book_in_organization("Customer".book_id)
+  AND "Customer".deleted_at IS NULL
+  AND ($9::bigint IS NULL OR "Customer".version = $9)
+  -- 移動先のリストと業種も同じ組織のものに限る
+  AND book_in_organization(COALESCE($2, "Customer".book_id))
+  AND ($7::uuid IS NULL OR EXISTS (
+    SELECT 1 FROM "Category" cg
+    WHERE cg.id = $7 AND cg.organization_id = current_organization_id()
+  ))
+RETURNING id, book_id, category_id, job, name, corporation, address, leader, pic, memo, created_at, deleted_at, custom_fields, version
 `
 
 type UpdateCustomerParams struct {
-	Name        pgtype.Text `json:"name"`
-	BookID      pgtype.UUID `json:"book_id"`
-	Corporation pgtype.Text `json:"corporation"`
-	Address     pgtype.Text `json:"address"`
-	Memo        pgtype.Text `json:"memo"`
-	ID          uuid.UUID   `json:"id"`
+	Name         pgtype.Text `json:"name"`
+	BookID       pgtype.UUID `json:"book_id"`
+	Corporation  pgtype.Text `json:"corporation"`
+	Address      pgtype.Text `json:"address"`
+	Memo         pgtype.Text `json:"memo"`
+	CustomFields []byte      `json:"custom_fields"`
+	CategoryID   pgtype.UUID `json:"category_id"`
+	ID           uuid.UUID   `json:"id"`
+	Version      pgtype.Int8 `json:"version"`
 }
 
 func (q *Queries) UpdateCustomer(ctx context.Context, arg UpdateCustomerParams) (Customer, error) {
@@ -271,7 +646,10 @@ func (q *Queries) UpdateCustomer(ctx context.Context, arg UpdateCustomerParams)
 		arg.Corporation,
 		arg.Address,
 		arg.Memo,
+		arg.CustomFields,
+		arg.CategoryID,
 		arg.ID,
+		arg.Version,
 	)
 	var i Customer
 	err := row.Scan(
@@ -286,6 +664,9 @@ func (q *Queries) UpdateCustomer(ctx context.Context, arg UpdateCustomerParams)
 		&i.Pic,
 		&i.Memo,
 		&i.CreatedAt,
+		&i.DeletedAt,
+		&i.CustomFields,
+		&i.Version,
 	)
 	return i, err
 }
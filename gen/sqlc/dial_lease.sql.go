@@ -0,0 +1,196 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: dial_lease.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const acquireDialLease = `-- name: AcquireDialLease :one
+INSERT INTO "DialLease" (customer_id, user_id, expires_at)
+SELECT $1::uuid, $2::uuid, $3::timestamptz
+WHERE customer_in_organization($1)
+ON CONFLICT (customer_id) DO UPDATE
+SET
+  user_id = EXCLUDED.user_id,
+  expires_at = EXCLUDED.expires_at,
+  created_at = now()
+WHERE "DialLease".expires_at <= now()
+OR "DialLease".user_id = EXCLUDED.user_id
+RETURNING customer_id, user_id, expires_at, created_at
+`
+
+type AcquireDialLeaseParams struct {
+	CustomerID uuid.UUID `json:"customer_id"`
+	UserID     uuid.UUID `json:"user_id"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+// 期限切れまたは自分のリースのみ上書きする。他人の有効なリースがある場合は行を返さない。
+func (q *Queries) AcquireDialLease(ctx context.Context, arg AcquireDialLeaseParams) (DialLease, error) {
+	row := q.db.QueryRow(ctx, acquireDialLease, arg.CustomerID, arg.UserID, arg.ExpiresAt)
+	var i DialLease
+	err := row.Scan(
+		&i.CustomerID,
+		&i.UserID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const extendDialLease = `-- name: ExtendDialLease :one
+UPDATE "DialLease"
+SET expires_at = $1
+WHERE customer_id = $2 AND customer_in_organization(customer_id)
+AND user_id = $3
+RETURNING customer_id, user_id, expires_at, created_at
+`
+
+type ExtendDialLeaseParams struct {
+	ExpiresAt  time.Time `json:"expires_at"`
+	CustomerID uuid.UUID `json:"customer_id"`
+	UserID     uuid.UUID `json:"user_id"`
+}
+
+func (q *Queries) ExtendDialLease(ctx context.Context, arg ExtendDialLeaseParams) (DialLease, error) {
+	row := q.db.QueryRow(ctx, extendDialLease, arg.ExpiresAt, arg.CustomerID, arg.UserID)
+	var i DialLease
+	err := row.Scan(
+		&i.CustomerID,
+		&i.UserID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getActiveDialLease = `-- name: GetActiveDialLease :one
+SELECT l.customer_id, l.user_id, l.expires_at, l.created_at, c.id, c.book_id, c.category_id, c.job, c.name, c.corporation, c.address, c.leader, c.pic, c.memo, c.created_at, c.deleted_at, c.custom_fields, c.version FROM "DialLease" l
+JOIN "Customer" c ON c.id = l.customer_id
+WHERE c.book_id = $1 AND book_in_organization(c.book_id)
+AND c.deleted_at IS NULL
+AND l.user_id = $2
+AND l.expires_at > now()
+ORDER BY l.created_at
+LIMIT 1
+`
+
+type GetActiveDialLeaseParams struct {
+	BookID uuid.UUID `json:"book_id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+type GetActiveDialLeaseRow struct {
+	DialLease DialLease `json:"dial_lease"`
+	Customer  Customer  `json:"customer"`
+}
+
+func (q *Queries) GetActiveDialLease(ctx context.Context, arg GetActiveDialLeaseParams) (GetActiveDialLeaseRow, error) {
+	row := q.db.QueryRow(ctx, getActiveDialLease, arg.BookID, arg.UserID)
+	var i GetActiveDialLeaseRow
+	err := row.Scan(
+		&i.DialLease.CustomerID,
+		&i.DialLease.UserID,
+		&i.DialLease.ExpiresAt,
+		&i.DialLease.CreatedAt,
+		&i.Customer.ID,
+		&i.Customer.BookID,
+		&i.Customer.CategoryID,
+		&i.Customer.Job,
+		&i.Customer.Name,
+		&i.Customer.Corporation,
+		&i.Customer.Address,
+		&i.Customer.Leader,
+		&i.Customer.Pic,
+		&i.Customer.Memo,
+		&i.Customer.CreatedAt,
+		&i.Customer.DeletedAt,
+		&i.Customer.CustomFields,
+		&i.Customer.Version,
+	)
+	return i, err
+}
+
+const lockNextDialCandidate = `-- name: LockNextDialCandidate :one
+SELECT c.id, c.book_id, c.category_id, c.job, c.name, c.corporation, c.address, c.leader, c.pic, c.memo, c.created_at, c.deleted_at, c.custom_fields, c.version FROM "Customer" c
+LEFT JOIN "DialLease" l ON l.customer_id = c.id
+LEFT JOIN "Redial" r ON r.id = c.id
+WHERE c.book_id = $1 AND book_in_organization(c.book_id)
+AND c.deleted_at IS NULL
+AND (l.customer_id IS NULL OR l.expires_at <= now())
+AND NOT COALESCE((
+  SELECT s.ng FROM "Call" ca
+  JOIN "Status" s ON s.id = ca.status_id
+  WHERE ca.customer_id = c.id
+  ORDER BY ca.created_at DESC
+  LIMIT 1
+), false)
+AND NOT EXISTS (
+  SELECT 1 FROM "Contact" ct
+  JOIN "DoNotCall" d ON d.organization_id = current_organization_id() AND d.phone = normalize_phone(ct.phone)
+  WHERE ct.customer_id = c.id
+  AND ct.deleted_at IS NULL
+)
+AND (
+  (r.id IS NULL AND NOT EXISTS (SELECT 1 FROM "Call" ca WHERE ca.customer_id = c.id))
+  OR (r.user_id = $2 AND r.scheduled_at <= now())
+)
+ORDER BY r.id IS NULL, r.scheduled_at, c.created_at, c.id
+LIMIT 1
+FOR UPDATE OF c SKIP LOCKED
+`
+
+type LockNextDialCandidateParams struct {
+	BookID uuid.UUID `json:"book_id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+// NG や架電禁止になっておらず、未架電または再架電予定時刻を過ぎた顧客を1件ロックする。
+// 他のトランザクションがロック中の行は SKIP LOCKED で読み飛ばす。
+func (q *Queries) LockNextDialCandidate(ctx context.Context, arg LockNextDialCandidateParams) (Customer, error) {
+	row := q.db.QueryRow(ctx, lockNextDialCandidate, arg.BookID, arg.UserID)
+	var i Customer
+	err := row.Scan(
+		&i.ID,
+		&i.BookID,
+		&i.CategoryID,
+		&i.Job,
+		&i.Name,
+		&i.Corporation,
+		&i.Address,
+		&i.Leader,
+		&i.Pic,
+		&i.Memo,
+		&i.CreatedAt,
+		&i.DeletedAt,
+		&i.CustomFields,
+		&i.Version,
+	)
+	return i, err
+}
+
+const releaseDialLease = `-- name: ReleaseDialLease :execrows
+DELETE FROM "DialLease"
+WHERE customer_id = $1 AND customer_in_organization(customer_id)
+AND user_id = $2
+`
+
+type ReleaseDialLeaseParams struct {
+	CustomerID uuid.UUID `json:"customer_id"`
+	UserID     uuid.UUID `json:"user_id"`
+}
+
+func (q *Queries) ReleaseDialLease(ctx context.Context, arg ReleaseDialLeaseParams) (int64, error) {
+	result, err := q.db.Exec(ctx, releaseDialLease, arg.CustomerID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
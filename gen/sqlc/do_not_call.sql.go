@@ -0,0 +1,188 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: do_not_call.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countDoNotCall = `-- name: CountDoNotCall :one
+SELECT count(*) FROM "DoNotCall"
+WHERE organization_id = current_organization_id()
+`
+
+func (q *Queries) CountDoNotCall(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, countDoNotCall)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createDoNotCall = `-- name: CreateDoNotCall :one
+INSERT INTO "DoNotCall" (phone, reason, source, user_id, call_id)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (organization_id, phone) DO UPDATE
+SET reason = COALESCE(EXCLUDED.reason, "DoNotCall".reason)
+RETURNING phone, reason, source, user_id, call_id, created_at, organization_id
+`
+
+type CreateDoNotCallParams struct {
+	Phone  string      `json:"phone"`
+	Reason pgtype.Text `json:"reason"`
+	Source DncSource   `json:"source"`
+	UserID pgtype.UUID `json:"user_id"`
+	CallID pgtype.UUID `json:"call_id"`
+}
+
+func (q *Queries) CreateDoNotCall(ctx context.Context, arg CreateDoNotCallParams) (DoNotCall, error) {
+	row := q.db.QueryRow(ctx, createDoNotCall,
+		arg.Phone,
+		arg.Reason,
+		arg.Source,
+		arg.UserID,
+		arg.CallID,
+	)
+	var i DoNotCall
+	err := row.Scan(
+		&i.Phone,
+		&i.Reason,
+		&i.Source,
+		&i.UserID,
+		&i.CallID,
+		&i.CreatedAt,
+		&i.OrganizationID,
+	)
+	return i, err
+}
+
+const createDoNotCallFromCall = `-- name: CreateDoNotCallFromCall :execrows
+INSERT INTO "DoNotCall" (phone, reason, source, user_id, call_id)
+SELECT DISTINCT normalize_phone(ct.phone), $1::text, 'call', $2::uuid, $3::uuid
+FROM "Contact" ct
+WHERE ct.customer_id = $4 AND customer_in_organization(ct.customer_id)
+AND ct.deleted_at IS NULL
+AND normalize_phone(ct.phone) <> ''
+ON CONFLICT (organization_id, phone) DO NOTHING
+`
+
+type CreateDoNotCallFromCallParams struct {
+	Reason     pgtype.Text `json:"reason"`
+	UserID     uuid.UUID   `json:"user_id"`
+	CallID     uuid.UUID   `json:"call_id"`
+	CustomerID uuid.UUID   `json:"customer_id"`
+}
+
+// 顧客の連絡先の電話番号をまとめて架電禁止リストに登録する
+func (q *Queries) CreateDoNotCallFromCall(ctx context.Context, arg CreateDoNotCallFromCallParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createDoNotCallFromCall,
+		arg.Reason,
+		arg.UserID,
+		arg.CallID,
+		arg.CustomerID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteDoNotCall = `-- name: DeleteDoNotCall :execrows
+DELETE FROM "DoNotCall"
+WHERE organization_id = current_organization_id()
+AND phone = $1
+`
+
+func (q *Queries) DeleteDoNotCall(ctx context.Context, phone string) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteDoNotCall, phone)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getDoNotCall = `-- name: GetDoNotCall :one
+SELECT phone, reason, source, user_id, call_id, created_at, organization_id FROM "DoNotCall"
+WHERE organization_id = current_organization_id()
+AND phone = $1 LIMIT 1
+`
+
+func (q *Queries) GetDoNotCall(ctx context.Context, phone string) (DoNotCall, error) {
+	row := q.db.QueryRow(ctx, getDoNotCall, phone)
+	var i DoNotCall
+	err := row.Scan(
+		&i.Phone,
+		&i.Reason,
+		&i.Source,
+		&i.UserID,
+		&i.CallID,
+		&i.CreatedAt,
+		&i.OrganizationID,
+	)
+	return i, err
+}
+
+const importDoNotCall = `-- name: ImportDoNotCall :execrows
+INSERT INTO "DoNotCall" (phone, reason, source, user_id)
+SELECT unnest($1::varchar[]), $2::text, 'import', $3::uuid
+ON CONFLICT (organization_id, phone) DO NOTHING
+`
+
+type ImportDoNotCallParams struct {
+	Phones []string    `json:"phones"`
+	Reason pgtype.Text `json:"reason"`
+	UserID pgtype.UUID `json:"user_id"`
+}
+
+func (q *Queries) ImportDoNotCall(ctx context.Context, arg ImportDoNotCallParams) (int64, error) {
+	result, err := q.db.Exec(ctx, importDoNotCall, arg.Phones, arg.Reason, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const listDoNotCall = `-- name: ListDoNotCall :many
+SELECT phone, reason, source, user_id, call_id, created_at, organization_id FROM "DoNotCall"
+WHERE organization_id = current_organization_id()
+ORDER BY created_at DESC, phone
+LIMIT $1 OFFSET $2
+`
+
+type ListDoNotCallParams struct {
+	Limit  int32 `json:"limit"`
+	Offset int32 `json:"offset"`
+}
+
+func (q *Queries) ListDoNotCall(ctx context.Context, arg ListDoNotCallParams) ([]DoNotCall, error) {
+	rows, err := q.db.Query(ctx, listDoNotCall, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []DoNotCall{}
+	for rows.Next() {
+		var i DoNotCall
+		if err := rows.Scan(
+			&i.Phone,
+			&i.Reason,
+			&i.Source,
+			&i.UserID,
+			&i.CallID,
+			&i.CreatedAt,
+			&i.OrganizationID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,147 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: idempotency_key.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const deleteExpiredIdempotencyKeys = `-- name: DeleteExpiredIdempotencyKeys :execrows
+DELETE FROM "IdempotencyKey"
+WHERE expires_at < now()
+`
+
+func (q *Queries) DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteExpiredIdempotencyKeys)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteIdempotencyKey = `-- name: DeleteIdempotencyKey :exec
+DELETE FROM "IdempotencyKey"
+WHERE user_id = $1 AND key = $2
+`
+
+type DeleteIdempotencyKeyParams struct {
+	UserID uuid.UUID `json:"user_id"`
+	Key    string    `json:"key"`
+}
+
+func (q *Queries) DeleteIdempotencyKey(ctx context.Context, arg DeleteIdempotencyKeyParams) error {
+	_, err := q.db.Exec(ctx, deleteIdempotencyKey, arg.UserID, arg.Key)
+	return err
+}
+
+const getIdempotencyKey = `-- name: GetIdempotencyKey :one
+SELECT user_id, key, rpc, request_hash, response, created_at, expires_at, version FROM "IdempotencyKey"
+WHERE user_id = $1 AND key = $2 LIMIT 1
+`
+
+type GetIdempotencyKeyParams struct {
+	UserID uuid.UUID `json:"user_id"`
+	Key    string    `json:"key"`
+}
+
+func (q *Queries) GetIdempotencyKey(ctx context.Context, arg GetIdempotencyKeyParams) (IdempotencyKey, error) {
+	row := q.db.QueryRow(ctx, getIdempotencyKey, arg.UserID, arg.Key)
+	var i IdempotencyKey
+	err := row.Scan(
+		&i.UserID,
+		&i.Key,
+		&i.Rpc,
+		&i.RequestHash,
+		&i.Response,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.Version,
+	)
+	return i, err
+}
+
+const reserveIdempotencyKey = `-- name: ReserveIdempotencyKey :one
+INSERT INTO "IdempotencyKey" (user_id, key, rpc, request_hash, expires_at)
+VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5
+)
+ON CONFLICT (user_id, key) DO UPDATE
+SET
+  rpc = EXCLUDED.rpc,
+  request_hash = EXCLUDED.request_hash,
+  response = NULL,
+  version = NULL,
+  created_at = now(),
+  expires_at = EXCLUDED.expires_at
+WHERE "IdempotencyKey".expires_at < now()
+OR ("IdempotencyKey".response IS NULL AND "IdempotencyKey".created_at < $6::timestamptz)
+RETURNING user_id, key, rpc, request_hash, response, created_at, expires_at, version
+`
+
+type ReserveIdempotencyKeyParams struct {
+	UserID      uuid.UUID `json:"user_id"`
+	Key         string    `json:"key"`
+	Rpc         string    `json:"rpc"`
+	RequestHash []byte    `json:"request_hash"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	StaleBefore time.Time `json:"stale_before"`
+}
+
+// キーを処理中として登録する。期限切れか、処理中のまま放置されたキーは上書きする。
+// 使用中の場合は行を返さない
+func (q *Queries) ReserveIdempotencyKey(ctx context.Context, arg ReserveIdempotencyKeyParams) (IdempotencyKey, error) {
+	row := q.db.QueryRow(ctx, reserveIdempotencyKey,
+		arg.UserID,
+		arg.Key,
+		arg.Rpc,
+		arg.RequestHash,
+		arg.ExpiresAt,
+		arg.StaleBefore,
+	)
+	var i IdempotencyKey
+	err := row.Scan(
+		&i.UserID,
+		&i.Key,
+		&i.Rpc,
+		&i.RequestHash,
+		&i.Response,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.Version,
+	)
+	return i, err
+}
+
+const saveIdempotencyResponse = `-- name: SaveIdempotencyResponse :exec
+UPDATE "IdempotencyKey"
+SET response = $1, version = $2
+WHERE user_id = $3 AND key = $4
+`
+
+type SaveIdempotencyResponseParams struct {
+	Response []byte      `json:"response"`
+	Version  pgtype.Int8 `json:"version"`
+	UserID   uuid.UUID   `json:"user_id"`
+	Key      string      `json:"key"`
+}
+
+func (q *Queries) SaveIdempotencyResponse(ctx context.Context, arg SaveIdempotencyResponseParams) error {
+	_, err := q.db.Exec(ctx, saveIdempotencyResponse,
+		arg.Response,
+		arg.Version,
+		arg.UserID,
+		arg.Key,
+	)
+	return err
+}
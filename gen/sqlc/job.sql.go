@@ -0,0 +1,472 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: job.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const cancelJob = `-- name: CancelJob :one
+UPDATE "Job"
+SET
+  cancel_requested = true,
+  status = CASE
+    WHEN status = 'queued' OR locked_until < now() THEN 'canceled'::job_status
+    ELSE status
+  END,
+  finished_at = CASE
+    WHEN status = 'queued' OR locked_until < now() THEN now()
+    ELSE finished_at
+  END
+WHERE id = $1 AND organization_id = current_organization_id()
+AND status IN ('queued', 'running')
+RETURNING id, type, status, params, progress, result, result_location, error, attempts, max_attempts, cancel_requested, user_id, run_after, locked_by, locked_until, created_at, started_at, finished_at, organization_id
+`
+
+// 実行待ちのジョブと、ワーカーが停止して期限切れになったジョブはすぐにキャンセルし、
+// 実行中のジョブはワーカーに中断を要求する
+func (q *Queries) CancelJob(ctx context.Context, id uuid.UUID) (Job, error) {
+	row := q.db.QueryRow(ctx, cancelJob, id)
+	var i Job
+	err := row.Scan(
+		&i.ID,
+		&i.Type,
+		&i.Status,
+		&i.Params,
+		&i.Progress,
+		&i.Result,
+		&i.ResultLocation,
+		&i.Error,
+		&i.Attempts,
+		&i.MaxAttempts,
+		&i.CancelRequested,
+		&i.UserID,
+		&i.RunAfter,
+		&i.LockedBy,
+		&i.LockedUntil,
+		&i.CreatedAt,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.OrganizationID,
+	)
+	return i, err
+}
+
+const claimJob = `-- name: ClaimJob :one
+WITH exhausted AS (
+  UPDATE "Job"
+  SET
+    status = 'failed',
+    error = 'job lease expired on the last attempt',
+    locked_by = NULL,
+    locked_until = NULL,
+    finished_at = now()
+  WHERE "Job".type = ANY ($3::varchar[])
+  AND "Job".status = 'running'
+  AND "Job".locked_until < now()
+  AND "Job".attempts >= "Job".max_attempts
+)
+UPDATE "Job"
+SET
+  status = 'running',
+  attempts = attempts + 1,
+  locked_by = $1::varchar,
+  locked_until = $2::timestamptz,
+  started_at = COALESCE(started_at, now())
+WHERE id = (
+  SELECT j.id FROM "Job" j
+  WHERE j.type = ANY ($3::varchar[])
+  AND NOT j.cancel_requested
+  AND (
+    (j.status = 'queued' AND j.run_after <= now())
+    OR (j.status = 'running' AND j.locked_until < now() AND j.attempts < j.max_attempts)
+  )
+  ORDER BY j.run_after, j.created_at
+  LIMIT 1
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING id, type, status, params, progress, result, result_location, error, attempts, max_attempts, cancel_requested, user_id, run_after, locked_by, locked_until, created_at, started_at, finished_at, organization_id
+`
+
+type ClaimJobParams struct {
+	LockedBy    string    `json:"locked_by"`
+	LockedUntil time.Time `json:"locked_until"`
+	Types       []string  `json:"types"`
+}
+
+// 実行待ちのジョブか、ワーカーが停止して期限切れになったジョブを全組織から1件取得する。
+// 期限切れのジョブのうち試行回数を使い切ったものは、同じ文で失敗にする
+func (q *Queries) ClaimJob(ctx context.Context, arg ClaimJobParams) (Job, error) {
+	row := q.db.QueryRow(ctx, claimJob, arg.LockedBy, arg.LockedUntil, arg.Types)
+	var i Job
+	err := row.Scan(
+		&i.ID,
+		&i.Type,
+		&i.Status,
+		&i.Params,
+		&i.Progress,
+		&i.Result,
+		&i.ResultLocation,
+		&i.Error,
+		&i.Attempts,
+		&i.MaxAttempts,
+		&i.CancelRequested,
+		&i.UserID,
+		&i.RunAfter,
+		&i.LockedBy,
+		&i.LockedUntil,
+		&i.CreatedAt,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.OrganizationID,
+	)
+	return i, err
+}
+
+const completeJob = `-- name: CompleteJob :exec
+UPDATE "Job"
+SET
+  status = 'succeeded',
+  progress = 100,
+  result = $1,
+  result_location = $2,
+  error = NULL,
+  locked_by = NULL,
+  locked_until = NULL,
+  finished_at = now()
+WHERE id = $3 AND locked_by = $4::varchar
+`
+
+type CompleteJobParams struct {
+	Result         []byte      `json:"result"`
+	ResultLocation pgtype.Text `json:"result_location"`
+	ID             uuid.UUID   `json:"id"`
+	LockedBy       string      `json:"locked_by"`
+}
+
+func (q *Queries) CompleteJob(ctx context.Context, arg CompleteJobParams) error {
+	_, err := q.db.Exec(ctx, completeJob,
+		arg.Result,
+		arg.ResultLocation,
+		arg.ID,
+		arg.LockedBy,
+	)
+	return err
+}
+
+const createJob = `-- name: CreateJob :one
+INSERT INTO "Job" (id, type, params, max_attempts, user_id)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, type, status, params, progress, result, result_location, error, attempts, max_attempts, cancel_requested, user_id, run_after, locked_by, locked_until, created_at, started_at, finished_at, organization_id
+`
+
+type CreateJobParams struct {
+	ID          uuid.UUID   `json:"id"`
+	Type        string      `json:"type"`
+	Params      []byte      `json:"params"`
+	MaxAttempts int32       `json:"max_attempts"`
+	UserID      pgtype.UUID `json:"user_id"`
+}
+
+func (q *Queries) CreateJob(ctx context.Context, arg CreateJobParams) (Job, error) {
+	row := q.db.QueryRow(ctx, createJob,
+		arg.ID,
+		arg.Type,
+		arg.Params,
+		arg.MaxAttempts,
+		arg.UserID,
+	)
+	var i Job
+	err := row.Scan(
+		&i.ID,
+		&i.Type,
+		&i.Status,
+		&i.Params,
+		&i.Progress,
+		&i.Result,
+		&i.ResultLocation,
+		&i.Error,
+		&i.Attempts,
+		&i.MaxAttempts,
+		&i.CancelRequested,
+		&i.UserID,
+		&i.RunAfter,
+		&i.LockedBy,
+		&i.LockedUntil,
+		&i.CreatedAt,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.OrganizationID,
+	)
+	return i, err
+}
+
+const failJob = `-- name: FailJob :one
+UPDATE "Job"
+SET
+  status = CASE
+    WHEN $1::bool AND attempts < max_attempts THEN 'queued'::job_status
+    ELSE 'failed'::job_status
+  END,
+  error = $2::text,
+  run_after = $3,
+  locked_by = NULL,
+  locked_until = NULL,
+  finished_at = CASE
+    WHEN $1::bool AND attempts < max_attempts THEN NULL
+    ELSE now()
+  END
+WHERE id = $4 AND locked_by = $5::varchar
+RETURNING id, type, status, params, progress, result, result_location, error, attempts, max_attempts, cancel_requested, user_id, run_after, locked_by, locked_until, created_at, started_at, finished_at, organization_id
+`
+
+type FailJobParams struct {
+	Retryable bool      `json:"retryable"`
+	Error     string    `json:"error"`
+	RunAfter  time.Time `json:"run_after"`
+	ID        uuid.UUID `json:"id"`
+	LockedBy  string    `json:"locked_by"`
+}
+
+// 試行回数が残っていれば run_after 以降に再実行する
+func (q *Queries) FailJob(ctx context.Context, arg FailJobParams) (Job, error) {
+	row := q.db.QueryRow(ctx, failJob,
+		arg.Retryable,
+		arg.Error,
+		arg.RunAfter,
+		arg.ID,
+		arg.LockedBy,
+	)
+	var i Job
+	err := row.Scan(
+		&i.ID,
+		&i.Type,
+		&i.Status,
+		&i.Params,
+		&i.Progress,
+		&i.Result,
+		&i.ResultLocation,
+		&i.Error,
+		&i.Attempts,
+		&i.MaxAttempts,
+		&i.CancelRequested,
+		&i.UserID,
+		&i.RunAfter,
+		&i.LockedBy,
+		&i.LockedUntil,
+		&i.CreatedAt,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.OrganizationID,
+	)
+	return i, err
+}
+
+const finishCanceledJob = `-- name: FinishCanceledJob :exec
+UPDATE "Job"
+SET
+  status = 'canceled',
+  locked_by = NULL,
+  locked_until = NULL,
+  finished_at = now()
+WHERE id = $1 AND locked_by = $2::varchar
+`
+
+type FinishCanceledJobParams struct {
+	ID       uuid.UUID `json:"id"`
+	LockedBy string    `json:"locked_by"`
+}
+
+func (q *Queries) FinishCanceledJob(ctx context.Context, arg FinishCanceledJobParams) error {
+	_, err := q.db.Exec(ctx, finishCanceledJob, arg.ID, arg.LockedBy)
+	return err
+}
+
+const getJob = `-- name: GetJob :one
+SELECT id, type, status, params, progress, result, result_location, error, attempts, max_attempts, cancel_requested, user_id, run_after, locked_by, locked_until, created_at, started_at, finished_at, organization_id FROM "Job"
+WHERE id = $1 AND organization_id = current_organization_id() LIMIT 1
+`
+
+func (q *Queries) GetJob(ctx context.Context, id uuid.UUID) (Job, error) {
+	row := q.db.QueryRow(ctx, getJob, id)
+	var i Job
+	err := row.Scan(
+		&i.ID,
+		&i.Type,
+		&i.Status,
+		&i.Params,
+		&i.Progress,
+		&i.Result,
+		&i.ResultLocation,
+		&i.Error,
+		&i.Attempts,
+		&i.MaxAttempts,
+		&i.CancelRequested,
+		&i.UserID,
+		&i.RunAfter,
+		&i.LockedBy,
+		&i.LockedUntil,
+		&i.CreatedAt,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.OrganizationID,
+	)
+	return i, err
+}
+
+const getJobFile = `-- name: GetJobFile :one
+SELECT job_id, content_type, content, created_at FROM "JobFile"
+WHERE job_id = $1 LIMIT 1
+`
+
+func (q *Queries) GetJobFile(ctx context.Context, jobID uuid.UUID) (JobFile, error) {
+	row := q.db.QueryRow(ctx, getJobFile, jobID)
+	var i JobFile
+	err := row.Scan(
+		&i.JobID,
+		&i.ContentType,
+		&i.Content,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const heartbeatJob = `-- name: HeartbeatJob :one
+UPDATE "Job"
+SET
+  progress = GREATEST(progress, $1::int),
+  locked_until = $2::timestamptz
+WHERE id = $3 AND locked_by = $4::varchar AND status = 'running'
+RETURNING cancel_requested
+`
+
+type HeartbeatJobParams struct {
+	Progress    int32     `json:"progress"`
+	LockedUntil time.Time `json:"locked_until"`
+	ID          uuid.UUID `json:"id"`
+	LockedBy    string    `json:"locked_by"`
+}
+
+// 進捗を更新してロックを延長する。キャンセルが要求されているかを返す
+func (q *Queries) HeartbeatJob(ctx context.Context, arg HeartbeatJobParams) (bool, error) {
+	row := q.db.QueryRow(ctx, heartbeatJob,
+		arg.Progress,
+		arg.LockedUntil,
+		arg.ID,
+		arg.LockedBy,
+	)
+	var cancel_requested bool
+	err := row.Scan(&cancel_requested)
+	return cancel_requested, err
+}
+
+const listJobs = `-- name: ListJobs :many
+SELECT id, type, status, params, progress, result, result_location, error, attempts, max_attempts, cancel_requested, user_id, run_after, locked_by, locked_until, created_at, started_at, finished_at, organization_id FROM "Job"
+WHERE organization_id = current_organization_id()
+AND ($1::uuid IS NULL OR user_id = $1)
+AND ($2::varchar IS NULL OR type = $2)
+AND ($3::job_status IS NULL OR status = $3)
+ORDER BY created_at DESC, id
+LIMIT $5 OFFSET $4
+`
+
+type ListJobsParams struct {
+	UserID    pgtype.UUID   `json:"user_id"`
+	Type      pgtype.Text   `json:"type"`
+	Status    NullJobStatus `json:"status"`
+	RowOffset int32         `json:"row_offset"`
+	RowLimit  int32         `json:"row_limit"`
+}
+
+func (q *Queries) ListJobs(ctx context.Context, arg ListJobsParams) ([]Job, error) {
+	rows, err := q.db.Query(ctx, listJobs,
+		arg.UserID,
+		arg.Type,
+		arg.Status,
+		arg.RowOffset,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Job{}
+	for rows.Next() {
+		var i Job
+		if err := rows.Scan(
+			&i.ID,
+			&i.Type,
+			&i.Status,
+			&i.Params,
+			&i.Progress,
+			&i.Result,
+			&i.ResultLocation,
+			&i.Error,
+			&i.Attempts,
+			&i.MaxAttempts,
+			&i.CancelRequested,
+			&i.UserID,
+			&i.RunAfter,
+			&i.LockedBy,
+			&i.LockedUntil,
+			&i.CreatedAt,
+			&i.StartedAt,
+			&i.FinishedAt,
+			&i.OrganizationID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const releaseJob = `-- name: ReleaseJob :exec
+UPDATE "Job"
+SET
+  status = 'queued',
+  attempts = GREATEST(attempts - 1, 0),
+  locked_by = NULL,
+  locked_until = NULL
+WHERE id = $1 AND locked_by = $2::varchar AND status = 'running'
+`
+
+type ReleaseJobParams struct {
+	ID       uuid.UUID `json:"id"`
+	LockedBy string    `json:"locked_by"`
+}
+
+// シャットダウンで中断したジョブを試行回数を戻して実行待ちにする
+func (q *Queries) ReleaseJob(ctx context.Context, arg ReleaseJobParams) error {
+	_, err := q.db.Exec(ctx, releaseJob, arg.ID, arg.LockedBy)
+	return err
+}
+
+const saveJobFile = `-- name: SaveJobFile :exec
+INSERT INTO "JobFile" (job_id, content_type, content)
+VALUES ($1, $2, $3)
+ON CONFLICT (job_id) DO UPDATE
+SET content_type = EXCLUDED.content_type, content = EXCLUDED.content, created_at = now()
+`
+
+type SaveJobFileParams struct {
+	JobID       uuid.UUID `json:"job_id"`
+	ContentType string    `json:"content_type"`
+	Content     []byte    `json:"content"`
+}
+
+// 再試行で作り直したファイルは上書きする
+func (q *Queries) SaveJobFile(ctx context.Context, arg SaveJobFileParams) error {
+	_, err := q.db.Exec(ctx, saveJobFile, arg.JobID, arg.ContentType, arg.Content)
+	return err
+}
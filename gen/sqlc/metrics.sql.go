@@ -0,0 +1,69 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: metrics.sql
+
+package db
+
+import (
+	"context"
+	"time"
+)
+
+const countActiveJobs = `-- name: CountActiveJobs :many
+SELECT type, status, count(*) AS jobs FROM "Job"
+WHERE status IN ('queued', 'running')
+GROUP BY type, status
+`
+
+type CountActiveJobsRow struct {
+	Type   string    `json:"type"`
+	Status JobStatus `json:"status"`
+	Jobs   int64     `json:"jobs"`
+}
+
+func (q *Queries) CountActiveJobs(ctx context.Context) ([]CountActiveJobsRow, error) {
+	rows, err := q.db.Query(ctx, countActiveJobs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CountActiveJobsRow{}
+	for rows.Next() {
+		var i CountActiveJobsRow
+		if err := rows.Scan(&i.Type, &i.Status, &i.Jobs); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countCallsSince = `-- name: CountCallsSince :one
+SELECT count(*) FROM "Call"
+WHERE created_at >= $1
+`
+
+func (q *Queries) CountCallsSince(ctx context.Context, fromTime time.Time) (int64, error) {
+	row := q.db.QueryRow(ctx, countCallsSince, fromTime)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countOverdueRedials = `-- name: CountOverdueRedials :one
+SELECT count(*) FROM "Redial" r
+JOIN "Customer" c ON c.id = r.id
+WHERE c.deleted_at IS NULL
+AND r.scheduled_at <= $1
+`
+
+func (q *Queries) CountOverdueRedials(ctx context.Context, now time.Time) (int64, error) {
+	row := q.db.QueryRow(ctx, countOverdueRedials, now)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
@@ -13,6 +13,182 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type AuditAction string
+
+const (
+	AuditActionInsert AuditAction = "insert"
+	AuditActionUpdate AuditAction = "update"
+	AuditActionDelete AuditAction = "delete"
+)
+
+func (e *AuditAction) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = AuditAction(s)
+	case string:
+		*e = AuditAction(s)
+	default:
+		return fmt.Errorf("unsupported scan type for AuditAction: %T", src)
+	}
+	return nil
+}
+
+type NullAuditAction struct {
+	AuditAction AuditAction `json:"audit_action"`
+	Valid       bool        `json:"valid"` // Valid is true if AuditAction is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAuditAction) Scan(value interface{}) error {
+	if value == nil {
+		ns.AuditAction, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.AuditAction.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAuditAction) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.AuditAction), nil
+}
+
+type CustomFieldType string
+
+const (
+	CustomFieldTypeText    CustomFieldType = "text"
+	CustomFieldTypeNumber  CustomFieldType = "number"
+	CustomFieldTypeDate    CustomFieldType = "date"
+	CustomFieldTypeSelect  CustomFieldType = "select"
+	CustomFieldTypeBoolean CustomFieldType = "boolean"
+)
+
+func (e *CustomFieldType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = CustomFieldType(s)
+	case string:
+		*e = CustomFieldType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for CustomFieldType: %T", src)
+	}
+	return nil
+}
+
+type NullCustomFieldType struct {
+	CustomFieldType CustomFieldType `json:"custom_field_type"`
+	Valid           bool            `json:"valid"` // Valid is true if CustomFieldType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullCustomFieldType) Scan(value interface{}) error {
+	if value == nil {
+		ns.CustomFieldType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.CustomFieldType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullCustomFieldType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.CustomFieldType), nil
+}
+
+type DncSource string
+
+const (
+	DncSourceManual DncSource = "manual"
+	DncSourceImport DncSource = "import"
+	DncSourceCall   DncSource = "call"
+)
+
+func (e *DncSource) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = DncSource(s)
+	case string:
+		*e = DncSource(s)
+	default:
+		return fmt.Errorf("unsupported scan type for DncSource: %T", src)
+	}
+	return nil
+}
+
+type NullDncSource struct {
+	DncSource DncSource `json:"dnc_source"`
+	Valid     bool      `json:"valid"` // Valid is true if DncSource is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullDncSource) Scan(value interface{}) error {
+	if value == nil {
+		ns.DncSource, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.DncSource.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullDncSource) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.DncSource), nil
+}
+
+type JobStatus string
+
+const (
+	JobStatusQueued    JobStatus = "queued"
+	JobStatusRunning   JobStatus = "running"
+	JobStatusSucceeded JobStatus = "succeeded"
+	JobStatusFailed    JobStatus = "failed"
+	JobStatusCanceled  JobStatus = "canceled"
+)
+
+func (e *JobStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = JobStatus(s)
+	case string:
+		*e = JobStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for JobStatus: %T", src)
+	}
+	return nil
+}
+
+type NullJobStatus struct {
+	JobStatus JobStatus `json:"job_status"`
+	Valid     bool      `json:"valid"` // Valid is true if JobStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullJobStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.JobStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.JobStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullJobStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.JobStatus), nil
+}
+
 type Role string
 
 const (
@@ -56,10 +232,79 @@ func (ns NullRole) Value() (driver.Value, error) {
 	return string(ns.Role), nil
 }
 
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryStatusDead      WebhookDeliveryStatus = "dead"
+)
+
+func (e *WebhookDeliveryStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WebhookDeliveryStatus(s)
+	case string:
+		*e = WebhookDeliveryStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WebhookDeliveryStatus: %T", src)
+	}
+	return nil
+}
+
+type NullWebhookDeliveryStatus struct {
+	WebhookDeliveryStatus WebhookDeliveryStatus `json:"webhook_delivery_status"`
+	Valid                 bool                  `json:"valid"` // Valid is true if WebhookDeliveryStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWebhookDeliveryStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.WebhookDeliveryStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WebhookDeliveryStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWebhookDeliveryStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WebhookDeliveryStatus), nil
+}
+
+// 変更履歴。追記のみで更新・削除はできない
+type AuditEvent struct {
+	ID uuid.UUID `json:"id"`
+	// 変更したユーザー。ユーザー削除後も残すため外部キーにしない
+	ActorID    pgtype.UUID `json:"actor_id"`
+	Rpc        pgtype.Text `json:"rpc"`
+	EntityType string      `json:"entity_type"`
+	EntityID   string      `json:"entity_id"`
+	Action     AuditAction `json:"action"`
+	// 更新の場合は変更された項目のみ
+	Before []byte `json:"before"`
+	// 更新の場合は変更された項目のみ
+	After     []byte    `json:"after"`
+	CreatedAt time.Time `json:"created_at"`
+	// バックグラウンド処理による変更は NULL
+	OrganizationID pgtype.UUID `json:"organization_id"`
+}
+
 type Book struct {
 	ID        uuid.UUID `json:"id"`
 	Name      string    `json:"name"`
 	CreatedAt time.Time `json:"created_at"`
+	Timezone  string    `json:"timezone"`
+	// 祝日と年末年始は架電しない
+	SkipHolidays bool `json:"skip_holidays"`
+	// ゴミ箱に移動した日時。保持期間を過ぎると完全に削除する
+	DeletedAt pgtype.Timestamptz `json:"deleted_at"`
+	// 更新のたびに増える。ETag として返し、古い値での更新を拒否する
+	Version        int64     `json:"version"`
+	OrganizationID uuid.UUID `json:"organization_id"`
 }
 
 type Call struct {
@@ -70,10 +315,22 @@ type Call struct {
 	CreatedAt  time.Time   `json:"created_at"`
 }
 
+// 顧客リストごとの架電可能時間帯
+type CallingWindow struct {
+	ID     uuid.UUID `json:"id"`
+	BookID uuid.UUID `json:"book_id"`
+	// 0が日曜日
+	Weekday   int16       `json:"weekday"`
+	StartTime pgtype.Time `json:"start_time"`
+	EndTime   pgtype.Time `json:"end_time"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
 type Category struct {
-	ID        uuid.UUID `json:"id"`
-	Name      string    `json:"name"`
-	CreatedAt time.Time `json:"created_at"`
+	ID             uuid.UUID `json:"id"`
+	Name           string    `json:"name"`
+	CreatedAt      time.Time `json:"created_at"`
+	OrganizationID uuid.UUID `json:"organization_id"`
 }
 
 type Contact struct {
@@ -85,6 +342,25 @@ type Contact struct {
 	Mail      pgtype.Text `json:"mail"`
 	Fax       pgtype.Text `json:"fax"`
 	CreatedAt time.Time   `json:"created_at"`
+	// ゴミ箱に移動した日時。顧客と一緒に削除した場合は顧客と同じ日時
+	DeletedAt pgtype.Timestamptz `json:"deleted_at"`
+	// 更新のたびに増える。ETag として返し、古い値での更新を拒否する
+	Version int64 `json:"version"`
+}
+
+// 顧客リストごとの独自項目の定義
+type CustomField struct {
+	ID     uuid.UUID `json:"id"`
+	BookID uuid.UUID `json:"book_id"`
+	// Customer.custom_fields のキー。作成後は変更できない
+	Key   string          `json:"key"`
+	Label string          `json:"label"`
+	Type  CustomFieldType `json:"type"`
+	// select の選択肢
+	Options   []string  `json:"options"`
+	Required  bool      `json:"required"`
+	Position  int32     `json:"position"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 type Customer struct {
@@ -101,14 +377,142 @@ type Customer struct {
 	Pic       pgtype.UUID `json:"pic"`
 	Memo      pgtype.Text `json:"memo"`
 	CreatedAt time.Time   `json:"created_at"`
+	// ゴミ箱に移動した日時。リストと一緒に削除した場合はリストと同じ日時
+	DeletedAt pgtype.Timestamptz `json:"deleted_at"`
+	// 独自項目の値。キーは CustomField.key
+	CustomFields []byte `json:"custom_fields"`
+	// 更新のたびに増える。ETag として返し、古い値での更新を拒否する
+	Version int64 `json:"version"`
 }
 
-type Redial struct {
+type CustomerTag struct {
+	CustomerID uuid.UUID `json:"customer_id"`
+	TagID      uuid.UUID `json:"tag_id"`
+	// タグを付けたユーザー
+	UserID    pgtype.UUID `json:"user_id"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+// 架電中の顧客の貸し出し状況
+type DialLease struct {
+	CustomerID uuid.UUID `json:"customer_id"`
+	UserID     uuid.UUID `json:"user_id"`
+	// この時刻を過ぎたら他のユーザーに再度払い出す
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// 架電禁止リスト
+type DoNotCall struct {
+	// normalize_phone で正規化済みの電話番号
+	Phone          string      `json:"phone"`
+	Reason         pgtype.Text `json:"reason"`
+	Source         DncSource   `json:"source"`
+	UserID         pgtype.UUID `json:"user_id"`
+	CallID         pgtype.UUID `json:"call_id"`
+	CreatedAt      time.Time   `json:"created_at"`
+	OrganizationID uuid.UUID   `json:"organization_id"`
+}
+
+// 作成系RPCの Idempotency-Key と最初のレスポンス
+type IdempotencyKey struct {
+	// キーはユーザーごと。ユーザーがないリクエストの Idempotency-Key は受け付けない
+	UserID uuid.UUID `json:"user_id"`
+	Key    string    `json:"key"`
+	Rpc    string    `json:"rpc"`
+	// RPCとリクエストの SHA-256。同じキーで異なるリクエストを拒否する
+	RequestHash []byte `json:"request_hash"`
+	// protobuf でシリアライズしたレスポンス。NULL の場合は処理中
+	Response  []byte    `json:"response"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	// レスポンスの ETag に使った version。再送時に同じ ETag を返す
+	Version pgtype.Int8 `json:"version"`
+}
+
+// 時間のかかる処理をサーバー内のワーカーで非同期に実行する
+type Job struct {
+	ID     uuid.UUID `json:"id"`
+	Type   string    `json:"type"`
+	Status JobStatus `json:"status"`
+	Params []byte    `json:"params"`
+	// 進捗率 (0〜100)
+	Progress int32  `json:"progress"`
+	Result   []byte `json:"result"`
+	// エクスポートしたファイルなど結果の取得先
+	ResultLocation  pgtype.Text `json:"result_location"`
+	Error           pgtype.Text `json:"error"`
+	Attempts        int32       `json:"attempts"`
+	MaxAttempts     int32       `json:"max_attempts"`
+	CancelRequested bool        `json:"cancel_requested"`
+	UserID          pgtype.UUID `json:"user_id"`
+	// この時刻以降に実行する。再試行の待ち時間に使う
+	RunAfter time.Time `json:"run_after"`
+	// 実行中のワーカー
+	LockedBy pgtype.Text `json:"locked_by"`
+	// ワーカーが定期的に延長する。過ぎた場合はワーカーが停止したとみなして再実行する
+	LockedUntil    pgtype.Timestamptz `json:"locked_until"`
+	CreatedAt      time.Time          `json:"created_at"`
+	StartedAt      pgtype.Timestamptz `json:"started_at"`
+	FinishedAt     pgtype.Timestamptz `json:"finished_at"`
+	OrganizationID uuid.UUID          `json:"organization_id"`
+}
+
+// エクスポートなどジョブが作成したファイル。Job.result_location から取得する
+type JobFile struct {
+	JobID       uuid.UUID `json:"job_id"`
+	ContentType string    `json:"content_type"`
+	Content     []byte    `json:"content"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// 顧客へのメモ。Customer.memo と違い追記していく
+type Note struct {
+	ID         uuid.UUID `json:"id"`
+	CustomerID uuid.UUID `json:"customer_id"`
+	// 書いたユーザー
+	UserID    uuid.UUID `json:"user_id"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// prism を利用する代理店。データは組織ごとに分離する
+type Organization struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// 外部に通知するイベント。変更と同じトランザクションでトリガーが書き込む
+type OutboxEvent struct {
 	ID        uuid.UUID   `json:"id"`
-	UserID    uuid.UUID   `json:"user_id"`
-	Date      pgtype.Date `json:"date"`
-	Time      pgtype.Time `json:"time"`
+	EventType string      `json:"event_type"`
+	BookID    pgtype.UUID `json:"book_id"`
+	Payload   []byte      `json:"payload"`
 	CreatedAt time.Time   `json:"created_at"`
+	// WebhookDelivery に振り分けた日時
+	DispatchedAt   pgtype.Timestamptz `json:"dispatched_at"`
+	OrganizationID uuid.UUID          `json:"organization_id"`
+}
+
+type Redial struct {
+	ID          uuid.UUID `json:"id"`
+	UserID      uuid.UUID `json:"user_id"`
+	CreatedAt   time.Time `json:"created_at"`
+	ScheduledAt time.Time `json:"scheduled_at"`
+	// 再架電を予約したユーザー
+	BookedBy pgtype.UUID `json:"booked_by"`
+	BookedAt time.Time   `json:"booked_at"`
+}
+
+// 再架電の予約履歴。Redial の予約時にトリガーで追加する
+type RedialBooking struct {
+	ID          uuid.UUID   `json:"id"`
+	CustomerID  uuid.UUID   `json:"customer_id"`
+	BookedBy    pgtype.UUID `json:"booked_by"`
+	BookedAt    time.Time   `json:"booked_at"`
+	ScheduledAt time.Time   `json:"scheduled_at"`
 }
 
 type Staff struct {
@@ -116,6 +520,9 @@ type Staff struct {
 	Name      pgtype.Text `json:"name"`
 	Sex       pgtype.Text `json:"sex"`
 	CreatedAt time.Time   `json:"created_at"`
+	// 更新のたびに増える。ETag として返し、古い値での更新を拒否する
+	Version        int64     `json:"version"`
+	OrganizationID uuid.UUID `json:"organization_id"`
 }
 
 type Status struct {
@@ -126,12 +533,56 @@ type Status struct {
 	// NG
 	Ng        pgtype.Bool `json:"ng"`
 	CreatedAt time.Time   `json:"created_at"`
+	// このステータスで架電記録したら架電禁止リストに登録する
+	Dnc            bool      `json:"dnc"`
+	OrganizationID uuid.UUID `json:"organization_id"`
 }
 
-type User struct {
+// 顧客に複数付けられるラベル。顧客リストごとに管理する
+type Tag struct {
 	ID        uuid.UUID `json:"id"`
-	Email     string    `json:"email"`
+	BookID    uuid.UUID `json:"book_id"`
 	Name      string    `json:"name"`
-	Role      Role      `json:"role"`
 	CreatedAt time.Time `json:"created_at"`
 }
+
+type User struct {
+	ID             uuid.UUID `json:"id"`
+	Email          string    `json:"email"`
+	Name           string    `json:"name"`
+	Role           Role      `json:"role"`
+	CreatedAt      time.Time `json:"created_at"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+}
+
+// イベントを通知する外部のエンドポイント
+type Webhook struct {
+	ID  uuid.UUID `json:"id"`
+	Url string    `json:"url"`
+	// ペイロードの HMAC-SHA256 署名に使う
+	Secret string `json:"secret"`
+	// 通知するイベントの種類。空の場合はすべて
+	EventTypes     []string    `json:"event_types"`
+	Description    pgtype.Text `json:"description"`
+	Active         bool        `json:"active"`
+	UserID         pgtype.UUID `json:"user_id"`
+	CreatedAt      time.Time   `json:"created_at"`
+	UpdatedAt      time.Time   `json:"updated_at"`
+	OrganizationID uuid.UUID   `json:"organization_id"`
+}
+
+// イベントごとの送信状況。再試行の上限を超えたものは dead になる
+type WebhookDelivery struct {
+	ID            uuid.UUID             `json:"id"`
+	WebhookID     uuid.UUID             `json:"webhook_id"`
+	EventID       uuid.UUID             `json:"event_id"`
+	Status        WebhookDeliveryStatus `json:"status"`
+	Attempts      int32                 `json:"attempts"`
+	NextAttemptAt time.Time             `json:"next_attempt_at"`
+	// 送信中のディスパッチャーが設定する。過ぎた場合は再送する
+	LockedUntil    pgtype.Timestamptz `json:"locked_until"`
+	LastStatusCode pgtype.Int4        `json:"last_status_code"`
+	LastError      pgtype.Text        `json:"last_error"`
+	CreatedAt      time.Time          `json:"created_at"`
+	DeliveredAt    pgtype.Timestamptz `json:"delivered_at"`
+}
@@ -0,0 +1,102 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: note.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const createNote = `-- name: CreateNote :one
+INSERT INTO "Note" (id, customer_id, user_id, body)
+SELECT $1::uuid, $2::uuid, $3::uuid, $4::text
+WHERE customer_in_organization($2)
+RETURNING id, customer_id, user_id, body, created_at, updated_at
+`
+
+type CreateNoteParams struct {
+	ID         uuid.UUID `json:"id"`
+	CustomerID uuid.UUID `json:"customer_id"`
+	UserID     uuid.UUID `json:"user_id"`
+	Body       string    `json:"body"`
+}
+
+func (q *Queries) CreateNote(ctx context.Context, arg CreateNoteParams) (Note, error) {
+	row := q.db.QueryRow(ctx, createNote,
+		arg.ID,
+		arg.CustomerID,
+		arg.UserID,
+		arg.Body,
+	)
+	var i Note
+	err := row.Scan(
+		&i.ID,
+		&i.CustomerID,
+		&i.UserID,
+		&i.Body,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteNote = `-- name: DeleteNote :exec
+DELETE FROM "Note"
+WHERE id = $1 AND customer_in_organization(customer_id)
+`
+
+func (q *Queries) DeleteNote(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteNote, id)
+	return err
+}
+
+const getNote = `-- name: GetNote :one
+SELECT id, customer_id, user_id, body, created_at, updated_at FROM "Note"
+WHERE id = $1 AND customer_in_organization(customer_id) LIMIT 1
+`
+
+func (q *Queries) GetNote(ctx context.Context, id uuid.UUID) (Note, error) {
+	row := q.db.QueryRow(ctx, getNote, id)
+	var i Note
+	err := row.Scan(
+		&i.ID,
+		&i.CustomerID,
+		&i.UserID,
+		&i.Body,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateNote = `-- name: UpdateNote :one
+UPDATE "Note"
+SET
+  body = $1,
+  updated_at = now()
+WHERE id = $2 AND customer_in_organization(customer_id)
+RETURNING id, customer_id, user_id, body, created_at, updated_at
+`
+
+type UpdateNoteParams struct {
+	Body string    `json:"body"`
+	ID   uuid.UUID `json:"id"`
+}
+
+func (q *Queries) UpdateNote(ctx context.Context, arg UpdateNoteParams) (Note, error) {
+	row := q.db.QueryRow(ctx, updateNote, arg.Body, arg.ID)
+	var i Note
+	err := row.Scan(
+		&i.ID,
+		&i.CustomerID,
+		&i.UserID,
+		&i.Body,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
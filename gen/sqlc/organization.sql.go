@@ -0,0 +1,152 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: organization.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countOrganizationOwners = `-- name: CountOrganizationOwners :one
+SELECT count(*) FROM "User"
+WHERE organization_id = current_organization_id() AND role = 'owner'
+`
+
+func (q *Queries) CountOrganizationOwners(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, countOrganizationOwners)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createOrganization = `-- name: CreateOrganization :one
+INSERT INTO "Organization" (id, name)
+VALUES ($1, $2)
+RETURNING id, name, created_at
+`
+
+type CreateOrganizationParams struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+}
+
+func (q *Queries) CreateOrganization(ctx context.Context, arg CreateOrganizationParams) (Organization, error) {
+	row := q.db.QueryRow(ctx, createOrganization, arg.ID, arg.Name)
+	var i Organization
+	err := row.Scan(&i.ID, &i.Name, &i.CreatedAt)
+	return i, err
+}
+
+const createOrganizationUser = `-- name: CreateOrganizationUser :one
+INSERT INTO "User" (id, email, name, role, organization_id)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, email, name, role, created_at, organization_id
+`
+
+type CreateOrganizationUserParams struct {
+	ID             uuid.UUID `json:"id"`
+	Email          string    `json:"email"`
+	Name           string    `json:"name"`
+	Role           Role      `json:"role"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+}
+
+// 組織を指定してユーザーを作成する。組織の作成時に最初のオーナーを登録する
+func (q *Queries) CreateOrganizationUser(ctx context.Context, arg CreateOrganizationUserParams) (User, error) {
+	row := q.db.QueryRow(ctx, createOrganizationUser,
+		arg.ID,
+		arg.Email,
+		arg.Name,
+		arg.Role,
+		arg.OrganizationID,
+	)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Name,
+		&i.Role,
+		&i.CreatedAt,
+		&i.OrganizationID,
+	)
+	return i, err
+}
+
+const getOrganization = `-- name: GetOrganization :one
+SELECT id, name, created_at FROM "Organization"
+WHERE id = $1 AND id = current_organization_id() LIMIT 1
+`
+
+func (q *Queries) GetOrganization(ctx context.Context, id uuid.UUID) (Organization, error) {
+	row := q.db.QueryRow(ctx, getOrganization, id)
+	var i Organization
+	err := row.Scan(&i.ID, &i.Name, &i.CreatedAt)
+	return i, err
+}
+
+const listOrganizationUsers = `-- name: ListOrganizationUsers :many
+SELECT id, email, name, role, created_at, organization_id FROM "User"
+WHERE organization_id = current_organization_id()
+ORDER BY created_at, id
+`
+
+func (q *Queries) ListOrganizationUsers(ctx context.Context) ([]User, error) {
+	rows, err := q.db.Query(ctx, listOrganizationUsers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []User{}
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.Email,
+			&i.Name,
+			&i.Role,
+			&i.CreatedAt,
+			&i.OrganizationID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setOrganizationContext = `-- name: SetOrganizationContext :exec
+SELECT set_config('prism.organization_id', $1::text, true)
+`
+
+// 行レベルセキュリティのポリシーが参照する組織を現在のトランザクションに設定する
+func (q *Queries) SetOrganizationContext(ctx context.Context, organizationID string) error {
+	_, err := q.db.Exec(ctx, setOrganizationContext, organizationID)
+	return err
+}
+
+const updateOrganization = `-- name: UpdateOrganization :one
+UPDATE "Organization"
+SET name = COALESCE($1, name)
+WHERE id = $2 AND id = current_organization_id()
+RETURNING id, name, created_at
+`
+
+type UpdateOrganizationParams struct {
+	Name pgtype.Text `json:"name"`
+	ID   uuid.UUID   `json:"id"`
+}
+
+func (q *Queries) UpdateOrganization(ctx context.Context, arg UpdateOrganizationParams) (Organization, error) {
+	row := q.db.QueryRow(ctx, updateOrganization, arg.Name, arg.ID)
+	var i Organization
+	err := row.Scan(&i.ID, &i.Name, &i.CreatedAt)
+	return i, err
+}
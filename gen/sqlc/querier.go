@@ -6,45 +6,203 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type Querier interface {
+	// 期限切れまたは自分のリースのみ上書きする。他人の有効なリースがある場合は行を返さない。
+	AcquireDialLease(ctx context.Context, arg AcquireDialLeaseParams) (DialLease, error)
+	// 同じリストの顧客にのみタグを付ける。付与済みの組み合わせは無視する
+	AddCustomerTags(ctx context.Context, arg AddCustomerTagsParams) (int64, error)
+	// リクエストの組織を決めるため、組織を限定せずに取得する。認証以外では GetUser を使う
+	AuthenticateUser(ctx context.Context, id uuid.UUID) (User, error)
+	// 実行待ちのジョブと、ワーカーが停止して期限切れになったジョブはすぐにキャンセルし、
+	// 実行中のジョブはワーカーに中断を要求する
+	CancelJob(ctx context.Context, id uuid.UUID) (Job, error)
+	// 実行待ちのジョブか、ワーカーが停止して期限切れになったジョブを全組織から1件取得する。
+	// 期限切れのジョブのうち試行回数を使い切ったものは、同じ文で失敗にする
+	ClaimJob(ctx context.Context, arg ClaimJobParams) (Job, error)
+	// 送信時刻を過ぎた送信をロックして送信先とイベントと一緒に返す
+	ClaimWebhookDeliveries(ctx context.Context, arg ClaimWebhookDeliveriesParams) ([]ClaimWebhookDeliveriesRow, error)
+	ClearCustomerTags(ctx context.Context, customerID uuid.UUID) error
+	CompleteJob(ctx context.Context, arg CompleteJobParams) error
+	CountActiveJobs(ctx context.Context) ([]CountActiveJobsRow, error)
+	CountCallsSince(ctx context.Context, fromTime time.Time) (int64, error)
+	// select の選択肢から外す値を使っている顧客の数
+	CountCustomFieldValuesNotIn(ctx context.Context, arg CountCustomFieldValuesNotInParams) (int64, error)
+	// 必須にする項目が未入力の顧客の数
+	CountCustomersMissingCustomField(ctx context.Context, arg CountCustomersMissingCustomFieldParams) (int64, error)
+	CountDoNotCall(ctx context.Context) (int64, error)
+	CountOrganizationOwners(ctx context.Context) (int64, error)
+	CountOverdueRedials(ctx context.Context, now time.Time) (int64, error)
 	CreateBook(ctx context.Context, arg CreateBookParams) (Book, error)
+	CreateCall(ctx context.Context, arg CreateCallParams) (Call, error)
+	CreateCallingWindow(ctx context.Context, arg CreateCallingWindowParams) (CallingWindow, error)
 	CreateCategory(ctx context.Context, arg CreateCategoryParams) (Category, error)
 	CreateContact(ctx context.Context, arg CreateContactParams) (Contact, error)
+	CreateCustomField(ctx context.Context, arg CreateCustomFieldParams) (CustomField, error)
 	CreateCustomer(ctx context.Context, arg CreateCustomerParams) (Customer, error)
+	CreateDoNotCall(ctx context.Context, arg CreateDoNotCallParams) (DoNotCall, error)
+	// 顧客の連絡先の電話番号をまとめて架電禁止リストに登録する
+	CreateDoNotCallFromCall(ctx context.Context, arg CreateDoNotCallFromCallParams) (int64, error)
+	CreateJob(ctx context.Context, arg CreateJobParams) (Job, error)
+	CreateNote(ctx context.Context, arg CreateNoteParams) (Note, error)
+	CreateOrganization(ctx context.Context, arg CreateOrganizationParams) (Organization, error)
+	// 組織を指定してユーザーを作成する。組織の作成時に最初のオーナーを登録する
+	CreateOrganizationUser(ctx context.Context, arg CreateOrganizationUserParams) (User, error)
 	CreateRedial(ctx context.Context, arg CreateRedialParams) (Redial, error)
 	CreateStaff(ctx context.Context, arg CreateStaffParams) (Staff, error)
 	CreateStatus(ctx context.Context, arg CreateStatusParams) (Status, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
-	DeleteBook(ctx context.Context, id uuid.UUID) error
+	CreateWebhook(ctx context.Context, arg CreateWebhookParams) (Webhook, error)
+	DeleteCall(ctx context.Context, id uuid.UUID) error
+	DeleteCallingWindows(ctx context.Context, bookID uuid.UUID) error
 	DeleteCategory(ctx context.Context, id uuid.UUID) error
 	DeleteContact(ctx context.Context, id uuid.UUID) error
-	DeleteCustomer(ctx context.Context, id uuid.UUID) error
+	DeleteCustomField(ctx context.Context, id uuid.UUID) error
+	// 削除した項目の値を顧客から取り除く
+	DeleteCustomFieldValues(ctx context.Context, arg DeleteCustomFieldValuesParams) (int64, error)
+	DeleteDoNotCall(ctx context.Context, phone string) (int64, error)
+	DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, error)
+	DeleteIdempotencyKey(ctx context.Context, arg DeleteIdempotencyKeyParams) error
+	DeleteNote(ctx context.Context, id uuid.UUID) error
 	DeleteRedial(ctx context.Context, id uuid.UUID) error
 	DeleteStaff(ctx context.Context, id uuid.UUID) error
 	DeleteStatus(ctx context.Context, id uuid.UUID) error
+	DeleteTag(ctx context.Context, id uuid.UUID) error
 	DeleteUser(ctx context.Context, id uuid.UUID) error
+	DeleteWebhook(ctx context.Context, id uuid.UUID) (int64, error)
+	// 顧客リストのCSV出力。連絡先は最初に登録したものを出力し、id 順にページングする。
+	// for_calling の場合は架電キューと同じく、連絡先の番号が架電禁止リストにある顧客を除く
+	ExportCustomers(ctx context.Context, arg ExportCustomersParams) ([]ExportCustomersRow, error)
+	ExtendDialLease(ctx context.Context, arg ExtendDialLeaseParams) (DialLease, error)
+	// 試行回数が残っていれば run_after 以降に再実行する
+	FailJob(ctx context.Context, arg FailJobParams) (Job, error)
+	// 全組織の未処理のイベントを、同じ組織で購読している Webhook ごとの送信に振り分ける
+	FanOutOutboxEvents(ctx context.Context, rowLimit int32) (int64, error)
+	FinishCanceledJob(ctx context.Context, arg FinishCanceledJobParams) error
+	GetActiveDialLease(ctx context.Context, arg GetActiveDialLeaseParams) (GetActiveDialLeaseRow, error)
 	GetBook(ctx context.Context, id uuid.UUID) (Book, error)
+	GetBookByCustomer(ctx context.Context, id uuid.UUID) (Book, error)
+	GetCall(ctx context.Context, id uuid.UUID) (Call, error)
 	GetCategory(ctx context.Context, id uuid.UUID) (Category, error)
 	GetContact(ctx context.Context, id uuid.UUID) (Contact, error)
+	GetCustomField(ctx context.Context, id uuid.UUID) (CustomField, error)
 	GetCustomer(ctx context.Context, id uuid.UUID) (GetCustomerRow, error)
+	// tags_any はいずれか、tags_all はすべてのタグが付いた顧客、tags_none はいずれのタグも付いていない顧客に絞り込む
 	GetCustomerByBookId(ctx context.Context, arg GetCustomerByBookIdParams) ([]Customer, error)
+	GetCustomerByID(ctx context.Context, id uuid.UUID) (Customer, error)
+	GetDeletedBook(ctx context.Context, id uuid.UUID) (Book, error)
+	GetDeletedCustomer(ctx context.Context, id uuid.UUID) (Customer, error)
+	GetDoNotCall(ctx context.Context, phone string) (DoNotCall, error)
+	GetIdempotencyKey(ctx context.Context, arg GetIdempotencyKeyParams) (IdempotencyKey, error)
+	GetJob(ctx context.Context, id uuid.UUID) (Job, error)
+	GetJobFile(ctx context.Context, jobID uuid.UUID) (JobFile, error)
+	GetNote(ctx context.Context, id uuid.UUID) (Note, error)
+	GetOrganization(ctx context.Context, id uuid.UUID) (Organization, error)
 	GetRedial(ctx context.Context, id uuid.UUID) (Redial, error)
 	GetStaff(ctx context.Context, id uuid.UUID) (Staff, error)
 	GetStatus(ctx context.Context, id uuid.UUID) (Status, error)
+	GetTag(ctx context.Context, id uuid.UUID) (Tag, error)
 	GetUser(ctx context.Context, id uuid.UUID) (User, error)
+	GetWebhook(ctx context.Context, id uuid.UUID) (Webhook, error)
+	// 進捗を更新してロックを延長する。キャンセルが要求されているかを返す
+	HeartbeatJob(ctx context.Context, arg HeartbeatJobParams) (bool, error)
+	ImportDoNotCall(ctx context.Context, arg ImportDoNotCallParams) (int64, error)
+	ListAuditEvents(ctx context.Context, arg ListAuditEventsParams) ([]AuditEvent, error)
+	ListBookIDs(ctx context.Context) ([]uuid.UUID, error)
+	ListCallingWindows(ctx context.Context, bookID uuid.UUID) ([]CallingWindow, error)
+	ListCallsByCustomer(ctx context.Context, arg ListCallsByCustomerParams) ([]Call, error)
+	ListCustomFields(ctx context.Context, bookID uuid.UUID) ([]CustomField, error)
+	ListCustomerTagNames(ctx context.Context, customerIds []uuid.UUID) ([]ListCustomerTagNamesRow, error)
+	// 顧客の再架電・メモ・架電・顧客と連絡先とタグの変更履歴を新しい順にまとめる
+	ListCustomerTimeline(ctx context.Context, arg ListCustomerTimelineParams) ([]ListCustomerTimelineRow, error)
+	ListDoNotCall(ctx context.Context, arg ListDoNotCallParams) ([]DoNotCall, error)
+	// 全組織が対象
+	ListDueRedials(ctx context.Context, arg ListDueRedialsParams) ([]ListDueRedialsRow, error)
+	ListJobs(ctx context.Context, arg ListJobsParams) ([]Job, error)
+	ListOrganizationUsers(ctx context.Context) ([]User, error)
+	ListRedialsByUser(ctx context.Context, arg ListRedialsByUserParams) ([]Redial, error)
+	ListTagsByName(ctx context.Context, arg ListTagsByNameParams) ([]Tag, error)
+	ListTagsWithUsage(ctx context.Context, bookID uuid.UUID) ([]ListTagsWithUsageRow, error)
+	// ゴミ箱の一覧。リストと一緒に削除された顧客はリスト側にまとめて表示する
+	ListTrash(ctx context.Context, arg ListTrashParams) ([]ListTrashRow, error)
+	ListUserCallActivity(ctx context.Context, arg ListUserCallActivityParams) ([]ListUserCallActivityRow, error)
+	ListUserRedialActivity(ctx context.Context, arg ListUserRedialActivityParams) ([]ListUserRedialActivityRow, error)
+	ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]ListWebhookDeliveriesRow, error)
+	ListWebhooks(ctx context.Context) ([]Webhook, error)
+	// NG や架電禁止になっておらず、未架電または再架電予定時刻を過ぎた顧客を1件ロックする。
+	// 他のトランザクションがロック中の行は SKIP LOCKED で読み飛ばす。
+	LockNextDialCandidate(ctx context.Context, arg LockNextDialCandidateParams) (Customer, error)
+	MarkWebhookDelivered(ctx context.Context, arg MarkWebhookDeliveredParams) error
+	// 試行回数が上限に達した場合は dead にする
+	MarkWebhookDeliveryFailed(ctx context.Context, arg MarkWebhookDeliveryFailedParams) error
+	// 保持期間を過ぎた全組織のリストを完全に削除する。顧客と架電履歴、連絡先、再架電も ON DELETE CASCADE で削除される
+	PurgeBooks(ctx context.Context, deletedBefore time.Time) (int64, error)
+	// 全組織が対象
+	PurgeContacts(ctx context.Context, deletedBefore time.Time) (int64, error)
+	// 全組織が対象
+	PurgeCustomers(ctx context.Context, deletedBefore time.Time) (int64, error)
+	ReleaseBatchItem(ctx context.Context) error
+	ReleaseDialLease(ctx context.Context, arg ReleaseDialLeaseParams) (int64, error)
+	// シャットダウンで中断したジョブを試行回数を戻して実行待ちにする
+	ReleaseJob(ctx context.Context, arg ReleaseJobParams) error
+	RemoveCustomerTags(ctx context.Context, arg RemoveCustomerTagsParams) (int64, error)
+	ReplayDeadWebhookDeliveries(ctx context.Context, webhookID uuid.UUID) (int64, error)
+	// 送信済みや dead の送信を試行回数を戻して再送する
+	ReplayWebhookDelivery(ctx context.Context, id uuid.UUID) (ReplayWebhookDeliveryRow, error)
+	ReportCallsByBook(ctx context.Context, arg ReportCallsByBookParams) ([]ReportCallsByBookRow, error)
+	ReportCallsByDay(ctx context.Context, arg ReportCallsByDayParams) ([]ReportCallsByDayRow, error)
+	ReportCallsByHour(ctx context.Context, arg ReportCallsByHourParams) ([]ReportCallsByHourRow, error)
+	ReportCallsByUser(ctx context.Context, arg ReportCallsByUserParams) ([]ReportCallsByUserRow, error)
+	ReportCallsTotal(ctx context.Context, arg ReportCallsTotalParams) (ReportCallsTotalRow, error)
+	ReportLeaderboard(ctx context.Context, arg ReportLeaderboardParams) ([]ReportLeaderboardRow, error)
+	// キーを処理中として登録する。期限切れか、処理中のまま放置されたキーは上書きする。
+	// 使用中の場合は行を返さない
+	ReserveIdempotencyKey(ctx context.Context, arg ReserveIdempotencyKeyParams) (IdempotencyKey, error)
+	RestoreBook(ctx context.Context, id uuid.UUID) (Book, error)
+	RestoreContactsByBook(ctx context.Context, arg RestoreContactsByBookParams) (int64, error)
+	// 顧客と一緒にゴミ箱に移動した連絡先のみ戻す
+	RestoreContactsByCustomer(ctx context.Context, arg RestoreContactsByCustomerParams) (int64, error)
+	RestoreCustomer(ctx context.Context, id uuid.UUID) (Customer, error)
+	// リストと一緒にゴミ箱に移動した顧客のみ戻す
+	RestoreCustomersByBook(ctx context.Context, arg RestoreCustomersByBookParams) (int64, error)
+	RollbackToBatchItem(ctx context.Context) error
+	RotateWebhookSecret(ctx context.Context, arg RotateWebhookSecretParams) (Webhook, error)
+	SaveIdempotencyResponse(ctx context.Context, arg SaveIdempotencyResponseParams) error
+	// 再試行で作り直したファイルは上書きする
+	SaveJobFile(ctx context.Context, arg SaveJobFileParams) error
+	// 一括操作で1件の失敗がトランザクション全体を中断しないよう、項目ごとにセーブポイントを作る
+	SavepointBatchItem(ctx context.Context) error
+	// custom_field_filters は [{"key", "type", "op", "value"}] の配列。
+	// 値は CustomField の型で検証済みのため、number の場合のみ数値として比較する
 	SearchCustomer(ctx context.Context, arg SearchCustomerParams) ([]Customer, error)
+	// トランザクション内の変更を記録するトリガーに操作したユーザーとRPCを渡す
+	SetAuditContext(ctx context.Context, arg SetAuditContextParams) error
+	// 行レベルセキュリティのポリシーが参照する組織を現在のトランザクションに設定する
+	SetOrganizationContext(ctx context.Context, organizationID string) error
+	SoftDeleteBook(ctx context.Context, id uuid.UUID) (Book, error)
+	SoftDeleteContactsByBook(ctx context.Context, arg SoftDeleteContactsByBookParams) (int64, error)
+	SoftDeleteContactsByCustomer(ctx context.Context, arg SoftDeleteContactsByCustomerParams) (int64, error)
+	SoftDeleteCustomer(ctx context.Context, id uuid.UUID) (Customer, error)
+	SoftDeleteCustomersByBook(ctx context.Context, arg SoftDeleteCustomersByBookParams) (int64, error)
 	UpdateBook(ctx context.Context, arg UpdateBookParams) (Book, error)
 	UpdateCategory(ctx context.Context, arg UpdateCategoryParams) (Category, error)
 	UpdateContact(ctx context.Context, arg UpdateContactParams) (Contact, error)
+	UpdateCustomField(ctx context.Context, arg UpdateCustomFieldParams) (CustomField, error)
 	UpdateCustomer(ctx context.Context, arg UpdateCustomerParams) (Customer, error)
+	UpdateNote(ctx context.Context, arg UpdateNoteParams) (Note, error)
+	UpdateOrganization(ctx context.Context, arg UpdateOrganizationParams) (Organization, error)
 	UpdateRedial(ctx context.Context, arg UpdateRedialParams) (Redial, error)
 	UpdateStaff(ctx context.Context, arg UpdateStaffParams) (Staff, error)
 	UpdateStatus(ctx context.Context, arg UpdateStatusParams) (Status, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
+	UpdateWebhook(ctx context.Context, arg UpdateWebhookParams) (Webhook, error)
+	UpsertRedial(ctx context.Context, arg UpsertRedialParams) (Redial, error)
+	// 名前で指定したタグを作成し、既存のタグはそのまま返す
+	UpsertTags(ctx context.Context, arg UpsertTagsParams) ([]Tag, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -7,45 +7,42 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createRedial = `-- name: CreateRedial :one
-INSERT INTO "Redial" (id, user_id, date, time)
-VALUES ($1, $2, $3, $4)
-RETURNING id, user_id, date, time, created_at
+INSERT INTO "Redial" (id, user_id, scheduled_at)
+SELECT $1::uuid, $2::uuid, $3::timestamptz
+WHERE customer_in_organization($1)
+RETURNING id, user_id, created_at, scheduled_at, booked_by, booked_at
 `
 
 type CreateRedialParams struct {
-	ID     uuid.UUID   `json:"id"`
-	UserID uuid.UUID   `json:"user_id"`
-	Date   pgtype.Date `json:"date"`
-	Time   pgtype.Time `json:"time"`
+	ID          uuid.UUID `json:"id"`
+	UserID      uuid.UUID `json:"user_id"`
+	ScheduledAt time.Time `json:"scheduled_at"`
 }
 
 func (q *Queries) CreateRedial(ctx context.Context, arg CreateRedialParams) (Redial, error) {
-	row := q.db.QueryRow(ctx, createRedial,
-		arg.ID,
-		arg.UserID,
-		arg.Date,
-		arg.Time,
-	)
+	row := q.db.QueryRow(ctx, createRedial, arg.ID, arg.UserID, arg.ScheduledAt)
 	var i Redial
 	err := row.Scan(
 		&i.ID,
 		&i.UserID,
-		&i.Date,
-		&i.Time,
 		&i.CreatedAt,
+		&i.ScheduledAt,
+		&i.BookedBy,
+		&i.BookedAt,
 	)
 	return i, err
 }
 
 const deleteRedial = `-- name: DeleteRedial :exec
 DELETE FROM "Redial"
-WHERE id = $1
+WHERE id = $1 AND customer_in_organization(id)
 `
 
 func (q *Queries) DeleteRedial(ctx context.Context, id uuid.UUID) error {
@@ -54,8 +51,8 @@ func (q *Queries) DeleteRedial(ctx context.Context, id uuid.UUID) error {
 }
 
 const getRedial = `-- name: GetRedial :one
-SELECT id, user_id, date, time, created_at FROM "Redial"
-WHERE id = $1 LIMIT 1
+SELECT id, user_id, created_at, scheduled_at, booked_by, booked_at FROM "Redial"
+WHERE id = $1 AND customer_in_organization(id) LIMIT 1
 `
 
 func (q *Queries) GetRedial(ctx context.Context, id uuid.UUID) (Redial, error) {
@@ -64,44 +61,172 @@ func (q *Queries) GetRedial(ctx context.Context, id uuid.UUID) (Redial, error) {
 	err := row.Scan(
 		&i.ID,
 		&i.UserID,
-		&i.Date,
-		&i.Time,
 		&i.CreatedAt,
+		&i.ScheduledAt,
+		&i.BookedBy,
+		&i.BookedAt,
 	)
 	return i, err
 }
 
+const listDueRedials = `-- name: ListDueRedials :many
+SELECT r.id, r.user_id, r.created_at, r.scheduled_at, r.booked_by, r.booked_at, c.book_id FROM "Redial" r
+JOIN "Customer" c ON c.id = r.id
+WHERE c.deleted_at IS NULL
+AND r.scheduled_at > $1
+AND r.scheduled_at <= $2
+ORDER BY r.scheduled_at
+`
+
+type ListDueRedialsParams struct {
+	FromTime time.Time `json:"from_time"`
+	ToTime   time.Time `json:"to_time"`
+}
+
+type ListDueRedialsRow struct {
+	ID          uuid.UUID   `json:"id"`
+	UserID      uuid.UUID   `json:"user_id"`
+	CreatedAt   time.Time   `json:"created_at"`
+	ScheduledAt time.Time   `json:"scheduled_at"`
+	BookedBy    pgtype.UUID `json:"booked_by"`
+	BookedAt    time.Time   `json:"booked_at"`
+	BookID      uuid.UUID   `json:"book_id"`
+}
+
+// 全組織が対象
+func (q *Queries) ListDueRedials(ctx context.Context, arg ListDueRedialsParams) ([]ListDueRedialsRow, error) {
+	rows, err := q.db.Query(ctx, listDueRedials, arg.FromTime, arg.ToTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListDueRedialsRow{}
+	for rows.Next() {
+		var i ListDueRedialsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.CreatedAt,
+			&i.ScheduledAt,
+			&i.BookedBy,
+			&i.BookedAt,
+			&i.BookID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRedialsByUser = `-- name: ListRedialsByUser :many
+SELECT id, user_id, created_at, scheduled_at, booked_by, booked_at FROM "Redial"
+WHERE user_id = $1 AND customer_in_organization(id)
+AND scheduled_at >= $2
+AND scheduled_at < $3
+ORDER BY scheduled_at
+`
+
+type ListRedialsByUserParams struct {
+	UserID   uuid.UUID `json:"user_id"`
+	FromTime time.Time `json:"from_time"`
+	ToTime   time.Time `json:"to_time"`
+}
+
+func (q *Queries) ListRedialsByUser(ctx context.Context, arg ListRedialsByUserParams) ([]Redial, error) {
+	rows, err := q.db.Query(ctx, listRedialsByUser, arg.UserID, arg.FromTime, arg.ToTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Redial{}
+	for rows.Next() {
+		var i Redial
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.CreatedAt,
+			&i.ScheduledAt,
+			&i.BookedBy,
+			&i.BookedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateRedial = `-- name: UpdateRedial :one
 UPDATE "Redial"
 SET 
   user_id = COALESCE($1, user_id),
-  date = COALESCE($2, date),
-  time = COALESCE($3, time)
-WHERE id = $4
-RETURNING id, user_id, date, time, created_at
+  scheduled_at = COALESCE($2, scheduled_at)
+WHERE id = $3 AND customer_in_organization(id)
+RETURNING id, user_id, created_at, scheduled_at, booked_by, booked_at
 `
 
 type UpdateRedialParams struct {
-	UserID pgtype.UUID `json:"user_id"`
-	Date   pgtype.Date `json:"date"`
-	Time   pgtype.Time `json:"time"`
-	ID     uuid.UUID   `json:"id"`
+	UserID      pgtype.UUID        `json:"user_id"`
+	ScheduledAt pgtype.Timestamptz `json:"scheduled_at"`
+	ID          uuid.UUID          `json:"id"`
 }
 
 func (q *Queries) UpdateRedial(ctx context.Context, arg UpdateRedialParams) (Redial, error) {
-	row := q.db.QueryRow(ctx, updateRedial,
-		arg.UserID,
-		arg.Date,
-		arg.Time,
+	row := q.db.QueryRow(ctx, updateRedial, arg.UserID, arg.ScheduledAt, arg.ID)
+	var i Redial
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.CreatedAt,
+		&i.ScheduledAt,
+		&i.BookedBy,
+		&i.BookedAt,
+	)
+	return i, err
+}
+
+const upsertRedial = `-- name: UpsertRedial :one
+INSERT INTO "Redial" (id, user_id, scheduled_at, booked_by)
+SELECT $1::uuid, $2::uuid, $3::timestamptz, $4::uuid
+WHERE customer_in_organization($1)
+ON CONFLICT (id) DO UPDATE
+SET
+  user_id = EXCLUDED.user_id,
+  scheduled_at = EXCLUDED.scheduled_at,
+  booked_by = EXCLUDED.booked_by,
+  booked_at = now()
+RETURNING id, user_id, created_at, scheduled_at, booked_by, booked_at
+`
+
+type UpsertRedialParams struct {
+	ID          uuid.UUID   `json:"id"`
+	UserID      uuid.UUID   `json:"user_id"`
+	ScheduledAt time.Time   `json:"scheduled_at"`
+	BookedBy    pgtype.UUID `json:"booked_by"`
+}
+
+func (q *Queries) UpsertRedial(ctx context.Context, arg UpsertRedialParams) (Redial, error) {
+	row := q.db.QueryRow(ctx, upsertRedial,
 		arg.ID,
+		arg.UserID,
+		arg.ScheduledAt,
+		arg.BookedBy,
 	)
 	var i Redial
 	err := row.Scan(
 		&i.ID,
 		&i.UserID,
-		&i.Date,
-		&i.Time,
 		&i.CreatedAt,
+		&i.ScheduledAt,
+		&i.BookedBy,
+		&i.BookedAt,
 	)
 	return i, err
 }
@@ -0,0 +1,524 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: report.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const listUserCallActivity = `-- name: ListUserCallActivity :many
+SELECT
+  ca.id AS call_id,
+  ca.created_at,
+  c.id AS customer_id,
+  c.name AS customer_name,
+  s.name AS status_name,
+  COALESCE(s.effective, false)::bool AS effective,
+  COALESCE(s.ng, false)::bool AS ng
+FROM "Call" ca
+JOIN "Customer" c ON c.id = ca.customer_id AND c.deleted_at IS NULL AND book_in_organization(c.book_id)
+LEFT JOIN "Status" s ON s.id = ca.status_id
+WHERE ca.user_id = $1
+AND ca.created_at >= $2
+AND ca.created_at < $3
+ORDER BY ca.created_at
+`
+
+type ListUserCallActivityParams struct {
+	UserID   uuid.UUID `json:"user_id"`
+	FromTime time.Time `json:"from_time"`
+	ToTime   time.Time `json:"to_time"`
+}
+
+type ListUserCallActivityRow struct {
+	CallID       uuid.UUID   `json:"call_id"`
+	CreatedAt    time.Time   `json:"created_at"`
+	CustomerID   uuid.UUID   `json:"customer_id"`
+	CustomerName string      `json:"customer_name"`
+	StatusName   pgtype.Text `json:"status_name"`
+	Effective    bool        `json:"effective"`
+	Ng           bool        `json:"ng"`
+}
+
+func (q *Queries) ListUserCallActivity(ctx context.Context, arg ListUserCallActivityParams) ([]ListUserCallActivityRow, error) {
+	rows, err := q.db.Query(ctx, listUserCallActivity, arg.UserID, arg.FromTime, arg.ToTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListUserCallActivityRow{}
+	for rows.Next() {
+		var i ListUserCallActivityRow
+		if err := rows.Scan(
+			&i.CallID,
+			&i.CreatedAt,
+			&i.CustomerID,
+			&i.CustomerName,
+			&i.StatusName,
+			&i.Effective,
+			&i.Ng,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserRedialActivity = `-- name: ListUserRedialActivity :many
+SELECT
+  rb.booked_at,
+  rb.scheduled_at,
+  c.id AS customer_id,
+  c.name AS customer_name
+FROM "RedialBooking" rb
+JOIN "Customer" c ON c.id = rb.customer_id AND c.deleted_at IS NULL AND book_in_organization(c.book_id)
+WHERE rb.booked_by = $1
+AND rb.booked_at >= $2
+AND rb.booked_at < $3
+ORDER BY rb.booked_at
+`
+
+type ListUserRedialActivityParams struct {
+	UserID   pgtype.UUID `json:"user_id"`
+	FromTime time.Time   `json:"from_time"`
+	ToTime   time.Time   `json:"to_time"`
+}
+
+type ListUserRedialActivityRow struct {
+	BookedAt     time.Time `json:"booked_at"`
+	ScheduledAt  time.Time `json:"scheduled_at"`
+	CustomerID   uuid.UUID `json:"customer_id"`
+	CustomerName string    `json:"customer_name"`
+}
+
+func (q *Queries) ListUserRedialActivity(ctx context.Context, arg ListUserRedialActivityParams) ([]ListUserRedialActivityRow, error) {
+	rows, err := q.db.Query(ctx, listUserRedialActivity, arg.UserID, arg.FromTime, arg.ToTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListUserRedialActivityRow{}
+	for rows.Next() {
+		var i ListUserRedialActivityRow
+		if err := rows.Scan(
+			&i.BookedAt,
+			&i.ScheduledAt,
+			&i.CustomerID,
+			&i.CustomerName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const reportCallsByBook = `-- name: ReportCallsByBook :many
+SELECT
+  b.id AS book_id,
+  b.name AS book_name,
+  count(*) AS calls,
+  count(*) FILTER (WHERE s.effective) AS effective,
+  count(*) FILTER (WHERE s.ng) AS ng,
+  count(DISTINCT ca.customer_id) AS customers
+FROM "Call" ca
+JOIN "Customer" c ON c.id = ca.customer_id AND c.deleted_at IS NULL AND book_in_organization(c.book_id)
+JOIN "Book" b ON b.id = c.book_id AND b.organization_id = current_organization_id()
+LEFT JOIN "Status" s ON s.id = ca.status_id
+WHERE ca.created_at >= $1
+AND ca.created_at < $2
+AND ($3::uuid IS NULL OR c.book_id = $3)
+AND ($4::uuid IS NULL OR ca.user_id = $4)
+GROUP BY b.id, b.name
+ORDER BY calls DESC, b.name
+`
+
+type ReportCallsByBookParams struct {
+	FromTime time.Time   `json:"from_time"`
+	ToTime   time.Time   `json:"to_time"`
+	BookID   pgtype.UUID `json:"book_id"`
+	UserID   pgtype.UUID `json:"user_id"`
+}
+
+type ReportCallsByBookRow struct {
+	BookID    uuid.UUID `json:"book_id"`
+	BookName  string    `json:"book_name"`
+	Calls     int64     `json:"calls"`
+	Effective int64     `json:"effective"`
+	Ng        int64     `json:"ng"`
+	Customers int64     `json:"customers"`
+}
+
+func (q *Queries) ReportCallsByBook(ctx context.Context, arg ReportCallsByBookParams) ([]ReportCallsByBookRow, error) {
+	rows, err := q.db.Query(ctx, reportCallsByBook,
+		arg.FromTime,
+		arg.ToTime,
+		arg.BookID,
+		arg.UserID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ReportCallsByBookRow{}
+	for rows.Next() {
+		var i ReportCallsByBookRow
+		if err := rows.Scan(
+			&i.BookID,
+			&i.BookName,
+			&i.Calls,
+			&i.Effective,
+			&i.Ng,
+			&i.Customers,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const reportCallsByDay = `-- name: ReportCallsByDay :many
+SELECT
+  (ca.created_at AT TIME ZONE $1::text)::date AS day,
+  count(*) AS calls,
+  count(*) FILTER (WHERE s.effective) AS effective,
+  count(*) FILTER (WHERE s.ng) AS ng,
+  count(DISTINCT ca.customer_id) AS customers
+FROM "Call" ca
+JOIN "Customer" c ON c.id = ca.customer_id AND c.deleted_at IS NULL AND book_in_organization(c.book_id)
+LEFT JOIN "Status" s ON s.id = ca.status_id
+WHERE ca.created_at >= $2
+AND ca.created_at < $3
+AND ($4::uuid IS NULL OR c.book_id = $4)
+AND ($5::uuid IS NULL OR ca.user_id = $5)
+GROUP BY day
+ORDER BY day
+`
+
+type ReportCallsByDayParams struct {
+	Timezone string      `json:"timezone"`
+	FromTime time.Time   `json:"from_time"`
+	ToTime   time.Time   `json:"to_time"`
+	BookID   pgtype.UUID `json:"book_id"`
+	UserID   pgtype.UUID `json:"user_id"`
+}
+
+type ReportCallsByDayRow struct {
+	Day       pgtype.Date `json:"day"`
+	Calls     int64       `json:"calls"`
+	Effective int64       `json:"effective"`
+	Ng        int64       `json:"ng"`
+	Customers int64       `json:"customers"`
+}
+
+func (q *Queries) ReportCallsByDay(ctx context.Context, arg ReportCallsByDayParams) ([]ReportCallsByDayRow, error) {
+	rows, err := q.db.Query(ctx, reportCallsByDay,
+		arg.Timezone,
+		arg.FromTime,
+		arg.ToTime,
+		arg.BookID,
+		arg.UserID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ReportCallsByDayRow{}
+	for rows.Next() {
+		var i ReportCallsByDayRow
+		if err := rows.Scan(
+			&i.Day,
+			&i.Calls,
+			&i.Effective,
+			&i.Ng,
+			&i.Customers,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const reportCallsByHour = `-- name: ReportCallsByHour :many
+SELECT
+  extract(hour FROM ca.created_at AT TIME ZONE $1::text)::int AS hour,
+  count(*) AS calls,
+  count(*) FILTER (WHERE s.effective) AS effective,
+  count(*) FILTER (WHERE s.ng) AS ng,
+  count(DISTINCT ca.customer_id) AS customers
+FROM "Call" ca
+JOIN "Customer" c ON c.id = ca.customer_id AND c.deleted_at IS NULL AND book_in_organization(c.book_id)
+LEFT JOIN "Status" s ON s.id = ca.status_id
+WHERE ca.created_at >= $2
+AND ca.created_at < $3
+AND ($4::uuid IS NULL OR c.book_id = $4)
+AND ($5::uuid IS NULL OR ca.user_id = $5)
+GROUP BY hour
+ORDER BY hour
+`
+
+type ReportCallsByHourParams struct {
+	Timezone string      `json:"timezone"`
+	FromTime time.Time   `json:"from_time"`
+	ToTime   time.Time   `json:"to_time"`
+	BookID   pgtype.UUID `json:"book_id"`
+	UserID   pgtype.UUID `json:"user_id"`
+}
+
+type ReportCallsByHourRow struct {
+	Hour      int32 `json:"hour"`
+	Calls     int64 `json:"calls"`
+	Effective int64 `json:"effective"`
+	Ng        int64 `json:"ng"`
+	Customers int64 `json:"customers"`
+}
+
+func (q *Queries) ReportCallsByHour(ctx context.Context, arg ReportCallsByHourParams) ([]ReportCallsByHourRow, error) {
+	rows, err := q.db.Query(ctx, reportCallsByHour,
+		arg.Timezone,
+		arg.FromTime,
+		arg.ToTime,
+		arg.BookID,
+		arg.UserID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ReportCallsByHourRow{}
+	for rows.Next() {
+		var i ReportCallsByHourRow
+		if err := rows.Scan(
+			&i.Hour,
+			&i.Calls,
+			&i.Effective,
+			&i.Ng,
+			&i.Customers,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const reportCallsByUser = `-- name: ReportCallsByUser :many
+SELECT
+  u.id AS user_id,
+  u.name AS user_name,
+  count(*) AS calls,
+  count(*) FILTER (WHERE s.effective) AS effective,
+  count(*) FILTER (WHERE s.ng) AS ng,
+  count(DISTINCT ca.customer_id) AS customers
+FROM "Call" ca
+JOIN "Customer" c ON c.id = ca.customer_id AND c.deleted_at IS NULL AND book_in_organization(c.book_id)
+JOIN "User" u ON u.id = ca.user_id
+LEFT JOIN "Status" s ON s.id = ca.status_id
+WHERE ca.created_at >= $1
+AND ca.created_at < $2
+AND ($3::uuid IS NULL OR c.book_id = $3)
+AND ($4::uuid IS NULL OR ca.user_id = $4)
+GROUP BY u.id, u.name
+ORDER BY calls DESC, u.name
+`
+
+type ReportCallsByUserParams struct {
+	FromTime time.Time   `json:"from_time"`
+	ToTime   time.Time   `json:"to_time"`
+	BookID   pgtype.UUID `json:"book_id"`
+	UserID   pgtype.UUID `json:"user_id"`
+}
+
+type ReportCallsByUserRow struct {
+	UserID    uuid.UUID `json:"user_id"`
+	UserName  string    `json:"user_name"`
+	Calls     int64     `json:"calls"`
+	Effective int64     `json:"effective"`
+	Ng        int64     `json:"ng"`
+	Customers int64     `json:"customers"`
+}
+
+func (q *Queries) ReportCallsByUser(ctx context.Context, arg ReportCallsByUserParams) ([]ReportCallsByUserRow, error) {
+	rows, err := q.db.Query(ctx, reportCallsByUser,
+		arg.FromTime,
+		arg.ToTime,
+		arg.BookID,
+		arg.UserID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ReportCallsByUserRow{}
+	for rows.Next() {
+		var i ReportCallsByUserRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.UserName,
+			&i.Calls,
+			&i.Effective,
+			&i.Ng,
+			&i.Customers,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const reportCallsTotal = `-- name: ReportCallsTotal :one
+SELECT
+  count(*) AS calls,
+  count(*) FILTER (WHERE s.effective) AS effective,
+  count(*) FILTER (WHERE s.ng) AS ng,
+  count(DISTINCT ca.customer_id) AS customers
+FROM "Call" ca
+JOIN "Customer" c ON c.id = ca.customer_id AND c.deleted_at IS NULL AND book_in_organization(c.book_id)
+LEFT JOIN "Status" s ON s.id = ca.status_id
+WHERE ca.created_at >= $1
+AND ca.created_at < $2
+AND ($3::uuid IS NULL OR c.book_id = $3)
+AND ($4::uuid IS NULL OR ca.user_id = $4)
+`
+
+type ReportCallsTotalParams struct {
+	FromTime time.Time   `json:"from_time"`
+	ToTime   time.Time   `json:"to_time"`
+	BookID   pgtype.UUID `json:"book_id"`
+	UserID   pgtype.UUID `json:"user_id"`
+}
+
+type ReportCallsTotalRow struct {
+	Calls     int64 `json:"calls"`
+	Effective int64 `json:"effective"`
+	Ng        int64 `json:"ng"`
+	Customers int64 `json:"customers"`
+}
+
+func (q *Queries) ReportCallsTotal(ctx context.Context, arg ReportCallsTotalParams) (ReportCallsTotalRow, error) {
+	row := q.db.QueryRow(ctx, reportCallsTotal,
+		arg.FromTime,
+		arg.ToTime,
+		arg.BookID,
+		arg.UserID,
+	)
+	var i ReportCallsTotalRow
+	err := row.Scan(
+		&i.Calls,
+		&i.Effective,
+		&i.Ng,
+		&i.Customers,
+	)
+	return i, err
+}
+
+const reportLeaderboard = `-- name: ReportLeaderboard :many
+WITH call_counts AS (
+  SELECT
+    ca.user_id,
+    count(*) AS calls,
+    count(*) FILTER (WHERE s.effective) AS effective,
+    count(*) FILTER (WHERE s.ng) AS ng
+  FROM "Call" ca
+  JOIN "Customer" c ON c.id = ca.customer_id AND c.deleted_at IS NULL AND book_in_organization(c.book_id)
+  LEFT JOIN "Status" s ON s.id = ca.status_id
+  WHERE ca.created_at >= $1
+  AND ca.created_at < $2
+  AND ($3::uuid IS NULL OR c.book_id = $3)
+  GROUP BY ca.user_id
+), redial_counts AS (
+  -- Redial は予約し直すと上書きされるため、予約履歴から数える
+  SELECT
+    rb.booked_by AS user_id,
+    count(*) AS redials
+  FROM "RedialBooking" rb
+  JOIN "Customer" c ON c.id = rb.customer_id AND c.deleted_at IS NULL AND book_in_organization(c.book_id)
+  WHERE rb.booked_at >= $1
+  AND rb.booked_at < $2
+  AND ($3::uuid IS NULL OR c.book_id = $3)
+  GROUP BY rb.booked_by
+)
+SELECT
+  u.id AS user_id,
+  u.name AS user_name,
+  COALESCE(cc.calls, 0)::bigint AS calls,
+  COALESCE(cc.effective, 0)::bigint AS effective,
+  COALESCE(cc.ng, 0)::bigint AS ng,
+  COALESCE(rc.redials, 0)::bigint AS redials
+FROM "User" u
+LEFT JOIN call_counts cc ON cc.user_id = u.id
+LEFT JOIN redial_counts rc ON rc.user_id = u.id
+WHERE u.organization_id = current_organization_id()
+ORDER BY calls DESC, u.name
+`
+
+type ReportLeaderboardParams struct {
+	FromTime time.Time   `json:"from_time"`
+	ToTime   time.Time   `json:"to_time"`
+	BookID   pgtype.UUID `json:"book_id"`
+}
+
+type ReportLeaderboardRow struct {
+	UserID    uuid.UUID `json:"user_id"`
+	UserName  string    `json:"user_name"`
+	Calls     int64     `json:"calls"`
+	Effective int64     `json:"effective"`
+	Ng        int64     `json:"ng"`
+	Redials   int64     `json:"redials"`
+}
+
+func (q *Queries) ReportLeaderboard(ctx context.Context, arg ReportLeaderboardParams) ([]ReportLeaderboardRow, error) {
+	rows, err := q.db.Query(ctx, reportLeaderboard, arg.FromTime, arg.ToTime, arg.BookID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ReportLeaderboardRow{}
+	for rows.Next() {
+		var i ReportLeaderboardRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.UserName,
+			&i.Calls,
+			&i.Effective,
+			&i.Ng,
+			&i.Redials,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
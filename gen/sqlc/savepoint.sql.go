@@ -0,0 +1,38 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: savepoint.sql
+
+package db
+
+import (
+	"context"
+)
+
+const releaseBatchItem = `-- name: ReleaseBatchItem :exec
+RELEASE SAVEPOINT batch_item
+`
+
+func (q *Queries) ReleaseBatchItem(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, releaseBatchItem)
+	return err
+}
+
+const rollbackToBatchItem = `-- name: RollbackToBatchItem :exec
+ROLLBACK TO SAVEPOINT batch_item
+`
+
+func (q *Queries) RollbackToBatchItem(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, rollbackToBatchItem)
+	return err
+}
+
+const savepointBatchItem = `-- name: SavepointBatchItem :exec
+SAVEPOINT batch_item
+`
+
+// 一括操作で1件の失敗がトランザクション全体を中断しないよう、項目ごとにセーブポイントを作る
+func (q *Queries) SavepointBatchItem(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, savepointBatchItem)
+	return err
+}
@@ -15,7 +15,7 @@ import (
 const createStaff = `-- name: CreateStaff :one
 INSERT INTO "Staff" (id, name, sex)
 VALUES ($1, $2, $3)
-RETURNING id, name, sex, created_at
+RETURNING id, name, sex, created_at, version, organization_id
 `
 
 type CreateStaffParams struct {
@@ -32,13 +32,15 @@ func (q *Queries) CreateStaff(ctx context.Context, arg CreateStaffParams) (Staff
 		&i.Name,
 		&i.Sex,
 		&i.CreatedAt,
+		&i.Version,
+		&i.OrganizationID,
 	)
 	return i, err
 }
 
 const deleteStaff = `-- name: DeleteStaff :exec
 DELETE FROM "Staff"
-WHERE id = $1
+WHERE id = $1 AND organization_id = current_organization_id()
 `
 
 func (q *Queries) DeleteStaff(ctx context.Context, id uuid.UUID) error {
@@ -47,8 +49,8 @@ func (q *Queries) DeleteStaff(ctx context.Context, id uuid.UUID) error {
 }
 
 const getStaff = `-- name: GetStaff :one
-SELECT id, name, sex, created_at FROM "Staff"
-WHERE id = $1 LIMIT 1
+SELECT id, name, sex, created_at, version, organization_id FROM "Staff"
+WHERE id = $1 AND organization_id = current_organization_id() LIMIT 1
 `
 
 func (q *Queries) GetStaff(ctx context.Context, id uuid.UUID) (Staff, error) {
@@ -59,6 +61,8 @@ func (q *Queries) GetStaff(ctx context.Context, id uuid.UUID) (Staff, error) {
 		&i.Name,
 		&i.Sex,
 		&i.CreatedAt,
+		&i.Version,
+		&i.OrganizationID,
 	)
 	return i, err
 }
@@ -68,24 +72,33 @@ UPDATE "Staff"
 SET 
   name = COALESCE($1, name),
   sex = COALESCE($2, sex)
-WHERE id = $3
-RETURNING id, name, sex, created_at
+WHERE id = $3 AND organization_id = current_organization_id()
+AND ($4::bigint IS NULL OR version = $4)
+RETURNING id, name, sex, created_at, version, organization_id
 `
 
 type UpdateStaffParams struct {
-	Name pgtype.Text `json:"name"`
-	Sex  pgtype.Text `json:"sex"`
-	ID   uuid.UUID   `json:"id"`
+	Name    pgtype.Text `json:"name"`
+	Sex     pgtype.Text `json:"sex"`
+	ID      uuid.UUID   `json:"id"`
+	Version pgtype.Int8 `json:"version"`
 }
 
 func (q *Queries) UpdateStaff(ctx context.Context, arg UpdateStaffParams) (Staff, error) {
-	row := q.db.QueryRow(ctx, updateStaff, arg.Name, arg.Sex, arg.ID)
+	row := q.db.QueryRow(ctx, updateStaff,
+		arg.Name,
+		arg.Sex,
+		arg.ID,
+		arg.Version,
+	)
 	var i Staff
 	err := row.Scan(
 		&i.ID,
 		&i.Name,
 		&i.Sex,
 		&i.CreatedAt,
+		&i.Version,
+		&i.OrganizationID,
 	)
 	return i, err
 }
@@ -13,9 +13,9 @@ import (
 )
 
 const createStatus = `-- name: CreateStatus :one
-INSERT INTO "Status" (id, name, effective, ng)
-VALUES ($1, $2, $3, $4)
-RETURNING id, name, effective, ng, created_at
+INSERT INTO "Status" (id, name, effective, ng, dnc)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, name, effective, ng, created_at, dnc, organization_id
 `
 
 type CreateStatusParams struct {
@@ -23,6 +23,7 @@ type CreateStatusParams struct {
 	Name      string      `json:"name"`
 	Effective pgtype.Bool `json:"effective"`
 	Ng        pgtype.Bool `json:"ng"`
+	Dnc       bool        `json:"dnc"`
 }
 
 func (q *Queries) CreateStatus(ctx context.Context, arg CreateStatusParams) (Status, error) {
@@ -31,6 +32,7 @@ func (q *Queries) CreateStatus(ctx context.Context, arg CreateStatusParams) (Sta
 		arg.Name,
 		arg.Effective,
 		arg.Ng,
+		arg.Dnc,
 	)
 	var i Status
 	err := row.Scan(
@@ -39,13 +41,15 @@ func (q *Queries) CreateStatus(ctx context.Context, arg CreateStatusParams) (Sta
 		&i.Effective,
 		&i.Ng,
 		&i.CreatedAt,
+		&i.Dnc,
+		&i.OrganizationID,
 	)
 	return i, err
 }
 
 const deleteStatus = `-- name: DeleteStatus :exec
 DELETE FROM "Status"
-WHERE id = $1
+WHERE id = $1 AND organization_id = current_organization_id()
 `
 
 func (q *Queries) DeleteStatus(ctx context.Context, id uuid.UUID) error {
@@ -54,8 +58,8 @@ func (q *Queries) DeleteStatus(ctx context.Context, id uuid.UUID) error {
 }
 
 const getStatus = `-- name: GetStatus :one
-SELECT id, name, effective, ng, created_at FROM "Status"
-WHERE id = $1 LIMIT 1
+SELECT id, name, effective, ng, created_at, dnc, organization_id FROM "Status"
+WHERE id = $1 AND organization_id = current_organization_id() LIMIT 1
 `
 
 func (q *Queries) GetStatus(ctx context.Context, id uuid.UUID) (Status, error) {
@@ -67,6 +71,8 @@ func (q *Queries) GetStatus(ctx context.Context, id uuid.UUID) (Status, error) {
 		&i.Effective,
 		&i.Ng,
 		&i.CreatedAt,
+		&i.Dnc,
+		&i.OrganizationID,
 	)
 	return i, err
 }
@@ -76,15 +82,17 @@ UPDATE "Status"
 SET 
   name = COALESCE($1, name),
   effective = COALESCE($2, effective),
-  ng = COALESCE($3, ng)
-WHERE id = $4
-RETURNING id, name, effective, ng, created_at
+  ng = COALESCE($3, ng),
+  dnc = COALESCE($4, dnc)
+WHERE id = $5 AND organization_id = current_organization_id()
+RETURNING id, name, effective, ng, created_at, dnc, organization_id
 `
 
 type UpdateStatusParams struct {
 	Name      pgtype.Text `json:"name"`
 	Effective pgtype.Bool `json:"effective"`
 	Ng        pgtype.Bool `json:"ng"`
+	Dnc       pgtype.Bool `json:"dnc"`
 	ID        uuid.UUID   `json:"id"`
 }
 
@@ -93,6 +101,7 @@ func (q *Queries) UpdateStatus(ctx context.Context, arg UpdateStatusParams) (Sta
 		arg.Name,
 		arg.Effective,
 		arg.Ng,
+		arg.Dnc,
 		arg.ID,
 	)
 	var i Status
@@ -102,6 +111,8 @@ func (q *Queries) UpdateStatus(ctx context.Context, arg UpdateStatusParams) (Sta
 		&i.Effective,
 		&i.Ng,
 		&i.CreatedAt,
+		&i.Dnc,
+		&i.OrganizationID,
 	)
 	return i, err
 }
@@ -0,0 +1,253 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: tag.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const addCustomerTags = `-- name: AddCustomerTags :execrows
+INSERT INTO "CustomerTag" (customer_id, tag_id, user_id)
+SELECT c.id, t.id, $1::uuid
+FROM "Customer" c
+JOIN "Tag" t ON t.book_id = c.book_id
+WHERE c.id = ANY ($2::uuid[])
+AND book_in_organization(c.book_id)
+AND c.deleted_at IS NULL
+AND t.id = ANY ($3::uuid[])
+ON CONFLICT (customer_id, tag_id) DO NOTHING
+`
+
+type AddCustomerTagsParams struct {
+	UserID      pgtype.UUID `json:"user_id"`
+	CustomerIds []uuid.UUID `json:"customer_ids"`
+	TagIds      []uuid.UUID `json:"tag_ids"`
+}
+
+// 同じリストの顧客にのみタグを付ける。付与済みの組み合わせは無視する
+func (q *Queries) AddCustomerTags(ctx context.Context, arg AddCustomerTagsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, addCustomerTags, arg.UserID, arg.CustomerIds, arg.TagIds)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const clearCustomerTags = `-- name: ClearCustomerTags :exec
+DELETE FROM "CustomerTag"
+WHERE customer_id = $1 AND customer_in_organization(customer_id)
+`
+
+func (q *Queries) ClearCustomerTags(ctx context.Context, customerID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, clearCustomerTags, customerID)
+	return err
+}
+
+const deleteTag = `-- name: DeleteTag :exec
+DELETE FROM "Tag"
+WHERE id = $1 AND book_in_organization(book_id)
+`
+
+func (q *Queries) DeleteTag(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteTag, id)
+	return err
+}
+
+const getTag = `-- name: GetTag :one
+SELECT id, book_id, name, created_at FROM "Tag"
+WHERE id = $1 AND book_in_organization(book_id) LIMIT 1
+`
+
+func (q *Queries) GetTag(ctx context.Context, id uuid.UUID) (Tag, error) {
+	row := q.db.QueryRow(ctx, getTag, id)
+	var i Tag
+	err := row.Scan(
+		&i.ID,
+		&i.BookID,
+		&i.Name,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listCustomerTagNames = `-- name: ListCustomerTagNames :many
+SELECT ct.customer_id, t.name FROM "CustomerTag" ct
+JOIN "Tag" t ON t.id = ct.tag_id
+WHERE ct.customer_id = ANY ($1::uuid[])
+AND customer_in_organization(ct.customer_id)
+ORDER BY t.name
+`
+
+type ListCustomerTagNamesRow struct {
+	CustomerID uuid.UUID `json:"customer_id"`
+	Name       string    `json:"name"`
+}
+
+func (q *Queries) ListCustomerTagNames(ctx context.Context, customerIds []uuid.UUID) ([]ListCustomerTagNamesRow, error) {
+	rows, err := q.db.Query(ctx, listCustomerTagNames, customerIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListCustomerTagNamesRow{}
+	for rows.Next() {
+		var i ListCustomerTagNamesRow
+		if err := rows.Scan(&i.CustomerID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTagsByName = `-- name: ListTagsByName :many
+SELECT id, book_id, name, created_at FROM "Tag"
+WHERE book_id = $1 AND book_in_organization(book_id)
+AND name = ANY ($2::varchar[])
+`
+
+type ListTagsByNameParams struct {
+	BookID uuid.UUID `json:"book_id"`
+	Names  []string  `json:"names"`
+}
+
+func (q *Queries) ListTagsByName(ctx context.Context, arg ListTagsByNameParams) ([]Tag, error) {
+	rows, err := q.db.Query(ctx, listTagsByName, arg.BookID, arg.Names)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Tag{}
+	for rows.Next() {
+		var i Tag
+		if err := rows.Scan(
+			&i.ID,
+			&i.BookID,
+			&i.Name,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTagsWithUsage = `-- name: ListTagsWithUsage :many
+SELECT
+  t.id, t.book_id, t.name, t.created_at,
+  count(c.id) AS customers
+FROM "Tag" t
+LEFT JOIN "CustomerTag" ct ON ct.tag_id = t.id
+LEFT JOIN "Customer" c ON c.id = ct.customer_id AND c.deleted_at IS NULL
+WHERE t.book_id = $1 AND book_in_organization(t.book_id)
+GROUP BY t.id
+ORDER BY t.name
+`
+
+type ListTagsWithUsageRow struct {
+	ID        uuid.UUID `json:"id"`
+	BookID    uuid.UUID `json:"book_id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	Customers int64     `json:"customers"`
+}
+
+func (q *Queries) ListTagsWithUsage(ctx context.Context, bookID uuid.UUID) ([]ListTagsWithUsageRow, error) {
+	rows, err := q.db.Query(ctx, listTagsWithUsage, bookID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListTagsWithUsageRow{}
+	for rows.Next() {
+		var i ListTagsWithUsageRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.BookID,
+			&i.Name,
+			&i.CreatedAt,
+			&i.Customers,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const removeCustomerTags = `-- name: RemoveCustomerTags :execrows
+DELETE FROM "CustomerTag"
+WHERE customer_id = ANY ($1::uuid[])
+AND customer_in_organization(customer_id)
+AND tag_id = ANY ($2::uuid[])
+`
+
+type RemoveCustomerTagsParams struct {
+	CustomerIds []uuid.UUID `json:"customer_ids"`
+	TagIds      []uuid.UUID `json:"tag_ids"`
+}
+
+func (q *Queries) RemoveCustomerTags(ctx context.Context, arg RemoveCustomerTagsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, removeCustomerTags, arg.CustomerIds, arg.TagIds)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const upsertTags = `-- name: UpsertTags :many
+INSERT INTO "Tag" (id, book_id, name)
+SELECT gen_random_uuid(), $1::uuid, unnest($2::varchar[])
+WHERE book_in_organization($1)
+ON CONFLICT (book_id, name) DO UPDATE
+SET name = EXCLUDED.name
+RETURNING id, book_id, name, created_at
+`
+
+type UpsertTagsParams struct {
+	BookID uuid.UUID `json:"book_id"`
+	Names  []string  `json:"names"`
+}
+
+// 名前で指定したタグを作成し、既存のタグはそのまま返す
+func (q *Queries) UpsertTags(ctx context.Context, arg UpsertTagsParams) ([]Tag, error) {
+	rows, err := q.db.Query(ctx, upsertTags, arg.BookID, arg.Names)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Tag{}
+	for rows.Next() {
+		var i Tag
+		if err := rows.Scan(
+			&i.ID,
+			&i.BookID,
+			&i.Name,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
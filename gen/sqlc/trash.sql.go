@@ -0,0 +1,83 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: trash.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const listTrash = `-- name: ListTrash :many
+SELECT
+  'book'::text AS entity_type,
+  b.id,
+  b.name,
+  NULL::uuid AS book_id,
+  b.deleted_at::timestamptz AS deleted_at
+FROM "Book" b
+WHERE b.organization_id = current_organization_id()
+AND b.deleted_at IS NOT NULL
+AND ($3::text IS NULL OR $3::text = 'book')
+UNION ALL
+SELECT
+  'customer'::text AS entity_type,
+  c.id,
+  c.name,
+  c.book_id,
+  c.deleted_at::timestamptz AS deleted_at
+FROM "Customer" c
+JOIN "Book" b ON b.id = c.book_id
+WHERE b.organization_id = current_organization_id()
+AND c.deleted_at IS NOT NULL
+AND b.deleted_at IS DISTINCT FROM c.deleted_at
+AND ($3::text IS NULL OR $3::text = 'customer')
+ORDER BY deleted_at DESC, id
+LIMIT $2 OFFSET $1
+`
+
+type ListTrashParams struct {
+	RowOffset  int32       `json:"row_offset"`
+	RowLimit   int32       `json:"row_limit"`
+	EntityType pgtype.Text `json:"entity_type"`
+}
+
+type ListTrashRow struct {
+	EntityType string      `json:"entity_type"`
+	ID         uuid.UUID   `json:"id"`
+	Name       string      `json:"name"`
+	BookID     pgtype.UUID `json:"book_id"`
+	DeletedAt  time.Time   `json:"deleted_at"`
+}
+
+// ゴミ箱の一覧。リストと一緒に削除された顧客はリスト側にまとめて表示する
+func (q *Queries) ListTrash(ctx context.Context, arg ListTrashParams) ([]ListTrashRow, error) {
+	rows, err := q.db.Query(ctx, listTrash, arg.RowOffset, arg.RowLimit, arg.EntityType)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListTrashRow{}
+	for rows.Next() {
+		var i ListTrashRow
+		if err := rows.Scan(
+			&i.EntityType,
+			&i.ID,
+			&i.Name,
+			&i.BookID,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -12,10 +12,30 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const authenticateUser = `-- name: AuthenticateUser :one
+SELECT id, email, name, role, created_at, organization_id FROM "User"
+WHERE id = $1 LIMIT 1
+`
+
+// リクエストの組織を決めるため、組織を限定せずに取得する。認証以外では GetUser を使う
+func (q *Queries) AuthenticateUser(ctx context.Context, id uuid.UUID) (User, error) {
+	row := q.db.QueryRow(ctx, authenticateUser, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Name,
+		&i.Role,
+		&i.CreatedAt,
+		&i.OrganizationID,
+	)
+	return i, err
+}
+
 const createUser = `-- name: CreateUser :one
 INSERT INTO "User" (id, email, name, role)
 VALUES ($1, $2, $3, $4)
-RETURNING id, email, name, role, created_at
+RETURNING id, email, name, role, created_at, organization_id
 `
 
 type CreateUserParams struct {
@@ -39,13 +59,14 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.Name,
 		&i.Role,
 		&i.CreatedAt,
+		&i.OrganizationID,
 	)
 	return i, err
 }
 
 const deleteUser = `-- name: DeleteUser :exec
 DELETE FROM "User"
-WHERE id = $1
+WHERE id = $1 AND organization_id = current_organization_id()
 `
 
 func (q *Queries) DeleteUser(ctx context.Context, id uuid.UUID) error {
@@ -54,8 +75,8 @@ func (q *Queries) DeleteUser(ctx context.Context, id uuid.UUID) error {
 }
 
 const getUser = `-- name: GetUser :one
-SELECT id, email, name, role, created_at FROM "User"
-WHERE id = $1 LIMIT 1
+SELECT id, email, name, role, created_at, organization_id FROM "User"
+WHERE id = $1 AND organization_id = current_organization_id() LIMIT 1
 `
 
 func (q *Queries) GetUser(ctx context.Context, id uuid.UUID) (User, error) {
@@ -67,6 +88,7 @@ func (q *Queries) GetUser(ctx context.Context, id uuid.UUID) (User, error) {
 		&i.Name,
 		&i.Role,
 		&i.CreatedAt,
+		&i.OrganizationID,
 	)
 	return i, err
 }
@@ -77,8 +99,8 @@ SET
   email = COALESCE($1, email),
   name = COALESCE($2, name),
   role = COALESCE($3, role)
-WHERE id = $4
-RETURNING id, email, name, role, created_at
+WHERE id = $4 AND organization_id = current_organization_id()
+RETURNING id, email, name, role, created_at, organization_id
 `
 
 type UpdateUserParams struct {
@@ -102,6 +124,7 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 		&i.Name,
 		&i.Role,
 		&i.CreatedAt,
+		&i.OrganizationID,
 	)
 	return i, err
 }
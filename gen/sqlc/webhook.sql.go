@@ -0,0 +1,509 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: webhook.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const claimWebhookDeliveries = `-- name: ClaimWebhookDeliveries :many
+UPDATE "WebhookDelivery" d
+SET
+  attempts = d.attempts + 1,
+  locked_until = $1::timestamptz
+FROM "Webhook" w, "OutboxEvent" e
+WHERE d.id IN (
+  SELECT p.id FROM "WebhookDelivery" p
+  WHERE p.status = 'pending'
+  AND p.next_attempt_at <= now()
+  AND (p.locked_until IS NULL OR p.locked_until < now())
+  ORDER BY p.next_attempt_at
+  LIMIT $2
+  FOR UPDATE SKIP LOCKED
+)
+AND w.id = d.webhook_id
+AND e.id = d.event_id
+RETURNING
+  d.id,
+  d.attempts,
+  w.url,
+  w.secret,
+  e.id AS event_id,
+  e.event_type,
+  e.payload,
+  e.created_at AS event_created_at
+`
+
+type ClaimWebhookDeliveriesParams struct {
+	LockedUntil time.Time `json:"locked_until"`
+	RowLimit    int32     `json:"row_limit"`
+}
+
+type ClaimWebhookDeliveriesRow struct {
+	ID             uuid.UUID `json:"id"`
+	Attempts       int32     `json:"attempts"`
+	Url            string    `json:"url"`
+	Secret         string    `json:"secret"`
+	EventID        uuid.UUID `json:"event_id"`
+	EventType      string    `json:"event_type"`
+	Payload        []byte    `json:"payload"`
+	EventCreatedAt time.Time `json:"event_created_at"`
+}
+
+// 送信時刻を過ぎた送信をロックして送信先とイベントと一緒に返す
+func (q *Queries) ClaimWebhookDeliveries(ctx context.Context, arg ClaimWebhookDeliveriesParams) ([]ClaimWebhookDeliveriesRow, error) {
+	rows, err := q.db.Query(ctx, claimWebhookDeliveries, arg.LockedUntil, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ClaimWebhookDeliveriesRow{}
+	for rows.Next() {
+		var i ClaimWebhookDeliveriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Attempts,
+			&i.Url,
+			&i.Secret,
+			&i.EventID,
+			&i.EventType,
+			&i.Payload,
+			&i.EventCreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createWebhook = `-- name: CreateWebhook :one
+INSERT INTO "Webhook" (id, url, secret, event_types, description, user_id)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, url, secret, event_types, description, active, user_id, created_at, updated_at, organization_id
+`
+
+type CreateWebhookParams struct {
+	ID          uuid.UUID   `json:"id"`
+	Url         string      `json:"url"`
+	Secret      string      `json:"secret"`
+	EventTypes  []string    `json:"event_types"`
+	Description pgtype.Text `json:"description"`
+	UserID      pgtype.UUID `json:"user_id"`
+}
+
+func (q *Queries) CreateWebhook(ctx context.Context, arg CreateWebhookParams) (Webhook, error) {
+	row := q.db.QueryRow(ctx, createWebhook,
+		arg.ID,
+		arg.Url,
+		arg.Secret,
+		arg.EventTypes,
+		arg.Description,
+		arg.UserID,
+	)
+	var i Webhook
+	err := row.Scan(
+		&i.ID,
+		&i.Url,
+		&i.Secret,
+		&i.EventTypes,
+		&i.Description,
+		&i.Active,
+		&i.UserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.OrganizationID,
+	)
+	return i, err
+}
+
+const deleteWebhook = `-- name: DeleteWebhook :execrows
+DELETE FROM "Webhook"
+WHERE id = $1 AND organization_id = current_organization_id()
+`
+
+func (q *Queries) DeleteWebhook(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteWebhook, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const fanOutOutboxEvents = `-- name: FanOutOutboxEvents :execrows
+WITH events AS (
+  SELECT o.id, o.event_type, o.organization_id FROM "OutboxEvent" o
+  WHERE o.dispatched_at IS NULL
+  ORDER BY o.created_at
+  LIMIT $1
+  FOR UPDATE SKIP LOCKED
+), deliveries AS (
+  INSERT INTO "WebhookDelivery" (webhook_id, event_id)
+  SELECT w.id, e.id
+  FROM events e
+  JOIN "Webhook" w ON w.organization_id = e.organization_id
+  AND w.active AND (cardinality(w.event_types) = 0 OR e.event_type = ANY (w.event_types))
+  ON CONFLICT (webhook_id, event_id) DO NOTHING
+)
+UPDATE "OutboxEvent" o
+SET dispatched_at = now()
+FROM events e
+WHERE o.id = e.id
+`
+
+// 全組織の未処理のイベントを、同じ組織で購読している Webhook ごとの送信に振り分ける
+func (q *Queries) FanOutOutboxEvents(ctx context.Context, rowLimit int32) (int64, error) {
+	result, err := q.db.Exec(ctx, fanOutOutboxEvents, rowLimit)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getWebhook = `-- name: GetWebhook :one
+SELECT id, url, secret, event_types, description, active, user_id, created_at, updated_at, organization_id FROM "Webhook"
+WHERE id = $1 AND organization_id = current_organization_id() LIMIT 1
+`
+
+func (q *Queries) GetWebhook(ctx context.Context, id uuid.UUID) (Webhook, error) {
+	row := q.db.QueryRow(ctx, getWebhook, id)
+	var i Webhook
+	err := row.Scan(
+		&i.ID,
+		&i.Url,
+		&i.Secret,
+		&i.EventTypes,
+		&i.Description,
+		&i.Active,
+		&i.UserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.OrganizationID,
+	)
+	return i, err
+}
+
+const listWebhookDeliveries = `-- name: ListWebhookDeliveries :many
+SELECT
+  d.id, d.webhook_id, d.event_id, d.status, d.attempts, d.next_attempt_at, d.locked_until, d.last_status_code, d.last_error, d.created_at, d.delivered_at,
+  e.event_type,
+  e.payload
+FROM "WebhookDelivery" d
+JOIN "OutboxEvent" e ON e.id = d.event_id
+WHERE e.organization_id = current_organization_id()
+AND ($1::uuid IS NULL OR d.webhook_id = $1)
+AND ($2::webhook_delivery_status IS NULL OR d.status = $2)
+ORDER BY d.created_at DESC, d.id
+LIMIT $4 OFFSET $3
+`
+
+type ListWebhookDeliveriesParams struct {
+	WebhookID pgtype.UUID               `json:"webhook_id"`
+	Status    NullWebhookDeliveryStatus `json:"status"`
+	RowOffset int32                     `json:"row_offset"`
+	RowLimit  int32                     `json:"row_limit"`
+}
+
+type ListWebhookDeliveriesRow struct {
+	ID             uuid.UUID             `json:"id"`
+	WebhookID      uuid.UUID             `json:"webhook_id"`
+	EventID        uuid.UUID             `json:"event_id"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	Attempts       int32                 `json:"attempts"`
+	NextAttemptAt  time.Time             `json:"next_attempt_at"`
+	LockedUntil    pgtype.Timestamptz    `json:"locked_until"`
+	LastStatusCode pgtype.Int4           `json:"last_status_code"`
+	LastError      pgtype.Text           `json:"last_error"`
+	CreatedAt      time.Time             `json:"created_at"`
+	DeliveredAt    pgtype.Timestamptz    `json:"delivered_at"`
+	EventType      string                `json:"event_type"`
+	Payload        []byte                `json:"payload"`
+}
+
+func (q *Queries) ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]ListWebhookDeliveriesRow, error) {
+	rows, err := q.db.Query(ctx, listWebhookDeliveries,
+		arg.WebhookID,
+		arg.Status,
+		arg.RowOffset,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListWebhookDeliveriesRow{}
+	for rows.Next() {
+		var i ListWebhookDeliveriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.WebhookID,
+			&i.EventID,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.LockedUntil,
+			&i.LastStatusCode,
+			&i.LastError,
+			&i.CreatedAt,
+			&i.DeliveredAt,
+			&i.EventType,
+			&i.Payload,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhooks = `-- name: ListWebhooks :many
+SELECT id, url, secret, event_types, description, active, user_id, created_at, updated_at, organization_id FROM "Webhook"
+WHERE organization_id = current_organization_id()
+ORDER BY created_at, id
+`
+
+func (q *Queries) ListWebhooks(ctx context.Context) ([]Webhook, error) {
+	rows, err := q.db.Query(ctx, listWebhooks)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Webhook{}
+	for rows.Next() {
+		var i Webhook
+		if err := rows.Scan(
+			&i.ID,
+			&i.Url,
+			&i.Secret,
+			&i.EventTypes,
+			&i.Description,
+			&i.Active,
+			&i.UserID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.OrganizationID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markWebhookDelivered = `-- name: MarkWebhookDelivered :exec
+UPDATE "WebhookDelivery"
+SET
+  status = 'succeeded',
+  locked_until = NULL,
+  last_status_code = $1::int,
+  last_error = NULL,
+  delivered_at = now()
+WHERE id = $2
+`
+
+type MarkWebhookDeliveredParams struct {
+	StatusCode int32     `json:"status_code"`
+	ID         uuid.UUID `json:"id"`
+}
+
+func (q *Queries) MarkWebhookDelivered(ctx context.Context, arg MarkWebhookDeliveredParams) error {
+	_, err := q.db.Exec(ctx, markWebhookDelivered, arg.StatusCode, arg.ID)
+	return err
+}
+
+const markWebhookDeliveryFailed = `-- name: MarkWebhookDeliveryFailed :exec
+UPDATE "WebhookDelivery"
+SET
+  status = CASE
+    WHEN attempts >= $1::int THEN 'dead'::webhook_delivery_status
+    ELSE 'pending'::webhook_delivery_status
+  END,
+  locked_until = NULL,
+  next_attempt_at = $2::timestamptz,
+  last_status_code = $3::int,
+  last_error = $4::text
+WHERE id = $5
+`
+
+type MarkWebhookDeliveryFailedParams struct {
+	MaxAttempts   int32       `json:"max_attempts"`
+	NextAttemptAt time.Time   `json:"next_attempt_at"`
+	StatusCode    pgtype.Int4 `json:"status_code"`
+	Error         string      `json:"error"`
+	ID            uuid.UUID   `json:"id"`
+}
+
+// 試行回数が上限に達した場合は dead にする
+func (q *Queries) MarkWebhookDeliveryFailed(ctx context.Context, arg MarkWebhookDeliveryFailedParams) error {
+	_, err := q.db.Exec(ctx, markWebhookDeliveryFailed,
+		arg.MaxAttempts,
+		arg.NextAttemptAt,
+		arg.StatusCode,
+		arg.Error,
+		arg.ID,
+	)
+	return err
+}
+
+const replayDeadWebhookDeliveries = `-- name: ReplayDeadWebhookDeliveries :execrows
+UPDATE "WebhookDelivery"
+SET
+  status = 'pending',
+  attempts = 0,
+  next_attempt_at = now(),
+  locked_until = NULL
+WHERE webhook_id = $1 AND status = 'dead'
+AND webhook_id IN (SELECT w.id FROM "Webhook" w WHERE w.organization_id = current_organization_id())
+`
+
+func (q *Queries) ReplayDeadWebhookDeliveries(ctx context.Context, webhookID uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, replayDeadWebhookDeliveries, webhookID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const replayWebhookDelivery = `-- name: ReplayWebhookDelivery :one
+UPDATE "WebhookDelivery" d
+SET
+  status = 'pending',
+  attempts = 0,
+  next_attempt_at = now(),
+  locked_until = NULL
+FROM "OutboxEvent" e
+WHERE d.id = $1 AND e.id = d.event_id
+AND e.organization_id = current_organization_id()
+RETURNING
+  d.id, d.webhook_id, d.event_id, d.status, d.attempts, d.next_attempt_at, d.locked_until, d.last_status_code, d.last_error, d.created_at, d.delivered_at,
+  e.event_type,
+  e.payload
+`
+
+type ReplayWebhookDeliveryRow struct {
+	ID             uuid.UUID             `json:"id"`
+	WebhookID      uuid.UUID             `json:"webhook_id"`
+	EventID        uuid.UUID             `json:"event_id"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	Attempts       int32                 `json:"attempts"`
+	NextAttemptAt  time.Time             `json:"next_attempt_at"`
+	LockedUntil    pgtype.Timestamptz    `json:"locked_until"`
+	LastStatusCode pgtype.Int4           `json:"last_status_code"`
+	LastError      pgtype.Text           `json:"last_error"`
+	CreatedAt      time.Time             `json:"created_at"`
+	DeliveredAt    pgtype.Timestamptz    `json:"delivered_at"`
+	EventType      string                `json:"event_type"`
+	Payload        []byte                `json:"payload"`
+}
+
+// 送信済みや dead の送信を試行回数を戻して再送する
+func (q *Queries) ReplayWebhookDelivery(ctx context.Context, id uuid.UUID) (ReplayWebhookDeliveryRow, error) {
+	row := q.db.QueryRow(ctx, replayWebhookDelivery, id)
+	var i ReplayWebhookDeliveryRow
+	err := row.Scan(
+		&i.ID,
+		&i.WebhookID,
+		&i.EventID,
+		&i.Status,
+		&i.Attempts,
+		&i.NextAttemptAt,
+		&i.LockedUntil,
+		&i.LastStatusCode,
+		&i.LastError,
+		&i.CreatedAt,
+		&i.DeliveredAt,
+		&i.EventType,
+		&i.Payload,
+	)
+	return i, err
+}
+
+const rotateWebhookSecret = `-- name: RotateWebhookSecret :one
+UPDATE "Webhook"
+SET secret = $2, updated_at = now()
+WHERE id = $1 AND organization_id = current_organization_id()
+RETURNING id, url, secret, event_types, description, active, user_id, created_at, updated_at, organization_id
+`
+
+type RotateWebhookSecretParams struct {
+	ID     uuid.UUID `json:"id"`
+	Secret string    `json:"secret"`
+}
+
+func (q *Queries) RotateWebhookSecret(ctx context.Context, arg RotateWebhookSecretParams) (Webhook, error) {
+	row := q.db.QueryRow(ctx, rotateWebhookSecret, arg.ID, arg.Secret)
+	var i Webhook
+	err := row.Scan(
+		&i.ID,
+		&i.Url,
+		&i.Secret,
+		&i.EventTypes,
+		&i.Description,
+		&i.Active,
+		&i.UserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.OrganizationID,
+	)
+	return i, err
+}
+
+const updateWebhook = `-- name: UpdateWebhook :one
+UPDATE "Webhook"
+SET
+  url = COALESCE($1, url),
+  event_types = COALESCE($2::varchar[], event_types),
+  description = COALESCE($3, description),
+  active = COALESCE($4, active),
+  updated_at = now()
+WHERE id = $5 AND organization_id = current_organization_id()
+RETURNING id, url, secret, event_types, description, active, user_id, created_at, updated_at, organization_id
+`
+
+type UpdateWebhookParams struct {
+	Url         pgtype.Text `json:"url"`
+	EventTypes  []string    `json:"event_types"`
+	Description pgtype.Text `json:"description"`
+	Active      pgtype.Bool `json:"active"`
+	ID          uuid.UUID   `json:"id"`
+}
+
+func (q *Queries) UpdateWebhook(ctx context.Context, arg UpdateWebhookParams) (Webhook, error) {
+	row := q.db.QueryRow(ctx, updateWebhook,
+		arg.Url,
+		arg.EventTypes,
+		arg.Description,
+		arg.Active,
+		arg.ID,
+	)
+	var i Webhook
+	err := row.Scan(
+		&i.ID,
+		&i.Url,
+		&i.Secret,
+		&i.EventTypes,
+		&i.Description,
+		&i.Active,
+		&i.UserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.OrganizationID,
+	)
+	return i, err
+}
@@ -0,0 +1,98 @@
+// Package activity 架電や顧客の変更などのイベントを購読者に配信する
+package activity
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// 購読者ごとのバッファ。溢れた分は破棄する
+const subscriberBuffer = 64
+
+type EventType string
+
+const (
+	EventCallLogged      EventType = "call_logged"
+	EventCustomerCreated EventType = "customer_created"
+	EventCustomerUpdated EventType = "customer_updated"
+	EventRedialDue       EventType = "redial_due"
+)
+
+// Event NOTIFY のペイロードと同じ形式のイベント
+type Event struct {
+	Type              EventType  `json:"type"`
+	BookID            uuid.UUID  `json:"book_id"`
+	CustomerID        uuid.UUID  `json:"customer_id"`
+	UserID            *uuid.UUID `json:"user_id,omitempty"`
+	CallID            *uuid.UUID `json:"call_id,omitempty"`
+	StatusID          *uuid.UUID `json:"status_id,omitempty"`
+	RedialScheduledAt *time.Time `json:"redial_scheduled_at,omitempty"`
+	OccurredAt        time.Time  `json:"occurred_at"`
+}
+
+// Subscription Hub から受け取るイベントのチャネル
+type Subscription struct {
+	C     <-chan Event
+	ch    chan Event
+	books map[uuid.UUID]struct{}
+}
+
+// Hub サーバー内の購読者にイベントを配信する
+type Hub struct {
+	mu          sync.RWMutex
+	subscribers map[*Subscription]struct{}
+}
+
+func NewHub() *Hub {
+	return &Hub{
+		subscribers: make(map[*Subscription]struct{}),
+	}
+}
+
+// Subscribe 指定した顧客リストのイベントを購読する
+func (hub *Hub) Subscribe(bookIDs []uuid.UUID) *Subscription {
+	ch := make(chan Event, subscriberBuffer)
+	sub := &Subscription{
+		C:     ch,
+		ch:    ch,
+		books: make(map[uuid.UUID]struct{}, len(bookIDs)),
+	}
+	for _, id := range bookIDs {
+		sub.books[id] = struct{}{}
+	}
+
+	hub.mu.Lock()
+	hub.subscribers[sub] = struct{}{}
+	hub.mu.Unlock()
+	return sub
+}
+
+// Unsubscribe 購読をやめてチャネルを閉じる
+func (hub *Hub) Unsubscribe(sub *Subscription) {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	if _, ok := hub.subscribers[sub]; !ok {
+		return
+	}
+	delete(hub.subscribers, sub)
+	close(sub.ch)
+}
+
+// Publish イベントの顧客リストを購読している全員に配信する
+func (hub *Hub) Publish(event Event) {
+	hub.mu.RLock()
+	defer hub.mu.RUnlock()
+	for sub := range hub.subscribers {
+		if _, ok := sub.books[event.BookID]; !ok {
+			continue
+		}
+		select {
+		case sub.ch <- event:
+		default:
+			log.Warn().Str("type", string(event.Type)).Msg("Drop activity event for slow subscriber")
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package activity
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+)
+
+// Channel トリガーが pg_notify に使うチャネル名
+const Channel = "prism_activity"
+
+// 接続が切れた場合に再接続するまでの待ち時間
+const reconnectInterval = 5 * time.Second
+
+// Listen LISTEN で受け取った通知を Hub に配信する。ctx が終了するまで再接続を繰り返す
+func (hub *Hub) Listen(ctx context.Context, connPool *pgxpool.Pool) error {
+	for {
+		err := hub.listen(ctx, connPool)
+		if ctx.Err() != nil {
+			return nil
+		}
+		log.Error().Err(err).Msg("Activity listener disconnected")
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(reconnectInterval):
+		}
+	}
+}
+
+func (hub *Hub) listen(ctx context.Context, connPool *pgxpool.Pool) error {
+	poolConn, err := connPool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	// LISTEN したままプールに戻さないように切り離す
+	conn := poolConn.Hijack()
+	defer conn.Close(context.Background())
+
+	_, err = conn.Exec(ctx, "LISTEN "+Channel)
+	if err != nil {
+		return err
+	}
+	log.Info().Msgf("Listen activity notifications on %s", Channel)
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		var event Event
+		err = json.Unmarshal([]byte(notification.Payload), &event)
+		if err != nil {
+			log.Error().Err(err).Str("payload", notification.Payload).Msg("Invalid activity notification")
+			continue
+		}
+		hub.Publish(event)
+	}
+}
@@ -0,0 +1,50 @@
+package activity
+
+import (
+	"context"
+	"time"
+
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/rs/zerolog/log"
+)
+
+// WatchDueRedials 予定時刻を過ぎた再架電を定期的に調べて配信する。
+// 時間経過で発生するイベントのため NOTIFY は使わず、各サーバーが自分の購読者に配信する。
+// 全組織の再架電を対象にするため queries は BYPASSRLS のロールで接続する。配信先は購読者の顧客リストで絞り込まれる
+func (hub *Hub) WatchDueRedials(ctx context.Context, queries db.Querier, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	last := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		now := time.Now()
+		redials, err := queries.ListDueRedials(ctx, db.ListDueRedialsParams{
+			FromTime: last,
+			ToTime:   now,
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to list due redials")
+			continue
+		}
+		last = now
+
+		for _, redial := range redials {
+			userID := redial.UserID
+			scheduledAt := redial.ScheduledAt
+			hub.Publish(Event{
+				Type:              EventRedialDue,
+				BookID:            redial.BookID,
+				CustomerID:        redial.ID,
+				UserID:            &userID,
+				RedialScheduledAt: &scheduledAt,
+				OccurredAt:        scheduledAt,
+			})
+		}
+	}
+}
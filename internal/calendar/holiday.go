@@ -0,0 +1,217 @@
+// Package calendar 日本の祝日と架電可能時間帯の計算を行う
+package calendar
+
+import (
+	"time"
+)
+
+// JST 祝日判定に使うタイムゾーン
+var JST = time.FixedZone("Asia/Tokyo", 9*60*60)
+
+// HolidayName 日付が祝日であればその名前を返す。振替休日と国民の休日も含む。
+// 日付の判定は JST で行う。
+func HolidayName(t time.Time) (string, bool) {
+	y, m, d := t.In(JST).Date()
+	if name, ok := baseHoliday(y, m, d); ok {
+		return name, true
+	}
+
+	date := time.Date(y, m, d, 0, 0, 0, 0, JST)
+
+	// 祝日が日曜日に当たるときは、その日後の最も近い平日を休日とする
+	if y >= 2007 {
+		for prev := date.AddDate(0, 0, -1); ; prev = prev.AddDate(0, 0, -1) {
+			if _, ok := baseHoliday(prev.Date()); !ok {
+				break
+			}
+			if prev.Weekday() == time.Sunday {
+				return "振替休日", true
+			}
+		}
+	} else if y >= 1973 && date.Weekday() == time.Monday {
+		if _, ok := baseHoliday(date.AddDate(0, 0, -1).Date()); ok {
+			return "振替休日", true
+		}
+	}
+
+	// 前日と翌日が祝日である日は休日とする
+	if y >= 1988 && date.Weekday() != time.Sunday {
+		_, prevOK := baseHoliday(date.AddDate(0, 0, -1).Date())
+		_, nextOK := baseHoliday(date.AddDate(0, 0, 1).Date())
+		if prevOK && nextOK {
+			return "国民の休日", true
+		}
+	}
+
+	return "", false
+}
+
+// IsHoliday 日付が祝日かどうか
+func IsHoliday(t time.Time) bool {
+	_, ok := HolidayName(t)
+	return ok
+}
+
+// IsBusinessDay 土日・祝日・年末年始(12/29〜1/3)を除いた営業日かどうか
+func IsBusinessDay(t time.Time) bool {
+	t = t.In(JST)
+	switch t.Weekday() {
+	case time.Saturday, time.Sunday:
+		return false
+	}
+	return !isYearEndBreak(t) && !IsHoliday(t)
+}
+
+// isYearEndBreak 年末年始(12/29〜1/3)かどうか
+func isYearEndBreak(t time.Time) bool {
+	_, m, d := t.Date()
+	return (m == time.December && d >= 29) || (m == time.January && d <= 3)
+}
+
+// baseHoliday 「国民の祝日に関する法律」で日付が定められた祝日
+func baseHoliday(y int, m time.Month, d int) (string, bool) {
+	switch m {
+	case time.January:
+		if d == 1 {
+			return "元日", true
+		}
+		if y >= 2000 && d == nthMonday(y, m, 2) {
+			return "成人の日", true
+		}
+		if y < 2000 && d == 15 {
+			return "成人の日", true
+		}
+	case time.February:
+		if d == 11 && y >= 1967 {
+			return "建国記念の日", true
+		}
+		if d == 23 && y >= 2020 {
+			return "天皇誕生日", true
+		}
+	case time.March:
+		if d == vernalEquinoxDay(y) {
+			return "春分の日", true
+		}
+	case time.April:
+		if d == 29 {
+			switch {
+			case y >= 2007:
+				return "昭和の日", true
+			case y >= 1989:
+				return "みどりの日", true
+			default:
+				return "天皇誕生日", true
+			}
+		}
+	case time.May:
+		switch d {
+		case 1:
+			if y == 2019 {
+				return "即位の日", true
+			}
+		case 3:
+			return "憲法記念日", true
+		case 4:
+			if y >= 2007 {
+				return "みどりの日", true
+			}
+		case 5:
+			return "こどもの日", true
+		}
+	case time.July:
+		switch y {
+		case 2020:
+			if d == 23 {
+				return "海の日", true
+			}
+			if d == 24 {
+				return "スポーツの日", true
+			}
+		case 2021:
+			if d == 22 {
+				return "海の日", true
+			}
+			if d == 23 {
+				return "スポーツの日", true
+			}
+		default:
+			if y >= 2003 && d == nthMonday(y, m, 3) {
+				return "海の日", true
+			}
+			if y >= 1996 && y < 2003 && d == 20 {
+				return "海の日", true
+			}
+		}
+	case time.August:
+		switch y {
+		case 2020:
+			if d == 10 {
+				return "山の日", true
+			}
+		case 2021:
+			if d == 8 {
+				return "山の日", true
+			}
+		default:
+			if y >= 2016 && d == 11 {
+				return "山の日", true
+			}
+		}
+	case time.September:
+		if y >= 2003 && d == nthMonday(y, m, 3) {
+			return "敬老の日", true
+		}
+		if y >= 1966 && y < 2003 && d == 15 {
+			return "敬老の日", true
+		}
+		if d == autumnalEquinoxDay(y) {
+			return "秋分の日", true
+		}
+	case time.October:
+		if y == 2019 && d == 22 {
+			return "即位礼正殿の儀の行われる日", true
+		}
+		if y == 2020 || y == 2021 {
+			break
+		}
+		name := "体育の日"
+		if y >= 2020 {
+			name = "スポーツの日"
+		}
+		if y >= 2000 && d == nthMonday(y, m, 2) {
+			return name, true
+		}
+		if y >= 1966 && y < 2000 && d == 10 {
+			return name, true
+		}
+	case time.November:
+		if d == 3 {
+			return "文化の日", true
+		}
+		if d == 23 {
+			return "勤労感謝の日", true
+		}
+	case time.December:
+		if d == 23 && y >= 1989 && y <= 2018 {
+			return "天皇誕生日", true
+		}
+	}
+	return "", false
+}
+
+// nthMonday その月の第n月曜日の日付
+func nthMonday(y int, m time.Month, n int) int {
+	first := time.Date(y, m, 1, 0, 0, 0, 0, JST).Weekday()
+	offset := (int(time.Monday) - int(first) + 7) % 7
+	return 1 + offset + (n-1)*7
+}
+
+// vernalEquinoxDay 春分日の近似式(1980〜2099年で有効)
+func vernalEquinoxDay(y int) int {
+	return int(20.8431+0.242194*float64(y-1980)) - (y-1980)/4
+}
+
+// autumnalEquinoxDay 秋分日の近似式(1980〜2099年で有効)
+func autumnalEquinoxDay(y int) int {
+	return int(23.2488+0.242194*float64(y-1980)) - (y-1980)/4
+}
@@ -0,0 +1,59 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHolidayName(t *testing.T) {
+	tests := []struct {
+		date string
+		want string
+	}{
+		{"2024-03-20", "春分の日"},
+		{"2026-09-23", "秋分の日"},
+		// 建国記念の日が日曜日
+		{"2024-02-12", "振替休日"},
+		// 敬老の日と秋分の日に挟まれた日
+		{"2026-09-22", "国民の休日"},
+		{"2024-03-21", ""},
+		{"2026-09-24", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.date, func(t *testing.T) {
+			date, err := time.ParseInLocation(time.DateOnly, tt.date, JST)
+			if err != nil {
+				t.Fatal(err)
+			}
+			name, ok := HolidayName(date)
+			if name != tt.want || ok != (tt.want != "") {
+				t.Errorf("HolidayName() = %q, %t, want %q", name, ok, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsBusinessDay(t *testing.T) {
+	tests := []struct {
+		date string
+		want bool
+	}{
+		{"2026-12-28", true},
+		{"2026-12-29", false},
+		{"2027-01-03", false},
+		{"2027-01-04", true},
+		{"2026-09-22", false},
+		{"2026-09-26", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.date, func(t *testing.T) {
+			date, err := time.ParseInLocation(time.DateOnly, tt.date, JST)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := IsBusinessDay(date); got != tt.want {
+				t.Errorf("IsBusinessDay() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package calendar
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// 架電可能な時刻を探す上限日数
+const maxSearchDays = 366
+
+// Window 曜日ごとの架電可能時間帯。Start と End は0時からの経過時間で、End は含まない。
+type Window struct {
+	Weekday time.Weekday
+	Start   time.Duration
+	End     time.Duration
+}
+
+// Validate 時間帯が1日の範囲に収まっているか検証する
+func (w Window) Validate() error {
+	if w.Weekday < time.Sunday || w.Weekday > time.Saturday {
+		return fmt.Errorf("invalid weekday: %d", w.Weekday)
+	}
+	if w.Start < 0 || w.End > 24*time.Hour || w.Start >= w.End {
+		return fmt.Errorf("invalid window: %s-%s", w.Start, w.End)
+	}
+	return nil
+}
+
+// DefaultWindows 時間帯が設定されていない場合に使う平日9時〜18時
+func DefaultWindows() []Window {
+	windows := make([]Window, 0, 5)
+	for wd := time.Monday; wd <= time.Friday; wd++ {
+		windows = append(windows, Window{Weekday: wd, Start: 9 * time.Hour, End: 18 * time.Hour})
+	}
+	return windows
+}
+
+// Schedule 架電可能時間帯の設定
+type Schedule struct {
+	Location *time.Location
+	Windows  []Window
+	// 祝日と年末年始は架電しない
+	SkipHolidays bool
+}
+
+// Contains 時刻が架電可能時間帯に含まれるかどうか
+func (s Schedule) Contains(t time.Time) bool {
+	local := t.In(s.location())
+	if s.SkipHolidays && isDayOff(local) {
+		return false
+	}
+	offset := sinceMidnight(local)
+	for _, w := range s.Windows {
+		if w.Weekday == local.Weekday() && offset >= w.Start && offset < w.End {
+			return true
+		}
+	}
+	return false
+}
+
+// Next t 以降で最も早い架電可能な時刻を返す。見つからない場合は false を返す。
+func (s Schedule) Next(t time.Time) (time.Time, bool) {
+	if s.Contains(t) {
+		return t, true
+	}
+
+	windows := make([]Window, len(s.Windows))
+	copy(windows, s.Windows)
+	sort.Slice(windows, func(i, j int) bool {
+		return windows[i].Start < windows[j].Start
+	})
+
+	local := t.In(s.location())
+	day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, local.Location())
+	for i := 0; i < maxSearchDays; i++ {
+		if !s.SkipHolidays || !isDayOff(day) {
+			for _, w := range windows {
+				if w.Weekday != day.Weekday() {
+					continue
+				}
+				start := day.Add(w.Start)
+				if !start.Before(local) {
+					return start, true
+				}
+			}
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+	return time.Time{}, false
+}
+
+func (s Schedule) location() *time.Location {
+	if s.Location == nil {
+		return JST
+	}
+	return s.Location
+}
+
+// isDayOff 祝日または年末年始かどうか。平日は !IsBusinessDay と同じで、土日は時間帯の設定に従う。
+// 日付は t のタイムゾーン（スケジュールの Location）で判定する。
+func isDayOff(t time.Time) bool {
+	y, m, d := t.Date()
+	date := time.Date(y, m, d, 0, 0, 0, 0, JST)
+	return isYearEndBreak(date) || IsHoliday(date)
+}
+
+func sinceMidnight(t time.Time) time.Duration {
+	return time.Duration(t.Hour())*time.Hour +
+		time.Duration(t.Minute())*time.Minute +
+		time.Duration(t.Second())*time.Second +
+		time.Duration(t.Nanosecond())
+}
@@ -0,0 +1,145 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+// pst JST と日付がずれるタイムゾーン
+var pst = time.FixedZone("PST", -8*60*60)
+
+func everyDay(start, end time.Duration) []Window {
+	windows := make([]Window, 0, 7)
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+		windows = append(windows, Window{Weekday: wd, Start: start, End: end})
+	}
+	return windows
+}
+
+func TestScheduleContains(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule Schedule
+		at       time.Time
+		want     bool
+	}{
+		{
+			name:     "weekday",
+			schedule: Schedule{Windows: DefaultWindows(), SkipHolidays: true},
+			at:       time.Date(2024, 3, 21, 10, 0, 0, 0, JST),
+			want:     true,
+		},
+		{
+			name:     "vernal equinox",
+			schedule: Schedule{Windows: DefaultWindows(), SkipHolidays: true},
+			at:       time.Date(2024, 3, 20, 10, 0, 0, 0, JST),
+			want:     false,
+		},
+		{
+			name:     "holiday allowed",
+			schedule: Schedule{Windows: DefaultWindows()},
+			at:       time.Date(2024, 3, 20, 10, 0, 0, 0, JST),
+			want:     true,
+		},
+		{
+			name:     "substitute holiday",
+			schedule: Schedule{Windows: DefaultWindows(), SkipHolidays: true},
+			at:       time.Date(2024, 2, 12, 10, 0, 0, 0, JST),
+			want:     false,
+		},
+		{
+			name:     "citizens' holiday",
+			schedule: Schedule{Windows: DefaultWindows(), SkipHolidays: true},
+			at:       time.Date(2026, 9, 22, 10, 0, 0, 0, JST),
+			want:     false,
+		},
+		{
+			name:     "year-end break on a weekday",
+			schedule: Schedule{Windows: DefaultWindows(), SkipHolidays: true},
+			at:       time.Date(2026, 12, 29, 10, 0, 0, 0, JST),
+			want:     false,
+		},
+		{
+			name:     "year-end break on a saturday",
+			schedule: Schedule{Windows: everyDay(9*time.Hour, 18*time.Hour), SkipHolidays: true},
+			at:       time.Date(2027, 1, 2, 10, 0, 0, 0, JST),
+			want:     false,
+		},
+		{
+			name:     "saturday window",
+			schedule: Schedule{Windows: everyDay(9*time.Hour, 18*time.Hour), SkipHolidays: true},
+			at:       time.Date(2027, 1, 9, 10, 0, 0, 0, JST),
+			want:     true,
+		},
+		{
+			// 現地の3/19は平日。JSTでは春分の日になっている
+			name:     "date in the schedule location",
+			schedule: Schedule{Location: pst, Windows: everyDay(9*time.Hour, 19*time.Hour), SkipHolidays: true},
+			at:       time.Date(2024, 3, 19, 18, 0, 0, 0, pst),
+			want:     true,
+		},
+		{
+			// 現地の3/20は春分の日。JSTでは翌日になっている
+			name:     "holiday in the schedule location",
+			schedule: Schedule{Location: pst, Windows: everyDay(9*time.Hour, 19*time.Hour), SkipHolidays: true},
+			at:       time.Date(2024, 3, 20, 18, 0, 0, 0, pst),
+			want:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.schedule.Contains(tt.at); got != tt.want {
+				t.Errorf("Contains(%s) = %t, want %t", tt.at, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScheduleNext(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule Schedule
+		from     time.Time
+		want     time.Time
+	}{
+		{
+			name:     "within window",
+			schedule: Schedule{Windows: DefaultWindows(), SkipHolidays: true},
+			from:     time.Date(2026, 9, 17, 10, 0, 0, 0, JST),
+			want:     time.Date(2026, 9, 17, 10, 0, 0, 0, JST),
+		},
+		{
+			// 9/21 敬老の日、9/22 国民の休日、9/23 秋分の日
+			name:     "across consecutive holidays",
+			schedule: Schedule{Windows: DefaultWindows(), SkipHolidays: true},
+			from:     time.Date(2026, 9, 18, 19, 0, 0, 0, JST),
+			want:     time.Date(2026, 9, 24, 9, 0, 0, 0, JST),
+		},
+		{
+			name:     "holidays allowed",
+			schedule: Schedule{Windows: DefaultWindows()},
+			from:     time.Date(2026, 9, 18, 19, 0, 0, 0, JST),
+			want:     time.Date(2026, 9, 21, 9, 0, 0, 0, JST),
+		},
+		{
+			name:     "across year-end break",
+			schedule: Schedule{Windows: everyDay(9*time.Hour, 18*time.Hour), SkipHolidays: true},
+			from:     time.Date(2026, 12, 28, 18, 0, 0, 0, JST),
+			want:     time.Date(2027, 1, 4, 9, 0, 0, 0, JST),
+		},
+		{
+			name:     "schedule location",
+			schedule: Schedule{Location: pst, Windows: DefaultWindows(), SkipHolidays: true},
+			from:     time.Date(2024, 3, 19, 18, 0, 0, 0, pst),
+			want:     time.Date(2024, 3, 21, 9, 0, 0, 0, pst),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.schedule.Next(tt.from)
+			if !ok || !got.Equal(tt.want) {
+				t.Errorf("Next(%s) = %s, %t, want %s", tt.from, got, ok, tt.want)
+			}
+		})
+	}
+}
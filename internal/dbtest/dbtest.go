@@ -0,0 +1,110 @@
+// Package dbtest TEST_DB_SOURCE のデータベースを使うテストの共通処理。
+// TEST_DB_SOURCE と TEST_SYSTEM_DB_SOURCE が未設定の場合はテストをスキップする。
+// マイグレーションは TEST_MIGRATION_DB_SOURCE（未設定の場合は TEST_DB_SOURCE）のロールで適用する
+package dbtest
+
+import (
+	"context"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/0utl1er-tech/prism-backend/db/migration"
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/0utl1er-tech/prism-backend/internal/store"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	sourceEnv          = "TEST_DB_SOURCE"
+	systemSourceEnv    = "TEST_SYSTEM_DB_SOURCE"
+	migrationSourceEnv = "TEST_MIGRATION_DB_SOURCE"
+)
+
+var (
+	migrateOnce sync.Once
+	migrateErr  error
+)
+
+// Open マイグレーションを適用したデータベースの、組織に限定した Store と組織をまたぐ処理の Store を返す。
+// プールはテストの終了時に閉じる
+func Open(t *testing.T) (dbStore, systemStore store.Store) {
+	t.Helper()
+	source := os.Getenv(sourceEnv)
+	if source == "" {
+		t.Skipf("%s is not set", sourceEnv)
+	}
+	systemSource := os.Getenv(systemSourceEnv)
+	if systemSource == "" {
+		t.Skipf("%s is not set", systemSourceEnv)
+	}
+
+	migrationSource := os.Getenv(migrationSourceEnv)
+	if migrationSource == "" {
+		migrationSource = source
+	}
+
+	ctx := context.Background()
+	migrateOnce.Do(func() {
+		var migrator *migration.Migrator
+		migrator, migrateErr = migration.New(migrationSource)
+		if migrateErr != nil {
+			return
+		}
+		defer migrator.Close()
+		migrateErr = migrator.Up(ctx, 0)
+	})
+	if migrateErr != nil {
+		t.Fatalf("failed to migrate test database: %v", migrateErr)
+	}
+
+	// 行レベルセキュリティが適用されるロールでテストする
+	connPool := openPool(t, source, store.CheckRole)
+	systemPool := openPool(t, systemSource, store.CheckSystemRole)
+	return store.New(connPool), store.NewSystem(systemPool)
+}
+
+func openPool(t *testing.T, source string, checkRole func(context.Context, *pgxpool.Pool) error) *pgxpool.Pool {
+	t.Helper()
+	ctx := context.Background()
+	connPool, err := pgxpool.New(ctx, source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(connPool.Close)
+
+	err = checkRole(ctx, connPool)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return connPool
+}
+
+// CreateOrganization テスト用の組織とオーナーを systemStore で作成し、その組織に限定したコンテキストを返す
+func CreateOrganization(t *testing.T, systemStore store.Store) (context.Context, db.User) {
+	t.Helper()
+	ctx := context.Background()
+	var user db.User
+	err := systemStore.ExecTx(ctx, func(q *db.Queries) error {
+		organization, err := q.CreateOrganization(ctx, db.CreateOrganizationParams{
+			ID:   uuid.New(),
+			Name: t.Name(),
+		})
+		if err != nil {
+			return err
+		}
+		user, err = q.CreateOrganizationUser(ctx, db.CreateOrganizationUserParams{
+			ID:             uuid.New(),
+			Email:          "owner@example.com",
+			Name:           "owner",
+			Role:           db.RoleOwner,
+			OrganizationID: organization.ID,
+		})
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return store.WithOrganization(context.Background(), user.OrganizationID), user
+}
@@ -0,0 +1,129 @@
+// Package health Kubernetes のプローブと grpc.health.v1 に返すサーバーの状態を管理する
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/0utl1er-tech/prism-backend/db/migration"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const checkTimeout = 2 * time.Second
+
+// Checker データベースへの接続とマイグレーションのバージョンを確認し、
+// /readyz と grpc.health.v1 に同じ結果を返す
+type Checker struct {
+	pool         *pgxpool.Pool
+	latest       uint
+	server       *grpchealth.Server
+	shuttingDown atomic.Bool
+}
+
+func NewChecker(pool *pgxpool.Pool) (*Checker, error) {
+	latest, err := migration.Latest()
+	if err != nil {
+		return nil, err
+	}
+	server := grpchealth.NewServer()
+	// 最初の確認が終わるまではリクエストを受けない
+	server.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	return &Checker{
+		pool:   pool,
+		latest: latest,
+		server: server,
+	}, nil
+}
+
+// Server gRPCサーバーに登録する grpc.health.v1 の実装
+func (checker *Checker) Server() healthpb.HealthServer {
+	return checker.server
+}
+
+// Run interval ごとに確認して grpc.health.v1 の状態を更新する
+func (checker *Checker) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		checker.update(ctx)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Shutdown グレースフルシャットダウンの開始時に呼び、以降は NOT_SERVING を返す
+func (checker *Checker) Shutdown() {
+	if checker.shuttingDown.CompareAndSwap(false, true) {
+		log.Info().Msg("Health status is NOT_SERVING for shutdown")
+		checker.server.Shutdown()
+	}
+}
+
+// Liveness /healthz。プロセスが応答できれば成功とし、データベースの障害で再起動させない
+func (checker *Checker) Liveness(w http.ResponseWriter, r *http.Request) {
+	writeStatus(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// Readiness /readyz。データベースに接続でき、マイグレーションが最新の場合のみ成功する
+func (checker *Checker) Readiness(w http.ResponseWriter, r *http.Request) {
+	err := checker.check(r.Context())
+	if err != nil {
+		writeStatus(w, http.StatusServiceUnavailable, map[string]string{"status": "unavailable", "error": err.Error()})
+		return
+	}
+	writeStatus(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+func (checker *Checker) update(ctx context.Context) {
+	status := healthpb.HealthCheckResponse_SERVING
+	err := checker.check(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("Health check failed")
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	// Shutdown の後は grpchealth.Server が更新を無視する
+	checker.server.SetServingStatus("", status)
+}
+
+func (checker *Checker) check(ctx context.Context) error {
+	if checker.shuttingDown.Load() {
+		return fmt.Errorf("shutting down")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	err := checker.pool.Ping(ctx)
+	if err != nil {
+		return fmt.Errorf("database: %w", err)
+	}
+	version, dirty, err := migration.Current(ctx, checker.pool)
+	if err != nil {
+		return fmt.Errorf("migration: %w", err)
+	}
+	if dirty {
+		return fmt.Errorf("migration: version %d is dirty", version)
+	}
+	if version < checker.latest {
+		return fmt.Errorf("migration: version %d is behind %d", version, checker.latest)
+	}
+	return nil
+}
+
+func writeStatus(w http.ResponseWriter, code int, body map[string]string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,280 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"runtime/debug"
+	"sync/atomic"
+	"time"
+
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/0utl1er-tech/prism-backend/internal/store"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/sync/errgroup"
+)
+
+// Result ジョブの結果。Data は JSON にして Job.result に保存する
+type Result struct {
+	Data     any
+	Location string
+}
+
+// ProgressFunc 進捗率 (0〜100) を通知する
+type ProgressFunc func(progress int)
+
+// Handler ジョブを実行する。ctx はキャンセルの要求とサーバーのシャットダウンで終了する
+type Handler func(ctx context.Context, job db.Job, progress ProgressFunc) (Result, error)
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent 再試行しても成功しないエラーにする
+func Permanent(err error) error {
+	return &permanentError{err: err}
+}
+
+var (
+	errCanceled = errors.New("job canceled")
+	errLockLost = errors.New("job lock lost")
+)
+
+// Runner サーバー内でジョブを実行するワーカープール。
+// ジョブの取得と結果の書き込みは全組織を対象にするため、store は BYPASSRLS のロールで接続する。
+// ハンドラーは組織に限定した Store を使う
+type Runner struct {
+	store         store.Store
+	handlers      map[string]Handler
+	workers       int
+	pollInterval  time.Duration
+	leaseDuration time.Duration
+	workerID      string
+}
+
+func NewRunner(store store.Store, workers int, pollInterval, leaseDuration time.Duration) *Runner {
+	hostname, _ := os.Hostname()
+	return &Runner{
+		store:         store,
+		handlers:      map[string]Handler{},
+		workers:       workers,
+		pollInterval:  pollInterval,
+		leaseDuration: leaseDuration,
+		workerID:      fmt.Sprintf("%s-%d-%s", hostname, os.Getpid(), uuid.NewString()[:8]),
+	}
+}
+
+// Register ジョブの種類と実行する処理を登録する。Run の前に呼ぶ
+func (runner *Runner) Register(jobType string, handler Handler) {
+	runner.handlers[jobType] = handler
+}
+
+// Handles 登録済みのジョブの種類か
+func (runner *Runner) Handles(jobType string) bool {
+	_, ok := runner.handlers[jobType]
+	return ok
+}
+
+// Run ctx が終了するまでワーカーを動かす。実行中のジョブは中断して実行待ちに戻す
+func (runner *Runner) Run(ctx context.Context) error {
+	types := make([]string, 0, len(runner.handlers))
+	for jobType := range runner.handlers {
+		types = append(types, jobType)
+	}
+
+	group, ctx := errgroup.WithContext(ctx)
+	for i := 0; i < runner.workers; i++ {
+		workerID := fmt.Sprintf("%s/%d", runner.workerID, i)
+		group.Go(func() error {
+			runner.work(ctx, workerID, types)
+			return nil
+		})
+	}
+	log.Info().Int("workers", runner.workers).Strs("types", types).Msg("Start job runner")
+	err := group.Wait()
+	log.Info().Msg("Job runner is stopped")
+	return err
+}
+
+func (runner *Runner) work(ctx context.Context, workerID string, types []string) {
+	for {
+		job, err := runner.store.ClaimJob(ctx, db.ClaimJobParams{
+			LockedBy:    workerID,
+			LockedUntil: time.Now().Add(runner.leaseDuration),
+			Types:       types,
+		})
+		if err == nil {
+			runner.execute(ctx, workerID, job)
+			continue
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			log.Error().Err(err).Msg("Failed to claim job")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(runner.pollInterval):
+		}
+	}
+}
+
+func (runner *Runner) execute(ctx context.Context, workerID string, job db.Job) {
+	logger := log.With().Str("job_id", job.ID.String()).Str("type", job.Type).Int32("attempt", job.Attempts).Logger()
+	logger.Info().Msg("Start job")
+
+	// ハンドラーはジョブを作成した組織に限定して実行し、変更履歴に作成したユーザーとジョブの種類を残す
+	jobCtx, cancel := context.WithCancelCause(store.WithAudit(store.WithOrganization(ctx, job.OrganizationID), store.Audit{
+		ActorID: job.UserID.Bytes,
+		RPC:     "job." + job.Type,
+	}))
+	defer cancel(nil)
+
+	var progress atomic.Int32
+	progress.Store(job.Progress)
+	done := make(chan struct{})
+	go runner.heartbeat(jobCtx, cancel, done, workerID, job.ID, &progress)
+
+	result, err := runner.call(jobCtx, job, func(p int) {
+		progress.Store(int32(max(0, min(p, 100))))
+	})
+	close(done)
+
+	// シャットダウン中でも結果を書き込めるようにする
+	finishCtx := context.WithoutCancel(ctx)
+	switch cause := context.Cause(jobCtx); {
+	case err == nil:
+		err = runner.complete(finishCtx, workerID, job.ID, result)
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to complete job")
+			return
+		}
+		logger.Info().Msg("Job succeeded")
+	case errors.Is(cause, errCanceled):
+		err = runner.store.FinishCanceledJob(finishCtx, db.FinishCanceledJobParams{
+			ID:       job.ID,
+			LockedBy: workerID,
+		})
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to cancel job")
+			return
+		}
+		logger.Info().Msg("Job canceled")
+	case errors.Is(cause, errLockLost):
+		logger.Warn().Msg("Job lock lost")
+	case ctx.Err() != nil:
+		err = runner.store.ReleaseJob(finishCtx, db.ReleaseJobParams{
+			ID:       job.ID,
+			LockedBy: workerID,
+		})
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to release job")
+			return
+		}
+		logger.Info().Msg("Job released for shutdown")
+	default:
+		var permanent *permanentError
+		failed, failErr := runner.store.FailJob(finishCtx, db.FailJobParams{
+			Retryable: !errors.As(err, &permanent),
+			Error:     err.Error(),
+			RunAfter:  time.Now().Add(retryDelay(job.Attempts)),
+			ID:        job.ID,
+			LockedBy:  workerID,
+		})
+		if failErr != nil {
+			logger.Error().Err(failErr).Msg("Failed to record job failure")
+			return
+		}
+		logger.Error().Err(err).Str("status", string(failed.Status)).Msg("Job failed")
+	}
+}
+
+// call ハンドラーの panic をジョブの失敗として扱う
+func (runner *Runner) call(ctx context.Context, job db.Job, progress ProgressFunc) (result Result, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Error().Str("job_id", job.ID.String()).Bytes("stack", debug.Stack()).Msg("Job panicked")
+			err = Permanent(fmt.Errorf("panic: %v", r))
+		}
+	}()
+	return runner.handlers[job.Type](ctx, job, progress)
+}
+
+// heartbeat 進捗を書き込んでロックを延長し、キャンセルの要求があればジョブを中断する
+func (runner *Runner) heartbeat(
+	ctx context.Context,
+	cancel context.CancelCauseFunc,
+	done <-chan struct{},
+	workerID string,
+	jobID uuid.UUID,
+	progress *atomic.Int32,
+) {
+	ticker := time.NewTicker(runner.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		canceled, err := runner.store.HeartbeatJob(ctx, db.HeartbeatJobParams{
+			Progress:    progress.Load(),
+			LockedUntil: time.Now().Add(runner.leaseDuration),
+			ID:          jobID,
+			LockedBy:    workerID,
+		})
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			cancel(errLockLost)
+			return
+		case err != nil:
+			if ctx.Err() == nil {
+				log.Error().Err(err).Str("job_id", jobID.String()).Msg("Failed to heartbeat job")
+			}
+		case canceled:
+			cancel(errCanceled)
+			return
+		}
+	}
+}
+
+func (runner *Runner) complete(ctx context.Context, workerID string, jobID uuid.UUID, result Result) error {
+	var data []byte
+	if result.Data != nil {
+		var err error
+		data, err = json.Marshal(result.Data)
+		if err != nil {
+			return err
+		}
+	}
+
+	return runner.store.CompleteJob(ctx, db.CompleteJobParams{
+		Result: data,
+		ResultLocation: pgtype.Text{
+			String: result.Location,
+			Valid:  result.Location != "",
+		},
+		ID:       jobID,
+		LockedBy: workerID,
+	})
+}
+
+// retryDelay 試行回数の2乗に比例して待つ
+func retryDelay(attempts int32) time.Duration {
+	return time.Duration(attempts*attempts) * 10 * time.Second
+}
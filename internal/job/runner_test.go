@@ -0,0 +1,245 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/0utl1er-tech/prism-backend/internal/dbtest"
+	"github.com/0utl1er-tech/prism-backend/internal/store"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		attempts int32
+		want     time.Duration
+	}{
+		{1, 10 * time.Second},
+		{2, 40 * time.Second},
+		{3, 90 * time.Second},
+		{10, 1000 * time.Second},
+	}
+	for _, tt := range tests {
+		if got := retryDelay(tt.attempts); got != tt.want {
+			t.Errorf("retryDelay(%d) = %s, want %s", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+// fakeStore execute が結果の書き込みに使うクエリだけを記録する
+type fakeStore struct {
+	store.Store
+
+	mu        sync.Mutex
+	canceled  bool
+	lockLost  bool
+	completed *db.CompleteJobParams
+	failed    *db.FailJobParams
+	finished  bool
+	released  bool
+}
+
+func (s *fakeStore) HeartbeatJob(ctx context.Context, arg db.HeartbeatJobParams) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.lockLost {
+		return false, pgx.ErrNoRows
+	}
+	return s.canceled, nil
+}
+
+func (s *fakeStore) CompleteJob(ctx context.Context, arg db.CompleteJobParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.completed = &arg
+	return nil
+}
+
+func (s *fakeStore) FailJob(ctx context.Context, arg db.FailJobParams) (db.Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failed = &arg
+	return db.Job{ID: arg.ID, Status: db.JobStatusQueued}, nil
+}
+
+func (s *fakeStore) FinishCanceledJob(ctx context.Context, arg db.FinishCanceledJobParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.finished = true
+	return nil
+}
+
+func (s *fakeStore) ReleaseJob(ctx context.Context, arg db.ReleaseJobParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.released = true
+	return nil
+}
+
+// waitForDone ハートビートでキャンセルされるまで待つハンドラー
+func waitForDone(ctx context.Context, job db.Job, progress ProgressFunc) (Result, error) {
+	<-ctx.Done()
+	return Result{}, ctx.Err()
+}
+
+func TestRunnerExecute(t *testing.T) {
+	errTemporary := errors.New("temporary")
+	tests := []struct {
+		name     string
+		store    *fakeStore
+		handler  Handler
+		shutdown bool
+		check    func(t *testing.T, s *fakeStore)
+	}{
+		{
+			name:  "succeeded",
+			store: &fakeStore{},
+			handler: func(ctx context.Context, job db.Job, progress ProgressFunc) (Result, error) {
+				return Result{Data: map[string]int{"rows": 1}, Location: "s3://bucket/key"}, nil
+			},
+			check: func(t *testing.T, s *fakeStore) {
+				if s.completed == nil || string(s.completed.Result) != `{"rows":1}` || s.completed.ResultLocation.String != "s3://bucket/key" {
+					t.Errorf("CompleteJob() = %+v", s.completed)
+				}
+			},
+		},
+		{
+			name:  "retryable error",
+			store: &fakeStore{},
+			handler: func(ctx context.Context, job db.Job, progress ProgressFunc) (Result, error) {
+				return Result{}, errTemporary
+			},
+			check: func(t *testing.T, s *fakeStore) {
+				if s.failed == nil || !s.failed.Retryable || s.failed.Error != errTemporary.Error() {
+					t.Fatalf("FailJob() = %+v, want retryable", s.failed)
+				}
+				// 2回目の試行なので retryDelay(2) 後に再実行する
+				delay := time.Until(s.failed.RunAfter)
+				if delay < 30*time.Second || delay > retryDelay(2) {
+					t.Errorf("FailJob() run after %s, want about %s", delay, retryDelay(2))
+				}
+			},
+		},
+		{
+			name:  "permanent error",
+			store: &fakeStore{},
+			handler: func(ctx context.Context, job db.Job, progress ProgressFunc) (Result, error) {
+				return Result{}, Permanent(errTemporary)
+			},
+			check: func(t *testing.T, s *fakeStore) {
+				if s.failed == nil || s.failed.Retryable {
+					t.Errorf("FailJob() = %+v, want not retryable", s.failed)
+				}
+			},
+		},
+		{
+			name:  "panic",
+			store: &fakeStore{},
+			handler: func(ctx context.Context, job db.Job, progress ProgressFunc) (Result, error) {
+				panic("boom")
+			},
+			check: func(t *testing.T, s *fakeStore) {
+				if s.failed == nil || s.failed.Retryable || s.failed.Error != "panic: boom" {
+					t.Errorf("FailJob() = %+v, want not retryable panic", s.failed)
+				}
+			},
+		},
+		{
+			name:    "canceled",
+			store:   &fakeStore{canceled: true},
+			handler: waitForDone,
+			check: func(t *testing.T, s *fakeStore) {
+				if !s.finished || s.failed != nil {
+					t.Errorf("finished = %t, failed = %+v, want canceled only", s.finished, s.failed)
+				}
+			},
+		},
+		{
+			name:    "lock lost",
+			store:   &fakeStore{lockLost: true},
+			handler: waitForDone,
+			check: func(t *testing.T, s *fakeStore) {
+				// 別のワーカーが取得したジョブの状態は変更しない
+				if s.completed != nil || s.failed != nil || s.finished || s.released {
+					t.Errorf("store = %+v, want no writes", s)
+				}
+			},
+		},
+		{
+			name:     "shutdown",
+			store:    &fakeStore{},
+			handler:  waitForDone,
+			shutdown: true,
+			check: func(t *testing.T, s *fakeStore) {
+				if !s.released || s.failed != nil {
+					t.Errorf("released = %t, failed = %+v, want released only", s.released, s.failed)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := NewRunner(tt.store, 1, 10*time.Millisecond, time.Minute)
+			runner.Register("test", tt.handler)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.shutdown {
+				time.AfterFunc(20*time.Millisecond, cancel)
+			}
+			runner.execute(ctx, "worker", db.Job{ID: uuid.New(), Type: "test", Attempts: 2})
+
+			tt.store.mu.Lock()
+			defer tt.store.mu.Unlock()
+			tt.check(t, tt.store)
+		})
+	}
+}
+
+func TestClaimJobFailsExhaustedLease(t *testing.T) {
+	dbStore, systemStore := dbtest.Open(t)
+	ctx, _ := dbtest.CreateOrganization(t, systemStore)
+
+	jobType := "test." + uuid.NewString()
+	job, err := dbStore.CreateJob(ctx, db.CreateJobParams{
+		ID:          uuid.New(),
+		Type:        jobType,
+		Params:      []byte("{}"),
+		MaxAttempts: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// ワーカーが停止してロックが期限切れになった状態にする
+	_, err = systemStore.ClaimJob(context.Background(), db.ClaimJobParams{
+		LockedBy:    "stopped",
+		LockedUntil: time.Now().Add(-time.Second),
+		Types:       []string{jobType},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = systemStore.ClaimJob(context.Background(), db.ClaimJobParams{
+		LockedBy:    "worker",
+		LockedUntil: time.Now().Add(time.Minute),
+		Types:       []string{jobType},
+	})
+	if !errors.Is(err, pgx.ErrNoRows) {
+		t.Fatalf("ClaimJob() error = %v, want %v", err, pgx.ErrNoRows)
+	}
+
+	got, err := dbStore.GetJob(ctx, job.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Status != db.JobStatusFailed || got.Attempts != 1 || got.LockedBy.Valid {
+		t.Errorf("GetJob() = status %s, attempts %d, locked by %v, want failed after 1 attempt", got.Status, got.Attempts, got.LockedBy)
+	}
+}
@@ -0,0 +1,61 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/metadata"
+)
+
+// unmatchedRoute どのルートにも一致しなかったリクエスト。パスをそのままラベルにすると種類が増え続ける
+const unmatchedRoute = "unmatched"
+
+type routeKey struct{}
+
+// GatewayHandler gatewayのリクエスト数とレイテンシを記録する。ルートは GatewayRouteAnnotator が設定する
+func (metrics *Metrics) GatewayHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		route := unmatchedRoute
+		r = r.WithContext(context.WithValue(r.Context(), routeKey{}, &route))
+		recorder := &statusRecorder{ResponseWriter: w, code: http.StatusOK}
+
+		next.ServeHTTP(recorder, r)
+
+		metrics.httpRequests.WithLabelValues(r.Method, route, strconv.Itoa(recorder.code)).Inc()
+		metrics.httpDuration.WithLabelValues(r.Method, route).Observe(time.Since(start).Seconds())
+	})
+}
+
+// GatewayRouteAnnotator 一致したルートのパターン (/v1/books/{id} など) を GatewayHandler に渡す
+func GatewayRouteAnnotator() runtime.ServeMuxOption {
+	return runtime.WithMetadata(func(ctx context.Context, req *http.Request) metadata.MD {
+		route, ok := req.Context().Value(routeKey{}).(*string)
+		if !ok {
+			return nil
+		}
+		if pattern, ok := runtime.HTTPPathPattern(ctx); ok {
+			*route = pattern
+		}
+		return nil
+	})
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	code int
+}
+
+func (recorder *statusRecorder) WriteHeader(code int) {
+	recorder.code = code
+	recorder.ResponseWriter.WriteHeader(code)
+}
+
+func (recorder *statusRecorder) Flush() {
+	if flusher, ok := recorder.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
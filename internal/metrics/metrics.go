@@ -0,0 +1,148 @@
+// Package metrics Prometheus の /metrics で公開するRPC、コネクションプール、業務指標を管理する
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/0utl1er-tech/prism-backend/internal/calendar"
+	grpcprom "github.com/grpc-ecosystem/go-grpc-middleware/providers/prometheus"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+)
+
+const namespace = "prism"
+
+// Metrics サーバー全体で1つ作成し、gRPCサーバーとgatewayの両方に組み込む
+type Metrics struct {
+	registry *prometheus.Registry
+	queries  db.Querier
+
+	grpc         *grpcprom.ServerMetrics
+	httpRequests *prometheus.CounterVec
+	httpDuration *prometheus.HistogramVec
+
+	jobs           *prometheus.GaugeVec
+	callsToday     prometheus.Gauge
+	overdueRedials prometheus.Gauge
+}
+
+func New(pool *pgxpool.Pool, queries db.Querier) *Metrics {
+	metrics := &Metrics{
+		registry: prometheus.NewRegistry(),
+		queries:  queries,
+		grpc: grpcprom.NewServerMetrics(
+			grpcprom.WithServerHandlingTimeHistogram(),
+		),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "gateway",
+			Name:      "requests_total",
+			Help:      "Total number of HTTP requests handled by the gateway.",
+		}, []string{"method", "route", "code"}),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "gateway",
+			Name:      "request_duration_seconds",
+			Help:      "Latency of HTTP requests handled by the gateway.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route"}),
+		jobs: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "jobs",
+			Help:      "Number of queued and running jobs.",
+		}, []string{"type", "status"}),
+		callsToday: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "calls_today",
+			Help:      "Number of calls logged since midnight JST.",
+		}),
+		overdueRedials: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "overdue_redials",
+			Help:      "Number of redials whose scheduled time has passed.",
+		}),
+	}
+
+	metrics.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		metrics.grpc,
+		metrics.httpRequests,
+		metrics.httpDuration,
+		metrics.jobs,
+		metrics.callsToday,
+		metrics.overdueRedials,
+		newPoolCollector(pool),
+	)
+	return metrics
+}
+
+// Handler /metrics
+func (metrics *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(metrics.registry, promhttp.HandlerOpts{})
+}
+
+func (metrics *Metrics) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return metrics.grpc.UnaryServerInterceptor()
+}
+
+func (metrics *Metrics) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return metrics.grpc.StreamServerInterceptor()
+}
+
+// InitializeMetrics 呼ばれていないRPCも0件として出力されるよう、登録済みのサービスを事前に登録する
+func (metrics *Metrics) InitializeMetrics(server *grpc.Server) {
+	metrics.grpc.InitializeMetrics(server)
+}
+
+// Run interval ごとに業務指標を集計する。集計は全組織が対象のため、queries は BYPASSRLS のロールで接続する
+func (metrics *Metrics) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		err := metrics.update(ctx)
+		if err != nil {
+			log.Warn().Err(err).Msg("Failed to update metrics")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (metrics *Metrics) update(ctx context.Context) error {
+	jobs, err := metrics.queries.CountActiveJobs(ctx)
+	if err != nil {
+		return err
+	}
+	// 完了して無くなった種類のジョブが前回の件数のまま残らないようにする
+	metrics.jobs.Reset()
+	for _, row := range jobs {
+		metrics.jobs.WithLabelValues(row.Type, string(row.Status)).Set(float64(row.Jobs))
+	}
+
+	now := time.Now()
+	y, m, d := now.In(calendar.JST).Date()
+	calls, err := metrics.queries.CountCallsSince(ctx, time.Date(y, m, d, 0, 0, 0, 0, calendar.JST))
+	if err != nil {
+		return err
+	}
+	metrics.callsToday.Set(float64(calls))
+
+	redials, err := metrics.queries.CountOverdueRedials(ctx, now)
+	if err != nil {
+		return err
+	}
+	metrics.overdueRedials.Set(float64(redials))
+	return nil
+}
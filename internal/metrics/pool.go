@@ -0,0 +1,63 @@
+package metrics
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// poolCollector 収集のたびに pgxpool の統計を読み取る
+type poolCollector struct {
+	pool *pgxpool.Pool
+
+	acquiredConns    *prometheus.Desc
+	idleConns        *prometheus.Desc
+	totalConns       *prometheus.Desc
+	maxConns         *prometheus.Desc
+	acquires         *prometheus.Desc
+	acquireDuration  *prometheus.Desc
+	emptyAcquires    *prometheus.Desc
+	emptyAcquireWait *prometheus.Desc
+	canceledAcquires *prometheus.Desc
+}
+
+func newPoolCollector(pool *pgxpool.Pool) *poolCollector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "db_pool", name), help, nil, nil)
+	}
+	return &poolCollector{
+		pool:             pool,
+		acquiredConns:    desc("acquired_connections", "Number of connections currently in use."),
+		idleConns:        desc("idle_connections", "Number of idle connections."),
+		totalConns:       desc("total_connections", "Number of open connections."),
+		maxConns:         desc("max_connections", "Maximum size of the pool."),
+		acquires:         desc("acquires_total", "Total number of successful acquires."),
+		acquireDuration:  desc("acquire_duration_seconds_total", "Total time spent acquiring connections."),
+		emptyAcquires:    desc("empty_acquires_total", "Total number of acquires that waited for a connection."),
+		emptyAcquireWait: desc("empty_acquire_wait_seconds_total", "Total time spent waiting for a connection to be released."),
+		canceledAcquires: desc("canceled_acquires_total", "Total number of acquires canceled by the context."),
+	}
+}
+
+func (collector *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(collector, ch)
+}
+
+func (collector *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := collector.pool.Stat()
+	gauge := func(desc *prometheus.Desc, value float64) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, value)
+	}
+	counter := func(desc *prometheus.Desc, value float64) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.CounterValue, value)
+	}
+
+	gauge(collector.acquiredConns, float64(stat.AcquiredConns()))
+	gauge(collector.idleConns, float64(stat.IdleConns()))
+	gauge(collector.totalConns, float64(stat.TotalConns()))
+	gauge(collector.maxConns, float64(stat.MaxConns()))
+	counter(collector.acquires, float64(stat.AcquireCount()))
+	counter(collector.acquireDuration, stat.AcquireDuration().Seconds())
+	counter(collector.emptyAcquires, float64(stat.EmptyAcquireCount()))
+	counter(collector.emptyAcquireWait, stat.EmptyAcquireWaitTime().Seconds())
+	counter(collector.canceledAcquires, float64(stat.CanceledAcquireCount()))
+}
@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/textproto"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
+
+// UserIDMetadataKey 呼び出し元ユーザーのIDを受け渡すメタデータのキー。
+// 認証プロキシが利用者を認証した上で付与し、ProxySecretMetadataKey と一緒に送る
+const UserIDMetadataKey = "x-user-id"
+
+// ProxySecretMetadataKey 認証プロキシとの共有シークレット。一致しない場合は UserIDMetadataKey を受け付けない
+const ProxySecretMetadataKey = "x-proxy-secret"
+
+// IdempotencyKeyMetadataKey 作成系RPCの再送を判定するキーを受け渡すメタデータのキー
+const IdempotencyKeyMetadataKey = "idempotency-key"
+
+// IncomingHeaderMatcher X-User-Id、X-Proxy-Secret、Idempotency-Key、If-Matchヘッダーをgrpc-gatewayからgRPCメタデータへ転送する
+func IncomingHeaderMatcher() runtime.ServeMuxOption {
+	userIDHeader := textproto.CanonicalMIMEHeaderKey(UserIDMetadataKey)
+	proxySecretHeader := textproto.CanonicalMIMEHeaderKey(ProxySecretMetadataKey)
+	idempotencyKeyHeader := textproto.CanonicalMIMEHeaderKey(IdempotencyKeyMetadataKey)
+	ifMatchHeader := textproto.CanonicalMIMEHeaderKey(IfMatchMetadataKey)
+	return runtime.WithIncomingHeaderMatcher(func(key string) (string, bool) {
+		switch textproto.CanonicalMIMEHeaderKey(key) {
+		case userIDHeader:
+			return UserIDMetadataKey, true
+		case proxySecretHeader:
+			return ProxySecretMetadataKey, true
+		case idempotencyKeyHeader:
+			return IdempotencyKeyMetadataKey, true
+		case ifMatchHeader:
+			return IfMatchMetadataKey, true
+		}
+		return runtime.DefaultHeaderMatcher(key)
+	})
+}
+
+// validProxySecret 共有シークレットを一定時間で比較する。シークレットが未設定の場合は常に拒否する
+func validProxySecret(proxySecret, value string) bool {
+	return proxySecret != "" && subtle.ConstantTimeCompare([]byte(proxySecret), []byte(value)) == 1
+}
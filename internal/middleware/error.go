@@ -1,9 +1,14 @@
 package middleware
 
 import (
+	"errors"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 func fieldViolation(field string, err error) *errdetails.BadRequest_FieldViolation {
@@ -28,3 +33,22 @@ func invalidArgumentError(violations []*errdetails.BadRequest_FieldViolation) er
 func unauthenticatedError(err error) error {
 	return status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
 }
+
+// WriteHTTPError gatewayを経由しないHTTPハンドラーのエラーを、gatewayと同じ形式のJSONで返す。
+// runtime.HTTPStatusError の場合は指定したHTTPステータスを使う
+func WriteHTTPError(w http.ResponseWriter, err error) {
+	var statusErr *runtime.HTTPStatusError
+	httpStatus := 0
+	if errors.As(err, &statusErr) {
+		httpStatus = statusErr.HTTPStatus
+		err = statusErr.Err
+	}
+	st := status.Convert(err)
+	if httpStatus == 0 {
+		httpStatus = runtime.HTTPStatusFromCode(st.Code())
+	}
+	body, _ := protojson.Marshal(st.Proto())
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	_, _ = w.Write(body)
+}
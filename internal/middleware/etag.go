@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// IfMatchMetadataKey 更新時に期待する ETag を受け渡すメタデータのキー
+	IfMatchMetadataKey = "if-match"
+	// ETagMetadataKey レスポンスの ETag を返すヘッダーメタデータのキー
+	ETagMetadataKey = "etag"
+)
+
+// ReasonStaleVersion 古い ETag で更新しようとした場合の ErrorInfo の reason
+const ReasonStaleVersion = "STALE_VERSION"
+
+// OutgoingHeaderMatcher etagヘッダーメタデータをHTTPのETagヘッダーとして返す
+func OutgoingHeaderMatcher() runtime.ServeMuxOption {
+	return runtime.WithOutgoingHeaderMatcher(func(key string) (string, bool) {
+		if key == ETagMetadataKey {
+			return "ETag", true
+		}
+		return runtime.MetadataHeaderPrefix + key, true
+	})
+}
+
+// ErrorHandler 古い ETag による Aborted を 409 ではなく 412 Precondition Failed で返す
+func ErrorHandler() runtime.ServeMuxOption {
+	return runtime.WithErrorHandler(func(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+		if isStaleVersion(err) {
+			err = &runtime.HTTPStatusError{
+				HTTPStatus: http.StatusPreconditionFailed,
+				Err:        err,
+			}
+		}
+		runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
+	})
+}
+
+func isStaleVersion(err error) bool {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.Aborted {
+		return false
+	}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.GetReason() == ReasonStaleVersion {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"errors"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func withReason(t *testing.T, code codes.Code, reason string) error {
+	t.Helper()
+	st, err := status.New(code, "modified").WithDetails(&errdetails.ErrorInfo{Reason: reason, Domain: "prism"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return st.Err()
+}
+
+func TestIsStaleVersion(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"stale version", withReason(t, codes.Aborted, ReasonStaleVersion), true},
+		// 冪等キーの競合などの Aborted は 409 のまま
+		{"aborted without reason", status.Error(codes.Aborted, "in progress"), false},
+		{"other reason", withReason(t, codes.Aborted, "OTHER"), false},
+		{"other code", withReason(t, codes.FailedPrecondition, ReasonStaleVersion), false},
+		{"not a status", errors.New("boom"), false},
+		{"nil", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isStaleVersion(tt.err); got != tt.want {
+				t.Errorf("isStaleVersion() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
@@ -39,10 +39,11 @@ func GrpcLoggerAdapter() grpc.UnaryServerInterceptor {
 			}
 		}
 
-		logEvent.Msg(msg)
+		// トレースIDを付けるためにコンテキストを渡す
+		logEvent.Ctx(ctx).Msg(msg)
 	})
 
-	return logging.UnaryServerInterceptor(logger)
+	return logging.UnaryServerInterceptor(logger, logging.WithLogOnEvents(logging.FinishCall))
 }
 
 // HttpLoggerMiddleware grpc-gatewayのruntime.WithMiddlewaresで使用するHTTPログミドルウェア
@@ -0,0 +1,97 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/0utl1er-tech/prism-backend/internal/store"
+	"github.com/google/uuid"
+	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware/v2"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// OrganizationInterceptor 呼び出し元ユーザーの組織をコンテキストに設定し、以降のクエリをその組織に限定する。
+// ユーザーIDは proxySecret を知っている認証プロキシからのみ受け付ける。
+// queries は組織が決まる前にユーザーを検索するため、BYPASSRLS のロールで接続する
+func OrganizationInterceptor(queries db.Querier, proxySecret string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		userID, secret := userFromMetadata(ctx)
+		ctx, err := withUserOrganization(ctx, queries, proxySecret, userID, secret)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// OrganizationStreamInterceptor ストリーミングRPCに OrganizationInterceptor と同じ組織を設定する
+func OrganizationStreamInterceptor(queries db.Querier, proxySecret string) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		userID, secret := userFromMetadata(stream.Context())
+		ctx, err := withUserOrganization(stream.Context(), queries, proxySecret, userID, secret)
+		if err != nil {
+			return err
+		}
+		wrapped := grpcmiddleware.WrapServerStream(stream)
+		wrapped.WrappedContext = ctx
+		return handler(srv, wrapped)
+	}
+}
+
+// OrganizationHandler SSEのHTTPリクエストに OrganizationInterceptor と同じ組織を設定する
+func OrganizationHandler(queries db.Querier, proxySecret string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, err := withUserOrganization(r.Context(), queries, proxySecret,
+			r.Header.Get(UserIDMetadataKey), r.Header.Get(ProxySecretMetadataKey))
+		if err != nil {
+			WriteHTTPError(w, err)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func userFromMetadata(ctx context.Context) (userID, secret string) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", ""
+	}
+	if values := md.Get(UserIDMetadataKey); len(values) > 0 {
+		userID = values[0]
+	}
+	if values := md.Get(ProxySecretMetadataKey); len(values) > 0 {
+		secret = values[0]
+	}
+	return userID, secret
+}
+
+// withUserOrganization ユーザーIDがなければ組織を設定せずに返す。未認証の扱いは各RPCに任せる
+func withUserOrganization(ctx context.Context, queries db.Querier, proxySecret, value, secret string) (context.Context, error) {
+	if value == "" {
+		return ctx, nil
+	}
+	// クライアントが直接送ったユーザーIDで他のユーザーになりすませないようにする
+	if !validProxySecret(proxySecret, secret) {
+		return nil, status.Errorf(codes.Unauthenticated, "%s is accepted only from the authentication proxy", UserIDMetadataKey)
+	}
+	userID, err := uuid.Parse(value)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid %s: %s", UserIDMetadataKey, err)
+	}
+
+	// 組織が決まる前なのでユーザーの検索は行レベルセキュリティを迂回するロールの queries で行う
+	user, err := queries.AuthenticateUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.Unauthenticated, "unknown user %s", userID)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get user: %s", err)
+	}
+	return store.WithOrganization(ctx, user.OrganizationID), nil
+}
@@ -0,0 +1,104 @@
+package seed
+
+// prefecture 住所と市外局番の組み合わせ
+type prefecture struct {
+	name     string
+	areaCode string
+	cities   []string
+}
+
+var prefectures = []prefecture{
+	{"北海道", "011", []string{"札幌市中央区", "札幌市北区", "札幌市白石区"}},
+	{"宮城県", "022", []string{"仙台市青葉区", "仙台市宮城野区", "仙台市泉区"}},
+	{"埼玉県", "048", []string{"さいたま市大宮区", "さいたま市浦和区", "川口市"}},
+	{"千葉県", "043", []string{"千葉市中央区", "千葉市美浜区", "千葉市花見川区"}},
+	{"東京都", "03", []string{"千代田区", "中央区", "港区", "新宿区", "渋谷区", "品川区", "台東区"}},
+	{"神奈川県", "045", []string{"横浜市西区", "横浜市中区", "横浜市港北区"}},
+	{"新潟県", "025", []string{"新潟市中央区", "新潟市東区", "新潟市西区"}},
+	{"静岡県", "054", []string{"静岡市葵区", "静岡市駿河区", "静岡市清水区"}},
+	{"愛知県", "052", []string{"名古屋市中区", "名古屋市中村区", "名古屋市東区", "名古屋市千種区"}},
+	{"京都府", "075", []string{"京都市中京区", "京都市下京区", "京都市左京区"}},
+	{"大阪府", "06", []string{"大阪市北区", "大阪市中央区", "大阪市西区", "大阪市淀川区"}},
+	{"兵庫県", "078", []string{"神戸市中央区", "神戸市東灘区", "神戸市兵庫区"}},
+	{"岡山県", "086", []string{"岡山市北区", "岡山市中区", "岡山市南区"}},
+	{"広島県", "082", []string{"広島市中区", "広島市南区", "広島市西区"}},
+	{"福岡県", "092", []string{"福岡市博多区", "福岡市中央区", "福岡市東区"}},
+	{"熊本県", "096", []string{"熊本市中央区", "熊本市東区", "熊本市北区"}},
+	{"沖縄県", "098", []string{"那覇市", "浦添市", "宜野湾市"}},
+}
+
+var townNames = []string{
+	"本町", "栄町", "中央", "緑町", "旭町", "幸町", "錦町", "港町", "桜町", "新町", "東町", "西町", "南町", "北町", "大手町",
+}
+
+var buildingNames = []string{
+	"", "", "", "第一ビル", "中央ビル", "センタービル", "ビジネスタワー", "プラザビル", "駅前ビル",
+}
+
+var corporations = []string{
+	"株式会社", "株式会社", "株式会社", "有限会社", "合同会社", "一般社団法人",
+}
+
+var companyPrefixes = []string{
+	"山田", "日本", "東洋", "大和", "新興", "三和", "丸三", "平和", "中央", "富士", "朝日", "共栄", "太陽", "光", "北斗",
+	"みどり", "あおば", "さくら", "ひかり", "アーク", "ネクスト", "グローバル", "テクノ", "サン",
+}
+
+// companySuffixes 業種と社名の末尾の組み合わせ
+var companySuffixes = map[string][]string{
+	"IT・通信": {"システム", "ソフト", "ネットワークス", "データ", "ソリューションズ"},
+	"製造":    {"製作所", "工業", "精機", "化学", "電機"},
+	"建設":    {"建設", "工務店", "設備", "土木", "ハウス"},
+	"不動産":   {"不動産", "地所", "エステート", "住宅販売", "リアルティ"},
+	"小売":    {"商店", "商事", "ストア", "販売", "物産"},
+	"飲食":    {"フーズ", "食品", "キッチン", "ダイニング", "食堂"},
+	"医療・福祉": {"メディカル", "ケアサービス", "クリニック", "介護センター", "薬局"},
+	"士業":    {"会計事務所", "法律事務所", "税理士事務所", "社労士事務所", "行政書士事務所"},
+	"運輸・物流": {"運輸", "物流", "運送", "ロジスティクス", "倉庫"},
+	"教育":    {"学院", "教育センター", "スクール", "ゼミナール", "アカデミー"},
+}
+
+var categoryNames = []string{
+	"IT・通信", "製造", "建設", "不動産", "小売", "飲食", "医療・福祉", "士業", "運輸・物流", "教育",
+}
+
+var familyNames = []string{
+	"佐藤", "鈴木", "高橋", "田中", "伊藤", "渡辺", "山本", "中村", "小林", "加藤",
+	"吉田", "山田", "佐々木", "山口", "松本", "井上", "木村", "林", "斎藤", "清水",
+	"山崎", "森", "池田", "橋本", "阿部", "石川", "山下", "中島", "石井", "小川",
+}
+
+var maleGivenNames = []string{
+	"蓮", "大翔", "悠真", "湊", "健太", "翔太", "大輔", "拓也", "直樹", "誠", "浩", "隆", "修", "和也", "剛",
+}
+
+var femaleGivenNames = []string{
+	"陽葵", "結衣", "さくら", "美咲", "彩", "愛", "優子", "恵", "裕子", "真由美", "明美", "由美", "陽子", "舞", "千尋",
+}
+
+// statusSeeds 架電結果のステータスと出現の重み
+var statusSeeds = []struct {
+	name      string
+	effective bool
+	ng        bool
+	dnc       bool
+	weight    int
+}{
+	{"不在", false, false, false, 30},
+	{"留守電", false, false, false, 15},
+	{"担当者不在", true, false, false, 20},
+	{"資料送付", true, false, false, 10},
+	{"見込み", true, false, false, 8},
+	{"アポ獲得", true, false, false, 4},
+	{"NG", true, true, false, 10},
+	{"架電禁止", true, true, true, 3},
+}
+
+var bookNames = []string{
+	"新規開拓リスト", "休眠顧客リスト", "展示会名刺リスト", "資料請求リスト", "セミナー参加者リスト",
+}
+
+var memos = []string{
+	"", "", "", "", "月末は繁忙のため避ける", "午前中の方がつながりやすい", "代表番号から担当者につないでもらう",
+	"前回は資料送付で終了", "決裁者は社長", "来期の予算で検討予定",
+}
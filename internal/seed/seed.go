@@ -0,0 +1,345 @@
+// Package seed 開発用のデータを既存の Create* クエリで生成する
+package seed
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/0utl1er-tech/prism-backend/internal/calendar"
+	"github.com/0utl1er-tech/prism-backend/internal/store"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Config 生成する件数。同じ Seed からは同じIDと内容のデータを生成する
+type Config struct {
+	Seed             uint64
+	OrganizationName string
+	Users            int
+	Books            int
+	CustomersPerBook int
+	// MaxCallsPerCustomer 顧客ごとに0件からこの件数までの架電履歴を作る
+	MaxCallsPerCustomer int
+	// RedialRate 再架電を予約する顧客の割合 (0〜1)
+	RedialRate float64
+}
+
+// Result 生成したデータ。OwnerID を X-User-Id に指定すると生成した組織のデータを参照できる
+type Result struct {
+	OrganizationID uuid.UUID
+	OwnerID        uuid.UUID
+	Books          int
+	Customers      int
+	Calls          int
+	Redials        int
+}
+
+type generator struct {
+	rand    *rand.Rand
+	ids     *rand.ChaCha8
+	now     time.Time
+	config  Config
+	users   []db.User
+	status  []db.Status
+	weights int
+}
+
+// Run 新しい組織を作成し、その組織にユーザー、ステータス、業種、顧客リスト、顧客、架電履歴、再架電を生成する。
+// 再架電の予定日時は実行日を基準にする。組織とユーザーは systemStore、それ以外は dbStore で作成する
+func Run(ctx context.Context, dbStore, systemStore store.Store, config Config) (Result, error) {
+	var key [32]byte
+	binary.LittleEndian.PutUint64(key[:], config.Seed)
+	ids := rand.NewChaCha8(key)
+	gen := &generator{
+		rand:   rand.New(rand.NewPCG(config.Seed, config.Seed^0x5eed)),
+		ids:    ids,
+		now:    time.Now(),
+		config: config,
+	}
+
+	var result Result
+	// 変更履歴に生成したデータであることを残す
+	ctx = store.WithAudit(ctx, store.Audit{RPC: "cli.seed"})
+
+	// 組織とユーザーは作成先の組織が決まる前に作るため行レベルセキュリティを迂回する
+	err := systemStore.ExecTx(ctx, func(q *db.Queries) error {
+		organization, err := q.CreateOrganization(ctx, db.CreateOrganizationParams{
+			ID:   gen.uuid(),
+			Name: config.OrganizationName,
+		})
+		if err != nil {
+			return err
+		}
+		result.OrganizationID = organization.ID
+
+		for i := 0; i < max(config.Users, 1); i++ {
+			role := db.RoleEditor
+			if i == 0 {
+				role = db.RoleOwner
+			}
+			family, given, _ := gen.personName()
+			user, err := q.CreateOrganizationUser(ctx, db.CreateOrganizationUserParams{
+				ID:             gen.uuid(),
+				Email:          fmt.Sprintf("user%02d@example.com", i+1),
+				Name:           family + " " + given,
+				Role:           role,
+				OrganizationID: organization.ID,
+			})
+			if err != nil {
+				return err
+			}
+			gen.users = append(gen.users, user)
+		}
+		result.OwnerID = gen.users[0].ID
+		return nil
+	})
+	if err != nil {
+		return result, err
+	}
+
+	ctx = store.WithOrganization(ctx, result.OrganizationID)
+	var categories []db.Category
+	err = dbStore.ExecTx(ctx, func(q *db.Queries) error {
+		for _, s := range statusSeeds {
+			status, err := q.CreateStatus(ctx, db.CreateStatusParams{
+				ID:        gen.uuid(),
+				Name:      s.name,
+				Effective: pgtype.Bool{Bool: s.effective, Valid: true},
+				Ng:        pgtype.Bool{Bool: s.ng, Valid: true},
+				Dnc:       s.dnc,
+			})
+			if err != nil {
+				return err
+			}
+			gen.status = append(gen.status, status)
+			gen.weights += s.weight
+		}
+
+		for _, name := range categoryNames {
+			category, err := q.CreateCategory(ctx, db.CreateCategoryParams{
+				ID:   gen.uuid(),
+				Name: name,
+			})
+			if err != nil {
+				return err
+			}
+			categories = append(categories, category)
+		}
+		return nil
+	})
+	if err != nil {
+		return result, err
+	}
+
+	// 顧客リストごとにトランザクションを分け、件数が多くても長時間ロックしないようにする
+	for i := 0; i < config.Books; i++ {
+		err = dbStore.ExecTx(ctx, func(q *db.Queries) error {
+			name := bookNames[i%len(bookNames)]
+			if i >= len(bookNames) {
+				name = fmt.Sprintf("%s %d", name, i/len(bookNames)+1)
+			}
+			book, err := q.CreateBook(ctx, db.CreateBookParams{
+				ID:   gen.uuid(),
+				Name: name,
+			})
+			if err != nil {
+				return err
+			}
+
+			for j := 0; j < config.CustomersPerBook; j++ {
+				calls, redialed, err := gen.customer(ctx, q, book.ID, categories)
+				if err != nil {
+					return err
+				}
+				result.Customers++
+				result.Calls += calls
+				if redialed {
+					result.Redials++
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return result, err
+		}
+		result.Books++
+	}
+	return result, nil
+}
+
+// customer 顧客と代表者・担当者、連絡先、架電履歴、再架電を作成する
+func (gen *generator) customer(ctx context.Context, q *db.Queries, bookID uuid.UUID, categories []db.Category) (int, bool, error) {
+	category := categories[gen.rand.IntN(len(categories))]
+	pref := prefectures[gen.rand.IntN(len(prefectures))]
+	suffixes := companySuffixes[category.Name]
+	corporation := pick(gen.rand, corporations)
+	company := pick(gen.rand, companyPrefixes) + pick(gen.rand, suffixes)
+
+	leader, err := gen.staff(ctx, q)
+	if err != nil {
+		return 0, false, err
+	}
+	pic, err := gen.staff(ctx, q)
+	if err != nil {
+		return 0, false, err
+	}
+
+	customer, err := q.CreateCustomer(ctx, db.CreateCustomerParams{
+		ID:           gen.uuid(),
+		BookID:       bookID,
+		CategoryID:   pgtype.UUID{Bytes: category.ID, Valid: true},
+		Name:         corporation + company,
+		Corporation:  pgtype.Text{String: corporation, Valid: true},
+		Address:      pgtype.Text{String: gen.address(pref), Valid: true},
+		Leader:       pgtype.UUID{Bytes: leader.ID, Valid: true},
+		Pic:          pgtype.UUID{Bytes: pic.ID, Valid: true},
+		Memo:         pgtype.Text{String: pick(gen.rand, memos), Valid: true},
+		CustomFields: []byte("{}"),
+	})
+	if err != nil {
+		return 0, false, err
+	}
+
+	// 代表番号は GetCustomer で参照できるよう顧客と同じIDにする。担当者の直通番号は一部の顧客のみ
+	_, err = q.CreateContact(ctx, db.CreateContactParams{
+		ID:         customer.ID,
+		CustomerID: customer.ID,
+		Phone:      gen.phone(pref.areaCode),
+		Mail:       pgtype.Text{String: fmt.Sprintf("info@%s.example.jp", customer.ID.String()[:8]), Valid: true},
+		Fax:        pgtype.Text{String: gen.phone(pref.areaCode), Valid: gen.rand.IntN(2) == 0},
+	})
+	if err != nil {
+		return 0, false, err
+	}
+	if gen.rand.IntN(3) == 0 {
+		_, err = q.CreateContact(ctx, db.CreateContactParams{
+			ID:         gen.uuid(),
+			CustomerID: customer.ID,
+			StaffID:    pgtype.UUID{Bytes: pic.ID, Valid: true},
+			Phone:      gen.mobile(),
+		})
+		if err != nil {
+			return 0, false, err
+		}
+	}
+
+	calls := gen.rand.IntN(gen.config.MaxCallsPerCustomer + 1)
+	for k := 0; k < calls; k++ {
+		status := gen.pickStatus()
+		_, err = q.CreateCall(ctx, db.CreateCallParams{
+			ID:         gen.uuid(),
+			CustomerID: customer.ID,
+			UserID:     pick(gen.rand, gen.users).ID,
+			StatusID:   pgtype.UUID{Bytes: status.ID, Valid: true},
+		})
+		if err != nil {
+			return 0, false, err
+		}
+	}
+
+	if gen.rand.Float64() >= gen.config.RedialRate {
+		return calls, false, nil
+	}
+	// 再架電は顧客と同じIDで登録する
+	_, err = q.CreateRedial(ctx, db.CreateRedialParams{
+		ID:          customer.ID,
+		UserID:      pick(gen.rand, gen.users).ID,
+		ScheduledAt: gen.businessHour(),
+	})
+	if err != nil {
+		return 0, false, err
+	}
+	return calls, true, nil
+}
+
+func (gen *generator) staff(ctx context.Context, q *db.Queries) (db.Staff, error) {
+	family, given, sex := gen.personName()
+	return q.CreateStaff(ctx, db.CreateStaffParams{
+		ID:   gen.uuid(),
+		Name: pgtype.Text{String: family + " " + given, Valid: true},
+		Sex:  pgtype.Text{String: sex, Valid: true},
+	})
+}
+
+func (gen *generator) uuid() uuid.UUID {
+	id, err := uuid.NewRandomFromReader(gen.ids)
+	if err != nil {
+		// ChaCha8 の Read はエラーを返さない
+		panic(err)
+	}
+	return id
+}
+
+func (gen *generator) personName() (string, string, string) {
+	family := pick(gen.rand, familyNames)
+	if gen.rand.IntN(2) == 0 {
+		return family, pick(gen.rand, maleGivenNames), "男性"
+	}
+	return family, pick(gen.rand, femaleGivenNames), "女性"
+}
+
+func (gen *generator) address(pref prefecture) string {
+	return fmt.Sprintf("%s%s%s%d-%d-%d%s",
+		pref.name,
+		pick(gen.rand, pref.cities),
+		pick(gen.rand, townNames),
+		gen.rand.IntN(5)+1,
+		gen.rand.IntN(20)+1,
+		gen.rand.IntN(30)+1,
+		pick(gen.rand, buildingNames),
+	)
+}
+
+// phone 市外局番と合わせて10桁になる固定電話の番号
+func (gen *generator) phone(areaCode string) string {
+	digits := 6 - len(areaCode)
+	lower := pow10(digits - 1)
+	return fmt.Sprintf("%s-%d-%04d", areaCode, lower+gen.rand.IntN(9*lower), gen.rand.IntN(10000))
+}
+
+func (gen *generator) mobile() string {
+	prefix := []string{"070", "080", "090"}[gen.rand.IntN(3)]
+	return fmt.Sprintf("%s-%04d-%04d", prefix, gen.rand.IntN(10000), gen.rand.IntN(10000))
+}
+
+func (gen *generator) pickStatus() db.Status {
+	n := gen.rand.IntN(gen.weights)
+	for i, s := range statusSeeds {
+		if n < s.weight {
+			return gen.status[i]
+		}
+		n -= s.weight
+	}
+	return gen.status[len(gen.status)-1]
+}
+
+// businessHour 2週間以内の平日 10時〜17時前
+func (gen *generator) businessHour() time.Time {
+	day := gen.now.In(calendar.JST)
+	for {
+		day = day.AddDate(0, 0, gen.rand.IntN(14)+1)
+		_, holiday := calendar.HolidayName(day)
+		if day.Weekday() != time.Saturday && day.Weekday() != time.Sunday && !holiday {
+			break
+		}
+		day = gen.now.In(calendar.JST)
+	}
+	y, m, d := day.Date()
+	return time.Date(y, m, d, 10+gen.rand.IntN(7), gen.rand.IntN(4)*15, 0, 0, calendar.JST)
+}
+
+func pick[T any](r *rand.Rand, values []T) T {
+	return values[r.IntN(len(values))]
+}
+
+func pow10(n int) int {
+	v := 1
+	for range n {
+		v *= 10
+	}
+	return v
+}
@@ -0,0 +1,62 @@
+package seed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/0utl1er-tech/prism-backend/internal/dbtest"
+	"github.com/0utl1er-tech/prism-backend/internal/store"
+)
+
+func TestRun(t *testing.T) {
+	dbStore, systemStore := dbtest.Open(t)
+	ctx := context.Background()
+
+	config := Config{
+		// 同じデータベースで繰り返し実行してもIDが重複しないようにする
+		Seed:                uint64(time.Now().UnixNano()),
+		OrganizationName:    "seed test",
+		Users:               2,
+		Books:               1,
+		CustomersPerBook:    10,
+		MaxCallsPerCustomer: 2,
+		RedialRate:          0.5,
+	}
+	result, err := Run(ctx, dbStore, systemStore, config)
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if result.Books != 1 || result.Customers != 10 {
+		t.Fatalf("Run() = %+v, want 1 book and 10 customers", result)
+	}
+
+	ctx = store.WithOrganization(ctx, result.OrganizationID)
+	bookIDs, err := dbStore.ListBookIDs(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bookIDs) != 1 {
+		t.Fatalf("ListBookIDs() returned %d books, want 1", len(bookIDs))
+	}
+	customers, err := dbStore.GetCustomerByBookId(ctx, db.GetCustomerByBookIdParams{
+		BookID:   bookIDs[0],
+		RowLimit: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(customers) != 1 {
+		t.Fatalf("GetCustomerByBookId() returned %d customers, want 1", len(customers))
+	}
+
+	// 担当者と代表番号が参照できること
+	customer, err := dbStore.GetCustomer(ctx, customers[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !customer.ContactID.Valid || !customer.CustomerPic.Valid {
+		t.Errorf("GetCustomer() = %+v, want contact and pic", customer)
+	}
+}
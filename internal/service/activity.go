@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	activityv1 "github.com/0utl1er-tech/prism-backend/gen/pb/activity/v1"
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/0utl1er-tech/prism-backend/internal/activity"
+	"github.com/0utl1er-tech/prism-backend/internal/middleware"
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SSE の接続を維持するためのコメントを送る間隔
+const sseHeartbeatInterval = 15 * time.Second
+
+type ActivityService struct {
+	activityv1.UnimplementedActivityServiceServer
+	queries db.Querier
+	hub     *activity.Hub
+}
+
+func NewActivityService(queries db.Querier, hub *activity.Hub) *ActivityService {
+	return &ActivityService{
+		queries: queries,
+		hub:     hub,
+	}
+}
+
+func (server *ActivityService) WatchActivity(req *activityv1.WatchActivityRequest, stream activityv1.ActivityService_WatchActivityServer) error {
+	ctx := stream.Context()
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return err
+	}
+	bookIDs, err := server.watchBookIDs(ctx, userID, req.GetBookIds())
+	if err != nil {
+		return err
+	}
+
+	sub := server.hub.Subscribe(bookIDs)
+	defer server.hub.Unsubscribe(sub)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event := <-sub.C:
+			err := stream.Send(toActivityEventPb(event))
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// ServeHTTP WatchActivity と同じイベントを Server-Sent Events で配信する
+func (server *ActivityService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		middleware.WriteHTTPError(w, &runtime.HTTPStatusError{
+			HTTPStatus: http.StatusMethodNotAllowed,
+			Err:        status.Error(codes.Unimplemented, "method not allowed"),
+		})
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		middleware.WriteHTTPError(w, status.Error(codes.Internal, "streaming unsupported"))
+		return
+	}
+
+	ctx := metadata.NewIncomingContext(r.Context(), metadata.Pairs(
+		middleware.UserIDMetadataKey, r.Header.Get(middleware.UserIDMetadataKey),
+	))
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		middleware.WriteHTTPError(w, err)
+		return
+	}
+	bookIDs, err := server.watchBookIDs(ctx, userID, r.URL.Query()["book_id"])
+	if err != nil {
+		middleware.WriteHTTPError(w, err)
+		return
+	}
+
+	sub := server.hub.Subscribe(bookIDs)
+	defer server.hub.Unsubscribe(sub)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	marshaler := protojson.MarshalOptions{UseProtoNames: true}
+	heartbeat := time.NewTicker(sseHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-heartbeat.C:
+			fmt.Fprint(w, ": ping\n\n")
+		case event := <-sub.C:
+			data, err := marshaler.Marshal(toActivityEventPb(event))
+			if err != nil {
+				continue
+			}
+			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, data)
+		}
+		flusher.Flush()
+	}
+}
+
+// watchBookIDs 購読する顧客リストを閲覧できる範囲に絞り込む
+func (server *ActivityService) watchBookIDs(ctx context.Context, userID uuid.UUID, requested []string) ([]uuid.UUID, error) {
+	visible, err := visibleBookIDs(ctx, server.queries, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(requested) == 0 {
+		return visible, nil
+	}
+
+	visibleSet := make(map[uuid.UUID]struct{}, len(visible))
+	for _, id := range visible {
+		visibleSet[id] = struct{}{}
+	}
+
+	bookIDs := make([]uuid.UUID, 0, len(requested))
+	for _, value := range requested {
+		id, err := parseUUID("book_ids", value)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := visibleSet[id]; !ok {
+			return nil, status.Errorf(codes.PermissionDenied, "book %s is not visible", id)
+		}
+		bookIDs = append(bookIDs, id)
+	}
+	return bookIDs, nil
+}
+
+// visibleBookIDs ユーザーが閲覧できる顧客リスト。顧客リスト単位の権限はまだないため所属する組織の全件を返す
+func visibleBookIDs(ctx context.Context, queries db.Querier, _ uuid.UUID) ([]uuid.UUID, error) {
+	return queries.ListBookIDs(ctx)
+}
+
+var activityEventTypeToPb = map[activity.EventType]activityv1.EventType{
+	activity.EventCallLogged:      activityv1.EventType_EVENT_TYPE_CALL_LOGGED,
+	activity.EventCustomerCreated: activityv1.EventType_EVENT_TYPE_CUSTOMER_CREATED,
+	activity.EventCustomerUpdated: activityv1.EventType_EVENT_TYPE_CUSTOMER_UPDATED,
+	activity.EventRedialDue:       activityv1.EventType_EVENT_TYPE_REDIAL_DUE,
+}
+
+func toActivityEventPb(event activity.Event) *activityv1.ActivityEvent {
+	res := &activityv1.ActivityEvent{
+		Type:       activityEventTypeToPb[event.Type],
+		BookId:     event.BookID.String(),
+		CustomerId: event.CustomerID.String(),
+		OccurredAt: timestamppb.New(event.OccurredAt),
+	}
+	if event.UserID != nil {
+		res.UserId = event.UserID.String()
+	}
+	if event.CallID != nil {
+		res.CallId = event.CallID.String()
+	}
+	if event.StatusID != nil {
+		res.StatusId = event.StatusID.String()
+	}
+	if event.RedialScheduledAt != nil {
+		res.RedialScheduledAt = timestamppb.New(*event.RedialScheduledAt)
+	}
+	return res
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+
+	auditv1 "github.com/0utl1er-tech/prism-backend/gen/pb/audit/v1"
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/0utl1er-tech/prism-backend/internal/store"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type AuditService struct {
+	auditv1.UnimplementedAuditServiceServer
+	queries db.Querier
+}
+
+func NewAuditService(queries db.Querier) *AuditService {
+	return &AuditService{
+		queries: queries,
+	}
+}
+
+func (server *AuditService) ListAuditEvents(ctx context.Context, req *auditv1.ListAuditEventsRequest) (*auditv1.ListAuditEventsResponse, error) {
+	limit, offset := pagination(req.GetPage(), req.GetLimit())
+	arg := db.ListAuditEventsParams{
+		EntityType: pgtype.Text{
+			String: req.GetEntityType(),
+			Valid:  req.EntityType != nil,
+		},
+		EntityID: pgtype.Text{
+			String: req.GetEntityId(),
+			Valid:  req.EntityId != nil,
+		},
+		RowLimit:  limit,
+		RowOffset: offset,
+	}
+	if req.UserId != nil {
+		userID, err := parseUUID("user_id", req.GetUserId())
+		if err != nil {
+			return nil, err
+		}
+		arg.ActorID = pgtype.UUID{Bytes: userID, Valid: true}
+	}
+	if req.GetFrom() != nil {
+		arg.FromTime = pgtype.Timestamptz{Time: req.GetFrom().AsTime(), Valid: true}
+	}
+	if req.GetTo() != nil {
+		arg.ToTime = pgtype.Timestamptz{Time: req.GetTo().AsTime(), Valid: true}
+	}
+
+	events, err := server.queries.ListAuditEvents(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+
+	eventsRes := make([]*auditv1.AuditEvent, len(events))
+	for i, event := range events {
+		eventsRes[i], err = toAuditEventPb(event)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &auditv1.ListAuditEventsResponse{
+		Events: eventsRes,
+		Page:   req.GetPage(),
+		Limit:  limit,
+	}, nil
+}
+
+// execAuditTx 呼び出し元ユーザーとRPCを変更履歴に記録するトランザクションを実行する
+func execAuditTx(ctx context.Context, store store.Store, fn func(*db.Queries) error) error {
+	return store.ExecTx(auditContext(ctx), fn)
+}
+
+func auditContext(ctx context.Context) context.Context {
+	audit := store.Audit{RPC: rpcMethod(ctx)}
+	if userID, err := currentUserID(ctx); err == nil {
+		audit.ActorID = userID
+	}
+	return store.WithAudit(ctx, audit)
+}
+
+// rpcMethod 呼び出されたRPCのフルメソッド名。grpc-gatewayもgRPCサーバーを経由する
+func rpcMethod(ctx context.Context) string {
+	method, _ := grpc.Method(ctx)
+	return method
+}
+
+var auditActionToPb = map[db.AuditAction]auditv1.AuditAction{
+	db.AuditActionInsert: auditv1.AuditAction_AUDIT_ACTION_INSERT,
+	db.AuditActionUpdate: auditv1.AuditAction_AUDIT_ACTION_UPDATE,
+	db.AuditActionDelete: auditv1.AuditAction_AUDIT_ACTION_DELETE,
+}
+
+func toAuditEventPb(event db.AuditEvent) (*auditv1.AuditEvent, error) {
+	before, err := jsonbToStruct(event.Before)
+	if err != nil {
+		return nil, err
+	}
+	after, err := jsonbToStruct(event.After)
+	if err != nil {
+		return nil, err
+	}
+
+	return &auditv1.AuditEvent{
+		Id:         event.ID.String(),
+		UserId:     uuidString(event.ActorID),
+		Rpc:        event.Rpc.String,
+		EntityType: event.EntityType,
+		EntityId:   event.EntityID,
+		Action:     auditActionToPb[event.Action],
+		Before:     before,
+		After:      after,
+		CreatedAt:  timestamppb.New(event.CreatedAt),
+	}, nil
+}
+
+func jsonbToStruct(data []byte) (*structpb.Struct, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	s := &structpb.Struct{}
+	err := protojson.Unmarshal(data, s)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+
+	"github.com/0utl1er-tech/prism-backend/internal/middleware"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// currentUserID メタデータから呼び出し元ユーザーのIDを取り出す
+func currentUserID(ctx context.Context) (uuid.UUID, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return uuid.Nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+
+	values := md.Get(middleware.UserIDMetadataKey)
+	if len(values) == 0 {
+		return uuid.Nil, status.Errorf(codes.Unauthenticated, "missing %s", middleware.UserIDMetadataKey)
+	}
+
+	userID, err := uuid.Parse(values[0])
+	if err != nil {
+		return uuid.Nil, status.Errorf(codes.Unauthenticated, "invalid %s: %s", middleware.UserIDMetadataKey, err)
+	}
+	return userID, nil
+}
@@ -0,0 +1,355 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	bookv1 "github.com/0utl1er-tech/prism-backend/gen/pb/book/v1"
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/0utl1er-tech/prism-backend/internal/calendar"
+	"github.com/0utl1er-tech/prism-backend/internal/store"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type BookService struct {
+	bookv1.UnimplementedBookServiceServer
+	store store.Store
+}
+
+func NewBookService(store store.Store) *BookService {
+	return &BookService{
+		store: store,
+	}
+}
+
+func (server *BookService) GetBook(ctx context.Context, req *bookv1.GetBookRequest) (*bookv1.GetBookResponse, error) {
+	bookID, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	book, err := server.store.GetBook(ctx, bookID)
+	if err != nil {
+		return nil, notFoundError(err, "book")
+	}
+
+	setETag(ctx, book.Version)
+	return &bookv1.GetBookResponse{
+		Books: []*bookv1.Book{toBookPb(book)},
+	}, nil
+}
+
+func (server *BookService) UpdateBook(ctx context.Context, req *bookv1.UpdateBookRequest) (*bookv1.UpdateBookResponse, error) {
+	bookID, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := expectedVersion(ctx, req.Etag)
+	if err != nil {
+		return nil, err
+	}
+
+	var book db.Book
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		var err error
+		book, err = q.UpdateBook(ctx, db.UpdateBookParams{
+			ID: bookID,
+			Name: pgtype.Text{
+				String: req.GetName(),
+				Valid:  req.Name != nil,
+			},
+			Version: version,
+		})
+		if errors.Is(err, pgx.ErrNoRows) && version.Valid {
+			// リストがあれば version が古い
+			if _, getErr := q.GetBook(ctx, bookID); getErr == nil {
+				return staleVersionError("book")
+			}
+		}
+		return notFoundError(err, "book")
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	setETag(ctx, book.Version)
+	return &bookv1.UpdateBookResponse{
+		Id:   book.ID.String(),
+		Name: book.Name,
+		Etag: formatETag(book.Version),
+	}, nil
+}
+
+func (server *BookService) DeleteBook(ctx context.Context, req *bookv1.DeleteBookRequest) (*bookv1.DeleteBookResponse, error) {
+	bookID, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		book, err := q.SoftDeleteBook(ctx, bookID)
+		if err != nil {
+			return err
+		}
+
+		// 顧客と連絡先にもリストと同じ削除日時を入れ、復元時に一緒に削除されたものだけ戻せるようにする
+		_, err = q.SoftDeleteContactsByBook(ctx, db.SoftDeleteContactsByBookParams{
+			DeletedAt: book.DeletedAt,
+			BookID:    book.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = q.SoftDeleteCustomersByBook(ctx, db.SoftDeleteCustomersByBookParams{
+			DeletedAt: book.DeletedAt,
+			BookID:    book.ID,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, notFoundError(err, "book")
+	}
+
+	return &bookv1.DeleteBookResponse{}, nil
+}
+
+func (server *BookService) RestoreBook(ctx context.Context, req *bookv1.RestoreBookRequest) (*bookv1.RestoreBookResponse, error) {
+	bookID, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		book      db.Book
+		customers int64
+	)
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		deleted, err := q.GetDeletedBook(ctx, bookID)
+		if err != nil {
+			return err
+		}
+
+		book, err = q.RestoreBook(ctx, bookID)
+		if err != nil {
+			return err
+		}
+
+		customers, err = q.RestoreCustomersByBook(ctx, db.RestoreCustomersByBookParams{
+			BookID:    bookID,
+			DeletedAt: deleted.DeletedAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = q.RestoreContactsByBook(ctx, db.RestoreContactsByBookParams{
+			BookID:    bookID,
+			DeletedAt: deleted.DeletedAt,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, notFoundError(err, "deleted book")
+	}
+
+	return &bookv1.RestoreBookResponse{
+		Book:              toBookPb(book),
+		RestoredCustomers: customers,
+	}, nil
+}
+
+func (server *BookService) GetCallingHours(ctx context.Context, req *bookv1.GetCallingHoursRequest) (*bookv1.GetCallingHoursResponse, error) {
+	bookID, err := parseUUID("book_id", req.GetBookId())
+	if err != nil {
+		return nil, err
+	}
+
+	book, err := server.store.GetBook(ctx, bookID)
+	if err != nil {
+		return nil, notFoundError(err, "book")
+	}
+
+	windows, err := server.store.ListCallingWindows(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bookv1.GetCallingHoursResponse{
+		CallingHours: toCallingHoursPb(book, windows),
+	}, nil
+}
+
+func (server *BookService) UpdateCallingHours(ctx context.Context, req *bookv1.UpdateCallingHoursRequest) (*bookv1.UpdateCallingHoursResponse, error) {
+	bookID, err := parseUUID("book_id", req.GetBookId())
+	if err != nil {
+		return nil, err
+	}
+
+	hours := req.GetCallingHours()
+	if hours.GetTimezone() != "" {
+		if _, err := time.LoadLocation(hours.GetTimezone()); err != nil {
+			return nil, invalidArgumentError("calling_hours.timezone", err.Error())
+		}
+	}
+
+	windows := make([]calendar.Window, len(hours.GetWindows()))
+	for i, w := range hours.GetWindows() {
+		window, err := parseCallingWindow(w)
+		if err != nil {
+			return nil, invalidArgumentError(fmt.Sprintf("calling_hours.windows[%d]", i), err.Error())
+		}
+		windows[i] = window
+	}
+
+	var (
+		book    db.Book
+		created []db.CallingWindow
+	)
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		var err error
+		book, err = q.UpdateBook(ctx, db.UpdateBookParams{
+			ID: bookID,
+			Timezone: pgtype.Text{
+				String: hours.GetTimezone(),
+				Valid:  hours.GetTimezone() != "",
+			},
+			SkipHolidays: pgtype.Bool{
+				Bool:  hours.GetSkipHolidays(),
+				Valid: true,
+			},
+		})
+		if err != nil {
+			return notFoundError(err, "book")
+		}
+
+		err = q.DeleteCallingWindows(ctx, bookID)
+		if err != nil {
+			return err
+		}
+
+		created = make([]db.CallingWindow, len(windows))
+		for i, w := range windows {
+			created[i], err = q.CreateCallingWindow(ctx, db.CreateCallingWindowParams{
+				ID:        uuid.New(),
+				BookID:    bookID,
+				Weekday:   int16(w.Weekday),
+				StartTime: pgtype.Time{Microseconds: w.Start.Microseconds(), Valid: true},
+				EndTime:   pgtype.Time{Microseconds: w.End.Microseconds(), Valid: true},
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &bookv1.UpdateCallingHoursResponse{
+		CallingHours: toCallingHoursPb(book, created),
+	}, nil
+}
+
+// bookSchedule 顧客リストの架電可能時間帯を読み込む
+func bookSchedule(ctx context.Context, q db.Querier, book db.Book) (calendar.Schedule, error) {
+	location, err := time.LoadLocation(book.Timezone)
+	if err != nil {
+		return calendar.Schedule{}, err
+	}
+
+	rows, err := q.ListCallingWindows(ctx, book.ID)
+	if err != nil {
+		return calendar.Schedule{}, err
+	}
+
+	windows := calendar.DefaultWindows()
+	if len(rows) > 0 {
+		windows = make([]calendar.Window, len(rows))
+		for i, row := range rows {
+			windows[i] = toCalendarWindow(row)
+		}
+	}
+
+	return calendar.Schedule{
+		Location:     location,
+		Windows:      windows,
+		SkipHolidays: book.SkipHolidays,
+	}, nil
+}
+
+func parseCallingWindow(w *bookv1.CallingWindow) (calendar.Window, error) {
+	start, err := parseClock(w.GetStartTime())
+	if err != nil {
+		return calendar.Window{}, err
+	}
+	end, err := parseClock(w.GetEndTime())
+	if err != nil {
+		return calendar.Window{}, err
+	}
+
+	window := calendar.Window{
+		Weekday: time.Weekday(w.GetWeekday()),
+		Start:   start,
+		End:     end,
+	}
+	return window, window.Validate()
+}
+
+// parseClock HH:MM 形式の時刻を0時からの経過時間にする。24:00 も受け付ける
+func parseClock(value string) (time.Duration, error) {
+	var hour, minute int
+	if _, err := fmt.Sscanf(value, "%d:%d", &hour, &minute); err != nil {
+		return 0, fmt.Errorf("invalid time %q: expected HH:MM", value)
+	}
+	if hour < 0 || hour > 24 || minute < 0 || minute > 59 || (hour == 24 && minute != 0) {
+		return 0, fmt.Errorf("invalid time %q", value)
+	}
+	return time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute, nil
+}
+
+func formatClock(d time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(d.Hours()), int(d.Minutes())%60)
+}
+
+func toCalendarWindow(row db.CallingWindow) calendar.Window {
+	return calendar.Window{
+		Weekday: time.Weekday(row.Weekday),
+		Start:   time.Duration(row.StartTime.Microseconds) * time.Microsecond,
+		End:     time.Duration(row.EndTime.Microseconds) * time.Microsecond,
+	}
+}
+
+func toBookPb(book db.Book) *bookv1.Book {
+	return &bookv1.Book{
+		Id:           book.ID.String(),
+		Name:         book.Name,
+		Timezone:     book.Timezone,
+		SkipHolidays: book.SkipHolidays,
+		Etag:         formatETag(book.Version),
+	}
+}
+
+func toCallingHoursPb(book db.Book, rows []db.CallingWindow) *bookv1.CallingHours {
+	windows := make([]*bookv1.CallingWindow, len(rows))
+	for i, row := range rows {
+		w := toCalendarWindow(row)
+		windows[i] = &bookv1.CallingWindow{
+			Weekday:   int32(w.Weekday),
+			StartTime: formatClock(w.Start),
+			EndTime:   formatClock(w.End),
+		}
+	}
+
+	return &bookv1.CallingHours{
+		Timezone:     book.Timezone,
+		SkipHolidays: book.SkipHolidays,
+		Windows:      windows,
+	}
+}
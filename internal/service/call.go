@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+
+	callv1 "github.com/0utl1er-tech/prism-backend/gen/pb/call/v1"
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/0utl1er-tech/prism-backend/internal/store"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type CallService struct {
+	callv1.UnimplementedCallServiceServer
+	store       store.Store
+	idempotency *Idempotency
+}
+
+func NewCallService(store store.Store, idempotency *Idempotency) *CallService {
+	return &CallService{
+		store:       store,
+		idempotency: idempotency,
+	}
+}
+
+func (server *CallService) LogCall(ctx context.Context, req *callv1.LogCallRequest) (*callv1.LogCallResponse, error) {
+	return idempotent(ctx, server.idempotency, req, func(ctx context.Context) (*callv1.LogCallResponse, error) {
+		return server.logCall(ctx, req)
+	})
+}
+
+func (server *CallService) logCall(ctx context.Context, req *callv1.LogCallRequest) (*callv1.LogCallResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	customerID, err := parseUUID("customer_id", req.GetCustomerId())
+	if err != nil {
+		return nil, err
+	}
+	var statusID pgtype.UUID
+	if req.StatusId != nil {
+		id, err := parseUUID("status_id", req.GetStatusId())
+		if err != nil {
+			return nil, err
+		}
+		statusID = pgtype.UUID{Bytes: id, Valid: true}
+	}
+
+	var (
+		call          db.Call
+		dncRegistered int64
+	)
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		var err error
+		call, err = q.CreateCall(ctx, db.CreateCallParams{
+			ID:         uuid.New(),
+			CustomerID: customerID,
+			UserID:     userID,
+			StatusID:   statusID,
+		})
+		if err != nil {
+			return notFoundError(err, "customer")
+		}
+
+		// 架電が終わったので払い出しを返却する
+		_, err = q.ReleaseDialLease(ctx, db.ReleaseDialLeaseParams{
+			CustomerID: customerID,
+			UserID:     userID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if !statusID.Valid {
+			return nil
+		}
+		callStatus, err := q.GetStatus(ctx, statusID.Bytes)
+		if err != nil {
+			return err
+		}
+		if !callStatus.Ng.Bool || !callStatus.Dnc {
+			return nil
+		}
+		dncRegistered, err = q.CreateDoNotCallFromCall(ctx, db.CreateDoNotCallFromCallParams{
+			Reason: pgtype.Text{
+				String: callStatus.Name,
+				Valid:  true,
+			},
+			UserID:     userID,
+			CallID:     call.ID,
+			CustomerID: customerID,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &callv1.LogCallResponse{
+		Call:          toCallPb(call),
+		DncRegistered: int32(dncRegistered),
+	}, nil
+}
+
+func (server *CallService) ListCallsByCustomer(ctx context.Context, req *callv1.ListCallsByCustomerRequest) (*callv1.ListCallsByCustomerResponse, error) {
+	customerID, err := parseUUID("customer_id", req.GetCustomerId())
+	if err != nil {
+		return nil, err
+	}
+
+	limit, offset := pagination(req.GetPage(), req.GetLimit())
+	calls, err := server.store.ListCallsByCustomer(ctx, db.ListCallsByCustomerParams{
+		CustomerID: customerID,
+		Limit:      limit,
+		Offset:     offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	callsRes := make([]*callv1.Call, len(calls))
+	for i, call := range calls {
+		callsRes[i] = toCallPb(call)
+	}
+
+	return &callv1.ListCallsByCustomerResponse{
+		Calls: callsRes,
+	}, nil
+}
+
+func toCallPb(call db.Call) *callv1.Call {
+	return &callv1.Call{
+		Id:         call.ID.String(),
+		CustomerId: call.CustomerID.String(),
+		UserId:     call.UserID.String(),
+		StatusId:   uuidString(call.StatusID),
+		CreatedAt:  timestamppb.New(call.CreatedAt),
+	}
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	contactv1 "github.com/0utl1er-tech/prism-backend/gen/pb/contact/v1"
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/0utl1er-tech/prism-backend/internal/store"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type ContactService struct {
+	contactv1.UnimplementedContactServiceServer
+	store store.Store
+}
+
+func NewContactService(store store.Store) *ContactService {
+	return &ContactService{
+		store: store,
+	}
+}
+
+func (server *ContactService) UpdateContact(ctx context.Context, req *contactv1.UpdateContactRequest) (*contactv1.UpdateContactResponse, error) {
+	contactID, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if req.Phone != nil && req.GetPhone() == "" {
+		return nil, invalidArgumentError("phone", "must not be empty")
+	}
+
+	version, err := expectedVersion(ctx, req.Etag)
+	if err != nil {
+		return nil, err
+	}
+
+	var contact db.Contact
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		current, err := q.GetContact(ctx, contactID)
+		if err != nil {
+			return notFoundError(err, "contact")
+		}
+		if version.Valid && current.Version != version.Int64 {
+			return staleVersionError("contact")
+		}
+
+		contact, err = q.UpdateContact(ctx, db.UpdateContactParams{
+			ID:      contactID,
+			Version: version,
+			Phone: pgtype.Text{
+				String: req.GetPhone(),
+				Valid:  req.Phone != nil,
+			},
+			Mail: pgtype.Text{
+				String: req.GetMail(),
+				Valid:  req.Mail != nil,
+			},
+			Fax: pgtype.Text{
+				String: req.GetFax(),
+				Valid:  req.Fax != nil,
+			},
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			// 取得してから更新するまでの間に他の更新が行われた
+			return staleVersionError("contact")
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	setETag(ctx, contact.Version)
+	return &contactv1.UpdateContactResponse{
+		Contact: &contactv1.Contact{
+			Id:    contact.ID.String(),
+			Phone: contact.Phone,
+			Mail:  contact.Mail.String,
+			Fax:   contact.Fax.String,
+			Etag:  formatETag(contact.Version),
+		},
+	}, nil
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	customerv1 "github.com/0utl1er-tech/prism-backend/gen/pb/customer/v1"
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// parseUUID リクエストのIDを検証して変換する
+func parseUUID(field, value string) (uuid.UUID, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid %s: %s", field, err)
+	}
+	return id, nil
+}
+
+// uuidString NULL許容のUUIDを文字列にする。NULLの場合は空文字
+func uuidString(id pgtype.UUID) string {
+	if !id.Valid {
+		return ""
+	}
+	return uuid.UUID(id.Bytes).String()
+}
+
+const (
+	defaultPageLimit = 50
+	maxPageLimit     = 500
+)
+
+// pagination 1始まりのページ番号と件数から LIMIT と OFFSET を求める
+func pagination(page, limit int32) (int32, int32) {
+	if limit <= 0 {
+		limit = defaultPageLimit
+	}
+	if limit > maxPageLimit {
+		limit = maxPageLimit
+	}
+	if page <= 0 {
+		page = 1
+	}
+	return limit, (page - 1) * limit
+}
+
+func toCustomerPb(customer db.Customer) *customerv1.Customer {
+	return &customerv1.Customer{
+		Id:           customer.ID.String(),
+		Name:         customer.Name,
+		Job:          customer.Job.String,
+		Corporation:  customer.Corporation.String,
+		Address:      customer.Address.String,
+		Memo:         customer.Memo.String,
+		CustomFields: customFieldsPb(customer.CustomFields),
+		Etag:         formatETag(customer.Version),
+	}
+}
@@ -2,34 +2,54 @@ package service
 
 import (
 	"context"
+	"errors"
+	"slices"
 
 	contactv1 "github.com/0utl1er-tech/prism-backend/gen/pb/contact/v1"
 	customerv1 "github.com/0utl1er-tech/prism-backend/gen/pb/customer/v1"
 	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/0utl1er-tech/prism-backend/internal/store"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type CustomerService struct {
 	customerv1.UnimplementedCustomerServiceServer
-	queries *db.Queries
+	store        store.Store
+	idempotency  *Idempotency
+	maxBatchSize int
 }
 
-func NewCustomerService(queries *db.Queries) *CustomerService {
+func NewCustomerService(store store.Store, idempotency *Idempotency, maxBatchSize int) *CustomerService {
 	return &CustomerService{
-		queries: queries,
+		store:        store,
+		idempotency:  idempotency,
+		maxBatchSize: maxBatchSize,
 	}
 }
 
 func (server *CustomerService) CreateCustomer(ctx context.Context, customer *customerv1.CreateCustomerRequest) (*customerv1.CreateCustomerResponse, error) {
+	return idempotent(ctx, server.idempotency, customer, func(ctx context.Context) (*customerv1.CreateCustomerResponse, error) {
+		return server.createCustomer(ctx, customer)
+	})
+}
+
+func (server *CustomerService) createCustomer(ctx context.Context, customer *customerv1.CreateCustomerRequest) (*customerv1.CreateCustomerResponse, error) {
 	customerId := uuid.New()
-	contactId := uuid.New()
 	leaderId := uuid.New()
 	picId := uuid.New()
 
+	bookId, err := parseUUID("book_id", customer.GetBookId())
+	if err != nil {
+		return nil, err
+	}
+
 	customerArg := db.CreateCustomerParams{
 		ID:     customerId,
-		BookID: uuid.MustParse(customer.GetBookId()),
+		BookID: bookId,
 		Name:   customer.GetName(),
 		Corporation: pgtype.Text{
 			String: customer.GetCorporation(),
@@ -39,10 +59,13 @@ func (server *CustomerService) CreateCustomer(ctx context.Context, customer *cus
 			String: customer.GetAddress(),
 			Valid:  customer.GetAddress() != "",
 		},
+		Leader: pgtype.UUID{Bytes: leaderId, Valid: true},
+		Pic:    pgtype.UUID{Bytes: picId, Valid: true},
 	}
 
+	// 代表番号は GetCustomer で参照できるよう顧客と同じIDにする
 	contactArg := db.CreateContactParams{
-		ID:         contactId,
+		ID:         customerId,
 		CustomerID: customerId,
 		Phone:      customer.GetContact().GetPhone(),
 		Mail: pgtype.Text{
@@ -75,63 +98,66 @@ func (server *CustomerService) CreateCustomer(ctx context.Context, customer *cus
 		},
 	}
 
-	// db.Queriesをポインタとして使用
-	customerRes, err := server.queries.CreateCustomer(ctx, customerArg)
-	if err != nil {
-		return nil, err
-	}
+	var customerRes db.Customer
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		fields, err := q.ListCustomFields(ctx, bookId)
+		if err != nil {
+			return err
+		}
+		customerArg.CustomFields, err = customFieldValues(fields, nil, customer.GetCustomFields())
+		if err != nil {
+			return err
+		}
 
-	_, err = server.queries.CreateContact(ctx, contactArg)
-	if err != nil {
-		return nil, err
-	}
+		// 顧客が代表者と担当者を参照するため先に作成する
+		_, err = q.CreateStaff(ctx, leaderArg)
+		if err != nil {
+			return err
+		}
 
-	_, err = server.queries.CreateStaff(ctx, leaderArg)
-	if err != nil {
-		return nil, err
-	}
+		_, err = q.CreateStaff(ctx, picArg)
+		if err != nil {
+			return err
+		}
 
-	_, err = server.queries.CreateStaff(ctx, picArg)
+		// 他の組織のリストには作成されず行が返らない
+		customerRes, err = q.CreateCustomer(ctx, customerArg)
+		if err != nil {
+			return notFoundError(err, "book")
+		}
+
+		_, err = q.CreateContact(ctx, contactArg)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	// レスポンスを返す処理を追加
+	setETag(ctx, customerRes.Version)
 	return &customerv1.CreateCustomerResponse{
-		Id:          customerRes.ID.String(),
-		BookId:      customerRes.BookID.String(),
-		Name:        customerRes.Name,
-		Corporation: customerRes.Corporation.String,
-		Address:     customerRes.Address.String,
-		Memo:        customerRes.Memo.String,
+		Id:           customerRes.ID.String(),
+		BookId:       customerRes.BookID.String(),
+		Name:         customerRes.Name,
+		Corporation:  customerRes.Corporation.String,
+		Address:      customerRes.Address.String,
+		Memo:         customerRes.Memo.String,
+		CustomFields: customFieldsPb(customerRes.CustomFields),
+		Etag:         formatETag(customerRes.Version),
 	}, nil
 }
 
 func (server *CustomerService) SearchCustomer(ctx context.Context, customer *customerv1.SearchCustomerRequest) (*customerv1.SearchCustomerResponse, error) {
-	customerArg := db.SearchCustomerParams{
-		BookID: pgtype.UUID{
-			Bytes: uuid.MustParse(customer.GetBookId()),
-			Valid: customer.GetBookId() != "",
-		},
-		Name: pgtype.Text{
-			String: customer.GetName(),
-			Valid:  customer.GetName() != "",
-		},
-		Corporation: pgtype.Text{
-			String: customer.GetCorporation(),
-			Valid:  customer.GetCorporation() != "",
-		},
-		Address: pgtype.Text{
-			String: customer.GetAddress(),
-			Valid:  customer.GetAddress() != "",
-		},
-		Memo: pgtype.Text{
-			String: customer.GetMemo(),
-			Valid:  customer.GetMemo() != "",
-		},
+	customerArg, err := searchCustomerParams(ctx, server.store, customer)
+	if err != nil {
+		return nil, err
+	}
+
+	customers, err := server.store.SearchCustomer(ctx, customerArg)
+	if err != nil {
+		return nil, err
 	}
 
-	customers, err := server.queries.SearchCustomer(ctx, customerArg)
+	tags, err := customerTags(ctx, server.store, customerIDs(customers))
 	if err != nil {
 		return nil, err
 	}
@@ -139,11 +165,13 @@ func (server *CustomerService) SearchCustomer(ctx context.Context, customer *cus
 	customersRes := make([]*customerv1.Customer, len(customers))
 	for i, customer := range customers {
 		customersRes[i] = &customerv1.Customer{
-			Id:          customer.ID.String(),
-			Name:        customer.Name,
-			Corporation: customer.Corporation.String,
-			Address:     customer.Address.String,
-			Memo:        customer.Memo.String,
+			Id:           customer.ID.String(),
+			Name:         customer.Name,
+			Corporation:  customer.Corporation.String,
+			Address:      customer.Address.String,
+			Memo:         customer.Memo.String,
+			CustomFields: customFieldsPb(customer.CustomFields),
+			Tags:         tags[customer.ID],
 		}
 	}
 
@@ -153,61 +181,304 @@ func (server *CustomerService) SearchCustomer(ctx context.Context, customer *cus
 }
 
 func (server *CustomerService) GetCustomer(ctx context.Context, customer *customerv1.GetCustomerRequest) (*customerv1.GetCustomerResponse, error) {
-	customerId := uuid.MustParse(customer.GetId())
-	customerRes, err := server.queries.GetCustomer(
+	customerId, err := parseUUID("id", customer.GetId())
+	if err != nil {
+		return nil, err
+	}
+	customerRes, err := server.store.GetCustomer(
 		ctx,
 		customerId,
 	)
+	if err != nil {
+		return nil, notFoundError(err, "customer")
+	}
+
+	tags, err := customerTagNames(ctx, server.store, customerId)
 	if err != nil {
 		return nil, err
 	}
 
+	setETag(ctx, customerRes.CustomerVersion)
 	return &customerv1.GetCustomerResponse{
-		Id:          customerRes.CustomerID.String(),
-		Name:        customerRes.CustomerName,
-		Job:         customerRes.CustomerJob.String,
-		Corporation: customerRes.CustomerCorporation.String,
-		Address:     customerRes.CustomerAddress.String,
-		Phone:       customerRes.ContactPhone.String,
-		Mail:        customerRes.ContactMail.String,
-		Fax:         customerRes.ContactFax.String,
-		Memo:        customerRes.CustomerMemo.String,
+		Id:           customerRes.CustomerID.String(),
+		Name:         customerRes.CustomerName,
+		Job:          customerRes.CustomerJob.String,
+		Corporation:  customerRes.CustomerCorporation.String,
+		Address:      customerRes.CustomerAddress.String,
+		Phone:        customerRes.ContactPhone.String,
+		Mail:         customerRes.ContactMail.String,
+		Fax:          customerRes.ContactFax.String,
+		Memo:         customerRes.CustomerMemo.String,
+		CustomFields: customFieldsPb(customerRes.CustomerCustomFields),
+		Tags:         tags,
+		Etag:         formatETag(customerRes.CustomerVersion),
+		LeaderId:     uuidString(customerRes.CustomerLeader),
+		PicId:        uuidString(customerRes.CustomerPic),
 		Contact: &contactv1.Contact{
 			Id:    customerRes.ContactID.String(),
 			Phone: customerRes.ContactPhone.String,
 			Mail:  customerRes.ContactMail.String,
 			Fax:   customerRes.ContactFax.String,
+			Etag:  formatETag(customerRes.ContactVersion.Int64),
 		},
 	}, nil
 }
 
 func (server *CustomerService) GetCustomerByBookId(ctx context.Context, customer *customerv1.GetCustomerByBookIdRequest) (*customerv1.GetCustomerByBookIdResponse, error) {
-	bookId := uuid.MustParse(customer.GetBookId())
-	customers, err := server.queries.GetCustomerByBookId(
+	bookId, err := parseUUID("book_id", customer.GetBookId())
+	if err != nil {
+		return nil, err
+	}
+
+	limit, offset := pagination(customer.GetPage(), customer.GetLimit())
+	customers, err := server.store.GetCustomerByBookId(
 		ctx,
 		db.GetCustomerByBookIdParams{
-			BookID: bookId,
-			Limit:  int32(customer.GetLimit()),
-			Offset: int32(customer.GetPage()),
+			BookID:    bookId,
+			TagsAny:   customer.GetTagFilter().GetAny(),
+			TagsAll:   customer.GetTagFilter().GetAll(),
+			TagsNone:  customer.GetTagFilter().GetNone(),
+			RowLimit:  limit,
+			RowOffset: offset,
 		},
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	tags, err := customerTags(ctx, server.store, customerIDs(customers))
+	if err != nil {
+		return nil, err
+	}
+
 	customersRes := make([]*customerv1.Customer, len(customers))
 	for i, customer := range customers {
 		customersRes[i] = &customerv1.Customer{
-			Id:          customer.ID.String(),
-			Name:        customer.Name,
-			Job:         customer.Job.String,
-			Corporation: customer.Corporation.String,
-			Address:     customer.Address.String,
-			Memo:        customer.Memo.String,
+			Id:           customer.ID.String(),
+			Name:         customer.Name,
+			Job:          customer.Job.String,
+			Corporation:  customer.Corporation.String,
+			Address:      customer.Address.String,
+			Memo:         customer.Memo.String,
+			CustomFields: customFieldsPb(customer.CustomFields),
+			Tags:         tags[customer.ID],
 		}
 	}
 
 	return &customerv1.GetCustomerByBookIdResponse{
 		Customers: customersRes,
+		Page:      customer.GetPage(),
+		Limit:     limit,
 	}, nil
 }
+
+func (server *CustomerService) UpdateCustomer(ctx context.Context, req *customerv1.UpdateCustomerRequest) (*customerv1.UpdateCustomerResponse, error) {
+	customerID, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if req.Name != nil && req.GetName() == "" {
+		return nil, invalidArgumentError("name", "must not be empty")
+	}
+
+	version, err := expectedVersion(ctx, req.Etag)
+	if err != nil {
+		return nil, err
+	}
+
+	var customer db.Customer
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		current, err := q.GetCustomerByID(ctx, customerID)
+		if err != nil {
+			return notFoundError(err, "customer")
+		}
+		if version.Valid && current.Version != version.Int64 {
+			return staleVersionError("customer")
+		}
+
+		arg := db.UpdateCustomerParams{
+			ID:      customerID,
+			Version: version,
+			Name: pgtype.Text{
+				String: req.GetName(),
+				Valid:  req.Name != nil,
+			},
+			Corporation: pgtype.Text{
+				String: req.GetCorporation(),
+				Valid:  req.Corporation != nil,
+			},
+			Address: pgtype.Text{
+				String: req.GetAddress(),
+				Valid:  req.Address != nil,
+			},
+			Memo: pgtype.Text{
+				String: req.GetMemo(),
+				Valid:  req.Memo != nil,
+			},
+		}
+		if req.GetCustomFields() != nil {
+			fields, err := q.ListCustomFields(ctx, current.BookID)
+			if err != nil {
+				return err
+			}
+			arg.CustomFields, err = customFieldValues(fields, current.CustomFields, req.GetCustomFields())
+			if err != nil {
+				return err
+			}
+		}
+
+		customer, err = q.UpdateCustomer(ctx, arg)
+		if errors.Is(err, pgx.ErrNoRows) {
+			// 取得してから更新するまでの間に他の更新が行われた
+			return staleVersionError("customer")
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	setETag(ctx, customer.Version)
+	return &customerv1.UpdateCustomerResponse{
+		Customer: toCustomerPb(customer),
+	}, nil
+}
+
+func (server *CustomerService) DeleteCustomer(ctx context.Context, req *customerv1.DeleteCustomerRequest) (*customerv1.DeleteCustomerResponse, error) {
+	customerID, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		return softDeleteCustomer(ctx, q, customerID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &customerv1.DeleteCustomerResponse{}, nil
+}
+
+func (server *CustomerService) RestoreCustomer(ctx context.Context, req *customerv1.RestoreCustomerRequest) (*customerv1.RestoreCustomerResponse, error) {
+	customerID, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	var customer db.Customer
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		deleted, err := q.GetDeletedCustomer(ctx, customerID)
+		if err != nil {
+			return err
+		}
+
+		if _, err := q.GetBook(ctx, deleted.BookID); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return status.Error(codes.FailedPrecondition, "book of the customer is in the trash; restore the book first")
+			}
+			return err
+		}
+
+		customer, err = q.RestoreCustomer(ctx, customerID)
+		if err != nil {
+			return err
+		}
+
+		_, err = q.RestoreContactsByCustomer(ctx, db.RestoreContactsByCustomerParams{
+			CustomerID: customerID,
+			DeletedAt:  deleted.DeletedAt,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, notFoundError(err, "deleted customer")
+	}
+
+	return &customerv1.RestoreCustomerResponse{
+		Customer: toCustomerPb(customer),
+	}, nil
+}
+
+func customerIDs(customers []db.Customer) []uuid.UUID {
+	ids := make([]uuid.UUID, len(customers))
+	for i, customer := range customers {
+		ids[i] = customer.ID
+	}
+	return ids
+}
+
+// searchCustomerParams SearchCustomerRequest の条件を検証してクエリのパラメータにする
+func searchCustomerParams(ctx context.Context, q db.Querier, customer *customerv1.SearchCustomerRequest) (db.SearchCustomerParams, error) {
+	customerArg := db.SearchCustomerParams{
+		Name: pgtype.Text{
+			String: customer.GetName(),
+			Valid:  customer.GetName() != "",
+		},
+		Corporation: pgtype.Text{
+			String: customer.GetCorporation(),
+			Valid:  customer.GetCorporation() != "",
+		},
+		Address: pgtype.Text{
+			String: customer.GetAddress(),
+			Valid:  customer.GetAddress() != "",
+		},
+		Memo: pgtype.Text{
+			String: customer.GetMemo(),
+			Valid:  customer.GetMemo() != "",
+		},
+		SortDesc: customer.GetSortDesc(),
+		TagsAny:  customer.GetTagFilter().GetAny(),
+		TagsAll:  customer.GetTagFilter().GetAll(),
+		TagsNone: customer.GetTagFilter().GetNone(),
+	}
+
+	if customer.GetBookId() != "" {
+		bookId, err := parseUUID("book_id", customer.GetBookId())
+		if err != nil {
+			return db.SearchCustomerParams{}, err
+		}
+		customerArg.BookID = pgtype.UUID{Bytes: bookId, Valid: true}
+	}
+
+	// 独自項目の型はリストごとに異なるため、絞り込みと並べ替えにはリストの指定が必要
+	if len(customer.GetCustomFieldFilters()) > 0 || customer.SortCustomField != nil {
+		if !customerArg.BookID.Valid {
+			return db.SearchCustomerParams{}, invalidArgumentError("book_id", "is required to filter or sort by custom fields")
+		}
+		fields, err := q.ListCustomFields(ctx, customerArg.BookID.Bytes)
+		if err != nil {
+			return db.SearchCustomerParams{}, err
+		}
+
+		customerArg.CustomFieldFilters, err = customFieldFilters(fields, customer.GetCustomFieldFilters())
+		if err != nil {
+			return db.SearchCustomerParams{}, err
+		}
+
+		if customer.SortCustomField != nil {
+			i := slices.IndexFunc(fields, func(f db.CustomField) bool { return f.Key == customer.GetSortCustomField() })
+			if i < 0 {
+				return db.SearchCustomerParams{}, invalidArgumentError("sort_custom_field", "unknown custom field")
+			}
+			customerArg.SortKey = pgtype.Text{String: fields[i].Key, Valid: true}
+			customerArg.SortType = pgtype.Text{String: string(fields[i].Type), Valid: true}
+		}
+	}
+
+	return customerArg, nil
+}
+
+// softDeleteCustomer 顧客と連絡先を同じ削除日時でゴミ箱に移動する
+func softDeleteCustomer(ctx context.Context, q *db.Queries, customerID uuid.UUID) error {
+	customer, err := q.SoftDeleteCustomer(ctx, customerID)
+	if err != nil {
+		return notFoundError(err, "customer")
+	}
+
+	_, err = q.SoftDeleteContactsByCustomer(ctx, db.SoftDeleteContactsByCustomerParams{
+		DeletedAt:  customer.DeletedAt,
+		CustomerID: customer.ID,
+	})
+	return err
+}
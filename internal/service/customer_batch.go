@@ -0,0 +1,325 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	customerv1 "github.com/0utl1er-tech/prism-backend/gen/pb/customer/v1"
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func (server *CustomerService) BatchUpdateCustomers(ctx context.Context, req *customerv1.BatchUpdateCustomersRequest) (*customerv1.BatchUpdateCustomersResponse, error) {
+	if req.CategoryId == nil && req.Memo == nil && req.GetCustomFields() == nil {
+		return nil, invalidArgumentError("category_id", "at least one of category_id, memo or custom_fields is required")
+	}
+
+	var categoryID pgtype.UUID
+	if req.CategoryId != nil {
+		id, err := parseUUID("category_id", req.GetCategoryId())
+		if err != nil {
+			return nil, err
+		}
+		categoryID = pgtype.UUID{Bytes: id, Valid: true}
+	}
+
+	var batch customerBatch
+	err := execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		if categoryID.Valid {
+			if _, err := q.GetCategory(ctx, categoryID.Bytes); err != nil {
+				return notFoundError(err, "category")
+			}
+		}
+
+		items, err := server.selectCustomers(ctx, q, req.GetSelection())
+		if err != nil {
+			return err
+		}
+
+		fields := customFieldCache{}
+		return batch.run(ctx, q, items, func(customerID uuid.UUID) error {
+			customer, err := q.GetCustomerByID(ctx, customerID)
+			if err != nil {
+				return notFoundError(err, "customer")
+			}
+
+			arg := db.UpdateCustomerParams{
+				ID:         customerID,
+				CategoryID: categoryID,
+				Memo: pgtype.Text{
+					String: req.GetMemo(),
+					Valid:  req.Memo != nil,
+				},
+			}
+			if req.GetCustomFields() != nil {
+				bookFields, err := fields.get(ctx, q, customer.BookID)
+				if err != nil {
+					return err
+				}
+				arg.CustomFields, err = customFieldValues(bookFields, customer.CustomFields, req.GetCustomFields())
+				if err != nil {
+					return err
+				}
+			}
+
+			_, err = q.UpdateCustomer(ctx, arg)
+			return err
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &customerv1.BatchUpdateCustomersResponse{
+		Results:   batch.results,
+		Succeeded: batch.succeeded,
+		Failed:    batch.failed,
+	}, nil
+}
+
+func (server *CustomerService) BatchDeleteCustomers(ctx context.Context, req *customerv1.BatchDeleteCustomersRequest) (*customerv1.BatchDeleteCustomersResponse, error) {
+	var batch customerBatch
+	err := execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		items, err := server.selectCustomers(ctx, q, req.GetSelection())
+		if err != nil {
+			return err
+		}
+
+		return batch.run(ctx, q, items, func(customerID uuid.UUID) error {
+			return softDeleteCustomer(ctx, q, customerID)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &customerv1.BatchDeleteCustomersResponse{
+		Results:   batch.results,
+		Succeeded: batch.succeeded,
+		Failed:    batch.failed,
+	}, nil
+}
+
+func (server *CustomerService) MoveCustomers(ctx context.Context, req *customerv1.MoveCustomersRequest) (*customerv1.MoveCustomersResponse, error) {
+	bookID, err := parseUUID("book_id", req.GetBookId())
+	if err != nil {
+		return nil, err
+	}
+
+	var batch customerBatch
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		if _, err := q.GetBook(ctx, bookID); err != nil {
+			return notFoundError(err, "book")
+		}
+		targetFields, err := q.ListCustomFields(ctx, bookID)
+		if err != nil {
+			return err
+		}
+
+		items, err := server.selectCustomers(ctx, q, req.GetSelection())
+		if err != nil {
+			return err
+		}
+
+		return batch.run(ctx, q, items, func(customerID uuid.UUID) error {
+			customer, err := q.GetCustomerByID(ctx, customerID)
+			if err != nil {
+				return notFoundError(err, "customer")
+			}
+			if customer.BookID == bookID {
+				return nil
+			}
+
+			customFields, err := moveCustomFieldValues(targetFields, customer.CustomFields)
+			if err != nil {
+				return err
+			}
+
+			tags, err := customerTagNames(ctx, q, customerID)
+			if err != nil {
+				return err
+			}
+			err = q.ClearCustomerTags(ctx, customerID)
+			if err != nil {
+				return err
+			}
+
+			_, err = q.UpdateCustomer(ctx, db.UpdateCustomerParams{
+				ID:           customerID,
+				BookID:       pgtype.UUID{Bytes: bookID, Valid: true},
+				CustomFields: customFields,
+			})
+			if err != nil {
+				return err
+			}
+
+			if len(tags) > 0 {
+				_, err = addTags(ctx, q, bookID, []uuid.UUID{customerID}, tags)
+			}
+			return err
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &customerv1.MoveCustomersResponse{
+		Results:   batch.results,
+		Succeeded: batch.succeeded,
+		Failed:    batch.failed,
+	}, nil
+}
+
+// selectedCustomer 一括操作の対象。ID が不正な場合は err に理由を入れて結果に含める
+type selectedCustomer struct {
+	id         string
+	customerID uuid.UUID
+	err        error
+}
+
+// selectCustomers ID の指定または SearchCustomer の条件から一括操作の対象を決める
+func (server *CustomerService) selectCustomers(ctx context.Context, q *db.Queries, selection *customerv1.CustomerSelection) ([]selectedCustomer, error) {
+	var items []selectedCustomer
+	switch s := selection.GetSelection().(type) {
+	case *customerv1.CustomerSelection_Ids_:
+		seen := make(map[string]struct{}, len(s.Ids.GetIds()))
+		for i, id := range s.Ids.GetIds() {
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+
+			customerID, err := parseUUID(fmt.Sprintf("selection.ids[%d]", i), id)
+			items = append(items, selectedCustomer{id: id, customerID: customerID, err: err})
+		}
+	case *customerv1.CustomerSelection_Filter:
+		arg, err := searchCustomerParams(ctx, q, s.Filter)
+		if err != nil {
+			return nil, err
+		}
+		customers, err := q.SearchCustomer(ctx, arg)
+		if err != nil {
+			return nil, err
+		}
+		for _, customer := range customers {
+			items = append(items, selectedCustomer{id: customer.ID.String(), customerID: customer.ID})
+		}
+	default:
+		return nil, invalidArgumentError("selection", "ids or filter is required")
+	}
+
+	if len(items) == 0 {
+		return nil, invalidArgumentError("selection", "no customers selected")
+	}
+	if len(items) > server.maxBatchSize {
+		return nil, invalidArgumentError("selection", fmt.Sprintf("%d customers selected, must not exceed %d", len(items), server.maxBatchSize))
+	}
+	return items, nil
+}
+
+// customerBatch 顧客ごとの結果を集計する
+type customerBatch struct {
+	results   []*customerv1.BatchItemResult
+	succeeded int32
+	failed    int32
+}
+
+// run 顧客ごとにセーブポイントを作って fn を実行する。fn が失敗した場合はその顧客の変更のみ戻して失敗として記録し、
+// 残りの顧客の処理を続ける。セーブポイントの操作に失敗した場合はトランザクションごと中断する
+func (batch *customerBatch) run(ctx context.Context, q *db.Queries, items []selectedCustomer, fn func(customerID uuid.UUID) error) error {
+	batch.results = make([]*customerv1.BatchItemResult, 0, len(items))
+	for _, item := range items {
+		err := item.err
+		if err == nil {
+			var txErr error
+			err, txErr = runBatchItem(ctx, q, item.customerID, fn)
+			if txErr != nil {
+				return txErr
+			}
+		}
+
+		result := &customerv1.BatchItemResult{Id: item.id, Success: err == nil}
+		if err != nil {
+			st, ok := status.FromError(err)
+			if !ok {
+				log.Error().Err(err).Str("customer_id", item.id).Msg("Failed to process customer in batch")
+				st = status.New(codes.Internal, "internal error")
+			}
+			result.ErrorCode = st.Code().String()
+			result.ErrorMessage = st.Message()
+			batch.failed++
+		} else {
+			batch.succeeded++
+		}
+		batch.results = append(batch.results, result)
+	}
+	return nil
+}
+
+// runBatchItem fn が失敗した場合はセーブポイントまで戻して、その失敗を itemErr で返す。
+// セーブポイントの操作に失敗した場合は txErr を返す
+func runBatchItem(ctx context.Context, q *db.Queries, customerID uuid.UUID, fn func(customerID uuid.UUID) error) (itemErr, txErr error) {
+	if err := q.SavepointBatchItem(ctx); err != nil {
+		return nil, err
+	}
+	if err := fn(customerID); err != nil {
+		if rbErr := q.RollbackToBatchItem(ctx); rbErr != nil {
+			return nil, fmt.Errorf("item err: %v, rollback err: %w", err, rbErr)
+		}
+		return err, nil
+	}
+	return nil, q.ReleaseBatchItem(ctx)
+}
+
+// customFieldCache リストごとの独自項目の定義を一括操作の間だけ保持する
+type customFieldCache map[uuid.UUID][]db.CustomField
+
+func (cache customFieldCache) get(ctx context.Context, q *db.Queries, bookID uuid.UUID) ([]db.CustomField, error) {
+	if fields, ok := cache[bookID]; ok {
+		return fields, nil
+	}
+	fields, err := q.ListCustomFields(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+	cache[bookID] = fields
+	return fields, nil
+}
+
+// moveCustomFieldValues 移動先のリストの定義に合う値のみ残す。移動先で必須の項目がない場合はエラー
+func moveCustomFieldValues(targetFields []db.CustomField, current []byte) ([]byte, error) {
+	values := map[string]any{}
+	if len(current) > 0 {
+		if err := json.Unmarshal(current, &values); err != nil {
+			return nil, err
+		}
+	}
+
+	kept := map[string]any{}
+	for _, field := range targetFields {
+		value, ok := values[field.Key]
+		if !ok {
+			continue
+		}
+		pb, err := structpb.NewValue(value)
+		if err != nil {
+			continue
+		}
+		if _, err := customFieldValue(field, pb); err == nil {
+			kept[field.Key] = value
+		}
+	}
+
+	for _, field := range targetFields {
+		if _, ok := kept[field.Key]; field.Required && !ok {
+			return nil, status.Errorf(codes.FailedPrecondition, "custom field %s is required in the target book", field.Key)
+		}
+	}
+	return json.Marshal(kept)
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	contactv1 "github.com/0utl1er-tech/prism-backend/gen/pb/contact/v1"
+	customerv1 "github.com/0utl1er-tech/prism-backend/gen/pb/customer/v1"
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// CustomerImportColumns CSVの取り込みで指定できる列。name は必須
+var CustomerImportColumns = []string{
+	"name", "corporation", "address", "phone", "mail",
+	"leader", "leader_sex", "pic", "pic_sex", "custom_fields",
+}
+
+var CustomerExportColumns = []string{
+	"id", "name", "corporation", "address", "phone", "mail", "fax", "memo", "custom_fields",
+}
+
+const customerExportPageSize = 1000
+
+// CustomerCSVRow 取り込んだ1行の結果
+type CustomerCSVRow struct {
+	Line int
+	// Offset ここまでに読み込んだバイト数
+	Offset int64
+	// Err 登録できなかった場合のエラー
+	Err error
+}
+
+// ImportCSV CSVの顧客を CreateCustomer と同じ検証で1行ずつ登録する。
+// 行ごとに onRow を呼び、onRow がエラーを返すとそこで中断する。中断までの行は登録済みのまま残る
+func (server *CustomerService) ImportCSV(ctx context.Context, bookID string, r io.Reader, onRow func(row CustomerCSVRow) error) (int, error) {
+	reader := csv.NewReader(r)
+	header, err := reader.Read()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read header: %w", err)
+	}
+	index := make(map[string]int, len(header))
+	for i, column := range header {
+		column = strings.TrimSpace(strings.TrimPrefix(column, "\ufeff"))
+		if !slices.Contains(CustomerImportColumns, column) {
+			return 0, fmt.Errorf("unknown column %q", column)
+		}
+		index[column] = i
+	}
+	if _, ok := index["name"]; !ok {
+		return 0, errors.New("missing column \"name\"")
+	}
+
+	count := 0
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return count, nil
+		}
+		if err != nil {
+			return count, err
+		}
+		line, _ := reader.FieldPos(0)
+
+		err = server.importCSVRecord(ctx, bookID, index, record)
+		if err == nil {
+			count++
+		}
+		err = onRow(CustomerCSVRow{Line: line, Offset: reader.InputOffset(), Err: err})
+		if err != nil {
+			return count, err
+		}
+	}
+}
+
+func (server *CustomerService) importCSVRecord(ctx context.Context, bookID string, index map[string]int, record []string) error {
+	value := func(column string) string {
+		if i, ok := index[column]; ok {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+	optional := func(column string) *string {
+		if v := value(column); v != "" {
+			return &v
+		}
+		return nil
+	}
+
+	req := &customerv1.CreateCustomerRequest{
+		BookId:      bookID,
+		Name:        value("name"),
+		Corporation: optional("corporation"),
+		Address:     optional("address"),
+		Leader:      optional("leader"),
+		LeaderSex:   optional("leader_sex"),
+		Pic:         optional("pic"),
+		PicSex:      optional("pic_sex"),
+		Contact: &contactv1.Contact{
+			Phone: value("phone"),
+			Mail:  value("mail"),
+		},
+	}
+	if v := value("custom_fields"); v != "" {
+		req.CustomFields = &structpb.Struct{}
+		err := protojson.Unmarshal([]byte(v), req.CustomFields)
+		if err != nil {
+			return invalidArgumentError("custom_fields", err.Error())
+		}
+	}
+
+	_, err := server.CreateCustomer(ctx, req)
+	return err
+}
+
+// ExportCSV forCalling の場合は架電に使うリストとして架電禁止の顧客を出力しない
+func (server *CustomerService) ExportCSV(ctx context.Context, bookID uuid.UUID, forCalling bool, w io.Writer) error {
+	writer := csv.NewWriter(w)
+	err := writer.Write(CustomerExportColumns)
+	if err != nil {
+		return err
+	}
+
+	afterID := uuid.Nil
+	for {
+		rows, err := server.store.ExportCustomers(ctx, db.ExportCustomersParams{
+			BookID:     bookID,
+			AfterID:    afterID,
+			ForCalling: forCalling,
+			RowLimit:   customerExportPageSize,
+		})
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			err = writer.Write([]string{
+				row.ID.String(),
+				row.Name,
+				row.Corporation.String,
+				row.Address.String,
+				row.Phone.String,
+				row.Mail.String,
+				row.Fax.String,
+				row.Memo.String,
+				string(row.CustomFields),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if len(rows) < customerExportPageSize {
+			break
+		}
+		afterID = rows[len(rows)-1].ID
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/0utl1er-tech/prism-backend/internal/job"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 顧客の一括登録と出力のジョブ
+const (
+	CustomerImportJobType = "customer_import"
+	CustomerExportJobType = "customer_export"
+)
+
+type customerImportParams struct {
+	BookID string `json:"book_id"`
+	CSV    string `json:"csv"`
+}
+
+type customerImportFailure struct {
+	Line  int    `json:"line"`
+	Error string `json:"error"`
+}
+
+type customerImportResult struct {
+	Imported int                     `json:"imported"`
+	Failed   []customerImportFailure `json:"failed"`
+}
+
+type customerExportParams struct {
+	BookID     string `json:"book_id"`
+	ForCalling bool   `json:"for_calling"`
+}
+
+type customerExportResult struct {
+	Bytes int `json:"bytes"`
+}
+
+// RunImportJob CSVの顧客を ImportCSV で登録する。入力が不正な行は飛ばして結果に残す。
+// 途中で失敗したジョブを再試行すると登録済みの行が重複するため、エラーはすべて再試行しない
+func (server *CustomerService) RunImportJob(ctx context.Context, record db.Job, progress job.ProgressFunc) (job.Result, error) {
+	var params customerImportParams
+	err := json.Unmarshal(record.Params, &params)
+	if err != nil {
+		return job.Result{}, job.Permanent(fmt.Errorf("invalid params: %w", err))
+	}
+	bookID, err := server.jobBook(ctx, params.BookID)
+	if err != nil {
+		return job.Result{}, err
+	}
+
+	result := customerImportResult{Failed: []customerImportFailure{}}
+	size := max(int64(len(params.CSV)), 1)
+	result.Imported, err = server.ImportCSV(ctx, bookID.String(), strings.NewReader(params.CSV), func(row CustomerCSVRow) error {
+		if row.Err != nil {
+			if !isInvalidRowError(row.Err) {
+				return fmt.Errorf("line %d: %w", row.Line, row.Err)
+			}
+			result.Failed = append(result.Failed, customerImportFailure{
+				Line:  row.Line,
+				Error: errorDescription(row.Err),
+			})
+		}
+		progress(int(row.Offset * 100 / size))
+		return nil
+	})
+	if err != nil {
+		return job.Result{}, job.Permanent(err)
+	}
+	return job.Result{Data: result}, nil
+}
+
+// RunExportJob 顧客リストを ExportCSV で出力し、GetJobFile で取得できるように保存する
+func (server *CustomerService) RunExportJob(ctx context.Context, record db.Job, progress job.ProgressFunc) (job.Result, error) {
+	var params customerExportParams
+	err := json.Unmarshal(record.Params, &params)
+	if err != nil {
+		return job.Result{}, job.Permanent(fmt.Errorf("invalid params: %w", err))
+	}
+	bookID, err := server.jobBook(ctx, params.BookID)
+	if err != nil {
+		return job.Result{}, err
+	}
+
+	var out bytes.Buffer
+	err = server.ExportCSV(ctx, bookID, params.ForCalling, &out)
+	if err != nil {
+		return job.Result{}, err
+	}
+	progress(90)
+
+	err = server.store.SaveJobFile(ctx, db.SaveJobFileParams{
+		JobID:       record.ID,
+		ContentType: "text/csv; charset=utf-8",
+		Content:     out.Bytes(),
+	})
+	if err != nil {
+		return job.Result{}, err
+	}
+	return job.Result{
+		Data:     customerExportResult{Bytes: out.Len()},
+		Location: jobFileLocation(record.ID),
+	}, nil
+}
+
+// jobBook ジョブの対象の顧客リストを確認する。他の組織の顧客リストは行レベルセキュリティで見えない
+func (server *CustomerService) jobBook(ctx context.Context, value string) (uuid.UUID, error) {
+	bookID, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, job.Permanent(fmt.Errorf("invalid book_id: %w", err))
+	}
+	_, err = server.store.GetBook(ctx, bookID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return uuid.Nil, job.Permanent(fmt.Errorf("book %s not found", bookID))
+	}
+	return bookID, err
+}
+
+// isInvalidRowError 行の内容が原因のエラーか。それ以外のエラーは以降の行も失敗するため中断する
+func isInvalidRowError(err error) bool {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.AlreadyExists, codes.OutOfRange:
+		return true
+	}
+	return false
+}
+
+// errorDescription gRPCのエラーのメッセージに、不正なフィールドの説明を付ける
+func errorDescription(err error) string {
+	st := status.Convert(err)
+	description := st.Message()
+	for _, detail := range st.Details() {
+		badRequest, ok := detail.(*errdetails.BadRequest)
+		if !ok {
+			continue
+		}
+		for _, violation := range badRequest.GetFieldViolations() {
+			description += fmt.Sprintf("; %s: %s", violation.GetField(), violation.GetDescription())
+		}
+	}
+	return description
+}
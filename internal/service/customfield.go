@@ -0,0 +1,451 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	customerv1 "github.com/0utl1er-tech/prism-backend/gen/pb/customer/v1"
+	customfieldv1 "github.com/0utl1er-tech/prism-backend/gen/pb/customfield/v1"
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/0utl1er-tech/prism-backend/internal/store"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type CustomFieldService struct {
+	customfieldv1.UnimplementedCustomFieldServiceServer
+	store       store.Store
+	idempotency *Idempotency
+}
+
+func NewCustomFieldService(store store.Store, idempotency *Idempotency) *CustomFieldService {
+	return &CustomFieldService{
+		store:       store,
+		idempotency: idempotency,
+	}
+}
+
+func (server *CustomFieldService) ListCustomFields(ctx context.Context, req *customfieldv1.ListCustomFieldsRequest) (*customfieldv1.ListCustomFieldsResponse, error) {
+	bookID, err := parseUUID("book_id", req.GetBookId())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = server.store.GetBook(ctx, bookID)
+	if err != nil {
+		return nil, notFoundError(err, "book")
+	}
+
+	fields, err := server.store.ListCustomFields(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldsRes := make([]*customfieldv1.CustomField, len(fields))
+	for i, field := range fields {
+		fieldsRes[i] = toCustomFieldPb(field)
+	}
+
+	return &customfieldv1.ListCustomFieldsResponse{
+		CustomFields: fieldsRes,
+	}, nil
+}
+
+func (server *CustomFieldService) CreateCustomField(ctx context.Context, req *customfieldv1.CreateCustomFieldRequest) (*customfieldv1.CreateCustomFieldResponse, error) {
+	return idempotent(ctx, server.idempotency, req, func(ctx context.Context) (*customfieldv1.CreateCustomFieldResponse, error) {
+		return server.createCustomField(ctx, req)
+	})
+}
+
+func (server *CustomFieldService) createCustomField(ctx context.Context, req *customfieldv1.CreateCustomFieldRequest) (*customfieldv1.CreateCustomFieldResponse, error) {
+	bookID, err := parseUUID("book_id", req.GetBookId())
+	if err != nil {
+		return nil, err
+	}
+	if !customFieldKeyPattern.MatchString(req.GetKey()) {
+		return nil, invalidArgumentError("key", "must be lowercase letters, digits and underscores starting with a letter")
+	}
+	label := strings.TrimSpace(req.GetLabel())
+	if label == "" {
+		return nil, invalidArgumentError("label", "must not be empty")
+	}
+	fieldType, ok := customFieldTypeFromPb[req.GetType()]
+	if !ok {
+		return nil, invalidArgumentError("type", "must be specified")
+	}
+	options, err := customFieldOptions(fieldType, req.GetOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	var field db.CustomField
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		_, err := q.GetBook(ctx, bookID)
+		if err != nil {
+			return notFoundError(err, "book")
+		}
+
+		if req.GetRequired() {
+			if err := checkNoCustomersMissing(ctx, q, bookID, req.GetKey()); err != nil {
+				return err
+			}
+		}
+
+		field, err = q.CreateCustomField(ctx, db.CreateCustomFieldParams{
+			ID:       uuid.New(),
+			BookID:   bookID,
+			Key:      req.GetKey(),
+			Label:    label,
+			Type:     fieldType,
+			Options:  options,
+			Required: req.GetRequired(),
+			Position: req.GetPosition(),
+		})
+		return alreadyExistsError(err, "custom field key")
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &customfieldv1.CreateCustomFieldResponse{
+		CustomField: toCustomFieldPb(field),
+	}, nil
+}
+
+func (server *CustomFieldService) UpdateCustomField(ctx context.Context, req *customfieldv1.UpdateCustomFieldRequest) (*customfieldv1.UpdateCustomFieldResponse, error) {
+	fieldID, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if req.Label != nil && strings.TrimSpace(req.GetLabel()) == "" {
+		return nil, invalidArgumentError("label", "must not be empty")
+	}
+
+	var field db.CustomField
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		current, err := q.GetCustomField(ctx, fieldID)
+		if err != nil {
+			return notFoundError(err, "custom field")
+		}
+
+		arg := db.UpdateCustomFieldParams{
+			ID: fieldID,
+			Label: pgtype.Text{
+				String: strings.TrimSpace(req.GetLabel()),
+				Valid:  req.Label != nil,
+			},
+			Required: pgtype.Bool{
+				Bool:  req.GetRequired(),
+				Valid: req.Required != nil,
+			},
+			Position: pgtype.Int4{
+				Int32: req.GetPosition(),
+				Valid: req.Position != nil,
+			},
+		}
+
+		if len(req.GetOptions()) > 0 {
+			arg.Options, err = customFieldOptions(current.Type, req.GetOptions())
+			if err != nil {
+				return err
+			}
+			used, err := q.CountCustomFieldValuesNotIn(ctx, db.CountCustomFieldValuesNotInParams{
+				BookID:  current.BookID,
+				Key:     current.Key,
+				Options: arg.Options,
+			})
+			if err != nil {
+				return err
+			}
+			if used > 0 {
+				return status.Errorf(codes.FailedPrecondition, "%d customers use options that would be removed", used)
+			}
+		}
+
+		if req.GetRequired() && !current.Required {
+			if err := checkNoCustomersMissing(ctx, q, current.BookID, current.Key); err != nil {
+				return err
+			}
+		}
+
+		field, err = q.UpdateCustomField(ctx, arg)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &customfieldv1.UpdateCustomFieldResponse{
+		CustomField: toCustomFieldPb(field),
+	}, nil
+}
+
+func (server *CustomFieldService) DeleteCustomField(ctx context.Context, req *customfieldv1.DeleteCustomFieldRequest) (*customfieldv1.DeleteCustomFieldResponse, error) {
+	fieldID, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		field, err := q.GetCustomField(ctx, fieldID)
+		if err != nil {
+			return notFoundError(err, "custom field")
+		}
+
+		err = q.DeleteCustomField(ctx, fieldID)
+		if err != nil {
+			return err
+		}
+
+		_, err = q.DeleteCustomFieldValues(ctx, db.DeleteCustomFieldValuesParams{
+			BookID: field.BookID,
+			Key:    field.Key,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &customfieldv1.DeleteCustomFieldResponse{}, nil
+}
+
+var customFieldKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,62}$`)
+
+var customFieldTypeFromPb = map[customfieldv1.CustomFieldType]db.CustomFieldType{
+	customfieldv1.CustomFieldType_CUSTOM_FIELD_TYPE_TEXT:    db.CustomFieldTypeText,
+	customfieldv1.CustomFieldType_CUSTOM_FIELD_TYPE_NUMBER:  db.CustomFieldTypeNumber,
+	customfieldv1.CustomFieldType_CUSTOM_FIELD_TYPE_DATE:    db.CustomFieldTypeDate,
+	customfieldv1.CustomFieldType_CUSTOM_FIELD_TYPE_SELECT:  db.CustomFieldTypeSelect,
+	customfieldv1.CustomFieldType_CUSTOM_FIELD_TYPE_BOOLEAN: db.CustomFieldTypeBoolean,
+}
+
+func toCustomFieldPb(field db.CustomField) *customfieldv1.CustomField {
+	fieldType := customfieldv1.CustomFieldType_CUSTOM_FIELD_TYPE_UNSPECIFIED
+	for pb, t := range customFieldTypeFromPb {
+		if t == field.Type {
+			fieldType = pb
+		}
+	}
+
+	return &customfieldv1.CustomField{
+		Id:       field.ID.String(),
+		BookId:   field.BookID.String(),
+		Key:      field.Key,
+		Label:    field.Label,
+		Type:     fieldType,
+		Options:  field.Options,
+		Required: field.Required,
+		Position: field.Position,
+	}
+}
+
+// customFieldOptions select の選択肢を検証する。select 以外は選択肢を持たない
+func customFieldOptions(fieldType db.CustomFieldType, options []string) ([]string, error) {
+	if fieldType != db.CustomFieldTypeSelect {
+		if len(options) > 0 {
+			return nil, invalidArgumentError("options", "only select fields have options")
+		}
+		return []string{}, nil
+	}
+
+	result := make([]string, 0, len(options))
+	for _, option := range options {
+		option = strings.TrimSpace(option)
+		if option == "" {
+			return nil, invalidArgumentError("options", "must not contain empty options")
+		}
+		if slices.Contains(result, option) {
+			return nil, invalidArgumentError("options", fmt.Sprintf("duplicate option %q", option))
+		}
+		result = append(result, option)
+	}
+	if len(result) == 0 {
+		return nil, invalidArgumentError("options", "select fields need at least one option")
+	}
+	return result, nil
+}
+
+func checkNoCustomersMissing(ctx context.Context, q *db.Queries, bookID uuid.UUID, key string) error {
+	missing, err := q.CountCustomersMissingCustomField(ctx, db.CountCustomersMissingCustomFieldParams{
+		BookID: bookID,
+		Key:    key,
+	})
+	if err != nil {
+		return err
+	}
+	if missing > 0 {
+		return status.Errorf(codes.FailedPrecondition, "%d customers have no value for %s", missing, key)
+	}
+	return nil
+}
+
+const customFieldDateLayout = time.DateOnly
+
+// customFieldNumberPattern 絞り込みの数値。strconv.ParseFloat と違い、numeric に変換できない
+// 16進数、Inf、NaN、桁区切りの _ は受け付けない
+var customFieldNumberPattern = regexp.MustCompile(`^[+-]?([0-9]+(\.[0-9]*)?|\.[0-9]+)([eE][+-]?[0-9]+)?$`)
+
+// customFieldValues 独自項目の値をリストの定義で検証して JSONB に変換する。
+// current は更新前の値で、values で指定したキーのみ上書きし null のキーは削除する
+func customFieldValues(fields []db.CustomField, current []byte, values *structpb.Struct) ([]byte, error) {
+	result := map[string]any{}
+	if len(current) > 0 {
+		if err := json.Unmarshal(current, &result); err != nil {
+			return nil, err
+		}
+	}
+
+	for key, value := range values.GetFields() {
+		field := "custom_fields." + key
+		i := slices.IndexFunc(fields, func(f db.CustomField) bool { return f.Key == key })
+		if i < 0 {
+			return nil, invalidArgumentError(field, "unknown custom field")
+		}
+		if _, ok := value.GetKind().(*structpb.Value_NullValue); ok {
+			delete(result, key)
+			continue
+		}
+
+		normalized, err := customFieldValue(fields[i], value)
+		if err != nil {
+			return nil, invalidArgumentError(field, err.Error())
+		}
+		result[key] = normalized
+	}
+
+	for _, f := range fields {
+		if _, ok := result[f.Key]; f.Required && !ok {
+			return nil, invalidArgumentError("custom_fields."+f.Key, "is required")
+		}
+	}
+
+	return json.Marshal(result)
+}
+
+func customFieldValue(field db.CustomField, value *structpb.Value) (any, error) {
+	switch field.Type {
+	case db.CustomFieldTypeText:
+		if v, ok := value.GetKind().(*structpb.Value_StringValue); ok {
+			return v.StringValue, nil
+		}
+		return nil, fmt.Errorf("must be a string")
+	case db.CustomFieldTypeNumber:
+		// NaN と Inf は JSON にできない
+		if v, ok := value.GetKind().(*structpb.Value_NumberValue); ok && !math.IsNaN(v.NumberValue) && !math.IsInf(v.NumberValue, 0) {
+			return v.NumberValue, nil
+		}
+		return nil, fmt.Errorf("must be a finite number")
+	case db.CustomFieldTypeDate:
+		if v, ok := value.GetKind().(*structpb.Value_StringValue); ok {
+			if _, err := time.Parse(customFieldDateLayout, v.StringValue); err == nil {
+				return v.StringValue, nil
+			}
+		}
+		return nil, fmt.Errorf("must be a date in YYYY-MM-DD format")
+	case db.CustomFieldTypeSelect:
+		if v, ok := value.GetKind().(*structpb.Value_StringValue); ok && slices.Contains(field.Options, v.StringValue) {
+			return v.StringValue, nil
+		}
+		return nil, fmt.Errorf("must be one of %s", strings.Join(field.Options, ", "))
+	case db.CustomFieldTypeBoolean:
+		if v, ok := value.GetKind().(*structpb.Value_BoolValue); ok {
+			return v.BoolValue, nil
+		}
+		return nil, fmt.Errorf("must be a boolean")
+	}
+	return nil, fmt.Errorf("unsupported field type %s", field.Type)
+}
+
+type customFieldFilter struct {
+	Key   string `json:"key"`
+	Type  string `json:"type"`
+	Op    string `json:"op"`
+	Value string `json:"value"`
+}
+
+var customFieldFilterOps = map[customerv1.CustomFieldFilter_Op]string{
+	customerv1.CustomFieldFilter_OP_SET:      "set",
+	customerv1.CustomFieldFilter_OP_EQ:       "eq",
+	customerv1.CustomFieldFilter_OP_NE:       "ne",
+	customerv1.CustomFieldFilter_OP_LT:       "lt",
+	customerv1.CustomFieldFilter_OP_LTE:      "lte",
+	customerv1.CustomFieldFilter_OP_GT:       "gt",
+	customerv1.CustomFieldFilter_OP_GTE:      "gte",
+	customerv1.CustomFieldFilter_OP_CONTAINS: "contains",
+}
+
+// customFieldFilters 独自項目の絞り込み条件を検証して SearchCustomer に渡す JSON にする
+func customFieldFilters(fields []db.CustomField, filters []*customerv1.CustomFieldFilter) ([]byte, error) {
+	if len(filters) == 0 {
+		return nil, nil
+	}
+
+	result := make([]customFieldFilter, len(filters))
+	for i, filter := range filters {
+		name := fmt.Sprintf("custom_field_filters[%d]", i)
+		j := slices.IndexFunc(fields, func(f db.CustomField) bool { return f.Key == filter.GetKey() })
+		if j < 0 {
+			return nil, invalidArgumentError(name+".key", "unknown custom field")
+		}
+		field := fields[j]
+
+		op, ok := customFieldFilterOps[filter.GetOp()]
+		if !ok {
+			return nil, invalidArgumentError(name+".op", "must be specified")
+		}
+		if op == "contains" && field.Type != db.CustomFieldTypeText && field.Type != db.CustomFieldTypeSelect {
+			return nil, invalidArgumentError(name+".op", "contains is only supported for text and select fields")
+		}
+		if op != "set" {
+			if err := checkCustomFieldFilterValue(field.Type, filter.GetValue()); err != nil {
+				return nil, invalidArgumentError(name+".value", err.Error())
+			}
+		}
+
+		result[i] = customFieldFilter{
+			Key:   field.Key,
+			Type:  string(field.Type),
+			Op:    op,
+			Value: filter.GetValue(),
+		}
+	}
+
+	return json.Marshal(result)
+}
+
+func checkCustomFieldFilterValue(fieldType db.CustomFieldType, value string) error {
+	switch fieldType {
+	case db.CustomFieldTypeNumber:
+		if !customFieldNumberPattern.MatchString(value) {
+			return fmt.Errorf("must be a decimal number")
+		}
+	case db.CustomFieldTypeDate:
+		if _, err := time.Parse(customFieldDateLayout, value); err != nil {
+			return fmt.Errorf("must be a date in YYYY-MM-DD format")
+		}
+	case db.CustomFieldTypeBoolean:
+		if value != "true" && value != "false" {
+			return fmt.Errorf("must be true or false")
+		}
+	}
+	return nil
+}
+
+// customFieldsPb JSONB の独自項目を Struct にする
+func customFieldsPb(data []byte) *structpb.Struct {
+	s, err := jsonbToStruct(data)
+	if err != nil {
+		return nil
+	}
+	return s
+}
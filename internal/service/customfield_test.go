@@ -0,0 +1,147 @@
+package service
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var testCustomFields = []db.CustomField{
+	{Key: "memo", Type: db.CustomFieldTypeText},
+	{Key: "employees", Type: db.CustomFieldTypeNumber, Required: true},
+	{Key: "founded", Type: db.CustomFieldTypeDate},
+	{Key: "rank", Type: db.CustomFieldTypeSelect, Options: []string{"A", "B"}},
+	{Key: "listed", Type: db.CustomFieldTypeBoolean},
+}
+
+func TestCustomFieldValues(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  string
+		values   map[string]*structpb.Value
+		want     map[string]any
+		wantCode codes.Code
+	}{
+		{
+			name: "all types",
+			values: map[string]*structpb.Value{
+				"memo":      structpb.NewStringValue("メモ"),
+				"employees": structpb.NewNumberValue(120),
+				"founded":   structpb.NewStringValue("2001-04-01"),
+				"rank":      structpb.NewStringValue("A"),
+				"listed":    structpb.NewBoolValue(true),
+			},
+			want: map[string]any{
+				"memo": "メモ", "employees": 120.0, "founded": "2001-04-01", "rank": "A", "listed": true,
+			},
+		},
+		{
+			name:    "merge and delete",
+			current: `{"memo":"old","employees":10,"rank":"B"}`,
+			values: map[string]*structpb.Value{
+				"memo": structpb.NewNullValue(),
+				"rank": structpb.NewStringValue("A"),
+			},
+			want: map[string]any{"employees": 10.0, "rank": "A"},
+		},
+		{
+			name:     "missing required",
+			values:   map[string]*structpb.Value{"memo": structpb.NewStringValue("メモ")},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "unknown key",
+			values:   map[string]*structpb.Value{"employees": structpb.NewNumberValue(1), "unknown": structpb.NewStringValue("x")},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "wrong type",
+			values:   map[string]*structpb.Value{"employees": structpb.NewStringValue("10")},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "NaN",
+			values:   map[string]*structpb.Value{"employees": structpb.NewNumberValue(math.NaN())},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Inf",
+			values:   map[string]*structpb.Value{"employees": structpb.NewNumberValue(math.Inf(1))},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "invalid date",
+			values:   map[string]*structpb.Value{"employees": structpb.NewNumberValue(1), "founded": structpb.NewStringValue("2001/04/01")},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "unknown option",
+			values:   map[string]*structpb.Value{"employees": structpb.NewNumberValue(1), "rank": structpb.NewStringValue("C")},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := customFieldValues(testCustomFields, []byte(tt.current), &structpb.Struct{Fields: tt.values})
+			if tt.wantCode != codes.OK {
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("customFieldValues() error = %v, want %v", err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("customFieldValues() error = %v", err)
+			}
+			var result map[string]any
+			if err := json.Unmarshal(got, &result); err != nil {
+				t.Fatal(err)
+			}
+			if len(result) != len(tt.want) {
+				t.Fatalf("customFieldValues() = %v, want %v", result, tt.want)
+			}
+			for key, want := range tt.want {
+				if result[key] != want {
+					t.Errorf("customFieldValues()[%s] = %v, want %v", key, result[key], want)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckCustomFieldFilterValue(t *testing.T) {
+	tests := []struct {
+		fieldType db.CustomFieldType
+		value     string
+		valid     bool
+	}{
+		{db.CustomFieldTypeNumber, "10", true},
+		{db.CustomFieldTypeNumber, "-1.5", true},
+		{db.CustomFieldTypeNumber, "+.5", true},
+		{db.CustomFieldTypeNumber, "1e3", true},
+		{db.CustomFieldTypeNumber, "2.", true},
+		{db.CustomFieldTypeNumber, "0x10", false},
+		{db.CustomFieldTypeNumber, "0x1p-2", false},
+		{db.CustomFieldTypeNumber, "Inf", false},
+		{db.CustomFieldTypeNumber, "-infinity", false},
+		{db.CustomFieldTypeNumber, "NaN", false},
+		{db.CustomFieldTypeNumber, "1_000", false},
+		{db.CustomFieldTypeNumber, " 1", false},
+		{db.CustomFieldTypeNumber, "", false},
+		{db.CustomFieldTypeDate, "2024-02-29", true},
+		{db.CustomFieldTypeDate, "2023-02-29", false},
+		{db.CustomFieldTypeBoolean, "true", true},
+		{db.CustomFieldTypeBoolean, "1", false},
+		{db.CustomFieldTypeText, "0x10", true},
+	}
+	for _, tt := range tests {
+		err := checkCustomFieldFilterValue(tt.fieldType, tt.value)
+		if (err == nil) != tt.valid {
+			t.Errorf("checkCustomFieldFilterValue(%s, %q) error = %v, want valid %t", tt.fieldType, tt.value, err, tt.valid)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	dialqueuev1 "github.com/0utl1er-tech/prism-backend/gen/pb/dialqueue/v1"
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/0utl1er-tech/prism-backend/internal/store"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// 候補をロックした後、他のユーザーに先にリースされていた場合に取り直す回数
+const maxLeaseAttempts = 3
+
+type DialQueueService struct {
+	dialqueuev1.UnimplementedDialQueueServiceServer
+	store         store.Store
+	leaseDuration time.Duration
+}
+
+func NewDialQueueService(store store.Store, leaseDuration time.Duration) *DialQueueService {
+	return &DialQueueService{
+		store:         store,
+		leaseDuration: leaseDuration,
+	}
+}
+
+func (server *DialQueueService) NextCustomer(ctx context.Context, req *dialqueuev1.NextCustomerRequest) (*dialqueuev1.NextCustomerResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	bookID, err := parseUUID("book_id", req.GetBookId())
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		customer db.Customer
+		lease    db.DialLease
+	)
+	err = server.store.ExecTx(ctx, func(q *db.Queries) error {
+		expiresAt := time.Now().Add(server.leaseDuration)
+
+		// 既に払い出し中の顧客がいればそれを延長して返す
+		active, err := q.GetActiveDialLease(ctx, db.GetActiveDialLeaseParams{
+			BookID: bookID,
+			UserID: userID,
+		})
+		if err == nil {
+			lease, err = q.ExtendDialLease(ctx, db.ExtendDialLeaseParams{
+				ExpiresAt:  expiresAt,
+				CustomerID: active.DialLease.CustomerID,
+				UserID:     userID,
+			})
+			customer = active.Customer
+			return err
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+
+		for range maxLeaseAttempts {
+			customer, err = q.LockNextDialCandidate(ctx, db.LockNextDialCandidateParams{
+				BookID: bookID,
+				UserID: userID,
+			})
+			if err != nil {
+				return err
+			}
+
+			lease, err = q.AcquireDialLease(ctx, db.AcquireDialLeaseParams{
+				CustomerID: customer.ID,
+				UserID:     userID,
+				ExpiresAt:  expiresAt,
+			})
+			if !errors.Is(err, pgx.ErrNoRows) {
+				return err
+			}
+		}
+		return status.Error(codes.Aborted, "dial queue is busy, try again")
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "no customer to call")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	redial, err := server.store.GetRedial(ctx, customer.ID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	return &dialqueuev1.NextCustomerResponse{
+		Customer:       toCustomerPb(customer),
+		LeaseExpiresAt: timestamppb.New(lease.ExpiresAt),
+		Redial:         redial.ID != uuid.Nil,
+	}, nil
+}
+
+func (server *DialQueueService) ReleaseCustomer(ctx context.Context, req *dialqueuev1.ReleaseCustomerRequest) (*dialqueuev1.ReleaseCustomerResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	customerID, err := parseUUID("customer_id", req.GetCustomerId())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := server.store.ReleaseDialLease(ctx, db.ReleaseDialLeaseParams{
+		CustomerID: customerID,
+		UserID:     userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if rows == 0 {
+		return nil, status.Error(codes.NotFound, "customer is not leased to the user")
+	}
+
+	return &dialqueuev1.ReleaseCustomerResponse{}, nil
+}
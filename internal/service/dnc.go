@@ -0,0 +1,255 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	dncv1 "github.com/0utl1er-tech/prism-backend/gen/pb/dnc/v1"
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/0utl1er-tech/prism-backend/internal/job"
+	"github.com/0utl1er-tech/prism-backend/internal/store"
+	"github.com/0utl1er-tech/prism-backend/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type DncService struct {
+	dncv1.UnimplementedDncServiceServer
+	store       store.Store
+	idempotency *Idempotency
+}
+
+func NewDncService(store store.Store, idempotency *Idempotency) *DncService {
+	return &DncService{
+		store:       store,
+		idempotency: idempotency,
+	}
+}
+
+func (server *DncService) AddDnc(ctx context.Context, req *dncv1.AddDncRequest) (*dncv1.AddDncResponse, error) {
+	return idempotent(ctx, server.idempotency, req, func(ctx context.Context) (*dncv1.AddDncResponse, error) {
+		return server.addDnc(ctx, req)
+	})
+}
+
+func (server *DncService) addDnc(ctx context.Context, req *dncv1.AddDncRequest) (*dncv1.AddDncResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	phone, err := normalizeDncPhone(req.GetPhone())
+	if err != nil {
+		return nil, err
+	}
+
+	var dnc db.DoNotCall
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		var err error
+		dnc, err = q.CreateDoNotCall(ctx, db.CreateDoNotCallParams{
+			Phone: phone,
+			Reason: pgtype.Text{
+				String: req.GetReason(),
+				Valid:  req.Reason != nil,
+			},
+			Source: db.DncSourceManual,
+			UserID: pgtype.UUID{Bytes: userID, Valid: true},
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dncv1.AddDncResponse{
+		Dnc: toDncPb(dnc),
+	}, nil
+}
+
+func (server *DncService) RemoveDnc(ctx context.Context, req *dncv1.RemoveDncRequest) (*dncv1.RemoveDncResponse, error) {
+	phone, err := normalizeDncPhone(req.GetPhone())
+	if err != nil {
+		return nil, err
+	}
+
+	var rows int64
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		var err error
+		rows, err = q.DeleteDoNotCall(ctx, phone)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if rows == 0 {
+		return nil, status.Error(codes.NotFound, "phone is not in the dnc list")
+	}
+
+	return &dncv1.RemoveDncResponse{}, nil
+}
+
+func (server *DncService) ImportDnc(ctx context.Context, req *dncv1.ImportDncRequest) (*dncv1.ImportDncResponse, error) {
+	return idempotent(ctx, server.idempotency, req, func(ctx context.Context) (*dncv1.ImportDncResponse, error) {
+		return server.importDnc(ctx, req)
+	})
+}
+
+func (server *DncService) importDnc(ctx context.Context, req *dncv1.ImportDncRequest) (*dncv1.ImportDncResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	phones, invalid := normalizeDncPhones(req.GetPhones())
+
+	var imported int64
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		var err error
+		imported, err = q.ImportDoNotCall(ctx, db.ImportDoNotCallParams{
+			Phones: phones,
+			Reason: pgtype.Text{
+				String: req.GetReason(),
+				Valid:  req.Reason != nil,
+			},
+			UserID: pgtype.UUID{Bytes: userID, Valid: true},
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dncv1.ImportDncResponse{
+		Imported:   int32(imported),
+		Duplicated: int32(int64(len(phones)) - imported),
+		Invalid:    invalid,
+	}, nil
+}
+
+func (server *DncService) ListDnc(ctx context.Context, req *dncv1.ListDncRequest) (*dncv1.ListDncResponse, error) {
+	limit, offset := pagination(req.GetPage(), req.GetLimit())
+	dncs, err := server.store.ListDoNotCall(ctx, db.ListDoNotCallParams{
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := server.store.CountDoNotCall(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dncsRes := make([]*dncv1.Dnc, len(dncs))
+	for i, dnc := range dncs {
+		dncsRes[i] = toDncPb(dnc)
+	}
+
+	return &dncv1.ListDncResponse{
+		Dncs:  dncsRes,
+		Total: int32(total),
+		Page:  req.GetPage(),
+		Limit: limit,
+	}, nil
+}
+
+// DncImportJobType 架電禁止リストの一括登録ジョブ
+const DncImportJobType = "dnc_import"
+
+// dncImportChunkSize 1トランザクションで登録する件数
+const dncImportChunkSize = 1000
+
+type dncImportParams struct {
+	Phones []string `json:"phones"`
+	Reason string   `json:"reason"`
+}
+
+type dncImportResult struct {
+	Imported   int64    `json:"imported"`
+	Duplicated int64    `json:"duplicated"`
+	Invalid    []string `json:"invalid"`
+}
+
+// RunImportJob ImportDnc を分割して実行する。件数が多くてもゲートウェイのタイムアウトを受けない
+func (server *DncService) RunImportJob(ctx context.Context, record db.Job, progress job.ProgressFunc) (job.Result, error) {
+	var params dncImportParams
+	err := json.Unmarshal(record.Params, &params)
+	if err != nil {
+		return job.Result{}, job.Permanent(fmt.Errorf("invalid params: %w", err))
+	}
+
+	phones, invalid := normalizeDncPhones(params.Phones)
+	result := dncImportResult{Invalid: invalid}
+	for start := 0; start < len(phones); start += dncImportChunkSize {
+		chunk := phones[start:min(start+dncImportChunkSize, len(phones))]
+		var imported int64
+		err = server.store.ExecTx(ctx, func(q *db.Queries) error {
+			var err error
+			imported, err = q.ImportDoNotCall(ctx, db.ImportDoNotCallParams{
+				Phones: chunk,
+				Reason: pgtype.Text{
+					String: params.Reason,
+					Valid:  params.Reason != "",
+				},
+				UserID: record.UserID,
+			})
+			return err
+		})
+		if err != nil {
+			return job.Result{}, err
+		}
+		result.Imported += imported
+		result.Duplicated += int64(len(chunk)) - imported
+		progress((start + len(chunk)) * 100 / len(phones))
+	}
+
+	return job.Result{Data: result}, nil
+}
+
+// normalizeDncPhones 電話番号を正規化して重複を除く。不正な番号は入力のまま返す
+func normalizeDncPhones(raws []string) (phones []string, invalid []string) {
+	seen := make(map[string]struct{}, len(raws))
+	phones = make([]string, 0, len(raws))
+	invalid = []string{}
+	for _, raw := range raws {
+		phone := util.NormalizePhone(raw)
+		if !util.IsValidPhone(phone) {
+			invalid = append(invalid, raw)
+			continue
+		}
+		if _, ok := seen[phone]; ok {
+			continue
+		}
+		seen[phone] = struct{}{}
+		phones = append(phones, phone)
+	}
+	return phones, invalid
+}
+
+func normalizeDncPhone(raw string) (string, error) {
+	phone := util.NormalizePhone(raw)
+	if !util.IsValidPhone(phone) {
+		return "", status.Errorf(codes.InvalidArgument, "invalid phone: %q", raw)
+	}
+	return phone, nil
+}
+
+var dncSourceToPb = map[db.DncSource]dncv1.DncSource{
+	db.DncSourceManual: dncv1.DncSource_DNC_SOURCE_MANUAL,
+	db.DncSourceImport: dncv1.DncSource_DNC_SOURCE_IMPORT,
+	db.DncSourceCall:   dncv1.DncSource_DNC_SOURCE_CALL,
+}
+
+func toDncPb(dnc db.DoNotCall) *dncv1.Dnc {
+	return &dncv1.Dnc{
+		Phone:     dnc.Phone,
+		Reason:    dnc.Reason.String,
+		Source:    dncSourceToPb[dnc.Source],
+		UserId:    uuidString(dnc.UserID),
+		CallId:    uuidString(dnc.CallID),
+		CreatedAt: timestamppb.New(dnc.CreatedAt),
+	}
+}
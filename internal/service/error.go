@@ -0,0 +1,61 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func invalidArgumentError(field, description string) error {
+	badRequest := &errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{
+				Field:       field,
+				Description: description,
+			},
+		},
+	}
+	statusInvalid := status.New(codes.InvalidArgument, "invalid parameters")
+
+	statusDetails, err := statusInvalid.WithDetails(badRequest)
+	if err != nil {
+		return statusInvalid.Err()
+	}
+
+	return statusDetails.Err()
+}
+
+// notFoundError レコードが見つからない場合はNotFoundに変換する
+func notFoundError(err error, entity string) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return status.Errorf(codes.NotFound, "%s not found", entity)
+	}
+	return err
+}
+
+const (
+	uniqueViolation       = "23505"
+	invalidParameterValue = "22023"
+)
+
+// alreadyExistsError 一意制約違反の場合はAlreadyExistsに変換する
+func alreadyExistsError(err error, entity string) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return status.Errorf(codes.AlreadyExists, "%s already exists", entity)
+	}
+	return err
+}
+
+// timezoneError PostgreSQL が認識できないタイムゾーンの場合はInvalidArgumentに変換する
+func timezoneError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == invalidParameterValue {
+		return invalidArgumentError("timezone", pgErr.Message)
+	}
+	return err
+}
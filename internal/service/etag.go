@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/0utl1er-tech/prism-backend/internal/middleware"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// formatETag version を HTTP の ETag の形式 ("3") にする
+func formatETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// etagRecorderKey idempotent が保存するレスポンスの version を記録する
+type etagRecorderKey struct{}
+
+// recordETag setETag した version を recorded に記録するコンテキストを返す
+func recordETag(ctx context.Context, recorded *pgtype.Int8) context.Context {
+	return context.WithValue(ctx, etagRecorderKey{}, recorded)
+}
+
+// setETag レスポンスのヘッダーに ETag を入れる。gatewayでは ETag ヘッダーになる
+func setETag(ctx context.Context, version int64) {
+	if recorded, ok := ctx.Value(etagRecorderKey{}).(*pgtype.Int8); ok {
+		*recorded = pgtype.Int8{Int64: version, Valid: true}
+	}
+	// ジョブなどRPC以外から呼ばれた場合は何もしない
+	_ = grpc.SetHeader(ctx, metadata.Pairs(middleware.ETagMetadataKey, formatETag(version)))
+}
+
+// expectedVersion リクエストの etag か If-Match ヘッダーから更新前の version を取り出す。
+// どちらもない場合と "*" の場合は確認しない
+func expectedVersion(ctx context.Context, etag *string) (pgtype.Int8, error) {
+	value := ""
+	if etag != nil {
+		value = *etag
+	} else if values := metadata.ValueFromIncomingContext(ctx, middleware.IfMatchMetadataKey); len(values) > 0 {
+		value = values[0]
+	}
+	value = strings.TrimSpace(value)
+	if value == "" || value == "*" {
+		return pgtype.Int8{}, nil
+	}
+
+	version, err := strconv.ParseInt(strings.Trim(strings.TrimPrefix(value, "W/"), `"`), 10, 64)
+	if err != nil {
+		return pgtype.Int8{}, invalidArgumentError("etag", "invalid etag")
+	}
+	return pgtype.Int8{Int64: version, Valid: true}, nil
+}
+
+// staleVersionError 他の更新が先に行われた場合のエラー。gatewayでは 412 になる
+func staleVersionError(entity string) error {
+	st := status.Newf(codes.Aborted, "%s was modified by another request; fetch it again and retry", entity)
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: middleware.ReasonStaleVersion,
+		Domain: "prism",
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
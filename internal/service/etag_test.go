@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/0utl1er-tech/prism-backend/internal/middleware"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestExpectedVersion(t *testing.T) {
+	ptr := func(s string) *string { return &s }
+	tests := []struct {
+		name     string
+		etag     *string
+		ifMatch  string
+		want     int64
+		wantSet  bool
+		wantCode codes.Code
+	}{
+		{name: "none"},
+		{name: "etag", etag: ptr(`"3"`), want: 3, wantSet: true},
+		{name: "weak etag", etag: ptr(`W/"4"`), want: 4, wantSet: true},
+		{name: "unquoted", etag: ptr("5"), want: 5, wantSet: true},
+		{name: "if-match", ifMatch: ` "6" `, want: 6, wantSet: true},
+		// リクエストの etag を If-Match より優先する
+		{name: "etag over if-match", etag: ptr(`"7"`), ifMatch: `"8"`, want: 7, wantSet: true},
+		{name: "empty etag ignores if-match", etag: ptr(""), ifMatch: `"8"`},
+		{name: "wildcard", ifMatch: "*"},
+		{name: "invalid", etag: ptr(`"abc"`), wantCode: codes.InvalidArgument},
+		{name: "invalid if-match", ifMatch: `"1", "2"`, wantCode: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.ifMatch != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(middleware.IfMatchMetadataKey, tt.ifMatch))
+			}
+			got, err := expectedVersion(ctx, tt.etag)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("expectedVersion() error = %v, want %v", err, tt.wantCode)
+			}
+			if got.Valid != tt.wantSet || got.Int64 != tt.want {
+				t.Errorf("expectedVersion() = %+v, want %d (set %t)", got, tt.want, tt.wantSet)
+			}
+		})
+	}
+}
+
+func TestStaleVersionError(t *testing.T) {
+	err := staleVersionError("customer")
+	st := status.Convert(err)
+	if st.Code() != codes.Aborted {
+		t.Fatalf("staleVersionError() code = %v, want %v", st.Code(), codes.Aborted)
+	}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.GetReason() == middleware.ReasonStaleVersion {
+			return
+		}
+	}
+	t.Errorf("staleVersionError() details = %v, want %s", st.Details(), middleware.ReasonStaleVersion)
+}
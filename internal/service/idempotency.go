@@ -0,0 +1,189 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"time"
+
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/0utl1er-tech/prism-backend/internal/middleware"
+	"github.com/0utl1er-tech/prism-backend/internal/store"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const maxIdempotencyKeyLength = 255
+
+// Idempotency Idempotency-Key を指定した作成系RPCの最初のレスポンスを保存して、再送時に同じレスポンスを返す
+type Idempotency struct {
+	store store.Store
+	ttl   time.Duration
+	// 処理中のまま残ったキーを放棄されたとみなすまでの時間。RPCの処理時間の上限より長くする
+	staleAfter time.Duration
+}
+
+func NewIdempotency(store store.Store, ttl, staleAfter time.Duration) *Idempotency {
+	return &Idempotency{
+		store:      store,
+		ttl:        ttl,
+		staleAfter: staleAfter,
+	}
+}
+
+// RunCleanup 期限切れのキーを定期的に削除する
+func (idem *Idempotency) RunCleanup(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		deleted, err := idem.store.DeleteExpiredIdempotencyKeys(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to delete expired idempotency keys")
+			continue
+		}
+		if deleted > 0 {
+			log.Info().Int64("deleted", deleted).Msg("Deleted expired idempotency keys")
+		}
+	}
+}
+
+// idempotent キーがない場合は fn をそのまま実行する。
+// 同じキーで異なるリクエストを送った場合と、最初のリクエストが処理中の場合は Aborted を返す。
+// fn が setETag した version はレスポンスと一緒に保存し、再送時も同じ ETag を返す
+func idempotent[Res proto.Message](ctx context.Context, idem *Idempotency, req proto.Message, fn func(ctx context.Context) (Res, error)) (Res, error) {
+	var zero Res
+	key, err := idempotencyKey(ctx)
+	if err != nil {
+		return zero, err
+	}
+	if key == "" || idem == nil {
+		return fn(ctx)
+	}
+
+	// キーはユーザーごとに管理するため、ユーザーがない場合は他の呼び出し元とキーが衝突しないよう拒否する
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return zero, status.Errorf(codes.Unauthenticated, "%s requires an authenticated user: %s", middleware.IdempotencyKeyMetadataKey, status.Convert(err).Message())
+	}
+	rpc := rpcMethod(ctx)
+	hash, err := requestHash(rpc, req)
+	if err != nil {
+		return zero, err
+	}
+
+	now := time.Now()
+	_, err = idem.store.ReserveIdempotencyKey(ctx, db.ReserveIdempotencyKeyParams{
+		UserID:      userID,
+		Key:         key,
+		Rpc:         rpc,
+		RequestHash: hash,
+		ExpiresAt:   now.Add(idem.ttl),
+		StaleBefore: now.Add(-idem.staleAfter),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return replayIdempotent[Res](ctx, idem, userID, key, hash)
+	}
+	if err != nil {
+		return zero, err
+	}
+
+	var version pgtype.Int8
+	res, err := fn(recordETag(ctx, &version))
+	// シャットダウンやクライアントの切断でキーが残らないようにする
+	saveCtx := context.WithoutCancel(ctx)
+	if err != nil {
+		// 失敗した場合は同じキーで再試行できるようにする
+		deleteErr := idem.store.DeleteIdempotencyKey(saveCtx, db.DeleteIdempotencyKeyParams{
+			UserID: userID,
+			Key:    key,
+		})
+		if deleteErr != nil {
+			log.Error().Err(deleteErr).Msg("Failed to release idempotency key")
+		}
+		return zero, err
+	}
+
+	data, err := proto.Marshal(res)
+	if err != nil {
+		return zero, err
+	}
+	err = idem.store.SaveIdempotencyResponse(saveCtx, db.SaveIdempotencyResponseParams{
+		UserID:   userID,
+		Key:      key,
+		Response: data,
+		Version:  version,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to save idempotent response")
+	}
+	return res, nil
+}
+
+func replayIdempotent[Res proto.Message](ctx context.Context, idem *Idempotency, userID uuid.UUID, key string, hash []byte) (Res, error) {
+	var zero Res
+	record, err := idem.store.GetIdempotencyKey(ctx, db.GetIdempotencyKeyParams{
+		UserID: userID,
+		Key:    key,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		// 最初のリクエストが失敗して削除された直後
+		return zero, status.Error(codes.Aborted, "idempotency key was released, retry the request")
+	}
+	if err != nil {
+		return zero, err
+	}
+	if !bytes.Equal(record.RequestHash, hash) {
+		return zero, status.Error(codes.Aborted, "idempotency key was already used with a different request")
+	}
+	if record.Response == nil {
+		return zero, status.Error(codes.Aborted, "request with the same idempotency key is in progress")
+	}
+
+	res := zero.ProtoReflect().New().Interface().(Res)
+	err = proto.Unmarshal(record.Response, res)
+	if err != nil {
+		return zero, err
+	}
+	if record.Version.Valid {
+		setETag(ctx, record.Version.Int64)
+	}
+	return res, nil
+}
+
+// idempotencyKey メタデータの Idempotency-Key。指定がない場合は空文字
+func idempotencyKey(ctx context.Context) (string, error) {
+	values := metadata.ValueFromIncomingContext(ctx, middleware.IdempotencyKeyMetadataKey)
+	if len(values) == 0 || values[0] == "" {
+		return "", nil
+	}
+	if len(values[0]) > maxIdempotencyKeyLength {
+		return "", invalidArgumentError(middleware.IdempotencyKeyMetadataKey, "must be at most 255 characters")
+	}
+	return values[0], nil
+}
+
+func requestHash(rpc string, req proto.Message) ([]byte, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.New()
+	hash.Write([]byte(rpc))
+	hash.Write([]byte{0})
+	hash.Write(data)
+	return hash.Sum(nil), nil
+}
@@ -0,0 +1,283 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/0utl1er-tech/prism-backend/internal/middleware"
+	"github.com/0utl1er-tech/prism-backend/internal/store"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// fakeIdempotencyStore IdempotencyKey テーブルのクエリをメモリ上で再現する
+type fakeIdempotencyStore struct {
+	store.Store
+	keys map[db.GetIdempotencyKeyParams]db.IdempotencyKey
+}
+
+func newFakeIdempotencyStore() *fakeIdempotencyStore {
+	return &fakeIdempotencyStore{keys: map[db.GetIdempotencyKeyParams]db.IdempotencyKey{}}
+}
+
+func (s *fakeIdempotencyStore) ReserveIdempotencyKey(ctx context.Context, arg db.ReserveIdempotencyKeyParams) (db.IdempotencyKey, error) {
+	id := db.GetIdempotencyKeyParams{UserID: arg.UserID, Key: arg.Key}
+	if record, ok := s.keys[id]; ok && (record.Response != nil || !record.CreatedAt.Before(arg.StaleBefore)) {
+		return db.IdempotencyKey{}, pgx.ErrNoRows
+	}
+	record := db.IdempotencyKey{
+		UserID:      arg.UserID,
+		Key:         arg.Key,
+		Rpc:         arg.Rpc,
+		RequestHash: arg.RequestHash,
+		CreatedAt:   time.Now(),
+		ExpiresAt:   arg.ExpiresAt,
+	}
+	s.keys[id] = record
+	return record, nil
+}
+
+func (s *fakeIdempotencyStore) GetIdempotencyKey(ctx context.Context, arg db.GetIdempotencyKeyParams) (db.IdempotencyKey, error) {
+	record, ok := s.keys[arg]
+	if !ok {
+		return db.IdempotencyKey{}, pgx.ErrNoRows
+	}
+	return record, nil
+}
+
+func (s *fakeIdempotencyStore) SaveIdempotencyResponse(ctx context.Context, arg db.SaveIdempotencyResponseParams) error {
+	id := db.GetIdempotencyKeyParams{UserID: arg.UserID, Key: arg.Key}
+	record := s.keys[id]
+	record.Response = arg.Response
+	record.Version = arg.Version
+	s.keys[id] = record
+	return nil
+}
+
+func (s *fakeIdempotencyStore) DeleteIdempotencyKey(ctx context.Context, arg db.DeleteIdempotencyKeyParams) error {
+	delete(s.keys, db.GetIdempotencyKeyParams{UserID: arg.UserID, Key: arg.Key})
+	return nil
+}
+
+// headerStream grpc.SetHeader で設定したヘッダーを記録する
+type headerStream struct {
+	grpc.ServerTransportStream
+	header metadata.MD
+}
+
+func (s *headerStream) Method() string {
+	return "/customer.v1.CustomerService/CreateCustomer"
+}
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func idempotencyContext(userID uuid.UUID, key string) context.Context {
+	md := metadata.Pairs(middleware.IdempotencyKeyMetadataKey, key)
+	if userID != uuid.Nil {
+		md.Set(middleware.UserIDMetadataKey, userID.String())
+	}
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+func newStruct(t *testing.T, fields map[string]any) *structpb.Struct {
+	t.Helper()
+	s, err := structpb.NewStruct(fields)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestRequestHash(t *testing.T) {
+	req := newStruct(t, map[string]any{"name": "顧客", "phone": "0312345678", "tags": []any{"a", "b"}})
+	hash, err := requestHash("/customer.v1.CustomerService/CreateCustomer", req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// マップの順序が変わっても同じ値になる
+	for range 10 {
+		again, err := requestHash("/customer.v1.CustomerService/CreateCustomer", proto.Clone(req))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(hash, again) {
+			t.Fatal("requestHash() is not deterministic")
+		}
+	}
+
+	otherRPC, _ := requestHash("/note.v1.NoteService/CreateNote", req)
+	if bytes.Equal(hash, otherRPC) {
+		t.Error("requestHash() is the same for different RPCs")
+	}
+	otherReq, _ := requestHash("/customer.v1.CustomerService/CreateCustomer", newStruct(t, map[string]any{"name": "顧客"}))
+	if bytes.Equal(hash, otherReq) {
+		t.Error("requestHash() is the same for different requests")
+	}
+}
+
+func TestIdempotent(t *testing.T) {
+	userID := uuid.New()
+	req := newStruct(t, map[string]any{"name": "顧客"})
+
+	t.Run("without key", func(t *testing.T) {
+		calls := 0
+		for range 2 {
+			_, err := idempotent(idempotencyContext(userID, ""), NewIdempotency(newFakeIdempotencyStore(), time.Hour, time.Minute), req, func(ctx context.Context) (*structpb.Struct, error) {
+				calls++
+				return req, nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		if calls != 2 {
+			t.Errorf("fn called %d times, want 2", calls)
+		}
+	})
+
+	t.Run("without user", func(t *testing.T) {
+		_, err := idempotent(idempotencyContext(uuid.Nil, "key"), NewIdempotency(newFakeIdempotencyStore(), time.Hour, time.Minute), req, func(ctx context.Context) (*structpb.Struct, error) {
+			t.Fatal("fn must not be called")
+			return nil, nil
+		})
+		if status.Code(err) != codes.Unauthenticated {
+			t.Errorf("idempotent() error = %v, want %v", err, codes.Unauthenticated)
+		}
+	})
+
+	t.Run("replay", func(t *testing.T) {
+		idem := NewIdempotency(newFakeIdempotencyStore(), time.Hour, time.Minute)
+		ctx := idempotencyContext(userID, "key")
+		calls := 0
+		fn := func(ctx context.Context) (*structpb.Struct, error) {
+			calls++
+			return newStruct(t, map[string]any{"id": uuid.NewString()}), nil
+		}
+		first, err := idempotent(ctx, idem, req, fn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		second, err := idempotent(ctx, idem, req, fn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if calls != 1 || !proto.Equal(first, second) {
+			t.Errorf("fn called %d times, responses %v and %v, want 1 call and the same response", calls, first, second)
+		}
+
+		// キーはユーザーごと
+		_, err = idempotent(idempotencyContext(uuid.New(), "key"), idem, req, fn)
+		if err != nil || calls != 2 {
+			t.Errorf("idempotent() for another user: error = %v, calls = %d, want a new call", err, calls)
+		}
+
+		_, err = idempotent(ctx, idem, newStruct(t, map[string]any{"name": "別の顧客"}), fn)
+		if status.Code(err) != codes.Aborted {
+			t.Errorf("idempotent() with a different request error = %v, want %v", err, codes.Aborted)
+		}
+	})
+
+	t.Run("replay keeps the etag", func(t *testing.T) {
+		idem := NewIdempotency(newFakeIdempotencyStore(), time.Hour, time.Minute)
+		ctx := idempotencyContext(userID, "key")
+		fn := func(ctx context.Context) (*structpb.Struct, error) {
+			setETag(ctx, 3)
+			return req, nil
+		}
+		for i := range 2 {
+			stream := &headerStream{}
+			_, err := idempotent(grpc.NewContextWithServerTransportStream(ctx, stream), idem, req, fn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := stream.header.Get(middleware.ETagMetadataKey); len(got) != 1 || got[0] != `"3"` {
+				t.Errorf("call %d: ETag = %v, want %q", i+1, got, `"3"`)
+			}
+		}
+	})
+
+	t.Run("failed request is released", func(t *testing.T) {
+		idem := NewIdempotency(newFakeIdempotencyStore(), time.Hour, time.Minute)
+		ctx := idempotencyContext(userID, "key")
+		errFailed := status.Error(codes.Unavailable, "failed")
+		_, err := idempotent(ctx, idem, req, func(ctx context.Context) (*structpb.Struct, error) {
+			return nil, errFailed
+		})
+		if !errors.Is(err, errFailed) {
+			t.Fatalf("idempotent() error = %v, want %v", err, errFailed)
+		}
+		_, err = idempotent(ctx, idem, req, func(ctx context.Context) (*structpb.Struct, error) {
+			return req, nil
+		})
+		if err != nil {
+			t.Errorf("idempotent() retry error = %v", err)
+		}
+	})
+
+	t.Run("in progress", func(t *testing.T) {
+		fake := newFakeIdempotencyStore()
+		idem := NewIdempotency(fake, time.Hour, time.Minute)
+		ctx := idempotencyContext(userID, "key")
+		_, err := idempotent(ctx, idem, req, func(ctx context.Context) (*structpb.Struct, error) {
+			// 最初のリクエストの処理中に再送される
+			_, err := idempotent(ctx, idem, req, func(ctx context.Context) (*structpb.Struct, error) {
+				t.Fatal("fn must not be called while the first request is in progress")
+				return nil, nil
+			})
+			if status.Code(err) != codes.Aborted {
+				t.Errorf("idempotent() error = %v, want %v", err, codes.Aborted)
+			}
+			return req, nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("stale reservation", func(t *testing.T) {
+		fake := newFakeIdempotencyStore()
+		idem := NewIdempotency(fake, time.Hour, time.Minute)
+		ctx := idempotencyContext(userID, "key")
+		hash, err := requestHash("", req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// 処理中のまま staleAfter より前に放棄されたキー
+		fake.keys[db.GetIdempotencyKeyParams{UserID: userID, Key: "key"}] = db.IdempotencyKey{
+			UserID:      userID,
+			Key:         "key",
+			RequestHash: hash,
+			CreatedAt:   time.Now().Add(-2 * time.Minute),
+		}
+		calls := 0
+		_, err = idempotent(ctx, idem, req, func(ctx context.Context) (*structpb.Struct, error) {
+			calls++
+			return req, nil
+		})
+		if err != nil || calls != 1 {
+			t.Errorf("idempotent() error = %v, calls = %d, want the stale key to be taken over", err, calls)
+		}
+	})
+}
+
+func TestReplayIdempotentReleased(t *testing.T) {
+	idem := NewIdempotency(newFakeIdempotencyStore(), time.Hour, time.Minute)
+	_, err := replayIdempotent[*structpb.Struct](context.Background(), idem, uuid.New(), "key", nil)
+	if status.Code(err) != codes.Aborted {
+		t.Errorf("replayIdempotent() error = %v, want %v", err, codes.Aborted)
+	}
+}
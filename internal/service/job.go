@@ -0,0 +1,263 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	jobv1 "github.com/0utl1er-tech/prism-backend/gen/pb/job/v1"
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/0utl1er-tech/prism-backend/internal/job"
+	"github.com/0utl1er-tech/prism-backend/internal/store"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type JobService struct {
+	jobv1.UnimplementedJobServiceServer
+	store       store.Store
+	idempotency *Idempotency
+	runner      *job.Runner
+	maxAttempts int32
+}
+
+func NewJobService(store store.Store, idempotency *Idempotency, runner *job.Runner, maxAttempts int) *JobService {
+	return &JobService{
+		store:       store,
+		idempotency: idempotency,
+		runner:      runner,
+		maxAttempts: int32(maxAttempts),
+	}
+}
+
+func (server *JobService) CreateJob(ctx context.Context, req *jobv1.CreateJobRequest) (*jobv1.CreateJobResponse, error) {
+	return idempotent(ctx, server.idempotency, req, func(ctx context.Context) (*jobv1.CreateJobResponse, error) {
+		return server.createJob(ctx, req)
+	})
+}
+
+func (server *JobService) createJob(ctx context.Context, req *jobv1.CreateJobRequest) (*jobv1.CreateJobResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !server.runner.Handles(req.GetType()) {
+		return nil, invalidArgumentError("type", "unknown job type")
+	}
+
+	params := []byte("{}")
+	if req.GetParams() != nil {
+		params, err = protojson.Marshal(req.GetParams())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var record db.Job
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		var err error
+		record, err = q.CreateJob(ctx, db.CreateJobParams{
+			ID:          uuid.New(),
+			Type:        req.GetType(),
+			Params:      params,
+			MaxAttempts: server.maxAttempts,
+			UserID:      pgtype.UUID{Bytes: userID, Valid: true},
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	jobRes, err := toJobPb(record)
+	if err != nil {
+		return nil, err
+	}
+	return &jobv1.CreateJobResponse{
+		Job: jobRes,
+	}, nil
+}
+
+func (server *JobService) GetJob(ctx context.Context, req *jobv1.GetJobRequest) (*jobv1.GetJobResponse, error) {
+	id, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := server.store.GetJob(ctx, id)
+	if err != nil {
+		return nil, notFoundError(err, "job")
+	}
+
+	jobRes, err := toJobPb(record)
+	if err != nil {
+		return nil, err
+	}
+	return &jobv1.GetJobResponse{
+		Job: jobRes,
+	}, nil
+}
+
+func (server *JobService) ListJobs(ctx context.Context, req *jobv1.ListJobsRequest) (*jobv1.ListJobsResponse, error) {
+	limit, offset := pagination(req.GetPage(), req.GetLimit())
+	arg := db.ListJobsParams{
+		Type: pgtype.Text{
+			String: req.GetType(),
+			Valid:  req.Type != nil,
+		},
+		RowLimit:  limit,
+		RowOffset: offset,
+	}
+
+	userID, err := currentUserID(ctx)
+	if req.UserId != nil {
+		userID, err = parseUUID("user_id", req.GetUserId())
+	}
+	if err != nil {
+		return nil, err
+	}
+	arg.UserID = pgtype.UUID{Bytes: userID, Valid: true}
+
+	if req.GetStatus() != jobv1.JobStatus_JOB_STATUS_UNSPECIFIED {
+		jobStatus, ok := jobStatusFromPb[req.GetStatus()]
+		if !ok {
+			return nil, invalidArgumentError("status", "unknown job status")
+		}
+		arg.Status = db.NullJobStatus{JobStatus: jobStatus, Valid: true}
+	}
+
+	records, err := server.store.ListJobs(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+
+	jobsRes := make([]*jobv1.Job, len(records))
+	for i, record := range records {
+		jobsRes[i], err = toJobPb(record)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &jobv1.ListJobsResponse{
+		Jobs:  jobsRes,
+		Page:  req.GetPage(),
+		Limit: limit,
+	}, nil
+}
+
+func (server *JobService) CancelJob(ctx context.Context, req *jobv1.CancelJobRequest) (*jobv1.CancelJobResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := server.store.GetJob(ctx, id)
+	if err != nil {
+		return nil, notFoundError(err, "job")
+	}
+	if record.UserID.Bytes != userID {
+		return nil, status.Error(codes.PermissionDenied, "only the creator can cancel the job")
+	}
+
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		var err error
+		record, err = q.CancelJob(ctx, id)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.FailedPrecondition, "job is already finished")
+		}
+		return nil, err
+	}
+
+	jobRes, err := toJobPb(record)
+	if err != nil {
+		return nil, err
+	}
+	return &jobv1.CancelJobResponse{
+		Job: jobRes,
+	}, nil
+}
+
+func (server *JobService) GetJobFile(ctx context.Context, req *jobv1.GetJobFileRequest) (*httpbody.HttpBody, error) {
+	id, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := server.store.GetJobFile(ctx, id)
+	if err != nil {
+		return nil, notFoundError(err, "job file")
+	}
+
+	return &httpbody.HttpBody{
+		ContentType: file.ContentType,
+		Data:        file.Content,
+	}, nil
+}
+
+// jobFileLocation Job.result_location に残す GetJobFile のパス
+func jobFileLocation(id uuid.UUID) string {
+	return "/v1/jobs/" + id.String() + "/file"
+}
+
+var jobStatusToPb = map[db.JobStatus]jobv1.JobStatus{
+	db.JobStatusQueued:    jobv1.JobStatus_JOB_STATUS_QUEUED,
+	db.JobStatusRunning:   jobv1.JobStatus_JOB_STATUS_RUNNING,
+	db.JobStatusSucceeded: jobv1.JobStatus_JOB_STATUS_SUCCEEDED,
+	db.JobStatusFailed:    jobv1.JobStatus_JOB_STATUS_FAILED,
+	db.JobStatusCanceled:  jobv1.JobStatus_JOB_STATUS_CANCELED,
+}
+
+var jobStatusFromPb = map[jobv1.JobStatus]db.JobStatus{
+	jobv1.JobStatus_JOB_STATUS_QUEUED:    db.JobStatusQueued,
+	jobv1.JobStatus_JOB_STATUS_RUNNING:   db.JobStatusRunning,
+	jobv1.JobStatus_JOB_STATUS_SUCCEEDED: db.JobStatusSucceeded,
+	jobv1.JobStatus_JOB_STATUS_FAILED:    db.JobStatusFailed,
+	jobv1.JobStatus_JOB_STATUS_CANCELED:  db.JobStatusCanceled,
+}
+
+func toJobPb(record db.Job) (*jobv1.Job, error) {
+	params, err := jsonbToStruct(record.Params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := jsonbToStruct(record.Result)
+	if err != nil {
+		return nil, err
+	}
+
+	jobRes := &jobv1.Job{
+		Id:              record.ID.String(),
+		Type:            record.Type,
+		Status:          jobStatusToPb[record.Status],
+		Progress:        record.Progress,
+		Params:          params,
+		Result:          result,
+		ResultLocation:  record.ResultLocation.String,
+		Error:           record.Error.String,
+		Attempts:        record.Attempts,
+		MaxAttempts:     record.MaxAttempts,
+		CancelRequested: record.CancelRequested,
+		UserId:          uuidString(record.UserID),
+		CreatedAt:       timestamppb.New(record.CreatedAt),
+	}
+	if record.StartedAt.Valid {
+		jobRes.StartedAt = timestamppb.New(record.StartedAt.Time)
+	}
+	if record.FinishedAt.Valid {
+		jobRes.FinishedAt = timestamppb.New(record.FinishedAt.Time)
+	}
+	return jobRes, nil
+}
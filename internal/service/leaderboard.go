@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	reportv1 "github.com/0utl1er-tech/prism-backend/gen/pb/report/v1"
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (server *ReportService) GetLeaderboard(ctx context.Context, req *reportv1.GetLeaderboardRequest) (*reportv1.GetLeaderboardResponse, error) {
+	location, err := loadTimezone(req.GetTimezone())
+	if err != nil {
+		return nil, err
+	}
+	from, to, err := periodRange(req, time.Now().In(location))
+	if err != nil {
+		return nil, err
+	}
+
+	var bookID pgtype.UUID
+	if req.BookId != nil {
+		id, err := parseUUID("book_id", req.GetBookId())
+		if err != nil {
+			return nil, err
+		}
+		bookID = pgtype.UUID{Bytes: id, Valid: true}
+	}
+
+	rows, err := server.queries.ReportLeaderboard(ctx, db.ReportLeaderboardParams{
+		FromTime: from,
+		ToTime:   to,
+		BookID:   bookID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	score := rankScore(req.GetRankBy())
+	sort.SliceStable(rows, func(i, j int) bool {
+		return score(rows[i]) > score(rows[j])
+	})
+
+	entries := make([]*reportv1.LeaderboardEntry, 0, len(rows))
+	for i, row := range rows {
+		if req.GetLimit() > 0 && i >= int(req.GetLimit()) {
+			break
+		}
+		rank := int32(i + 1)
+		if i > 0 && score(row) == score(rows[i-1]) {
+			rank = entries[i-1].Rank
+		}
+		entries = append(entries, &reportv1.LeaderboardEntry{
+			Rank:      rank,
+			UserId:    row.UserID.String(),
+			UserName:  row.UserName,
+			Calls:     row.Calls,
+			Effective: row.Effective,
+			Ng:        row.Ng,
+			Redials:   row.Redials,
+		})
+	}
+
+	return &reportv1.GetLeaderboardResponse{
+		Entries: entries,
+		From:    timestamppb.New(from),
+		To:      timestamppb.New(to),
+	}, nil
+}
+
+func (server *ReportService) GetUserActivity(ctx context.Context, req *reportv1.GetUserActivityRequest) (*reportv1.GetUserActivityResponse, error) {
+	userID, err := parseUUID("user_id", req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	location, err := loadTimezone(req.GetTimezone())
+	if err != nil {
+		return nil, err
+	}
+
+	day := startOfDay(time.Now().In(location))
+	if req.GetDate() != "" {
+		day, err = time.ParseInLocation(time.DateOnly, req.GetDate(), location)
+		if err != nil {
+			return nil, invalidArgumentError("date", "date must be YYYY-MM-DD")
+		}
+	}
+	from, to := day, day.AddDate(0, 0, 1)
+
+	user, err := server.queries.GetUser(ctx, userID)
+	if err != nil {
+		return nil, notFoundError(err, "user")
+	}
+
+	calls, err := server.queries.ListUserCallActivity(ctx, db.ListUserCallActivityParams{
+		UserID:   userID,
+		FromTime: from,
+		ToTime:   to,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	redials, err := server.queries.ListUserRedialActivity(ctx, db.ListUserRedialActivityParams{
+		UserID:   pgtype.UUID{Bytes: userID, Valid: true},
+		FromTime: from,
+		ToTime:   to,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &reportv1.GetUserActivityResponse{
+		UserId:     user.ID.String(),
+		UserName:   user.Name,
+		Date:       day.Format(time.DateOnly),
+		Activities: make([]*reportv1.Activity, 0, len(calls)+len(redials)),
+		Redials:    int64(len(redials)),
+	}
+
+	customers := make(map[string]struct{}, len(calls))
+	var effective, ng int64
+	for _, call := range calls {
+		customers[call.CustomerID.String()] = struct{}{}
+		if call.Effective {
+			effective++
+		}
+		if call.Ng {
+			ng++
+		}
+		res.Activities = append(res.Activities, &reportv1.Activity{
+			Type:         reportv1.ActivityType_ACTIVITY_TYPE_CALL,
+			Time:         timestamppb.New(call.CreatedAt),
+			CustomerId:   call.CustomerID.String(),
+			CustomerName: call.CustomerName,
+			CallId:       call.CallID.String(),
+			StatusName:   call.StatusName.String,
+			Effective:    call.Effective,
+			Ng:           call.Ng,
+		})
+	}
+	if len(calls) > 0 {
+		res.FirstCallAt = timestamppb.New(calls[0].CreatedAt)
+		res.LastCallAt = timestamppb.New(calls[len(calls)-1].CreatedAt)
+	}
+	res.Stats = toCallStatsPb(int64(len(calls)), effective, ng, int64(len(customers)))
+
+	for _, redial := range redials {
+		res.Activities = append(res.Activities, &reportv1.Activity{
+			Type:              reportv1.ActivityType_ACTIVITY_TYPE_REDIAL_BOOKED,
+			Time:              timestamppb.New(redial.BookedAt),
+			CustomerId:        redial.CustomerID.String(),
+			CustomerName:      redial.CustomerName,
+			RedialScheduledAt: timestamppb.New(redial.ScheduledAt),
+		})
+	}
+	sort.SliceStable(res.Activities, func(i, j int) bool {
+		return res.Activities[i].GetTime().AsTime().Before(res.Activities[j].GetTime().AsTime())
+	})
+
+	return res, nil
+}
+
+// periodRange 集計期間の種類から開始と終了の時刻を求める
+func periodRange(req *reportv1.GetLeaderboardRequest, now time.Time) (time.Time, time.Time, error) {
+	today := startOfDay(now)
+	switch req.GetPeriod() {
+	case reportv1.Period_PERIOD_THIS_WEEK:
+		offset := (int(today.Weekday()) + 6) % 7
+		from := today.AddDate(0, 0, -offset)
+		return from, from.AddDate(0, 0, 7), nil
+	case reportv1.Period_PERIOD_THIS_MONTH:
+		from := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, today.Location())
+		return from, from.AddDate(0, 1, 0), nil
+	case reportv1.Period_PERIOD_CUSTOM:
+		if req.GetFrom() == nil || req.GetTo() == nil {
+			return time.Time{}, time.Time{}, invalidArgumentError("period", "from and to are required for custom period")
+		}
+		from, to := req.GetFrom().AsTime(), req.GetTo().AsTime()
+		if !from.Before(to) {
+			return time.Time{}, time.Time{}, invalidArgumentError("from", "from must be before to")
+		}
+		if to.Sub(from) > maxReportRange {
+			return time.Time{}, time.Time{}, invalidArgumentError("to", "report range must be within 366 days")
+		}
+		return from, to, nil
+	default:
+		return today, today.AddDate(0, 0, 1), nil
+	}
+}
+
+func rankScore(rankBy reportv1.RankBy) func(db.ReportLeaderboardRow) int64 {
+	switch rankBy {
+	case reportv1.RankBy_RANK_BY_EFFECTIVE:
+		return func(row db.ReportLeaderboardRow) int64 { return row.Effective }
+	case reportv1.RankBy_RANK_BY_NG:
+		return func(row db.ReportLeaderboardRow) int64 { return row.Ng }
+	case reportv1.RankBy_RANK_BY_REDIALS:
+		return func(row db.ReportLeaderboardRow) int64 { return row.Redials }
+	default:
+		return func(row db.ReportLeaderboardRow) int64 { return row.Calls }
+	}
+}
+
+// loadTimezone IANA のタイムゾーン名を読み込む。
+// "Local" はサーバーの設定に依存し、PostgreSQL の AT TIME ZONE でも使えないため受け付けない
+func loadTimezone(name string) (*time.Location, error) {
+	if name == "" {
+		name = defaultTimezone
+	}
+	if name == "Local" {
+		return nil, invalidArgumentError("timezone", "timezone must be an IANA time zone name")
+	}
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, invalidArgumentError("timezone", err.Error())
+	}
+	return location, nil
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
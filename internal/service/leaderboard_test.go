@@ -0,0 +1,88 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	reportv1 "github.com/0utl1er-tech/prism-backend/gen/pb/report/v1"
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/0utl1er-tech/prism-backend/internal/dbtest"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGetLeaderboardCountsRebookedRedials(t *testing.T) {
+	dbStore, systemStore := dbtest.Open(t)
+	ctx, user := dbtest.CreateOrganization(t, systemStore)
+
+	book, err := dbStore.CreateBook(ctx, db.CreateBookParams{ID: uuid.New(), Name: t.Name()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	customer, err := dbStore.CreateCustomer(ctx, db.CreateCustomerParams{
+		ID:           uuid.New(),
+		BookID:       book.ID,
+		Name:         "顧客",
+		CustomFields: []byte("{}"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 同じ顧客を予約し直しても、それぞれ1件の予約として数える
+	from := time.Now().Add(-time.Minute)
+	for i := range 3 {
+		_, err = dbStore.UpsertRedial(ctx, db.UpsertRedialParams{
+			ID:          customer.ID,
+			UserID:      user.ID,
+			ScheduledAt: time.Now().Add(time.Duration(i+1) * time.Hour),
+			BookedBy:    pgtype.UUID{Bytes: user.ID, Valid: true},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	res, err := NewReportService(dbStore).GetLeaderboard(ctx, &reportv1.GetLeaderboardRequest{
+		Period: reportv1.Period_PERIOD_CUSTOM,
+		From:   timestamppb.New(from),
+		To:     timestamppb.New(time.Now().Add(time.Minute)),
+	})
+	if err != nil {
+		t.Fatalf("GetLeaderboard() error = %v", err)
+	}
+	if len(res.Entries) != 1 {
+		t.Fatalf("GetLeaderboard() returned %d entries, want 1", len(res.Entries))
+	}
+	if got := res.Entries[0].Redials; got != 3 {
+		t.Errorf("GetLeaderboard() redials = %d, want 3", got)
+	}
+}
+
+func TestLoadTimezone(t *testing.T) {
+	for _, name := range []string{"", "Asia/Tokyo", "UTC"} {
+		if _, err := loadTimezone(name); err != nil {
+			t.Errorf("loadTimezone(%q) error = %v", name, err)
+		}
+	}
+	for _, name := range []string{"Local", "Asia/Nowhere"} {
+		if _, err := loadTimezone(name); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("loadTimezone(%q) error = %v, want %v", name, err, codes.InvalidArgument)
+		}
+	}
+}
+
+func TestTimezoneError(t *testing.T) {
+	err := timezoneError(&pgconn.PgError{Code: invalidParameterValue, Message: `time zone "Asia/Nowhere" not recognized`})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("timezoneError() = %v, want %v", err, codes.InvalidArgument)
+	}
+	other := &pgconn.PgError{Code: uniqueViolation}
+	if err := timezoneError(other); err != other {
+		t.Errorf("timezoneError() = %v, want the original error", err)
+	}
+}
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	notev1 "github.com/0utl1er-tech/prism-backend/gen/pb/note/v1"
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/0utl1er-tech/prism-backend/internal/store"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type NoteService struct {
+	notev1.UnimplementedNoteServiceServer
+	store       store.Store
+	idempotency *Idempotency
+}
+
+func NewNoteService(store store.Store, idempotency *Idempotency) *NoteService {
+	return &NoteService{
+		store:       store,
+		idempotency: idempotency,
+	}
+}
+
+func (server *NoteService) CreateNote(ctx context.Context, req *notev1.CreateNoteRequest) (*notev1.CreateNoteResponse, error) {
+	return idempotent(ctx, server.idempotency, req, func(ctx context.Context) (*notev1.CreateNoteResponse, error) {
+		return server.createNote(ctx, req)
+	})
+}
+
+func (server *NoteService) createNote(ctx context.Context, req *notev1.CreateNoteRequest) (*notev1.CreateNoteResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	customerID, err := parseUUID("customer_id", req.GetCustomerId())
+	if err != nil {
+		return nil, err
+	}
+	body, err := noteBody(req.GetBody())
+	if err != nil {
+		return nil, err
+	}
+
+	var note db.Note
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		_, err := q.GetCustomerByID(ctx, customerID)
+		if err != nil {
+			return notFoundError(err, "customer")
+		}
+
+		note, err = q.CreateNote(ctx, db.CreateNoteParams{
+			ID:         uuid.New(),
+			CustomerID: customerID,
+			UserID:     userID,
+			Body:       body,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &notev1.CreateNoteResponse{
+		Note: toNotePb(note),
+	}, nil
+}
+
+func (server *NoteService) UpdateNote(ctx context.Context, req *notev1.UpdateNoteRequest) (*notev1.UpdateNoteResponse, error) {
+	noteID, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	body, err := noteBody(req.GetBody())
+	if err != nil {
+		return nil, err
+	}
+
+	var note db.Note
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		if err := server.checkAuthor(ctx, q, noteID); err != nil {
+			return err
+		}
+
+		var err error
+		note, err = q.UpdateNote(ctx, db.UpdateNoteParams{
+			ID:   noteID,
+			Body: body,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &notev1.UpdateNoteResponse{
+		Note: toNotePb(note),
+	}, nil
+}
+
+func (server *NoteService) DeleteNote(ctx context.Context, req *notev1.DeleteNoteRequest) (*notev1.DeleteNoteResponse, error) {
+	noteID, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		if err := server.checkAuthor(ctx, q, noteID); err != nil {
+			return err
+		}
+		return q.DeleteNote(ctx, noteID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &notev1.DeleteNoteResponse{}, nil
+}
+
+// checkAuthor メモを書いたユーザー以外は編集・削除できない
+func (server *NoteService) checkAuthor(ctx context.Context, q *db.Queries, noteID uuid.UUID) error {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	note, err := q.GetNote(ctx, noteID)
+	if err != nil {
+		return notFoundError(err, "note")
+	}
+	if note.UserID != userID {
+		return status.Error(codes.PermissionDenied, "only the author can modify the note")
+	}
+	return nil
+}
+
+func noteBody(body string) (string, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return "", invalidArgumentError("body", "must not be empty")
+	}
+	return body, nil
+}
+
+func toNotePb(note db.Note) *notev1.Note {
+	return &notev1.Note{
+		Id:         note.ID.String(),
+		CustomerId: note.CustomerID.String(),
+		UserId:     note.UserID.String(),
+		Body:       note.Body,
+		CreatedAt:  timestamppb.New(note.CreatedAt),
+		UpdatedAt:  timestamppb.New(note.UpdatedAt),
+	}
+}
@@ -0,0 +1,261 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	organizationv1 "github.com/0utl1er-tech/prism-backend/gen/pb/organization/v1"
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/0utl1er-tech/prism-backend/internal/store"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const foreignKeyViolation = "23503"
+
+type OrganizationService struct {
+	organizationv1.UnimplementedOrganizationServiceServer
+	store store.Store
+}
+
+func NewOrganizationService(store store.Store) *OrganizationService {
+	return &OrganizationService{
+		store: store,
+	}
+}
+
+func (server *OrganizationService) GetOrganization(ctx context.Context, req *organizationv1.GetOrganizationRequest) (*organizationv1.GetOrganizationResponse, error) {
+	user, err := currentUser(ctx, server.store)
+	if err != nil {
+		return nil, err
+	}
+
+	organization, err := server.store.GetOrganization(ctx, user.OrganizationID)
+	if err != nil {
+		return nil, notFoundError(err, "organization")
+	}
+
+	return &organizationv1.GetOrganizationResponse{
+		Organization: toOrganizationPb(organization),
+	}, nil
+}
+
+func (server *OrganizationService) UpdateOrganization(ctx context.Context, req *organizationv1.UpdateOrganizationRequest) (*organizationv1.UpdateOrganizationResponse, error) {
+	user, err := requireOwner(ctx, server.store, "the organization")
+	if err != nil {
+		return nil, err
+	}
+	if req.Name != nil && strings.TrimSpace(req.GetName()) == "" {
+		return nil, invalidArgumentError("name", "must not be empty")
+	}
+
+	var organization db.Organization
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		var err error
+		organization, err = q.UpdateOrganization(ctx, db.UpdateOrganizationParams{
+			ID: user.OrganizationID,
+			Name: pgtype.Text{
+				String: strings.TrimSpace(req.GetName()),
+				Valid:  req.Name != nil,
+			},
+		})
+		return err
+	})
+	if err != nil {
+		return nil, notFoundError(err, "organization")
+	}
+
+	return &organizationv1.UpdateOrganizationResponse{
+		Organization: toOrganizationPb(organization),
+	}, nil
+}
+
+func (server *OrganizationService) ListMembers(ctx context.Context, req *organizationv1.ListMembersRequest) (*organizationv1.ListMembersResponse, error) {
+	if _, err := currentUser(ctx, server.store); err != nil {
+		return nil, err
+	}
+
+	users, err := server.store.ListOrganizationUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]*organizationv1.Member, len(users))
+	for i, user := range users {
+		members[i] = toMemberPb(user)
+	}
+
+	return &organizationv1.ListMembersResponse{
+		Members: members,
+	}, nil
+}
+
+func (server *OrganizationService) AddMember(ctx context.Context, req *organizationv1.AddMemberRequest) (*organizationv1.AddMemberResponse, error) {
+	if _, err := requireOwner(ctx, server.store, "the organization"); err != nil {
+		return nil, err
+	}
+	userID, err := parseUUID("user_id", req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetEmail() == "" {
+		return nil, invalidArgumentError("email", "must not be empty")
+	}
+	role, ok := roleFromPb[req.GetRole()]
+	if !ok {
+		return nil, invalidArgumentError("role", "must be specified")
+	}
+
+	var member db.User
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		var err error
+		// organization_id は呼び出し元の組織になる
+		member, err = q.CreateUser(ctx, db.CreateUserParams{
+			ID:    userID,
+			Email: req.GetEmail(),
+			Name:  req.GetName(),
+			Role:  role,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, alreadyExistsError(err, "user")
+	}
+
+	return &organizationv1.AddMemberResponse{
+		Member: toMemberPb(member),
+	}, nil
+}
+
+func (server *OrganizationService) UpdateMemberRole(ctx context.Context, req *organizationv1.UpdateMemberRoleRequest) (*organizationv1.UpdateMemberRoleResponse, error) {
+	if _, err := requireOwner(ctx, server.store, "the organization"); err != nil {
+		return nil, err
+	}
+	userID, err := parseUUID("user_id", req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	role, ok := roleFromPb[req.GetRole()]
+	if !ok {
+		return nil, invalidArgumentError("role", "must be specified")
+	}
+
+	var member db.User
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		var err error
+		member, err = q.UpdateUser(ctx, db.UpdateUserParams{
+			ID:   userID,
+			Role: db.NullRole{Role: role, Valid: true},
+		})
+		if err != nil {
+			return notFoundError(err, "member")
+		}
+
+		// 組織を管理できるユーザーがいなくならないようにする
+		owners, err := q.CountOrganizationOwners(ctx)
+		if err != nil {
+			return err
+		}
+		if owners == 0 {
+			return status.Error(codes.FailedPrecondition, "organization must have at least one owner")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &organizationv1.UpdateMemberRoleResponse{
+		Member: toMemberPb(member),
+	}, nil
+}
+
+func (server *OrganizationService) RemoveMember(ctx context.Context, req *organizationv1.RemoveMemberRequest) (*organizationv1.RemoveMemberResponse, error) {
+	owner, err := requireOwner(ctx, server.store, "the organization")
+	if err != nil {
+		return nil, err
+	}
+	userID, err := parseUUID("user_id", req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if userID == owner.ID {
+		return nil, status.Error(codes.FailedPrecondition, "cannot remove yourself")
+	}
+
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		// 他の組織のユーザーは行レベルセキュリティで見えない
+		if _, err := q.GetUser(ctx, userID); err != nil {
+			return notFoundError(err, "member")
+		}
+		return q.DeleteUser(ctx, userID)
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+			return nil, status.Error(codes.FailedPrecondition, "member has call history and cannot be removed")
+		}
+		return nil, err
+	}
+
+	return &organizationv1.RemoveMemberResponse{}, nil
+}
+
+// currentUser 呼び出し元ユーザー。組織のユーザーは行レベルセキュリティで自分の組織に限定される
+func currentUser(ctx context.Context, queries db.Querier) (db.User, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return db.User{}, err
+	}
+	user, err := queries.GetUser(ctx, userID)
+	if err != nil {
+		return db.User{}, notFoundError(err, "user")
+	}
+	return user, nil
+}
+
+// requireOwner 呼び出し元ユーザーがオーナーでなければ PermissionDenied を返す。target は管理する対象
+func requireOwner(ctx context.Context, queries db.Querier, target string) (db.User, error) {
+	user, err := currentUser(ctx, queries)
+	if err != nil {
+		return db.User{}, err
+	}
+	if user.Role != db.RoleOwner {
+		return db.User{}, status.Errorf(codes.PermissionDenied, "only owners can manage %s", target)
+	}
+	return user, nil
+}
+
+var roleFromPb = map[organizationv1.Role]db.Role{
+	organizationv1.Role_ROLE_OWNER:  db.RoleOwner,
+	organizationv1.Role_ROLE_EDITOR: db.RoleEditor,
+	organizationv1.Role_ROLE_VIEWER: db.RoleViewer,
+}
+
+var roleToPb = map[db.Role]organizationv1.Role{
+	db.RoleOwner:  organizationv1.Role_ROLE_OWNER,
+	db.RoleEditor: organizationv1.Role_ROLE_EDITOR,
+	db.RoleViewer: organizationv1.Role_ROLE_VIEWER,
+}
+
+func toOrganizationPb(organization db.Organization) *organizationv1.Organization {
+	return &organizationv1.Organization{
+		Id:        organization.ID.String(),
+		Name:      organization.Name,
+		CreatedAt: timestamppb.New(organization.CreatedAt),
+	}
+}
+
+func toMemberPb(user db.User) *organizationv1.Member {
+	return &organizationv1.Member{
+		UserId:    user.ID.String(),
+		Email:     user.Email,
+		Name:      user.Name,
+		Role:      roleToPb[user.Role],
+		CreatedAt: timestamppb.New(user.CreatedAt),
+	}
+}
@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	redialv1 "github.com/0utl1er-tech/prism-backend/gen/pb/redial/v1"
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/0utl1er-tech/prism-backend/internal/store"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListRedials で期間を省略した場合に返す範囲
+const defaultRedialRange = 7 * 24 * time.Hour
+
+type RedialService struct {
+	redialv1.UnimplementedRedialServiceServer
+	store store.Store
+}
+
+func NewRedialService(store store.Store) *RedialService {
+	return &RedialService{
+		store: store,
+	}
+}
+
+func (server *RedialService) ScheduleRedial(ctx context.Context, req *redialv1.ScheduleRedialRequest) (*redialv1.ScheduleRedialResponse, error) {
+	userID, err := server.targetUserID(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	customerID, err := parseUUID("customer_id", req.GetCustomerId())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetScheduledAt() == nil {
+		return nil, invalidArgumentError("scheduled_at", "scheduled_at is required")
+	}
+	scheduledAt := req.GetScheduledAt().AsTime()
+	if scheduledAt.Before(time.Now()) {
+		return nil, invalidArgumentError("scheduled_at", "scheduled_at must be in the future")
+	}
+
+	book, err := server.store.GetBookByCustomer(ctx, customerID)
+	if err != nil {
+		return nil, notFoundError(err, "customer")
+	}
+	schedule, err := bookSchedule(ctx, server.store, book)
+	if err != nil {
+		return nil, err
+	}
+
+	shifted := false
+	if !schedule.Contains(scheduledAt) {
+		next, ok := schedule.Next(scheduledAt)
+		if !ok {
+			return nil, status.Error(codes.FailedPrecondition, "book has no calling window available")
+		}
+		if !req.GetAutoShift() {
+			return nil, invalidArgumentError(
+				"scheduled_at",
+				fmt.Sprintf("outside calling hours, next available slot is %s", next.Format(time.RFC3339)),
+			)
+		}
+		scheduledAt = next
+		shifted = true
+	}
+
+	// 予約したユーザーはメタデータから取れた場合のみ記録する
+	var bookedBy pgtype.UUID
+	if callerID, err := currentUserID(ctx); err == nil {
+		bookedBy = pgtype.UUID{Bytes: callerID, Valid: true}
+	}
+
+	var redial db.Redial
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		var err error
+		redial, err = q.UpsertRedial(ctx, db.UpsertRedialParams{
+			ID:          customerID,
+			UserID:      userID,
+			ScheduledAt: scheduledAt,
+			BookedBy:    bookedBy,
+		})
+		return notFoundError(err, "customer")
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &redialv1.ScheduleRedialResponse{
+		Redial:  toRedialPb(redial),
+		Shifted: shifted,
+	}, nil
+}
+
+func (server *RedialService) GetRedial(ctx context.Context, req *redialv1.GetRedialRequest) (*redialv1.GetRedialResponse, error) {
+	customerID, err := parseUUID("customer_id", req.GetCustomerId())
+	if err != nil {
+		return nil, err
+	}
+
+	redial, err := server.store.GetRedial(ctx, customerID)
+	if err != nil {
+		return nil, notFoundError(err, "redial")
+	}
+
+	return &redialv1.GetRedialResponse{
+		Redial: toRedialPb(redial),
+	}, nil
+}
+
+func (server *RedialService) ListRedials(ctx context.Context, req *redialv1.ListRedialsRequest) (*redialv1.ListRedialsResponse, error) {
+	userID, err := server.targetUserID(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	from := time.Now()
+	if req.GetFrom() != nil {
+		from = req.GetFrom().AsTime()
+	}
+	to := from.Add(defaultRedialRange)
+	if req.GetTo() != nil {
+		to = req.GetTo().AsTime()
+	}
+
+	redials, err := server.store.ListRedialsByUser(ctx, db.ListRedialsByUserParams{
+		UserID:   userID,
+		FromTime: from,
+		ToTime:   to,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	redialsRes := make([]*redialv1.Redial, len(redials))
+	for i, redial := range redials {
+		redialsRes[i] = toRedialPb(redial)
+	}
+
+	return &redialv1.ListRedialsResponse{
+		Redials: redialsRes,
+	}, nil
+}
+
+func (server *RedialService) DeleteRedial(ctx context.Context, req *redialv1.DeleteRedialRequest) (*redialv1.DeleteRedialResponse, error) {
+	customerID, err := parseUUID("customer_id", req.GetCustomerId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		return q.DeleteRedial(ctx, customerID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &redialv1.DeleteRedialResponse{}, nil
+}
+
+// targetUserID 指定があればそのユーザー、なければ呼び出し元ユーザーのIDを返す
+func (server *RedialService) targetUserID(ctx context.Context, userID *string) (uuid.UUID, error) {
+	if userID == nil {
+		return currentUserID(ctx)
+	}
+	id, err := parseUUID("user_id", *userID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if _, err := server.store.GetUser(ctx, id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, invalidArgumentError("user_id", "user not found")
+		}
+		return uuid.Nil, err
+	}
+	return id, nil
+}
+
+func toRedialPb(redial db.Redial) *redialv1.Redial {
+	return &redialv1.Redial{
+		CustomerId:  redial.ID.String(),
+		UserId:      redial.UserID.String(),
+		ScheduledAt: timestamppb.New(redial.ScheduledAt),
+		CreatedAt:   timestamppb.New(redial.CreatedAt),
+		BookedBy:    uuidString(redial.BookedBy),
+		BookedAt:    timestamppb.New(redial.BookedAt),
+	}
+}
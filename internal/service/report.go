@@ -0,0 +1,259 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	reportv1 "github.com/0utl1er-tech/prism-backend/gen/pb/report/v1"
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	// 集計期間を省略した場合の日数
+	defaultReportDays = 30
+	// 集計期間の上限
+	maxReportRange  = 366 * 24 * time.Hour
+	defaultTimezone = "Asia/Tokyo"
+)
+
+type ReportService struct {
+	reportv1.UnimplementedReportServiceServer
+	queries db.Querier
+}
+
+func NewReportService(queries db.Querier) *ReportService {
+	return &ReportService{
+		queries: queries,
+	}
+}
+
+// reportFilter リクエストから集計条件を組み立てる
+type reportFilter struct {
+	from     time.Time
+	to       time.Time
+	bookID   pgtype.UUID
+	userID   pgtype.UUID
+	timezone string
+}
+
+func newReportFilter(req *reportv1.ReportRequest) (reportFilter, error) {
+	filter := reportFilter{
+		to:       time.Now(),
+		timezone: defaultTimezone,
+	}
+	if req.GetTo() != nil {
+		filter.to = req.GetTo().AsTime()
+	}
+	filter.from = filter.to.AddDate(0, 0, -defaultReportDays)
+	if req.GetFrom() != nil {
+		filter.from = req.GetFrom().AsTime()
+	}
+	if !filter.from.Before(filter.to) {
+		return filter, invalidArgumentError("from", "from must be before to")
+	}
+	if filter.to.Sub(filter.from) > maxReportRange {
+		return filter, invalidArgumentError("to", "report range must be within 366 days")
+	}
+
+	if req.GetTimezone() != "" {
+		if _, err := loadTimezone(req.GetTimezone()); err != nil {
+			return filter, err
+		}
+		filter.timezone = req.GetTimezone()
+	}
+
+	if req.BookId != nil {
+		bookID, err := parseUUID("book_id", req.GetBookId())
+		if err != nil {
+			return filter, err
+		}
+		filter.bookID = pgtype.UUID{Bytes: bookID, Valid: true}
+	}
+	if req.UserId != nil {
+		userID, err := parseUUID("user_id", req.GetUserId())
+		if err != nil {
+			return filter, err
+		}
+		filter.userID = pgtype.UUID{Bytes: userID, Valid: true}
+	}
+	return filter, nil
+}
+
+func (server *ReportService) total(ctx context.Context, filter reportFilter) (*reportv1.CallStats, error) {
+	total, err := server.queries.ReportCallsTotal(ctx, db.ReportCallsTotalParams{
+		FromTime: filter.from,
+		ToTime:   filter.to,
+		BookID:   filter.bookID,
+		UserID:   filter.userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toCallStatsPb(total.Calls, total.Effective, total.Ng, total.Customers), nil
+}
+
+func (server *ReportService) GetBookReport(ctx context.Context, req *reportv1.ReportRequest) (*reportv1.GetBookReportResponse, error) {
+	filter, err := newReportFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := server.queries.ReportCallsByBook(ctx, db.ReportCallsByBookParams{
+		FromTime: filter.from,
+		ToTime:   filter.to,
+		BookID:   filter.bookID,
+		UserID:   filter.userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	books := make([]*reportv1.BookStats, len(rows))
+	for i, row := range rows {
+		books[i] = &reportv1.BookStats{
+			BookId:   row.BookID.String(),
+			BookName: row.BookName,
+			Stats:    toCallStatsPb(row.Calls, row.Effective, row.Ng, row.Customers),
+		}
+	}
+
+	total, err := server.total(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &reportv1.GetBookReportResponse{
+		Books: books,
+		Total: total,
+	}, nil
+}
+
+func (server *ReportService) GetUserReport(ctx context.Context, req *reportv1.ReportRequest) (*reportv1.GetUserReportResponse, error) {
+	filter, err := newReportFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := server.queries.ReportCallsByUser(ctx, db.ReportCallsByUserParams{
+		FromTime: filter.from,
+		ToTime:   filter.to,
+		BookID:   filter.bookID,
+		UserID:   filter.userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*reportv1.UserStats, len(rows))
+	for i, row := range rows {
+		users[i] = &reportv1.UserStats{
+			UserId:   row.UserID.String(),
+			UserName: row.UserName,
+			Stats:    toCallStatsPb(row.Calls, row.Effective, row.Ng, row.Customers),
+		}
+	}
+
+	total, err := server.total(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &reportv1.GetUserReportResponse{
+		Users: users,
+		Total: total,
+	}, nil
+}
+
+func (server *ReportService) GetDailyReport(ctx context.Context, req *reportv1.ReportRequest) (*reportv1.GetDailyReportResponse, error) {
+	filter, err := newReportFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := server.queries.ReportCallsByDay(ctx, db.ReportCallsByDayParams{
+		Timezone: filter.timezone,
+		FromTime: filter.from,
+		ToTime:   filter.to,
+		BookID:   filter.bookID,
+		UserID:   filter.userID,
+	})
+	if err != nil {
+		return nil, timezoneError(err)
+	}
+
+	days := make([]*reportv1.DailyStats, len(rows))
+	for i, row := range rows {
+		days[i] = &reportv1.DailyStats{
+			Date:  row.Day.Time.Format(time.DateOnly),
+			Stats: toCallStatsPb(row.Calls, row.Effective, row.Ng, row.Customers),
+		}
+	}
+
+	total, err := server.total(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &reportv1.GetDailyReportResponse{
+		Days:  days,
+		Total: total,
+	}, nil
+}
+
+func (server *ReportService) GetHourlyReport(ctx context.Context, req *reportv1.ReportRequest) (*reportv1.GetHourlyReportResponse, error) {
+	filter, err := newReportFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := server.queries.ReportCallsByHour(ctx, db.ReportCallsByHourParams{
+		Timezone: filter.timezone,
+		FromTime: filter.from,
+		ToTime:   filter.to,
+		BookID:   filter.bookID,
+		UserID:   filter.userID,
+	})
+	if err != nil {
+		return nil, timezoneError(err)
+	}
+
+	// 架電のない時間帯も0件として返す
+	hours := make([]*reportv1.HourlyStats, 24)
+	for hour := range hours {
+		hours[hour] = &reportv1.HourlyStats{
+			Hour:  int32(hour),
+			Stats: toCallStatsPb(0, 0, 0, 0),
+		}
+	}
+	for _, row := range rows {
+		if row.Hour < 0 || row.Hour > 23 {
+			continue
+		}
+		hours[row.Hour].Stats = toCallStatsPb(row.Calls, row.Effective, row.Ng, row.Customers)
+	}
+
+	total, err := server.total(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &reportv1.GetHourlyReportResponse{
+		Hours: hours,
+		Total: total,
+	}, nil
+}
+
+func toCallStatsPb(calls, effective, ng, customers int64) *reportv1.CallStats {
+	stats := &reportv1.CallStats{
+		Calls:     calls,
+		Effective: effective,
+		Ng:        ng,
+		Customers: customers,
+	}
+	if calls > 0 {
+		stats.EffectiveRate = float64(effective) / float64(calls)
+		stats.NgRate = float64(ng) / float64(calls)
+	}
+	return stats
+}
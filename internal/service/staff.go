@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	staffv1 "github.com/0utl1er-tech/prism-backend/gen/pb/staff/v1"
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/0utl1er-tech/prism-backend/internal/store"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type StaffService struct {
+	staffv1.UnimplementedStaffServiceServer
+	store store.Store
+}
+
+func NewStaffService(store store.Store) *StaffService {
+	return &StaffService{
+		store: store,
+	}
+}
+
+func (server *StaffService) GetStaff(ctx context.Context, req *staffv1.GetStaffRequest) (*staffv1.GetStaffResponse, error) {
+	staffID, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	staff, err := server.store.GetStaff(ctx, staffID)
+	if err != nil {
+		return nil, notFoundError(err, "staff")
+	}
+
+	setETag(ctx, staff.Version)
+	return &staffv1.GetStaffResponse{
+		Staff: toStaffPb(staff),
+	}, nil
+}
+
+func (server *StaffService) UpdateStaff(ctx context.Context, req *staffv1.UpdateStaffRequest) (*staffv1.UpdateStaffResponse, error) {
+	staffID, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := expectedVersion(ctx, req.Etag)
+	if err != nil {
+		return nil, err
+	}
+
+	var staff db.Staff
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		current, err := q.GetStaff(ctx, staffID)
+		if err != nil {
+			return notFoundError(err, "staff")
+		}
+		if version.Valid && current.Version != version.Int64 {
+			return staleVersionError("staff")
+		}
+
+		staff, err = q.UpdateStaff(ctx, db.UpdateStaffParams{
+			ID:      staffID,
+			Version: version,
+			Name: pgtype.Text{
+				String: req.GetName(),
+				Valid:  req.Name != nil,
+			},
+			Sex: pgtype.Text{
+				String: req.GetSex(),
+				Valid:  req.Sex != nil,
+			},
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			// 取得してから更新するまでの間に他の更新が行われた
+			return staleVersionError("staff")
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	setETag(ctx, staff.Version)
+	return &staffv1.UpdateStaffResponse{
+		Staff: toStaffPb(staff),
+	}, nil
+}
+
+func toStaffPb(staff db.Staff) *staffv1.Staff {
+	return &staffv1.Staff{
+		Id:   staff.ID.String(),
+		Name: staff.Name.String,
+		Sex:  staff.Sex.String,
+		Etag: formatETag(staff.Version),
+	}
+}
@@ -0,0 +1,317 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	tagv1 "github.com/0utl1er-tech/prism-backend/gen/pb/tag/v1"
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/0utl1er-tech/prism-backend/internal/store"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const maxTagNameLength = 64
+
+type TagService struct {
+	tagv1.UnimplementedTagServiceServer
+	store store.Store
+}
+
+func NewTagService(store store.Store) *TagService {
+	return &TagService{
+		store: store,
+	}
+}
+
+func (server *TagService) ListTags(ctx context.Context, req *tagv1.ListTagsRequest) (*tagv1.ListTagsResponse, error) {
+	bookID, err := parseUUID("book_id", req.GetBookId())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = server.store.GetBook(ctx, bookID)
+	if err != nil {
+		return nil, notFoundError(err, "book")
+	}
+
+	tags, err := server.store.ListTagsWithUsage(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+
+	tagsRes := make([]*tagv1.Tag, len(tags))
+	for i, tag := range tags {
+		tagsRes[i] = &tagv1.Tag{
+			Id:        tag.ID.String(),
+			BookId:    tag.BookID.String(),
+			Name:      tag.Name,
+			Customers: tag.Customers,
+		}
+	}
+
+	return &tagv1.ListTagsResponse{
+		Tags: tagsRes,
+	}, nil
+}
+
+func (server *TagService) DeleteTag(ctx context.Context, req *tagv1.DeleteTagRequest) (*tagv1.DeleteTagResponse, error) {
+	tagID, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		_, err := q.GetTag(ctx, tagID)
+		if err != nil {
+			return notFoundError(err, "tag")
+		}
+		return q.DeleteTag(ctx, tagID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &tagv1.DeleteTagResponse{}, nil
+}
+
+func (server *TagService) AddCustomerTag(ctx context.Context, req *tagv1.AddCustomerTagRequest) (*tagv1.AddCustomerTagResponse, error) {
+	customerID, err := parseUUID("customer_id", req.GetCustomerId())
+	if err != nil {
+		return nil, err
+	}
+	names, err := tagNames("tag", []string{req.GetTag()})
+	if err != nil {
+		return nil, err
+	}
+
+	var tags []string
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		customer, err := q.GetCustomerByID(ctx, customerID)
+		if err != nil {
+			return notFoundError(err, "customer")
+		}
+
+		_, err = addTags(ctx, q, customer.BookID, []uuid.UUID{customerID}, names)
+		if err != nil {
+			return err
+		}
+
+		tags, err = customerTagNames(ctx, q, customerID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &tagv1.AddCustomerTagResponse{
+		Tags: tags,
+	}, nil
+}
+
+func (server *TagService) RemoveCustomerTag(ctx context.Context, req *tagv1.RemoveCustomerTagRequest) (*tagv1.RemoveCustomerTagResponse, error) {
+	customerID, err := parseUUID("customer_id", req.GetCustomerId())
+	if err != nil {
+		return nil, err
+	}
+	names, err := tagNames("tag", []string{req.GetTag()})
+	if err != nil {
+		return nil, err
+	}
+
+	var tags []string
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		customer, err := q.GetCustomerByID(ctx, customerID)
+		if err != nil {
+			return notFoundError(err, "customer")
+		}
+
+		_, err = removeTags(ctx, q, customer.BookID, []uuid.UUID{customerID}, names)
+		if err != nil {
+			return err
+		}
+
+		tags, err = customerTagNames(ctx, q, customerID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &tagv1.RemoveCustomerTagResponse{
+		Tags: tags,
+	}, nil
+}
+
+func (server *TagService) BatchAddTags(ctx context.Context, req *tagv1.BatchAddTagsRequest) (*tagv1.BatchAddTagsResponse, error) {
+	bookID, customerIDs, names, err := parseBatchTagsRequest(req.GetBookId(), req.GetCustomerIds(), req.GetTags())
+	if err != nil {
+		return nil, err
+	}
+
+	var added int64
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		_, err := q.GetBook(ctx, bookID)
+		if err != nil {
+			return notFoundError(err, "book")
+		}
+
+		added, err = addTags(ctx, q, bookID, customerIDs, names)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &tagv1.BatchAddTagsResponse{
+		Added: added,
+	}, nil
+}
+
+func (server *TagService) BatchRemoveTags(ctx context.Context, req *tagv1.BatchRemoveTagsRequest) (*tagv1.BatchRemoveTagsResponse, error) {
+	bookID, customerIDs, names, err := parseBatchTagsRequest(req.GetBookId(), req.GetCustomerIds(), req.GetTags())
+	if err != nil {
+		return nil, err
+	}
+
+	var removed int64
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		var err error
+		removed, err = removeTags(ctx, q, bookID, customerIDs, names)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &tagv1.BatchRemoveTagsResponse{
+		Removed: removed,
+	}, nil
+}
+
+func parseBatchTagsRequest(bookIDValue string, customerIDValues, tags []string) (uuid.UUID, []uuid.UUID, []string, error) {
+	bookID, err := parseUUID("book_id", bookIDValue)
+	if err != nil {
+		return uuid.Nil, nil, nil, err
+	}
+	if len(customerIDValues) == 0 {
+		return uuid.Nil, nil, nil, invalidArgumentError("customer_ids", "must not be empty")
+	}
+	if len(customerIDValues) > maxPageLimit {
+		return uuid.Nil, nil, nil, invalidArgumentError("customer_ids", fmt.Sprintf("must not exceed %d", maxPageLimit))
+	}
+
+	customerIDs := make([]uuid.UUID, len(customerIDValues))
+	for i, value := range customerIDValues {
+		customerIDs[i], err = parseUUID(fmt.Sprintf("customer_ids[%d]", i), value)
+		if err != nil {
+			return uuid.Nil, nil, nil, err
+		}
+	}
+
+	names, err := tagNames("tags", tags)
+	if err != nil {
+		return uuid.Nil, nil, nil, err
+	}
+	return bookID, customerIDs, names, nil
+}
+
+// tagNames タグ名の前後の空白を取り除き、重複を除いて検証する
+func tagNames(field string, values []string) ([]string, error) {
+	names := make([]string, 0, len(values))
+	seen := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		name := strings.TrimSpace(value)
+		if name == "" {
+			return nil, invalidArgumentError(field, "must not be empty")
+		}
+		if utf8.RuneCountInString(name) > maxTagNameLength {
+			return nil, invalidArgumentError(field, fmt.Sprintf("must be at most %d characters", maxTagNameLength))
+		}
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		return nil, invalidArgumentError(field, "must not be empty")
+	}
+	return names, nil
+}
+
+func addTags(ctx context.Context, q *db.Queries, bookID uuid.UUID, customerIDs []uuid.UUID, names []string) (int64, error) {
+	tags, err := q.UpsertTags(ctx, db.UpsertTagsParams{
+		BookID: bookID,
+		Names:  names,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	tagIDs := make([]uuid.UUID, len(tags))
+	for i, tag := range tags {
+		tagIDs[i] = tag.ID
+	}
+
+	var userID pgtype.UUID
+	if id, err := currentUserID(ctx); err == nil {
+		userID = pgtype.UUID{Bytes: id, Valid: true}
+	}
+
+	return q.AddCustomerTags(ctx, db.AddCustomerTagsParams{
+		UserID:      userID,
+		CustomerIds: customerIDs,
+		TagIds:      tagIDs,
+	})
+}
+
+func removeTags(ctx context.Context, q *db.Queries, bookID uuid.UUID, customerIDs []uuid.UUID, names []string) (int64, error) {
+	tags, err := q.ListTagsByName(ctx, db.ListTagsByNameParams{
+		BookID: bookID,
+		Names:  names,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(tags) == 0 {
+		return 0, nil
+	}
+
+	tagIDs := make([]uuid.UUID, len(tags))
+	for i, tag := range tags {
+		tagIDs[i] = tag.ID
+	}
+
+	return q.RemoveCustomerTags(ctx, db.RemoveCustomerTagsParams{
+		CustomerIds: customerIDs,
+		TagIds:      tagIDs,
+	})
+}
+
+func customerTagNames(ctx context.Context, q db.Querier, customerID uuid.UUID) ([]string, error) {
+	tags, err := customerTags(ctx, q, []uuid.UUID{customerID})
+	if err != nil {
+		return nil, err
+	}
+	return tags[customerID], nil
+}
+
+// customerTags 顧客ごとのタグ名。タグのない顧客は含まない
+func customerTags(ctx context.Context, q db.Querier, customerIDs []uuid.UUID) (map[uuid.UUID][]string, error) {
+	tags := make(map[uuid.UUID][]string, len(customerIDs))
+	if len(customerIDs) == 0 {
+		return tags, nil
+	}
+
+	rows, err := q.ListCustomerTagNames(ctx, customerIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		tags[row.CustomerID] = append(tags[row.CustomerID], row.Name)
+	}
+	return tags, nil
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+
+	timelinev1 "github.com/0utl1er-tech/prism-backend/gen/pb/timeline/v1"
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type TimelineService struct {
+	timelinev1.UnimplementedTimelineServiceServer
+	queries db.Querier
+}
+
+func NewTimelineService(queries db.Querier) *TimelineService {
+	return &TimelineService{
+		queries: queries,
+	}
+}
+
+func (server *TimelineService) GetCustomerTimeline(ctx context.Context, req *timelinev1.GetCustomerTimelineRequest) (*timelinev1.GetCustomerTimelineResponse, error) {
+	customerID, err := parseUUID("customer_id", req.GetCustomerId())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = server.queries.GetCustomerByID(ctx, customerID)
+	if err != nil {
+		return nil, notFoundError(err, "customer")
+	}
+
+	limit, offset := pagination(req.GetPage(), req.GetLimit())
+	rows, err := server.queries.ListCustomerTimeline(ctx, db.ListCustomerTimelineParams{
+		CustomerID: customerID,
+		RowLimit:   limit,
+		RowOffset:  offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*timelinev1.TimelineEntry, len(rows))
+	for i, row := range rows {
+		entries[i], err = toTimelineEntryPb(row)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &timelinev1.GetCustomerTimelineResponse{
+		Entries: entries,
+		Page:    req.GetPage(),
+		Limit:   limit,
+	}, nil
+}
+
+func toTimelineEntryPb(row db.ListCustomerTimelineRow) (*timelinev1.TimelineEntry, error) {
+	entry := &timelinev1.TimelineEntry{
+		Id:         row.ID.String(),
+		OccurredAt: timestamppb.New(row.OccurredAt),
+		UserId:     uuidString(row.UserID),
+		UserName:   row.UserName.String,
+	}
+
+	switch row.Kind {
+	case "note":
+		entry.Entry = &timelinev1.TimelineEntry_Note{
+			Note: &timelinev1.NoteEntry{
+				Body: row.NoteBody.String,
+			},
+		}
+	case "call":
+		entry.Entry = &timelinev1.TimelineEntry_Call{
+			Call: &timelinev1.CallEntry{
+				StatusId:   uuidString(row.StatusID),
+				StatusName: row.StatusName.String,
+				Effective:  row.Effective.Bool,
+				Ng:         row.Ng.Bool,
+			},
+		}
+	case "redial":
+		entry.Entry = &timelinev1.TimelineEntry_Redial{
+			Redial: &timelinev1.RedialEntry{
+				ScheduledAt: timestamppb.New(row.ScheduledAt.Time),
+			},
+		}
+	case "change":
+		before, err := jsonbToStruct(row.Before)
+		if err != nil {
+			return nil, err
+		}
+		after, err := jsonbToStruct(row.After)
+		if err != nil {
+			return nil, err
+		}
+		entry.Entry = &timelinev1.TimelineEntry_Change{
+			Change: &timelinev1.ChangeEntry{
+				EntityType: row.EntityType.String,
+				EntityId:   row.EntityID.String,
+				Action:     string(row.Action.AuditAction),
+				Before:     before,
+				After:      after,
+			},
+		}
+	}
+
+	return entry, nil
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	trashv1 "github.com/0utl1er-tech/prism-backend/gen/pb/trash/v1"
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type TrashService struct {
+	trashv1.UnimplementedTrashServiceServer
+	queries   db.Querier
+	retention time.Duration
+}
+
+func NewTrashService(queries db.Querier, retention time.Duration) *TrashService {
+	return &TrashService{
+		queries:   queries,
+		retention: retention,
+	}
+}
+
+var trashEntityTypes = map[trashv1.TrashEntityType]string{
+	trashv1.TrashEntityType_TRASH_ENTITY_TYPE_BOOK:     "book",
+	trashv1.TrashEntityType_TRASH_ENTITY_TYPE_CUSTOMER: "customer",
+}
+
+func (server *TrashService) ListTrash(ctx context.Context, req *trashv1.ListTrashRequest) (*trashv1.ListTrashResponse, error) {
+	limit, offset := pagination(req.GetPage(), req.GetLimit())
+	arg := db.ListTrashParams{
+		RowLimit:  limit,
+		RowOffset: offset,
+	}
+	if req.GetEntityType() != trashv1.TrashEntityType_TRASH_ENTITY_TYPE_UNSPECIFIED {
+		entityType, ok := trashEntityTypes[req.GetEntityType()]
+		if !ok {
+			return nil, invalidArgumentError("entity_type", "unknown entity type")
+		}
+		arg.EntityType = pgtype.Text{String: entityType, Valid: true}
+	}
+
+	rows, err := server.queries.ListTrash(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*trashv1.TrashItem, len(rows))
+	for i, row := range rows {
+		items[i] = &trashv1.TrashItem{
+			EntityType: toTrashEntityTypePb(row.EntityType),
+			Id:         row.ID.String(),
+			Name:       row.Name,
+			BookId:     uuidString(row.BookID),
+			DeletedAt:  timestamppb.New(row.DeletedAt),
+			PurgeAt:    timestamppb.New(row.DeletedAt.Add(server.retention)),
+		}
+	}
+
+	return &trashv1.ListTrashResponse{
+		Items: items,
+		Page:  req.GetPage(),
+		Limit: limit,
+	}, nil
+}
+
+func toTrashEntityTypePb(entityType string) trashv1.TrashEntityType {
+	for pb, name := range trashEntityTypes {
+		if name == entityType {
+			return pb
+		}
+	}
+	return trashv1.TrashEntityType_TRASH_ENTITY_TYPE_UNSPECIFIED
+}
@@ -0,0 +1,378 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	webhookv1 "github.com/0utl1er-tech/prism-backend/gen/pb/webhook/v1"
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/0utl1er-tech/prism-backend/internal/store"
+	"github.com/0utl1er-tech/prism-backend/internal/webhook"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type WebhookService struct {
+	webhookv1.UnimplementedWebhookServiceServer
+	store       store.Store
+	idempotency *Idempotency
+}
+
+func NewWebhookService(store store.Store, idempotency *Idempotency) *WebhookService {
+	return &WebhookService{
+		store:       store,
+		idempotency: idempotency,
+	}
+}
+
+func (server *WebhookService) CreateWebhook(ctx context.Context, req *webhookv1.CreateWebhookRequest) (*webhookv1.CreateWebhookResponse, error) {
+	return idempotent(ctx, server.idempotency, req, func(ctx context.Context) (*webhookv1.CreateWebhookResponse, error) {
+		return server.createWebhook(ctx, req)
+	})
+}
+
+func (server *WebhookService) createWebhook(ctx context.Context, req *webhookv1.CreateWebhookRequest) (*webhookv1.CreateWebhookResponse, error) {
+	user, err := requireOwner(ctx, server.store, "webhooks")
+	if err != nil {
+		return nil, err
+	}
+	err = validateWebhookURL(ctx, req.GetUrl())
+	if err != nil {
+		return nil, err
+	}
+	err = validateEventTypes(req.GetEventTypes())
+	if err != nil {
+		return nil, err
+	}
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	var hook db.Webhook
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		var err error
+		hook, err = q.CreateWebhook(ctx, db.CreateWebhookParams{
+			ID:         uuid.New(),
+			Url:        req.GetUrl(),
+			Secret:     secret,
+			EventTypes: append([]string{}, req.GetEventTypes()...),
+			Description: pgtype.Text{
+				String: req.GetDescription(),
+				Valid:  req.Description != nil,
+			},
+			UserID: pgtype.UUID{Bytes: user.ID, Valid: true},
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &webhookv1.CreateWebhookResponse{
+		Webhook: toWebhookPb(hook),
+		Secret:  hook.Secret,
+	}, nil
+}
+
+func (server *WebhookService) ListWebhooks(ctx context.Context, req *webhookv1.ListWebhooksRequest) (*webhookv1.ListWebhooksResponse, error) {
+	if _, err := requireOwner(ctx, server.store, "webhooks"); err != nil {
+		return nil, err
+	}
+	hooks, err := server.store.ListWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	hooksRes := make([]*webhookv1.Webhook, len(hooks))
+	for i, hook := range hooks {
+		hooksRes[i] = toWebhookPb(hook)
+	}
+
+	return &webhookv1.ListWebhooksResponse{
+		Webhooks: hooksRes,
+	}, nil
+}
+
+func (server *WebhookService) UpdateWebhook(ctx context.Context, req *webhookv1.UpdateWebhookRequest) (*webhookv1.UpdateWebhookResponse, error) {
+	if _, err := requireOwner(ctx, server.store, "webhooks"); err != nil {
+		return nil, err
+	}
+	id, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	arg := db.UpdateWebhookParams{
+		ID: id,
+		Url: pgtype.Text{
+			String: req.GetUrl(),
+			Valid:  req.Url != nil,
+		},
+		Description: pgtype.Text{
+			String: req.GetDescription(),
+			Valid:  req.Description != nil,
+		},
+		Active: pgtype.Bool{
+			Bool:  req.GetActive(),
+			Valid: req.Active != nil,
+		},
+	}
+	if req.Url != nil {
+		err = validateWebhookURL(ctx, req.GetUrl())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if req.GetEventTypes() != nil {
+		err = validateEventTypes(req.GetEventTypes().GetValues())
+		if err != nil {
+			return nil, err
+		}
+		// 空の配列はすべてのイベントを購読する
+		arg.EventTypes = append([]string{}, req.GetEventTypes().GetValues()...)
+	}
+
+	var hook db.Webhook
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		var err error
+		hook, err = q.UpdateWebhook(ctx, arg)
+		return err
+	})
+	if err != nil {
+		return nil, notFoundError(err, "webhook")
+	}
+
+	return &webhookv1.UpdateWebhookResponse{
+		Webhook: toWebhookPb(hook),
+	}, nil
+}
+
+func (server *WebhookService) DeleteWebhook(ctx context.Context, req *webhookv1.DeleteWebhookRequest) (*webhookv1.DeleteWebhookResponse, error) {
+	if _, err := requireOwner(ctx, server.store, "webhooks"); err != nil {
+		return nil, err
+	}
+	id, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	var rows int64
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		var err error
+		rows, err = q.DeleteWebhook(ctx, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if rows == 0 {
+		return nil, status.Error(codes.NotFound, "webhook not found")
+	}
+
+	return &webhookv1.DeleteWebhookResponse{}, nil
+}
+
+func (server *WebhookService) RotateWebhookSecret(ctx context.Context, req *webhookv1.RotateWebhookSecretRequest) (*webhookv1.RotateWebhookSecretResponse, error) {
+	if _, err := requireOwner(ctx, server.store, "webhooks"); err != nil {
+		return nil, err
+	}
+	id, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	var hook db.Webhook
+	err = execAuditTx(ctx, server.store, func(q *db.Queries) error {
+		var err error
+		hook, err = q.RotateWebhookSecret(ctx, db.RotateWebhookSecretParams{
+			ID:     id,
+			Secret: secret,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, notFoundError(err, "webhook")
+	}
+
+	return &webhookv1.RotateWebhookSecretResponse{
+		Webhook: toWebhookPb(hook),
+		Secret:  hook.Secret,
+	}, nil
+}
+
+func (server *WebhookService) ListWebhookDeliveries(ctx context.Context, req *webhookv1.ListWebhookDeliveriesRequest) (*webhookv1.ListWebhookDeliveriesResponse, error) {
+	if _, err := requireOwner(ctx, server.store, "webhooks"); err != nil {
+		return nil, err
+	}
+	limit, offset := pagination(req.GetPage(), req.GetLimit())
+	arg := db.ListWebhookDeliveriesParams{
+		RowLimit:  limit,
+		RowOffset: offset,
+	}
+	if req.WebhookId != nil {
+		webhookID, err := parseUUID("webhook_id", req.GetWebhookId())
+		if err != nil {
+			return nil, err
+		}
+		arg.WebhookID = pgtype.UUID{Bytes: webhookID, Valid: true}
+	}
+	if req.GetStatus() != webhookv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED {
+		deliveryStatus, ok := webhookDeliveryStatusFromPb[req.GetStatus()]
+		if !ok {
+			return nil, invalidArgumentError("status", "unknown delivery status")
+		}
+		arg.Status = db.NullWebhookDeliveryStatus{WebhookDeliveryStatus: deliveryStatus, Valid: true}
+	}
+
+	deliveries, err := server.store.ListWebhookDeliveries(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveriesRes := make([]*webhookv1.WebhookDelivery, len(deliveries))
+	for i, delivery := range deliveries {
+		deliveriesRes[i], err = toWebhookDeliveryPb(delivery)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &webhookv1.ListWebhookDeliveriesResponse{
+		Deliveries: deliveriesRes,
+		Page:       req.GetPage(),
+		Limit:      limit,
+	}, nil
+}
+
+func (server *WebhookService) ReplayWebhookDelivery(ctx context.Context, req *webhookv1.ReplayWebhookDeliveryRequest) (*webhookv1.ReplayWebhookDeliveryResponse, error) {
+	if _, err := requireOwner(ctx, server.store, "webhooks"); err != nil {
+		return nil, err
+	}
+	id, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := server.store.ReplayWebhookDelivery(ctx, id)
+	if err != nil {
+		return nil, notFoundError(err, "webhook delivery")
+	}
+
+	deliveryRes, err := toWebhookDeliveryPb(db.ListWebhookDeliveriesRow(delivery))
+	if err != nil {
+		return nil, err
+	}
+	return &webhookv1.ReplayWebhookDeliveryResponse{
+		Delivery: deliveryRes,
+	}, nil
+}
+
+func (server *WebhookService) ReplayDeadWebhookDeliveries(ctx context.Context, req *webhookv1.ReplayDeadWebhookDeliveriesRequest) (*webhookv1.ReplayDeadWebhookDeliveriesResponse, error) {
+	if _, err := requireOwner(ctx, server.store, "webhooks"); err != nil {
+		return nil, err
+	}
+	webhookID, err := parseUUID("webhook_id", req.GetWebhookId())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = server.store.GetWebhook(ctx, webhookID)
+	if err != nil {
+		return nil, notFoundError(err, "webhook")
+	}
+
+	replayed, err := server.store.ReplayDeadWebhookDeliveries(ctx, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &webhookv1.ReplayDeadWebhookDeliveriesResponse{
+		Replayed: int32(replayed),
+	}, nil
+}
+
+// validateWebhookURL サーバー自身や内部ネットワークを送信先にさせない
+func validateWebhookURL(ctx context.Context, raw string) error {
+	err := webhook.CheckURL(ctx, raw)
+	if err != nil {
+		return invalidArgumentError("url", err.Error())
+	}
+	return nil
+}
+
+func validateEventTypes(eventTypes []string) error {
+	for _, eventType := range eventTypes {
+		if !webhook.IsEventType(eventType) {
+			return invalidArgumentError("event_types", "unknown event type: "+eventType)
+		}
+	}
+	return nil
+}
+
+func newWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+var webhookDeliveryStatusToPb = map[db.WebhookDeliveryStatus]webhookv1.WebhookDeliveryStatus{
+	db.WebhookDeliveryStatusPending:   webhookv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING,
+	db.WebhookDeliveryStatusSucceeded: webhookv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED,
+	db.WebhookDeliveryStatusDead:      webhookv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD,
+}
+
+var webhookDeliveryStatusFromPb = map[webhookv1.WebhookDeliveryStatus]db.WebhookDeliveryStatus{
+	webhookv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING:   db.WebhookDeliveryStatusPending,
+	webhookv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED: db.WebhookDeliveryStatusSucceeded,
+	webhookv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD:      db.WebhookDeliveryStatusDead,
+}
+
+func toWebhookPb(hook db.Webhook) *webhookv1.Webhook {
+	return &webhookv1.Webhook{
+		Id:          hook.ID.String(),
+		Url:         hook.Url,
+		EventTypes:  hook.EventTypes,
+		Description: hook.Description.String,
+		Active:      hook.Active,
+		UserId:      uuidString(hook.UserID),
+		CreatedAt:   timestamppb.New(hook.CreatedAt),
+		UpdatedAt:   timestamppb.New(hook.UpdatedAt),
+	}
+}
+
+func toWebhookDeliveryPb(delivery db.ListWebhookDeliveriesRow) (*webhookv1.WebhookDelivery, error) {
+	payload, err := jsonbToStruct(delivery.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveryRes := &webhookv1.WebhookDelivery{
+		Id:             delivery.ID.String(),
+		WebhookId:      delivery.WebhookID.String(),
+		EventId:        delivery.EventID.String(),
+		EventType:      delivery.EventType,
+		Payload:        payload,
+		Status:         webhookDeliveryStatusToPb[delivery.Status],
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  timestamppb.New(delivery.NextAttemptAt),
+		LastStatusCode: delivery.LastStatusCode.Int32,
+		LastError:      delivery.LastError.String,
+		CreatedAt:      timestamppb.New(delivery.CreatedAt),
+	}
+	if delivery.DeliveredAt.Valid {
+		deliveryRes.DeliveredAt = timestamppb.New(delivery.DeliveredAt.Time)
+	}
+	return deliveryRes, nil
+}
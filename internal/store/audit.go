@@ -0,0 +1,38 @@
+package store
+
+import (
+	"context"
+
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/google/uuid"
+)
+
+type auditKey struct{}
+
+// Audit 変更履歴に記録する操作したユーザーとRPC
+type Audit struct {
+	ActorID uuid.UUID
+	RPC     string
+}
+
+// WithAudit ExecTx で実行する変更に Audit を記録させる
+func WithAudit(ctx context.Context, audit Audit) context.Context {
+	return context.WithValue(ctx, auditKey{}, audit)
+}
+
+// applyAudit コンテキストに Audit があればトランザクションに設定する
+func applyAudit(ctx context.Context, q *db.Queries) error {
+	audit, ok := ctx.Value(auditKey{}).(Audit)
+	if !ok {
+		return nil
+	}
+
+	actorID := ""
+	if audit.ActorID != uuid.Nil {
+		actorID = audit.ActorID.String()
+	}
+	return q.SetAuditContext(ctx, db.SetAuditContextParams{
+		ActorID: actorID,
+		Rpc:     audit.RPC,
+	})
+}
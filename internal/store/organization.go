@@ -0,0 +1,34 @@
+package store
+
+import (
+	"context"
+
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/google/uuid"
+)
+
+type organizationKey struct{}
+
+// WithOrganization 以降のクエリを行レベルセキュリティで指定した組織に限定する
+func WithOrganization(ctx context.Context, organizationID uuid.UUID) context.Context {
+	return context.WithValue(ctx, organizationKey{}, organizationID)
+}
+
+// OrganizationFromContext コンテキストに設定された組織を返す
+func OrganizationFromContext(ctx context.Context) (uuid.UUID, bool) {
+	organizationID, ok := ctx.Value(organizationKey{}).(uuid.UUID)
+	return organizationID, ok
+}
+
+// organizationSetting prism.organization_id に設定する値。組織がない場合は空文字列で、ポリシーはどの行も通さない
+func organizationSetting(ctx context.Context) string {
+	if id, ok := OrganizationFromContext(ctx); ok {
+		return id.String()
+	}
+	return ""
+}
+
+// applyOrganization コンテキストの組織をトランザクションに設定する
+func applyOrganization(ctx context.Context, q *db.Queries) error {
+	return q.SetOrganizationContext(ctx, organizationSetting(ctx))
+}
@@ -0,0 +1,42 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// CheckRole 接続したロールに行レベルセキュリティが適用されることを確認する。
+// スーパーユーザーと BYPASSRLS のロールはポリシーを無視して全組織の行を参照できる
+func CheckRole(ctx context.Context, pool *pgxpool.Pool) error {
+	name, superuser, bypassRls, err := currentRole(ctx, pool)
+	if err != nil {
+		return err
+	}
+	if superuser || bypassRls {
+		return fmt.Errorf("role %q bypasses row-level security; connect as a role without SUPERUSER and BYPASSRLS", name)
+	}
+	return nil
+}
+
+// CheckSystemRole 組織をまたぐバックグラウンド処理の接続が、スーパーユーザーではなく BYPASSRLS のロールであることを確認する
+func CheckSystemRole(ctx context.Context, pool *pgxpool.Pool) error {
+	name, superuser, bypassRls, err := currentRole(ctx, pool)
+	if err != nil {
+		return err
+	}
+	if superuser || !bypassRls {
+		return fmt.Errorf("role %q must have BYPASSRLS without SUPERUSER to run work across organizations", name)
+	}
+	return nil
+}
+
+func currentRole(ctx context.Context, pool *pgxpool.Pool) (name string, superuser, bypassRls bool, err error) {
+	err = pool.QueryRow(ctx, `SELECT rolname, rolsuper, rolbypassrls FROM pg_roles WHERE rolname = current_user`).
+		Scan(&name, &superuser, &bypassRls)
+	if err != nil {
+		err = fmt.Errorf("failed to get the current role: %w", err)
+	}
+	return
+}
@@ -0,0 +1,63 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Store provides all functions to execute db queries and transactions
+type Store interface {
+	db.Querier
+	ExecTx(ctx context.Context, fn func(*db.Queries) error) error
+}
+
+// SQLStore provides all functions to execute SQL queries and transactions
+type SQLStore struct {
+	connPool *pgxpool.Pool
+	*db.Queries
+}
+
+// New creates a new store whose queries are limited to the organization in the context
+func New(connPool *pgxpool.Pool) Store {
+	return &SQLStore{
+		connPool: connPool,
+		Queries:  db.New(tenantDB{pool: connPool}),
+	}
+}
+
+// NewSystem creates a new store for background work across organizations.
+// connPool must connect as a role with BYPASSRLS (see CheckSystemRole)
+func NewSystem(connPool *pgxpool.Pool) Store {
+	return &SQLStore{
+		connPool: connPool,
+		Queries:  db.New(connPool),
+	}
+}
+
+// ExecTx executes a function within a database transaction
+func (store *SQLStore) ExecTx(ctx context.Context, fn func(*db.Queries) error) error {
+	tx, err := store.connPool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	q := db.New(tx)
+	err = applyOrganization(ctx, q)
+	if err == nil {
+		err = applyAudit(ctx, q)
+	}
+	if err == nil {
+		err = fn(q)
+	}
+	if err != nil {
+		if rbErr := tx.Rollback(ctx); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
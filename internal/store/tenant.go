@@ -0,0 +1,83 @@
+package store
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// setOrganizationContext db.SetOrganizationContext と同じクエリ
+const setOrganizationContext = `SELECT set_config('prism.organization_id', $1::text, true)`
+
+// tenantDB トランザクションの外のクエリの前に、コンテキストの組織をそのクエリだけに設定する。
+// 設定とクエリは1つのバッチ（暗黙のトランザクション）で送るため、往復は増えず接続にも組織が残らない
+type tenantDB struct {
+	pool *pgxpool.Pool
+}
+
+func (tenant tenantDB) send(ctx context.Context, sql string, args []interface{}) (pgx.BatchResults, error) {
+	batch := &pgx.Batch{}
+	batch.Queue(setOrganizationContext, organizationSetting(ctx))
+	batch.Queue(sql, args...)
+
+	results := tenant.pool.SendBatch(ctx, batch)
+	_, err := results.Exec()
+	if err != nil {
+		results.Close()
+		return nil, err
+	}
+	return results, nil
+}
+
+func (tenant tenantDB) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	results, err := tenant.send(ctx, sql, args)
+	if err != nil {
+		return pgconn.CommandTag{}, err
+	}
+	defer results.Close()
+	return results.Exec()
+}
+
+func (tenant tenantDB) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	results, err := tenant.send(ctx, sql, args)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := results.Query()
+	if err != nil {
+		results.Close()
+		return nil, err
+	}
+	return tenantRows{Rows: rows, results: results}, nil
+}
+
+func (tenant tenantDB) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	results, err := tenant.send(ctx, sql, args)
+	return tenantRow{results: results, err: err}
+}
+
+// tenantRows 行を閉じるときにバッチも閉じて接続をプールに返す
+type tenantRows struct {
+	pgx.Rows
+	results pgx.BatchResults
+}
+
+func (rows tenantRows) Close() {
+	rows.Rows.Close()
+	rows.results.Close()
+}
+
+type tenantRow struct {
+	results pgx.BatchResults
+	err     error
+}
+
+func (row tenantRow) Scan(dest ...any) error {
+	if row.err != nil {
+		return row.err
+	}
+	defer row.results.Close()
+	return row.results.QueryRow().Scan(dest...)
+}
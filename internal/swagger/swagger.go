@@ -0,0 +1,81 @@
+// Package swagger Swagger UI と OpenAPI の仕様を gateway で配信する
+package swagger
+
+import (
+	"encoding/json"
+	"io/fs"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// SpecName Swagger UI が読み込む仕様のファイル名。swagger-initializer.js の url と合わせる
+const SpecName = "prism.swagger.json"
+
+// Handler prefix 以下で ui のファイルと spec を配信する。
+// spec は全protoをまとめて生成しているため、services に含まれるサービスのパスだけに絞る
+func Handler(prefix string, ui fs.FS, spec []byte, services []string) (http.Handler, error) {
+	filtered, err := filterSpec(spec, services)
+	if err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(prefix, http.StripPrefix(prefix, http.FileServer(http.FS(ui))))
+	mux.HandleFunc(prefix+SpecName, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(filtered)
+	})
+	return mux, nil
+}
+
+// filterSpec 各オペレーションの最初のタグ (サービス名) が services にないパスを取り除く。
+// services は customer.v1.CustomerService のような完全な名前
+func filterSpec(spec []byte, services []string) ([]byte, error) {
+	var document map[string]any
+	err := json.Unmarshal(spec, &document)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(services))
+	for i, service := range services {
+		names[i] = service[strings.LastIndex(service, ".")+1:]
+	}
+	registered := func(value any) bool {
+		tags, _ := value.([]any)
+		if len(tags) == 0 {
+			return false
+		}
+		tag, _ := tags[0].(string)
+		return slices.Contains(names, tag)
+	}
+
+	paths, _ := document["paths"].(map[string]any)
+	for path, item := range paths {
+		operations, _ := item.(map[string]any)
+		for method, operation := range operations {
+			op, _ := operation.(map[string]any)
+			if !registered(op["tags"]) {
+				delete(operations, method)
+			}
+		}
+		if len(operations) == 0 {
+			delete(paths, path)
+		}
+	}
+
+	if tags, ok := document["tags"].([]any); ok {
+		document["tags"] = slices.DeleteFunc(tags, func(tag any) bool {
+			t, _ := tag.(map[string]any)
+			return !registered([]any{t["name"]})
+		})
+	}
+
+	// protoc-gen-openapiv2 はまとめた場合に最初のprotoのファイル名をタイトルにする
+	document["info"] = map[string]any{
+		"title":   "prism API",
+		"version": "v1",
+	}
+	return json.Marshal(document)
+}
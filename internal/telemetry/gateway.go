@@ -0,0 +1,43 @@
+package telemetry
+
+import (
+	"context"
+	"net/http"
+	"slices"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+)
+
+// untracedPaths プローブとスクレイプは頻繁に呼ばれるためスパンを作らない
+var untracedPaths = []string{"/healthz", "/readyz", "/metrics"}
+
+// GatewayHandler gatewayのHTTPリクエストごとにスパンを作成し、traceparent ヘッダーを引き継ぐ
+func GatewayHandler(next http.Handler) http.Handler {
+	return otelhttp.NewHandler(next, "gateway",
+		otelhttp.WithSpanNameFormatter(func(operation string, r *http.Request) string {
+			return r.Method
+		}),
+		otelhttp.WithFilter(func(r *http.Request) bool {
+			return !slices.Contains(untracedPaths, r.URL.Path)
+		}),
+	)
+}
+
+// GatewaySpanAnnotator 一致したルートのパターンと呼び出すRPCをスパンに設定する
+func GatewaySpanAnnotator() runtime.ServeMuxOption {
+	return runtime.WithMetadata(func(ctx context.Context, req *http.Request) metadata.MD {
+		span := trace.SpanFromContext(ctx)
+		if pattern, ok := runtime.HTTPPathPattern(ctx); ok {
+			span.SetName(req.Method + " " + pattern)
+			span.SetAttributes(attribute.String("http.route", pattern))
+		}
+		if method, ok := runtime.RPCMethod(ctx); ok {
+			span.SetAttributes(attribute.String("rpc.method", method))
+		}
+		return nil
+	})
+}
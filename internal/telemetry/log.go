@@ -0,0 +1,18 @@
+package telemetry
+
+import (
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// LogHook Ctx で渡したコンテキストにスパンがあればログに trace_id と span_id を付ける
+type LogHook struct{}
+
+func (LogHook) Run(e *zerolog.Event, level zerolog.Level, msg string) {
+	spanContext := trace.SpanContextFromContext(e.GetCtx())
+	if !spanContext.IsValid() {
+		return
+	}
+	e.Str("trace_id", spanContext.TraceID().String()).
+		Str("span_id", spanContext.SpanID().String())
+}
@@ -0,0 +1,104 @@
+package telemetry
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type querySpanKey struct{}
+
+// QueryTracer pgx のクエリごとにスパンを作成する。
+// ジョブやWebhookのポーリングでスパンが大量にできないよう、親のスパンがないクエリは記録しない
+type QueryTracer struct {
+	tracer trace.Tracer
+}
+
+func NewQueryTracer() *QueryTracer {
+	return &QueryTracer{
+		tracer: otel.Tracer(instrumentationName),
+	}
+}
+
+func (tracer *QueryTracer) TraceQueryStart(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return ctx
+	}
+	ctx, span := tracer.tracer.Start(ctx, queryName(data.SQL),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system.name", "postgresql"),
+			attribute.String("db.namespace", conn.Config().Database),
+			attribute.String("db.query.text", data.SQL),
+		),
+	)
+	return context.WithValue(ctx, querySpanKey{}, span)
+}
+
+func (tracer *QueryTracer) TraceQueryEnd(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryEndData) {
+	span, ok := ctx.Value(querySpanKey{}).(trace.Span)
+	if !ok {
+		return
+	}
+	if data.Err != nil {
+		span.RecordError(data.Err)
+		span.SetStatus(codes.Error, data.Err.Error())
+	} else {
+		span.SetAttributes(attribute.Int64("db.response.returned_rows", data.CommandTag.RowsAffected()))
+	}
+	span.End()
+}
+
+// TraceBatchStart 組織に限定したクエリは組織の設定と1つのバッチで送るため、最後のクエリの名前でスパンを作成する
+func (tracer *QueryTracer) TraceBatchStart(ctx context.Context, conn *pgx.Conn, data pgx.TraceBatchStartData) context.Context {
+	queued := data.Batch.QueuedQueries
+	if len(queued) == 0 {
+		return ctx
+	}
+	return tracer.TraceQueryStart(ctx, conn, pgx.TraceQueryStartData{SQL: queued[len(queued)-1].SQL})
+}
+
+func (tracer *QueryTracer) TraceBatchQuery(ctx context.Context, conn *pgx.Conn, data pgx.TraceBatchQueryData) {
+	span, ok := ctx.Value(querySpanKey{}).(trace.Span)
+	if !ok {
+		return
+	}
+	if data.Err != nil {
+		span.RecordError(data.Err)
+		span.SetStatus(codes.Error, data.Err.Error())
+		return
+	}
+	span.SetAttributes(attribute.Int64("db.response.returned_rows", data.CommandTag.RowsAffected()))
+}
+
+func (tracer *QueryTracer) TraceBatchEnd(ctx context.Context, conn *pgx.Conn, data pgx.TraceBatchEndData) {
+	span, ok := ctx.Value(querySpanKey{}).(trace.Span)
+	if !ok {
+		return
+	}
+	if data.Err != nil {
+		span.RecordError(data.Err)
+		span.SetStatus(codes.Error, data.Err.Error())
+	}
+	span.End()
+}
+
+// queryName sqlc が生成したクエリは先頭の "-- name: GetBook :one" から GetBook を返す。
+// それ以外は SELECT などの最初の単語
+func queryName(sql string) string {
+	sql = strings.TrimSpace(sql)
+	if rest, ok := strings.CutPrefix(sql, "-- name: "); ok {
+		if name, _, ok := strings.Cut(rest, " "); ok {
+			return name
+		}
+	}
+	if keyword, _, ok := strings.Cut(sql, " "); ok {
+		return strings.ToUpper(keyword)
+	}
+	return strings.ToUpper(sql)
+}
@@ -0,0 +1,72 @@
+// Package telemetry OpenTelemetry のトレースとログへのトレースIDの付与を設定する
+package telemetry
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/0utl1er-tech/prism-backend/internal/util"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const (
+	serviceName         = "prism-backend"
+	instrumentationName = "github.com/0utl1er-tech/prism-backend/internal/telemetry"
+)
+
+// Setup TRACE_EXPORTER に応じて TracerProvider を登録する。返した関数は未送信のスパンを送信して終了する
+//
+//   - none:   スパンを記録しない。受け取った traceparent はログと下流に引き継ぐ
+//   - stdout: 標準出力に書き出す。ローカルでの確認用
+//   - otlp:   TRACE_OTLP_ENDPOINT に OTLP/gRPC で送信する
+func Setup(ctx context.Context, cfg util.Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	var (
+		exporter sdktrace.SpanExporter
+		err      error
+	)
+	switch cfg.TraceExporter {
+	case "", "none":
+		return func(context.Context) error { return nil }, nil
+	case "stdout":
+		exporter, err = stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case "otlp":
+		options := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.TraceOTLPEndpoint)}
+		if cfg.TraceOTLPInsecure {
+			options = append(options, otlptracegrpc.WithInsecure())
+		}
+		exporter, err = otlptracegrpc.New(ctx, options...)
+	default:
+		return nil, fmt.Errorf("unknown TRACE_EXPORTER %q", cfg.TraceExporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s trace exporter: %w", cfg.TraceExporter, err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(
+		attribute.String("service.name", serviceName),
+		attribute.String("deployment.environment.name", cfg.Environment),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		// 呼び出し元がサンプリングしたリクエストは比率に関係なく記録する
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.TraceSampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
@@ -0,0 +1,69 @@
+package trash
+
+import (
+	"context"
+	"time"
+
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/0utl1er-tech/prism-backend/internal/store"
+	"github.com/rs/zerolog/log"
+)
+
+// Result 完全に削除した件数
+type Result struct {
+	Books     int64
+	Customers int64
+	Contacts  int64
+}
+
+// Purge 保持期間を過ぎたゴミ箱の行を完全に削除する。
+// リストの削除で顧客と架電履歴、連絡先、再架電も消えるため、先にリスト、次に個別に削除された顧客、最後に連絡先の順に消す
+func Purge(ctx context.Context, dbStore store.Store, retention time.Duration) (Result, error) {
+	var result Result
+	deletedBefore := time.Now().Add(-retention)
+	// 変更履歴に自動削除であることを残す
+	auditCtx := store.WithAudit(ctx, store.Audit{RPC: "trash.Purge"})
+	err := dbStore.ExecTx(auditCtx, func(q *db.Queries) error {
+		var err error
+		result.Books, err = q.PurgeBooks(auditCtx, deletedBefore)
+		if err != nil {
+			return err
+		}
+
+		result.Customers, err = q.PurgeCustomers(auditCtx, deletedBefore)
+		if err != nil {
+			return err
+		}
+
+		result.Contacts, err = q.PurgeContacts(auditCtx, deletedBefore)
+		return err
+	})
+	return result, err
+}
+
+// RunPurger interval ごとに Purge を実行する。全組織のゴミ箱を対象にするため、dbStore は BYPASSRLS のロールで接続する
+func RunPurger(ctx context.Context, dbStore store.Store, retention, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		result, err := Purge(ctx, dbStore, retention)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to purge trash")
+			continue
+		}
+		if result.Books+result.Customers+result.Contacts > 0 {
+			log.Info().
+				Int64("books", result.Books).
+				Int64("customers", result.Customers).
+				Int64("contacts", result.Contacts).
+				Msg("Purged trash")
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package trash
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	bookv1 "github.com/0utl1er-tech/prism-backend/gen/pb/book/v1"
+	customerv1 "github.com/0utl1er-tech/prism-backend/gen/pb/customer/v1"
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/0utl1er-tech/prism-backend/internal/dbtest"
+	"github.com/0utl1er-tech/prism-backend/internal/service"
+	"github.com/0utl1er-tech/prism-backend/internal/store"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestPurge(t *testing.T) {
+	dbStore, systemStore := dbtest.Open(t)
+	ctx, user := dbtest.CreateOrganization(t, systemStore)
+
+	deletedBook := createBook(t, ctx, dbStore)
+	bookCustomers := []uuid.UUID{
+		createCustomer(t, ctx, dbStore, deletedBook, user.ID),
+		createCustomer(t, ctx, dbStore, deletedBook, user.ID),
+	}
+	keptBook := createBook(t, ctx, dbStore)
+	deletedCustomer := createCustomer(t, ctx, dbStore, keptBook, user.ID)
+	keptCustomer := createCustomer(t, ctx, dbStore, keptBook, user.ID)
+
+	_, err := service.NewBookService(dbStore).DeleteBook(ctx, &bookv1.DeleteBookRequest{Id: deletedBook.String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = service.NewCustomerService(dbStore, nil, 1000).DeleteCustomer(ctx, &customerv1.DeleteCustomerRequest{Id: deletedCustomer.String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 保持期間を負にして、削除したばかりの行も対象にする
+	result, err := Purge(context.Background(), systemStore, -time.Minute)
+	if err != nil {
+		t.Fatalf("Purge() error = %v", err)
+	}
+	if result.Books < 1 || result.Customers < 1 {
+		t.Errorf("Purge() = %+v, want at least 1 book and 1 customer", result)
+	}
+
+	_, err = dbStore.GetDeletedBook(ctx, deletedBook)
+	if !errors.Is(err, pgx.ErrNoRows) {
+		t.Errorf("GetDeletedBook() error = %v, want %v", err, pgx.ErrNoRows)
+	}
+	for _, id := range append(bookCustomers, deletedCustomer) {
+		_, err = dbStore.GetDeletedCustomer(ctx, id)
+		if !errors.Is(err, pgx.ErrNoRows) {
+			t.Errorf("GetDeletedCustomer(%s) error = %v, want %v", id, err, pgx.ErrNoRows)
+		}
+	}
+
+	customer, err := dbStore.GetCustomer(ctx, keptCustomer)
+	if err != nil {
+		t.Fatalf("GetCustomer() error = %v", err)
+	}
+	if !customer.ContactID.Valid {
+		t.Error("GetCustomer() lost the contact of a customer that was not deleted")
+	}
+	_, err = dbStore.GetRedial(ctx, keptCustomer)
+	if err != nil {
+		t.Errorf("GetRedial() error = %v", err)
+	}
+}
+
+func createBook(t *testing.T, ctx context.Context, dbStore store.Store) uuid.UUID {
+	t.Helper()
+	book, err := dbStore.CreateBook(ctx, db.CreateBookParams{
+		ID:   uuid.New(),
+		Name: t.Name(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return book.ID
+}
+
+// createCustomer 担当者、代表番号、担当者の直通番号、再架電を持つ顧客を作成する
+func createCustomer(t *testing.T, ctx context.Context, dbStore store.Store, bookID, userID uuid.UUID) uuid.UUID {
+	t.Helper()
+	customerID := uuid.New()
+	err := dbStore.ExecTx(ctx, func(q *db.Queries) error {
+		pic, err := q.CreateStaff(ctx, db.CreateStaffParams{
+			ID:   uuid.New(),
+			Name: pgtype.Text{String: "担当者", Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+		_, err = q.CreateCustomer(ctx, db.CreateCustomerParams{
+			ID:           customerID,
+			BookID:       bookID,
+			Name:         "顧客",
+			Pic:          pgtype.UUID{Bytes: pic.ID, Valid: true},
+			CustomFields: []byte("{}"),
+		})
+		if err != nil {
+			return err
+		}
+		_, err = q.CreateContact(ctx, db.CreateContactParams{
+			ID:         customerID,
+			CustomerID: customerID,
+			Phone:      "0312345678",
+		})
+		if err != nil {
+			return err
+		}
+		_, err = q.CreateContact(ctx, db.CreateContactParams{
+			ID:         uuid.New(),
+			CustomerID: customerID,
+			StaffID:    pgtype.UUID{Bytes: pic.ID, Valid: true},
+			Phone:      "09012345678",
+		})
+		if err != nil {
+			return err
+		}
+		_, err = q.CreateRedial(ctx, db.CreateRedialParams{
+			ID:          customerID,
+			UserID:      userID,
+			ScheduledAt: time.Now().Add(time.Hour),
+		})
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return customerID
+}
@@ -1,6 +1,10 @@
 package util
 
 import (
+	"errors"
+	"reflect"
+	"time"
+
 	"github.com/spf13/viper"
 )
 
@@ -9,10 +13,54 @@ import (
 type Config struct {
 	Environment       string `mapstructure:"ENVIRONMENT"`
 	DBSource          string `mapstructure:"DB_SOURCE"`
+	AutoMigrate       bool   `mapstructure:"AUTO_MIGRATE"`
 	HTTPServerAddress string `mapstructure:"HTTP_SERVER_ADDRESS"`
 	GRPCServerAddress string `mapstructure:"GRPC_SERVER_ADDRESS"`
 
+	// MigrationDBSource connects as the owner of the schema to apply migrations.
+	// DBSource must use a role that is subject to row-level security.
+	MigrationDBSource string `mapstructure:"MIGRATION_DB_SOURCE"`
+	// SystemDBSource connects as a role with BYPASSRLS for background work
+	// across organizations, such as jobs, webhooks and the activity feed.
+	SystemDBSource string `mapstructure:"SYSTEM_DB_SOURCE"`
+
+	HealthCheckInterval time.Duration `mapstructure:"HEALTH_CHECK_INTERVAL"`
+	MetricsInterval     time.Duration `mapstructure:"METRICS_INTERVAL"`
+
+	// TraceExporter is one of none, stdout or otlp.
+	TraceExporter     string  `mapstructure:"TRACE_EXPORTER"`
+	TraceOTLPEndpoint string  `mapstructure:"TRACE_OTLP_ENDPOINT"`
+	TraceOTLPInsecure bool    `mapstructure:"TRACE_OTLP_INSECURE"`
+	TraceSampleRatio  float64 `mapstructure:"TRACE_SAMPLE_RATIO"`
+
 	DefaultUserEmail string `mapstructure:"DEFAULT_USER_EMAIL"`
+
+	// AuthProxySecret is shared with the authentication proxy. X-User-Id is
+	// trusted only when the request also carries it in X-Proxy-Secret.
+	AuthProxySecret string `mapstructure:"AUTH_PROXY_SECRET"`
+
+	DialLeaseDuration  time.Duration `mapstructure:"DIAL_LEASE_DURATION"`
+	RedialPollInterval time.Duration `mapstructure:"REDIAL_POLL_INTERVAL"`
+
+	TrashRetention     time.Duration `mapstructure:"TRASH_RETENTION"`
+	TrashPurgeInterval time.Duration `mapstructure:"TRASH_PURGE_INTERVAL"`
+
+	BatchMaxSize int `mapstructure:"BATCH_MAX_SIZE"`
+
+	JobWorkers       int           `mapstructure:"JOB_WORKERS"`
+	JobPollInterval  time.Duration `mapstructure:"JOB_POLL_INTERVAL"`
+	JobLeaseDuration time.Duration `mapstructure:"JOB_LEASE_DURATION"`
+	JobMaxAttempts   int           `mapstructure:"JOB_MAX_ATTEMPTS"`
+
+	WebhookPollInterval time.Duration `mapstructure:"WEBHOOK_POLL_INTERVAL"`
+	WebhookTimeout      time.Duration `mapstructure:"WEBHOOK_TIMEOUT"`
+	WebhookMaxAttempts  int           `mapstructure:"WEBHOOK_MAX_ATTEMPTS"`
+
+	IdempotencyTTL             time.Duration `mapstructure:"IDEMPOTENCY_TTL"`
+	IdempotencyCleanupInterval time.Duration `mapstructure:"IDEMPOTENCY_CLEANUP_INTERVAL"`
+	// IdempotencyStaleAfter must be longer than the longest RPC, otherwise a
+	// retry may run again while the first request is still in progress.
+	IdempotencyStaleAfter time.Duration `mapstructure:"IDEMPOTENCY_STALE_AFTER"`
 }
 
 // LoadConfig reads configuration from file or environment variables.
@@ -21,13 +69,55 @@ func LoadConfig(path string) (config Config, err error) {
 	viper.SetConfigName("app")
 	viper.SetConfigType("env")
 
+	viper.SetDefault("AUTO_MIGRATE", false)
+	viper.SetDefault("GRPC_SERVER_ADDRESS", "localhost:9090")
+	viper.SetDefault("HEALTH_CHECK_INTERVAL", 10*time.Second)
+	viper.SetDefault("METRICS_INTERVAL", 30*time.Second)
+	viper.SetDefault("TRACE_EXPORTER", "none")
+	viper.SetDefault("TRACE_OTLP_ENDPOINT", "localhost:4317")
+	viper.SetDefault("TRACE_OTLP_INSECURE", false)
+	viper.SetDefault("TRACE_SAMPLE_RATIO", 1.0)
+	viper.SetDefault("DIAL_LEASE_DURATION", 10*time.Minute)
+	viper.SetDefault("REDIAL_POLL_INTERVAL", 30*time.Second)
+	viper.SetDefault("TRASH_RETENTION", 30*24*time.Hour)
+	viper.SetDefault("TRASH_PURGE_INTERVAL", time.Hour)
+	viper.SetDefault("BATCH_MAX_SIZE", 1000)
+	viper.SetDefault("JOB_WORKERS", 4)
+	viper.SetDefault("JOB_POLL_INTERVAL", 2*time.Second)
+	viper.SetDefault("JOB_LEASE_DURATION", time.Minute)
+	viper.SetDefault("JOB_MAX_ATTEMPTS", 3)
+	viper.SetDefault("WEBHOOK_POLL_INTERVAL", 5*time.Second)
+	viper.SetDefault("WEBHOOK_TIMEOUT", 10*time.Second)
+	viper.SetDefault("WEBHOOK_MAX_ATTEMPTS", 10)
+	viper.SetDefault("IDEMPOTENCY_TTL", 24*time.Hour)
+	viper.SetDefault("IDEMPOTENCY_CLEANUP_INTERVAL", time.Hour)
+	viper.SetDefault("IDEMPOTENCY_STALE_AFTER", time.Minute)
+
 	viper.AutomaticEnv()
+	// Unmarshal only reads environment variables for keys viper already knows,
+	// so bind every field for deployments that have no config file.
+	fields := reflect.TypeOf(config)
+	for i := 0; i < fields.NumField(); i++ {
+		err = viper.BindEnv(fields.Field(i).Tag.Get("mapstructure"))
+		if err != nil {
+			return
+		}
+	}
 
+	// The config file is for local development and is not shipped in the image.
 	err = viper.ReadInConfig()
-	if err != nil {
+	var notFound viper.ConfigFileNotFoundError
+	if err != nil && !errors.As(err, &notFound) {
 		return
 	}
 
 	err = viper.Unmarshal(&config)
+	if err != nil {
+		return
+	}
+
+	if config.MigrationDBSource == "" {
+		config.MigrationDBSource = config.DBSource
+	}
 	return
 }
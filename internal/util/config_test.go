@@ -0,0 +1,26 @@
+package util
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLoadConfigWithoutFile(t *testing.T) {
+	t.Setenv("DB_SOURCE", "postgresql://prism_app@db/prism")
+	t.Setenv("AUTH_PROXY_SECRET", "secret")
+	t.Setenv("JOB_WORKERS", "8")
+
+	config, err := LoadConfig(t.TempDir())
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+	if config.DBSource != "postgresql://prism_app@db/prism" || config.AuthProxySecret != "secret" {
+		t.Errorf("LoadConfig() did not read environment variables: %+v", config)
+	}
+	if config.MigrationDBSource != config.DBSource {
+		t.Errorf("MigrationDBSource = %q, want DBSource", config.MigrationDBSource)
+	}
+	if config.JobWorkers != 8 || config.JobPollInterval != 2*time.Second {
+		t.Errorf("JobWorkers = %d, JobPollInterval = %s, want 8 and the default", config.JobWorkers, config.JobPollInterval)
+	}
+}
@@ -0,0 +1,32 @@
+package util
+
+import (
+	"strings"
+)
+
+// NormalizePhone 電話番号を数字のみの国内表記に揃える。
+// DB側の normalize_phone 関数と同じ結果になるようにすること。
+func NormalizePhone(phone string) string {
+	var b strings.Builder
+	for _, r := range phone {
+		switch {
+		case r >= '０' && r <= '９':
+			b.WriteRune('0' + (r - '０'))
+		case r == '＋':
+			b.WriteRune('+')
+		case r == '+' || (r >= '0' && r <= '9'):
+			b.WriteRune(r)
+		}
+	}
+
+	normalized := b.String()
+	if strings.HasPrefix(normalized, "+81") {
+		normalized = "0" + strings.TrimPrefix(normalized, "+81")
+	}
+	return strings.ReplaceAll(normalized, "+", "")
+}
+
+// IsValidPhone 正規化済みの電話番号として妥当な桁数かどうか
+func IsValidPhone(normalized string) bool {
+	return len(normalized) >= 10 && len(normalized) <= 15
+}
@@ -0,0 +1,45 @@
+package util
+
+import "testing"
+
+func TestNormalizePhone(t *testing.T) {
+	tests := []struct {
+		phone string
+		want  string
+	}{
+		{"03-1234-5678", "0312345678"},
+		{"(03) 1234 5678", "0312345678"},
+		{"０３－１２３４－５６７８", "0312345678"},
+		{"+81 90-1234-5678", "09012345678"},
+		{"＋８１９０１２３４５６７８", "09012345678"},
+		{"+1 212 555 0100", "12125550100"},
+		{"内線 123", "123"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		got := NormalizePhone(tt.phone)
+		if got != tt.want {
+			t.Errorf("NormalizePhone(%q) = %q, want %q", tt.phone, got, tt.want)
+		}
+	}
+}
+
+func TestIsValidPhone(t *testing.T) {
+	tests := []struct {
+		normalized string
+		want       bool
+	}{
+		{"0312345678", true},
+		{"09012345678", true},
+		{"123456789012345", true},
+		{"031234567", false},
+		{"1234567890123456", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		got := IsValidPhone(tt.normalized)
+		if got != tt.want {
+			t.Errorf("IsValidPhone(%q) = %t, want %t", tt.normalized, got, tt.want)
+		}
+	}
+}
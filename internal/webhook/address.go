@@ -0,0 +1,89 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// sharedAddressSpace キャリアグレードNATのアドレス。インターネットからは到達できない
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// AllowedAddress Webhook の送信先にできるアドレスか。
+// サーバー自身やクラスタ内部のサービス、クラウドのメタデータサービスにリクエストを送らせない
+func AllowedAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsGlobalUnicast() &&
+		!addr.IsPrivate() &&
+		!sharedAddressSpace.Contains(addr)
+}
+
+// CheckURL Webhook の送信先の URL を確認する。
+// ホスト名は名前解決したすべてのアドレスを確認する。送信時にも接続するアドレスを確認するため、
+// 名前解決できない場合はここではエラーにしない
+func CheckURL(ctx context.Context, raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New("must be an absolute http or https URL")
+	}
+
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return fmt.Errorf("must not point to %s", host)
+	}
+	if addr, err := netip.ParseAddr(host); err == nil {
+		if !AllowedAddress(addr) {
+			return errors.New("must not point to a loopback, private or link-local address")
+		}
+		return nil
+	}
+
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return nil
+	}
+	for _, addr := range addrs {
+		if !AllowedAddress(addr) {
+			return fmt.Errorf("%s resolves to a loopback, private or link-local address", host)
+		}
+	}
+	return nil
+}
+
+// newClient 接続する直前に名前解決したアドレスを確認するクライアント。
+// 登録後に名前解決の結果を内部のアドレスに変えられても送信しない
+func newClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+			if !AllowedAddress(addrPort.Addr()) {
+				return fmt.Errorf("webhook: connecting to %s is not allowed", addrPort.Addr())
+			}
+			return nil
+		},
+	}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			// プロキシを経由すると接続先のアドレスを確認できないため使わない
+			Proxy:                 nil,
+			DialContext:           dialer.DialContext,
+			ForceAttemptHTTP2:     true,
+			MaxIdleConns:          100,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ExpectContinueTimeout: time.Second,
+		},
+	}
+}
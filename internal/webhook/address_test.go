@@ -0,0 +1,56 @@
+package webhook
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+)
+
+func TestAllowedAddress(t *testing.T) {
+	tests := []struct {
+		addr string
+		want bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:2800:220:1::1", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.0.0.1", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false},
+		{"100.64.0.1", false},
+		{"0.0.0.0", false},
+		{"fd00::1", false},
+		{"fe80::1", false},
+		{"::ffff:127.0.0.1", false},
+	}
+	for _, tt := range tests {
+		if got := AllowedAddress(netip.MustParseAddr(tt.addr)); got != tt.want {
+			t.Errorf("AllowedAddress(%s) = %v, want %v", tt.addr, got, tt.want)
+		}
+	}
+}
+
+func TestCheckURL(t *testing.T) {
+	tests := []struct {
+		url     string
+		wantErr bool
+	}{
+		{"https://93.184.216.34/hook", false},
+		{"ftp://93.184.216.34/hook", true},
+		{"/hook", true},
+		{"http://localhost:8020/v1/books", true},
+		{"http://api.localhost/", true},
+		{"http://127.0.0.1:9090/", true},
+		{"http://[::1]/", true},
+		{"http://169.254.169.254/latest/meta-data/", true},
+		{"http://10.0.0.5/", true},
+	}
+	for _, tt := range tests {
+		err := CheckURL(context.Background(), tt.url)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("CheckURL(%q) error = %v, wantErr %v", tt.url, err, tt.wantErr)
+		}
+	}
+}
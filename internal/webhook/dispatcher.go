@@ -0,0 +1,184 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/0utl1er-tech/prism-backend/internal/store"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// 1回に振り分け・送信する件数
+	batchSize = 100
+	// 同時に送信する件数
+	concurrency = 8
+	// 再送の間隔の上限
+	maxRetryDelay = 6 * time.Hour
+)
+
+// Dispatcher OutboxEvent を Webhook ごとの送信に振り分けて送信する。
+// 全組織の配信をまとめて処理するため、store は BYPASSRLS のロールで接続する
+type Dispatcher struct {
+	store        store.Store
+	client       *http.Client
+	pollInterval time.Duration
+	timeout      time.Duration
+	maxAttempts  int32
+}
+
+func NewDispatcher(store store.Store, pollInterval, timeout time.Duration, maxAttempts int) *Dispatcher {
+	return &Dispatcher{
+		store:        store,
+		client:       newClient(timeout),
+		pollInterval: pollInterval,
+		timeout:      timeout,
+		maxAttempts:  int32(maxAttempts),
+	}
+}
+
+// Run ctx が終了するまで振り分けと送信を繰り返す
+func (dispatcher *Dispatcher) Run(ctx context.Context) error {
+	log.Info().Msg("Start webhook dispatcher")
+	for {
+		busy, err := dispatcher.dispatch(ctx)
+		if ctx.Err() != nil {
+			log.Info().Msg("Webhook dispatcher is stopped")
+			return nil
+		}
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to dispatch webhooks")
+		}
+		// 残りがある場合は待たずに続ける
+		if busy && err == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("Webhook dispatcher is stopped")
+			return nil
+		case <-time.After(dispatcher.pollInterval):
+		}
+	}
+}
+
+func (dispatcher *Dispatcher) dispatch(ctx context.Context) (bool, error) {
+	fanned, err := dispatcher.store.FanOutOutboxEvents(ctx, batchSize)
+	if err != nil {
+		return false, err
+	}
+
+	deliveries, err := dispatcher.store.ClaimWebhookDeliveries(ctx, db.ClaimWebhookDeliveriesParams{
+		// 送信中に停止した場合はタイムアウトの後に再送する
+		LockedUntil: time.Now().Add(2 * dispatcher.timeout),
+		RowLimit:    batchSize,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	group := &errgroup.Group{}
+	group.SetLimit(concurrency)
+	for _, delivery := range deliveries {
+		group.Go(func() error {
+			dispatcher.deliver(ctx, delivery)
+			return nil
+		})
+	}
+	_ = group.Wait()
+
+	return fanned == batchSize || len(deliveries) == batchSize, nil
+}
+
+func (dispatcher *Dispatcher) deliver(ctx context.Context, delivery db.ClaimWebhookDeliveriesRow) {
+	logger := log.With().
+		Str("delivery_id", delivery.ID.String()).
+		Str("event_type", delivery.EventType).
+		Int32("attempt", delivery.Attempts).
+		Logger()
+
+	statusCode, err := dispatcher.send(ctx, delivery)
+	// シャットダウン中でも結果を書き込めるようにする
+	recordCtx := context.WithoutCancel(ctx)
+	if err == nil {
+		err = dispatcher.store.MarkWebhookDelivered(recordCtx, db.MarkWebhookDeliveredParams{
+			StatusCode: int32(statusCode),
+			ID:         delivery.ID,
+		})
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to record webhook delivery")
+		}
+		return
+	}
+
+	logger.Warn().Err(err).Int("status_code", statusCode).Msg("Failed to deliver webhook")
+	err = dispatcher.store.MarkWebhookDeliveryFailed(recordCtx, db.MarkWebhookDeliveryFailedParams{
+		MaxAttempts:   dispatcher.maxAttempts,
+		NextAttemptAt: time.Now().Add(retryDelay(delivery.Attempts)),
+		StatusCode: pgtype.Int4{
+			Int32: int32(statusCode),
+			Valid: statusCode != 0,
+		},
+		Error: err.Error(),
+		ID:    delivery.ID,
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to record webhook failure")
+	}
+}
+
+// send 2xx 以外のレスポンスはエラーにする。レスポンスがない場合のステータスコードは0
+func (dispatcher *Dispatcher) send(ctx context.Context, delivery db.ClaimWebhookDeliveriesRow) (int, error) {
+	body, err := json.Marshal(Payload{
+		ID:         delivery.EventID,
+		Type:       delivery.EventType,
+		OccurredAt: delivery.EventCreatedAt,
+		Data:       delivery.Payload,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.Url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	now := time.Now()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, delivery.EventType)
+	req.Header.Set(HeaderDelivery, delivery.ID.String())
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(now.Unix(), 10))
+	req.Header.Set(HeaderSignature, Sign(delivery.Secret, now, body))
+
+	res, err := dispatcher.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	// レスポンスボディは送信先の内容を読み出す手段にならないよう残さない
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return res.StatusCode, fmt.Errorf("unexpected status %d", res.StatusCode)
+	}
+	_, _ = io.Copy(io.Discard, res.Body)
+	return res.StatusCode, nil
+}
+
+// retryDelay 30秒から倍々に延ばす
+func retryDelay(attempts int32) time.Duration {
+	delay := 30 * time.Second
+	for i := int32(1); i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRetryDelay)
+}
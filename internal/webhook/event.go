@@ -0,0 +1,56 @@
+// Package webhook OutboxEvent を登録された外部のエンドポイントに送信する
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// イベントの種類。OutboxEvent に書き込むトリガーと合わせる
+const (
+	EventCallEffective   = "call.effective"
+	EventCustomerCreated = "customer.created"
+)
+
+// EventTypes 購読できるイベントの種類
+var EventTypes = []string{
+	EventCallEffective,
+	EventCustomerCreated,
+}
+
+func IsEventType(eventType string) bool {
+	return slices.Contains(EventTypes, eventType)
+}
+
+// 送信するリクエストのヘッダー
+const (
+	HeaderEvent     = "X-Prism-Event"
+	HeaderDelivery  = "X-Prism-Delivery"
+	HeaderTimestamp = "X-Prism-Timestamp"
+	HeaderSignature = "X-Prism-Signature"
+)
+
+// Payload 送信するリクエストのボディ
+type Payload struct {
+	ID         uuid.UUID       `json:"id"`
+	Type       string          `json:"type"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// Sign "{timestamp}.{body}" の HMAC-SHA256 を "sha256=" に続けて16進数で返す。
+// 受信側はタイムスタンプも検証してリプレイを防ぐ
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
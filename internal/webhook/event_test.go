@@ -0,0 +1,28 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSign(t *testing.T) {
+	timestamp := time.Unix(1700000000, 0)
+	body := []byte(`{"type":"customer.created"}`)
+
+	// "1700000000." と body を whsec_test で HMAC-SHA256 した値
+	want := "sha256=382bc86b77b5209eddcf7a12854f8a09a6535b86f161334785573cf526fb694c"
+	if got := Sign("whsec_test", timestamp, body); got != want {
+		t.Errorf("Sign() = %s, want %s", got, want)
+	}
+
+	// タイムスタンプのタイムゾーンには依存しない
+	if got := Sign("whsec_test", timestamp.In(time.FixedZone("JST", 9*60*60)), body); got != want {
+		t.Errorf("Sign() in JST = %s, want %s", got, want)
+	}
+	if Sign("whsec_other", timestamp, body) == want {
+		t.Error("Sign() is the same for a different secret")
+	}
+	if Sign("whsec_test", timestamp.Add(time.Second), body) == want {
+		t.Error("Sign() is the same for a different timestamp")
+	}
+}
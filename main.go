@@ -6,17 +6,49 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"os/signal"
 	"syscall"
+	_ "time/tzdata"
 
+	activityv1 "github.com/0utl1er-tech/prism-backend/gen/pb/activity/v1"
+	auditv1 "github.com/0utl1er-tech/prism-backend/gen/pb/audit/v1"
+	bookv1 "github.com/0utl1er-tech/prism-backend/gen/pb/book/v1"
+	callv1 "github.com/0utl1er-tech/prism-backend/gen/pb/call/v1"
+	contactv1 "github.com/0utl1er-tech/prism-backend/gen/pb/contact/v1"
 	customerv1 "github.com/0utl1er-tech/prism-backend/gen/pb/customer/v1"
+	customfieldv1 "github.com/0utl1er-tech/prism-backend/gen/pb/customfield/v1"
+	dialqueuev1 "github.com/0utl1er-tech/prism-backend/gen/pb/dialqueue/v1"
+	dncv1 "github.com/0utl1er-tech/prism-backend/gen/pb/dnc/v1"
+	jobv1 "github.com/0utl1er-tech/prism-backend/gen/pb/job/v1"
+	notev1 "github.com/0utl1er-tech/prism-backend/gen/pb/note/v1"
+	organizationv1 "github.com/0utl1er-tech/prism-backend/gen/pb/organization/v1"
+	redialv1 "github.com/0utl1er-tech/prism-backend/gen/pb/redial/v1"
+	reportv1 "github.com/0utl1er-tech/prism-backend/gen/pb/report/v1"
+	staffv1 "github.com/0utl1er-tech/prism-backend/gen/pb/staff/v1"
+	tagv1 "github.com/0utl1er-tech/prism-backend/gen/pb/tag/v1"
+	timelinev1 "github.com/0utl1er-tech/prism-backend/gen/pb/timeline/v1"
+	trashv1 "github.com/0utl1er-tech/prism-backend/gen/pb/trash/v1"
+	webhookv1 "github.com/0utl1er-tech/prism-backend/gen/pb/webhook/v1"
 	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/0utl1er-tech/prism-backend/internal/activity"
+	"github.com/0utl1er-tech/prism-backend/internal/health"
+	"github.com/0utl1er-tech/prism-backend/internal/job"
+	"github.com/0utl1er-tech/prism-backend/internal/metrics"
+	"github.com/0utl1er-tech/prism-backend/internal/middleware"
 	"github.com/0utl1er-tech/prism-backend/internal/service"
+	"github.com/0utl1er-tech/prism-backend/internal/store"
+	"github.com/0utl1er-tech/prism-backend/internal/telemetry"
+	"github.com/0utl1er-tech/prism-backend/internal/trash"
 	"github.com/0utl1er-tech/prism-backend/internal/util"
+	"github.com/0utl1er-tech/prism-backend/internal/webhook"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
@@ -26,41 +58,308 @@ var interruptSignals = []os.Signal{
 	syscall.SIGINT,
 }
 
+// services gRPCサーバーとgatewayに登録するサービスの一覧
+type services struct {
+	customer     *service.CustomerService
+	contact      *service.ContactService
+	staff        *service.StaffService
+	dialQueue    *service.DialQueueService
+	call         *service.CallService
+	dnc          *service.DncService
+	book         *service.BookService
+	redial       *service.RedialService
+	report       *service.ReportService
+	activity     *service.ActivityService
+	audit        *service.AuditService
+	trash        *service.TrashService
+	note         *service.NoteService
+	timeline     *service.TimelineService
+	customField  *service.CustomFieldService
+	tag          *service.TagService
+	job          *service.JobService
+	webhook      *service.WebhookService
+	organization *service.OrganizationService
+	health       *health.Checker
+	metrics      *metrics.Metrics
+}
+
+// gatewayHandlers gatewayでRESTとして公開するサービス。
+// ActivityService はサーバーストリーミングのため /v1/activity/stream のSSEで配信する
+var gatewayHandlers = []struct {
+	desc     *grpc.ServiceDesc
+	register func(context.Context, *runtime.ServeMux, *grpc.ClientConn) error
+}{
+	{&customerv1.CustomerService_ServiceDesc, customerv1.RegisterCustomerServiceHandler},
+	{&contactv1.ContactService_ServiceDesc, contactv1.RegisterContactServiceHandler},
+	{&staffv1.StaffService_ServiceDesc, staffv1.RegisterStaffServiceHandler},
+	{&dialqueuev1.DialQueueService_ServiceDesc, dialqueuev1.RegisterDialQueueServiceHandler},
+	{&callv1.CallService_ServiceDesc, callv1.RegisterCallServiceHandler},
+	{&dncv1.DncService_ServiceDesc, dncv1.RegisterDncServiceHandler},
+	{&bookv1.BookService_ServiceDesc, bookv1.RegisterBookServiceHandler},
+	{&redialv1.RedialService_ServiceDesc, redialv1.RegisterRedialServiceHandler},
+	{&reportv1.ReportService_ServiceDesc, reportv1.RegisterReportServiceHandler},
+	{&auditv1.AuditService_ServiceDesc, auditv1.RegisterAuditServiceHandler},
+	{&trashv1.TrashService_ServiceDesc, trashv1.RegisterTrashServiceHandler},
+	{&notev1.NoteService_ServiceDesc, notev1.RegisterNoteServiceHandler},
+	{&timelinev1.TimelineService_ServiceDesc, timelinev1.RegisterTimelineServiceHandler},
+	{&customfieldv1.CustomFieldService_ServiceDesc, customfieldv1.RegisterCustomFieldServiceHandler},
+	{&tagv1.TagService_ServiceDesc, tagv1.RegisterTagServiceHandler},
+	{&jobv1.JobService_ServiceDesc, jobv1.RegisterJobServiceHandler},
+	{&webhookv1.WebhookService_ServiceDesc, webhookv1.RegisterWebhookServiceHandler},
+	{&organizationv1.OrganizationService_ServiceDesc, organizationv1.RegisterOrganizationServiceHandler},
+}
+
 func main() {
-	cfg, err := util.LoadConfig(".")
+	err := newRootCommand().Execute()
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to run command")
+	}
+}
+
+// runServer gRPCサーバーとgateway、バックグラウンド処理を起動する
+func runServer(cfg util.Config) error {
+	if cfg.AuthProxySecret == "" {
+		return errors.New("AUTH_PROXY_SECRET must be set to accept X-User-Id from the authentication proxy")
+	}
+	if cfg.AutoMigrate {
+		runDBMigration(context.Background(), cfg.MigrationDBSource)
+	}
+
+	shutdownTracing, err := telemetry.Setup(context.Background(), cfg)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := shutdownTracing(context.Background())
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to flush traces")
+		}
+	}()
+	log.Logger = log.Hook(telemetry.LogHook{})
+
+	connPool, err := newConnPool(context.Background(), cfg)
 	if err != nil {
-		log.Fatal().Err(err).Msg("Failed to load config")
+		return err
 	}
+	defer connPool.Close()
 
-	connPool, err := pgxpool.New(context.Background(), cfg.DBSource)
+	systemPool, err := newSystemConnPool(context.Background(), cfg)
 	if err != nil {
-		log.Fatal().Err(err).Msg("Failed to create connection pool")
+		return err
 	}
+	defer systemPool.Close()
+
+	checker, err := health.NewChecker(connPool)
+	if err != nil {
+		return err
+	}
+
+	dbStore := store.New(connPool)
+	// ジョブの取得、Webhookの配信、アクティビティの配信など組織をまたぐ処理とユーザーの認証に使う
+	systemStore := store.NewSystem(systemPool)
+	hub := activity.NewHub()
+	idempotency := service.NewIdempotency(dbStore, cfg.IdempotencyTTL, cfg.IdempotencyStaleAfter)
+	runner := job.NewRunner(systemStore, cfg.JobWorkers, cfg.JobPollInterval, cfg.JobLeaseDuration)
+	svc := &services{
+		customer:     service.NewCustomerService(dbStore, idempotency, cfg.BatchMaxSize),
+		contact:      service.NewContactService(dbStore),
+		staff:        service.NewStaffService(dbStore),
+		dialQueue:    service.NewDialQueueService(dbStore, cfg.DialLeaseDuration),
+		call:         service.NewCallService(dbStore, idempotency),
+		dnc:          service.NewDncService(dbStore, idempotency),
+		book:         service.NewBookService(dbStore),
+		redial:       service.NewRedialService(dbStore),
+		report:       service.NewReportService(dbStore),
+		activity:     service.NewActivityService(dbStore, hub),
+		audit:        service.NewAuditService(dbStore),
+		trash:        service.NewTrashService(dbStore, cfg.TrashRetention),
+		note:         service.NewNoteService(dbStore, idempotency),
+		timeline:     service.NewTimelineService(dbStore),
+		customField:  service.NewCustomFieldService(dbStore, idempotency),
+		tag:          service.NewTagService(dbStore),
+		job:          service.NewJobService(dbStore, idempotency, runner, cfg.JobMaxAttempts),
+		webhook:      service.NewWebhookService(dbStore, idempotency),
+		organization: service.NewOrganizationService(dbStore),
+		health:       checker,
+		metrics:      metrics.New(connPool, systemStore),
+	}
+	runner.Register(service.DncImportJobType, svc.dnc.RunImportJob)
+	runner.Register(service.CustomerImportJobType, svc.customer.RunImportJob)
+	runner.Register(service.CustomerExportJobType, svc.customer.RunExportJob)
+
+	ctx, stop := signal.NotifyContext(context.Background(), interruptSignals...)
+	defer stop()
+
+	waitGroup, ctx := errgroup.WithContext(ctx)
+	runHealthChecker(ctx, waitGroup, checker, &cfg)
+	runMetrics(ctx, waitGroup, svc.metrics, &cfg)
+	runActivityHub(ctx, waitGroup, hub, systemPool, systemStore, &cfg)
+	runTrashPurger(ctx, waitGroup, systemStore, &cfg)
+	runJobRunner(ctx, waitGroup, runner)
+	runWebhookDispatcher(ctx, waitGroup, systemStore, &cfg)
+	runIdempotencyCleanup(ctx, waitGroup, idempotency, &cfg)
+	runGrpcServer(ctx, waitGroup, svc, systemStore, &cfg)
+	runGatewayServer(ctx, waitGroup, svc, systemStore, &cfg)
+
+	return waitGroup.Wait()
+}
+
+// newConnPool 行レベルセキュリティで組織ごとにデータを分離するコネクションプールを作成する
+func newConnPool(ctx context.Context, cfg util.Config) (*pgxpool.Pool, error) {
+	return openPool(ctx, cfg.DBSource, store.CheckRole)
+}
 
-	queries := db.New(connPool)
-	customerService := service.NewCustomerService(queries)
+// newSystemConnPool 組織をまたぐ処理のため行レベルセキュリティを迂回するコネクションプールを作成する
+func newSystemConnPool(ctx context.Context, cfg util.Config) (*pgxpool.Pool, error) {
+	if cfg.SystemDBSource == "" {
+		return nil, errors.New("SYSTEM_DB_SOURCE must be set to run work across organizations")
+	}
+	return openPool(ctx, cfg.SystemDBSource, store.CheckSystemRole)
+}
 
-	waitGroup, ctx := errgroup.WithContext(context.Background())
-	// TODO: 引数がデカすぎるのでリファクタリングする
-	runGrpcServer(ctx, waitGroup, customerService, &cfg)
-	runGatewayServer(ctx, waitGroup, customerService, &cfg)
+func openPool(ctx context.Context, source string, checkRole func(context.Context, *pgxpool.Pool) error) (*pgxpool.Pool, error) {
+	poolConfig, err := pgxpool.ParseConfig(source)
+	if err != nil {
+		return nil, err
+	}
+	poolConfig.ConnConfig.Tracer = telemetry.NewQueryTracer()
+	connPool, err := pgxpool.NewWithConfig(ctx, poolConfig)
+	if err != nil {
+		return nil, err
+	}
 
-	err = waitGroup.Wait()
+	err = checkRole(ctx, connPool)
 	if err != nil {
-		log.Fatal().Err(err).Msg("Failed to wait")
+		connPool.Close()
+		return nil, err
 	}
+	return connPool, nil
+}
+
+func runHealthChecker(
+	ctx context.Context,
+	waitGroup *errgroup.Group,
+	checker *health.Checker,
+	cfg *util.Config,
+) {
+	waitGroup.Go(func() error {
+		return checker.Run(ctx, cfg.HealthCheckInterval)
+	})
+}
 
+func runMetrics(
+	ctx context.Context,
+	waitGroup *errgroup.Group,
+	serverMetrics *metrics.Metrics,
+	cfg *util.Config,
+) {
+	waitGroup.Go(func() error {
+		return serverMetrics.Run(ctx, cfg.MetricsInterval)
+	})
+}
+
+func runActivityHub(
+	ctx context.Context,
+	waitGroup *errgroup.Group,
+	hub *activity.Hub,
+	systemPool *pgxpool.Pool,
+	systemQueries db.Querier,
+	cfg *util.Config,
+) {
+	waitGroup.Go(func() error {
+		return hub.Listen(ctx, systemPool)
+	})
+
+	waitGroup.Go(func() error {
+		return hub.WatchDueRedials(ctx, systemQueries, cfg.RedialPollInterval)
+	})
+}
+
+func runTrashPurger(
+	ctx context.Context,
+	waitGroup *errgroup.Group,
+	systemStore store.Store,
+	cfg *util.Config,
+) {
+	waitGroup.Go(func() error {
+		return trash.RunPurger(ctx, systemStore, cfg.TrashRetention, cfg.TrashPurgeInterval)
+	})
+}
+
+func runJobRunner(
+	ctx context.Context,
+	waitGroup *errgroup.Group,
+	runner *job.Runner,
+) {
+	waitGroup.Go(func() error {
+		return runner.Run(ctx)
+	})
+}
+
+func runWebhookDispatcher(
+	ctx context.Context,
+	waitGroup *errgroup.Group,
+	systemStore store.Store,
+	cfg *util.Config,
+) {
+	dispatcher := webhook.NewDispatcher(systemStore, cfg.WebhookPollInterval, cfg.WebhookTimeout, cfg.WebhookMaxAttempts)
+	waitGroup.Go(func() error {
+		return dispatcher.Run(ctx)
+	})
+}
+
+func runIdempotencyCleanup(
+	ctx context.Context,
+	waitGroup *errgroup.Group,
+	idempotency *service.Idempotency,
+	cfg *util.Config,
+) {
+	waitGroup.Go(func() error {
+		return idempotency.RunCleanup(ctx, cfg.IdempotencyCleanupInterval)
+	})
 }
 
 func runGrpcServer(
 	ctx context.Context,
 	waitGroup *errgroup.Group,
-	customer *service.CustomerService,
+	svc *services,
+	systemQueries db.Querier,
 	cfg *util.Config,
 ) {
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(
+			svc.metrics.UnaryServerInterceptor(),
+			middleware.GrpcLoggerAdapter(),
+			middleware.OrganizationInterceptor(systemQueries, cfg.AuthProxySecret),
+		),
+		grpc.ChainStreamInterceptor(
+			svc.metrics.StreamServerInterceptor(),
+			middleware.OrganizationStreamInterceptor(systemQueries, cfg.AuthProxySecret),
+		),
+	)
 
-	customerv1.RegisterCustomerServiceServer(grpcServer, customer)
+	customerv1.RegisterCustomerServiceServer(grpcServer, svc.customer)
+	contactv1.RegisterContactServiceServer(grpcServer, svc.contact)
+	staffv1.RegisterStaffServiceServer(grpcServer, svc.staff)
+	dialqueuev1.RegisterDialQueueServiceServer(grpcServer, svc.dialQueue)
+	callv1.RegisterCallServiceServer(grpcServer, svc.call)
+	dncv1.RegisterDncServiceServer(grpcServer, svc.dnc)
+	bookv1.RegisterBookServiceServer(grpcServer, svc.book)
+	redialv1.RegisterRedialServiceServer(grpcServer, svc.redial)
+	reportv1.RegisterReportServiceServer(grpcServer, svc.report)
+	activityv1.RegisterActivityServiceServer(grpcServer, svc.activity)
+	auditv1.RegisterAuditServiceServer(grpcServer, svc.audit)
+	trashv1.RegisterTrashServiceServer(grpcServer, svc.trash)
+	notev1.RegisterNoteServiceServer(grpcServer, svc.note)
+	timelinev1.RegisterTimelineServiceServer(grpcServer, svc.timeline)
+	customfieldv1.RegisterCustomFieldServiceServer(grpcServer, svc.customField)
+	tagv1.RegisterTagServiceServer(grpcServer, svc.tag)
+	jobv1.RegisterJobServiceServer(grpcServer, svc.job)
+	webhookv1.RegisterWebhookServiceServer(grpcServer, svc.webhook)
+	organizationv1.RegisterOrganizationServiceServer(grpcServer, svc.organization)
+	healthpb.RegisterHealthServer(grpcServer, svc.health.Server())
+	svc.metrics.InitializeMetrics(grpcServer)
 
 	listener, err := net.Listen("tcp", cfg.GRPCServerAddress)
 	if err != nil {
@@ -81,6 +380,8 @@ func runGrpcServer(
 
 	waitGroup.Go(func() error {
 		<-ctx.Done()
+		// 停止を始める前にプローブとロードバランサーへ伝える
+		svc.health.Shutdown()
 		log.Info().Msg("Graceful shutdown gRPC server")
 		grpcServer.GracefulStop()
 		log.Info().Msg("gRPC server is stopped")
@@ -91,7 +392,8 @@ func runGrpcServer(
 func runGatewayServer(
 	ctx context.Context,
 	waitGroup *errgroup.Group,
-	customer *service.CustomerService,
+	svc *services,
+	systemQueries db.Querier,
 	cfg *util.Config,
 ) {
 	// grpc-ecosystemのmiddlewareを使用したServeMuxオプション
@@ -104,22 +406,54 @@ func runGatewayServer(
 				DiscardUnknown: true,
 			},
 		}),
+		middleware.IncomingHeaderMatcher(),
+		middleware.OutgoingHeaderMatcher(),
+		middleware.ErrorHandler(),
+		metrics.GatewayRouteAnnotator(),
+		telemetry.GatewaySpanAnnotator(),
 	}
 
-	serverMuxOptions := append(serveMuxOptions)
-	grpcMux := runtime.NewServeMux(serverMuxOptions...)
+	grpcMux := runtime.NewServeMux(serveMuxOptions...)
 
-	err := customerv1.RegisterCustomerServiceHandlerServer(ctx, grpcMux, customer)
+	// gRPCサーバーを経由させ、RESTにもgRPCと同じインターセプターを適用する
+	conn, err := grpc.NewClient(localAddress(cfg.GRPCServerAddress),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	)
 	if err != nil {
-		log.Fatal().Err(err).Msg("Failed to register customer service handler server")
+		log.Fatal().Err(err).Msg("Failed to create gRPC client for gateway")
+	}
+
+	serviceNames := make([]string, 0, len(gatewayHandlers))
+	for _, handler := range gatewayHandlers {
+		err = handler.register(ctx, grpcMux, conn)
+		if err != nil {
+			log.Fatal().Err(err).Msgf("Failed to register %s handler", handler.desc.ServiceName)
+		}
+		serviceNames = append(serviceNames, handler.desc.ServiceName)
+	}
+
+	swaggerHandler, err := newSwaggerHandler(serviceNames)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to load swagger spec")
 	}
 
 	mux := http.NewServeMux()
-	mux.Handle("/", grpcMux)
+	mux.Handle("/", svc.metrics.GatewayHandler(grpcMux))
+	mux.Handle("/swagger/", swaggerHandler)
+	// grpc-gatewayはサーバーストリーミングを中継できないためSSEで配信する
+	mux.Handle("/v1/activity/stream", middleware.OrganizationHandler(systemQueries, cfg.AuthProxySecret, svc.activity))
+	mux.HandleFunc("/healthz", svc.health.Liveness)
+	mux.HandleFunc("/readyz", svc.health.Readiness)
+	mux.Handle("/metrics", svc.metrics.Handler())
 
 	httpServer := &http.Server{
 		Addr:    cfg.HTTPServerAddress,
-		Handler: mux,
+		Handler: telemetry.GatewayHandler(mux),
+		// シャットダウン時にSSEの接続を終了させる
+		BaseContext: func(net.Listener) context.Context {
+			return ctx
+		},
 	}
 
 	waitGroup.Go(func() error {
@@ -137,6 +471,7 @@ func runGatewayServer(
 
 	waitGroup.Go(func() error {
 		<-ctx.Done()
+		svc.health.Shutdown()
 		log.Info().Msg("Graceful shutdown HTTP gateway server")
 		err := httpServer.Shutdown(context.Background())
 		if err != nil {
@@ -144,6 +479,18 @@ func runGatewayServer(
 			return err
 		}
 		log.Info().Msg("HTTP gateway server is stopped")
-		return nil
+		return conn.Close()
 	})
 }
+
+// localAddress 0.0.0.0:9090 のような待ち受けアドレスを接続先の localhost:9090 にする
+func localAddress(address string) string {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return address
+	}
+	if ip := net.ParseIP(host); host == "" || (ip != nil && ip.IsUnspecified()) {
+		host = "localhost"
+	}
+	return net.JoinHostPort(host, port)
+}
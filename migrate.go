@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/0utl1er-tech/prism-backend/db/migration"
+	"github.com/0utl1er-tech/prism-backend/internal/util"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+// newMigrateCommand Makefile の migrate CLI なしでコンテナ内からマイグレーションを実行する
+func newMigrateCommand(cfg *util.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Apply or roll back the embedded database migrations",
+	}
+
+	cmd.AddCommand(
+		&cobra.Command{
+			Use:   "up [N]",
+			Short: "Apply all or N pending migrations",
+			Args:  cobra.MaximumNArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				steps := 0
+				if len(args) > 0 {
+					var err error
+					steps, err = migrateSteps(args[0])
+					if err != nil {
+						return err
+					}
+				}
+				return withMigrator(cmd.Context(), cfg, func(migrator *migration.Migrator) error {
+					return migrator.Up(cmd.Context(), steps)
+				})
+			},
+		},
+		&cobra.Command{
+			Use:   "down N",
+			Short: "Roll back N migrations",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				steps, err := migrateSteps(args[0])
+				if err != nil {
+					return err
+				}
+				return withMigrator(cmd.Context(), cfg, func(migrator *migration.Migrator) error {
+					return migrator.Down(cmd.Context(), steps)
+				})
+			},
+		},
+		&cobra.Command{
+			Use:   "status",
+			Short: "Show the applied and latest versions",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return withMigrator(cmd.Context(), cfg, func(migrator *migration.Migrator) error {
+					return nil
+				})
+			},
+		},
+		&cobra.Command{
+			Use:   "force VERSION",
+			Short: "Record VERSION as applied and clear the dirty flag",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				version, err := strconv.Atoi(args[0])
+				if err != nil {
+					return fmt.Errorf("invalid version %q: %w", args[0], err)
+				}
+				return withMigrator(cmd.Context(), cfg, func(migrator *migration.Migrator) error {
+					return migrator.Force(cmd.Context(), version)
+				})
+			},
+		},
+	)
+	return cmd
+}
+
+// withMigrator fn を実行した後のマイグレーションの状態を出力する
+func withMigrator(ctx context.Context, cfg *util.Config, fn func(*migration.Migrator) error) error {
+	migrator, err := migration.New(cfg.MigrationDBSource)
+	if err != nil {
+		return err
+	}
+	defer migrator.Close()
+
+	err = fn(migrator)
+	if err != nil {
+		return err
+	}
+
+	status, err := migrator.Status()
+	if err != nil {
+		return err
+	}
+	fmt.Printf("version: %d\ndirty: %t\nlatest: %d\n", status.Version, status.Dirty, status.Latest)
+	return nil
+}
+
+func migrateSteps(arg string) (int, error) {
+	steps, err := strconv.Atoi(arg)
+	if err != nil || steps <= 0 {
+		return 0, fmt.Errorf("invalid number of migrations %q", arg)
+	}
+	return steps, nil
+}
+
+// runDBMigration AUTO_MIGRATE が有効な場合に起動時に未適用のマイグレーションを適用する
+func runDBMigration(ctx context.Context, dbSource string) {
+	migrator, err := migration.New(dbSource)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to create migrator")
+	}
+	defer migrator.Close()
+
+	err = migrator.Up(ctx, 0)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to run migrate up")
+	}
+
+	status, err := migrator.Status()
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to get migration status")
+	}
+	log.Info().Uint("version", status.Version).Msg("DB migrated successfully")
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/0utl1er-tech/prism-backend/internal/seed"
+	"github.com/0utl1er-tech/prism-backend/internal/store"
+	"github.com/0utl1er-tech/prism-backend/internal/util"
+	"github.com/spf13/cobra"
+)
+
+// newSeedCommand 開発環境に動作確認用の組織とデータを作成する
+func newSeedCommand(cfg *util.Config) *cobra.Command {
+	config := seed.Config{}
+
+	cmd := &cobra.Command{
+		Use:   "seed",
+		Short: "Generate a development organization with fake Japanese data",
+		Long: "Generate a new organization with users, statuses, categories, customer lists, customers, " +
+			"call history and redials. The same --seed produces the same IDs and data, " +
+			"so use a different seed to add another organization to the same database.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if config.OrganizationName == "" {
+				config.OrganizationName = fmt.Sprintf("開発用組織 %d", config.Seed)
+			}
+			return withStore(cmd.Context(), cfg, func(dbStore, systemStore store.Store) error {
+				result, err := seed.Run(cmd.Context(), dbStore, systemStore, config)
+				if err != nil {
+					return err
+				}
+				fmt.Printf("organization: %s\nowner: %s\nbooks: %d\ncustomers: %d\ncalls: %d\nredials: %d\n",
+					result.OrganizationID, result.OwnerID, result.Books, result.Customers, result.Calls, result.Redials)
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().Uint64Var(&config.Seed, "seed", 1, "random seed")
+	cmd.Flags().StringVar(&config.OrganizationName, "organization-name", "", "name of the generated organization")
+	cmd.Flags().IntVar(&config.Users, "users", 5, "number of users; the first one is the owner")
+	cmd.Flags().IntVar(&config.Books, "books", 3, "number of customer lists")
+	cmd.Flags().IntVar(&config.CustomersPerBook, "customers", 100, "number of customers per customer list")
+	cmd.Flags().IntVar(&config.MaxCallsPerCustomer, "max-calls", 5, "maximum number of calls per customer")
+	cmd.Flags().Float64Var(&config.RedialRate, "redial-rate", 0.15, "ratio of customers with a scheduled redial")
+	return cmd
+}
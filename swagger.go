@@ -0,0 +1,28 @@
+package main
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+
+	"github.com/0utl1er-tech/prism-backend/internal/swagger"
+)
+
+// swaggerUI ソースマップは配信しないため含めない
+//
+//go:embed gen/swagger/*.html gen/swagger/*.js gen/swagger/*.css gen/swagger/*.png
+var swaggerUI embed.FS
+
+// swaggerSpec make proto で全protoから生成した仕様
+//
+//go:embed docs/swagger/prism.swagger.json
+var swaggerSpec []byte
+
+// newSwaggerHandler /swagger/ で Swagger UI を、/swagger/prism.swagger.json で services の仕様を配信する
+func newSwaggerHandler(services []string) (http.Handler, error) {
+	ui, err := fs.Sub(swaggerUI, "gen/swagger")
+	if err != nil {
+		return nil, err
+	}
+	return swagger.Handler("/swagger/", ui, swaggerSpec, services)
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/0utl1er-tech/prism-backend/internal/store"
+	"github.com/0utl1er-tech/prism-backend/internal/trash"
+	"github.com/0utl1er-tech/prism-backend/internal/util"
+	"github.com/spf13/cobra"
+)
+
+func newTrashCommand(cfg *util.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trash",
+		Short: "Manage soft-deleted customer lists and customers",
+	}
+
+	var retention time.Duration
+	purge := &cobra.Command{
+		Use:   "purge",
+		Short: "Permanently delete trash older than the retention period in every organization",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			period := cfg.TrashRetention
+			if cmd.Flags().Changed("retention") {
+				period = retention
+			}
+			return withStore(cmd.Context(), cfg, func(_, systemStore store.Store) error {
+				result, err := trash.Purge(cmd.Context(), systemStore, period)
+				if err != nil {
+					return err
+				}
+				fmt.Printf("books: %d\ncustomers: %d\ncontacts: %d\n", result.Books, result.Customers, result.Contacts)
+				return nil
+			})
+		},
+	}
+	purge.Flags().DurationVar(&retention, "retention", 0, "override TRASH_RETENTION, e.g. 0s to purge everything")
+
+	cmd.AddCommand(purge)
+	return cmd
+}
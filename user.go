@@ -0,0 +1,113 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	db "github.com/0utl1er-tech/prism-backend/gen/sqlc"
+	"github.com/0utl1er-tech/prism-backend/internal/store"
+	"github.com/0utl1er-tech/prism-backend/internal/util"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+func newUserCommand(cfg *util.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "user",
+		Short: "Manage users",
+	}
+	cmd.AddCommand(newUserCreateCommand(cfg))
+	return cmd
+}
+
+// newUserCreateCommand 最初のオーナーなど、RPCを呼べるユーザーがいない状態でユーザーを作成する
+func newUserCreateCommand(cfg *util.Config) *cobra.Command {
+	var (
+		id               string
+		email            string
+		name             string
+		role             string
+		organizationID   string
+		organizationName string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a user in an existing or new organization",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			userID, err := uuid.Parse(id)
+			if err != nil {
+				return fmt.Errorf("invalid --id: %w", err)
+			}
+			userRole := db.Role(role)
+			switch userRole {
+			case db.RoleOwner, db.RoleEditor, db.RoleViewer:
+			default:
+				return fmt.Errorf("invalid --role %q", role)
+			}
+			if (organizationID == "") == (organizationName == "") {
+				return errors.New("specify either --organization or --organization-name")
+			}
+			var orgID uuid.UUID
+			if organizationID != "" {
+				orgID, err = uuid.Parse(organizationID)
+				if err != nil {
+					return fmt.Errorf("invalid --organization: %w", err)
+				}
+			}
+
+			// 組織と最初のユーザーは組織に属するユーザーがいない状態で作るため、組織をまたぐロールで作成する
+			return withStore(cmd.Context(), cfg, func(_, systemStore store.Store) error {
+				// RPCを経由しないため変更履歴にコマンド名を残す
+				ctx := store.WithAudit(cmd.Context(), store.Audit{RPC: "cli.user.create"})
+				if organizationName == "" {
+					ctx = store.WithOrganization(ctx, orgID)
+				}
+
+				var user db.User
+				err := systemStore.ExecTx(ctx, func(q *db.Queries) error {
+					if organizationName != "" {
+						organization, err := q.CreateOrganization(ctx, db.CreateOrganizationParams{
+							ID:   uuid.New(),
+							Name: organizationName,
+						})
+						if err != nil {
+							return err
+						}
+						orgID = organization.ID
+					} else if _, err := q.GetOrganization(ctx, orgID); err != nil {
+						return fmt.Errorf("organization %s: %w", orgID, err)
+					}
+
+					var err error
+					user, err = q.CreateOrganizationUser(ctx, db.CreateOrganizationUserParams{
+						ID:             userID,
+						Email:          email,
+						Name:           name,
+						Role:           userRole,
+						OrganizationID: orgID,
+					})
+					return err
+				})
+				if err != nil {
+					return err
+				}
+
+				fmt.Printf("user: %s\norganization: %s\nrole: %s\n", user.ID, user.OrganizationID, user.Role)
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&id, "id", "", "user ID forwarded as X-User-Id by the authentication proxy")
+	cmd.Flags().StringVar(&email, "email", "", "email address")
+	cmd.Flags().StringVar(&name, "name", "", "display name")
+	cmd.Flags().StringVar(&role, "role", string(db.RoleOwner), "owner, editor or viewer")
+	cmd.Flags().StringVar(&organizationID, "organization", "", "ID of an existing organization")
+	cmd.Flags().StringVar(&organizationName, "organization-name", "", "name of a new organization to create")
+	cmd.MarkFlagRequired("id")
+	cmd.MarkFlagRequired("email")
+	cmd.MarkFlagsMutuallyExclusive("organization", "organization-name")
+	return cmd
+}